@@ -11,26 +11,31 @@ import (
 
 	"github.com/isutare412/imageer/internal/gateway/config"
 	"github.com/isutare412/imageer/internal/gateway/crypt"
+	"github.com/isutare412/imageer/internal/gateway/jwks"
 	"github.com/isutare412/imageer/internal/gateway/jwt"
 	"github.com/isutare412/imageer/internal/gateway/kafka"
 	"github.com/isutare412/imageer/internal/gateway/kubernetes"
+	"github.com/isutare412/imageer/internal/gateway/localfs"
 	"github.com/isutare412/imageer/internal/gateway/oidc"
 	"github.com/isutare412/imageer/internal/gateway/port"
 	"github.com/isutare412/imageer/internal/gateway/postgres"
-	"github.com/isutare412/imageer/internal/gateway/s3"
+	"github.com/isutare412/imageer/internal/gateway/service/audit"
 	"github.com/isutare412/imageer/internal/gateway/service/auth"
 	"github.com/isutare412/imageer/internal/gateway/service/image"
 	"github.com/isutare412/imageer/internal/gateway/service/project"
 	"github.com/isutare412/imageer/internal/gateway/service/serviceaccount"
 	"github.com/isutare412/imageer/internal/gateway/service/user"
+	"github.com/isutare412/imageer/internal/gateway/service/watermark"
 	"github.com/isutare412/imageer/internal/gateway/sqs"
 	"github.com/isutare412/imageer/internal/gateway/valkey"
+	"github.com/isutare412/imageer/internal/gateway/webhook"
 	"github.com/isutare412/imageer/internal/gateway/webv2"
 )
 
 type application struct {
 	webServer           *webv2.Server
 	imageUploadListener *sqs.ImageUploadListener
+	usageTracker        *serviceaccount.UsageTracker
 	postgresClient      *postgres.Client
 	valkeyClient        *valkey.Client
 	kafkaClient         *kafka.Client
@@ -43,10 +48,10 @@ type application struct {
 func newApplication(cfg config.Config) (*application, error) {
 	defer logDuration("Application creation")()
 
-	slog.Info("Create OIDC Google client")
-	oidcProvider, err := oidc.NewGoogleClient(cfg.ToOIDCGoogleClientConfig())
+	slog.Info("Create OIDC router")
+	oidcProvider, err := oidc.NewRouter(cfg.ToOIDCRouterConfig())
 	if err != nil {
-		return nil, fmt.Errorf("creating oidc google client: %w", err)
+		return nil, fmt.Errorf("creating oidc router: %w", err)
 	}
 
 	slog.Info("Create AES crypter")
@@ -67,16 +72,10 @@ func newApplication(cfg config.Config) (*application, error) {
 		return nil, fmt.Errorf("creating jwt verifier: %w", err)
 	}
 
-	slog.Info("Create s3 presigner")
-	s3Presigner, err := s3.NewPresigner(cfg.ToS3PresignerConfig())
+	slog.Info("Create storage router")
+	storageRouter, localStorages, err := newStorageRouter(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("creating s3 presigner: %w", err)
-	}
-
-	slog.Info("Create s3 object storage")
-	s3ObjectStorage, err := s3.NewObjectStorage(cfg.ToS3ObjectStorageConfig())
-	if err != nil {
-		return nil, fmt.Errorf("creating s3 object storage: %w", err)
+		return nil, fmt.Errorf("creating storage router: %w", err)
 	}
 
 	slog.Info("Create repository client")
@@ -94,9 +93,18 @@ func newApplication(cfg config.Config) (*application, error) {
 	slog.Info("Create service account repository")
 	serviceAccountRepo := postgres.NewServiceAccountRepository(postgresClient)
 
+	slog.Info("Create service account API key repository")
+	serviceAccountAPIKeyRepo := postgres.NewServiceAccountAPIKeyRepository(postgresClient)
+
 	slog.Info("Create project repository")
 	projectRepo := postgres.NewProjectRepository(postgresClient)
 
+	slog.Info("Create project deletion repository")
+	projectDeletionRepo := postgres.NewProjectDeletionRepository(postgresClient)
+
+	slog.Info("Create project member repository")
+	projectMemberRepo := postgres.NewProjectMemberRepository(postgresClient)
+
 	slog.Info("Create image repository")
 	imageRepo := postgres.NewImageRepository(postgresClient)
 
@@ -109,6 +117,12 @@ func newApplication(cfg config.Config) (*application, error) {
 	slog.Info("Create preset repository")
 	presetRepo := postgres.NewPresetRepository(postgresClient)
 
+	slog.Info("Create watermark repository")
+	watermarkRepo := postgres.NewWatermarkRepository(postgresClient)
+
+	slog.Info("Create audit entry repository")
+	auditEntryRepo := postgres.NewAuditEntryRepository(postgresClient)
+
 	slog.Info("Create valkey client")
 	valkeyClient, err := valkey.NewClient(cfg.ToValkeyClientConfig())
 	if err != nil {
@@ -141,38 +155,86 @@ func newApplication(cfg config.Config) (*application, error) {
 	imageProcDoneSubscriber := valkey.NewImageProcessDoneSubscriber(
 		cfg.ToValkeyImageProcessDoneSubscriberConfig(), valkeyClient)
 
+	slog.Info("Create valkey session store")
+	sessionStore := valkey.NewSessionStore(cfg.ToValkeySessionStoreConfig(), valkeyClient)
+
+	slog.Info("Create audit service")
+	auditSvc := audit.NewService(auditEntryRepo)
+
 	slog.Info("Create auth service")
 	authSvc := auth.NewService(cfg.ToAuthServiceConfig(), oidcProvider,
-		aesCrypter, jwtSigner, jwtVerifier, userRepo)
+		aesCrypter, jwtSigner, jwtVerifier, userRepo, projectMemberRepo, sessionStore,
+		auditSvc)
+
+	slog.Info("Create service account usage tracker")
+	usageTracker := serviceaccount.NewUsageTracker(
+		cfg.ToServiceAccountUsageTrackerConfig(), transactioner, serviceAccountRepo,
+		serviceAccountAPIKeyRepo)
+
+	var workloadTokenVerifier port.WorkloadTokenVerifier
+	if cfg.Auth.ServiceAccount.WorkloadToken.Enabled {
+		slog.Info("Create workload token verifier")
+		workloadTokenVerifier, err = jwks.NewVerifier(cfg.ToWorkloadTokenVerifierConfig())
+		if err != nil {
+			return nil, fmt.Errorf("creating workload token verifier: %w", err)
+		}
+	}
 
 	slog.Info("Create service account service")
-	serviceAccountSvc := serviceaccount.NewService(serviceAccountRepo)
+	serviceAccountSvc := serviceaccount.NewService(cfg.ToServiceAccountServiceConfig(),
+		transactioner, serviceAccountRepo, serviceAccountAPIKeyRepo, auditSvc, usageTracker,
+		workloadTokenVerifier)
 
 	slog.Info("Create project service")
-	projectSvc := project.NewService(projectRepo)
+	projectSvc := project.NewService(cfg.ToProjectServiceConfig(), transactioner, projectRepo,
+		projectDeletionRepo, projectMemberRepo, userRepo, watermarkRepo, auditSvc)
 
 	slog.Info("Create user service")
-	userSvc := user.NewService(userRepo)
+	userSvc := user.NewService(transactioner, userRepo, sessionStore, auditSvc)
 
 	slog.Info("Create image service")
-	imageSvc := image.NewService(cfg.ToImageServiceConfig(), s3Presigner, s3ObjectStorage,
-		transactioner, imageRepo, imageVarRepo, imageProcLogRepo, presetRepo, imageProcRequestQueue,
-		imageNotificationPublisher, imageUploadDoneSubscriber, imageProcDoneSubscriber,
-		imageS3DeleteRequestQueue)
+	imageSvc := image.NewService(cfg.ToImageServiceConfig(), storageRouter, storageRouter,
+		transactioner, imageRepo, imageVarRepo, imageProcLogRepo, presetRepo, projectRepo,
+		watermarkRepo, imageProcRequestQueue, imageNotificationPublisher, imageUploadDoneSubscriber,
+		imageProcDoneSubscriber, imageS3DeleteRequestQueue, auditSvc)
+
+	slog.Info("Create watermark service")
+	watermarkSvc := watermark.NewService(cfg.ToWatermarkServiceConfig(), storageRouter,
+		storageRouter, transactioner, projectRepo, watermarkRepo, auditSvc)
+
+	var rawRoutes []webv2.RawRoute
+	if len(localStorages) > 0 {
+		slog.Info("Create local storage handler")
+		rawRoutes = append(rawRoutes, webv2.RawRoute{
+			PathPrefix: "/storage",
+			Handler:    localfs.NewHandler(localStorages, imageSvc),
+		})
+	}
+	if cfg.Web.UploadEventWebhook.Enabled {
+		slog.Info("Create upload event webhook handler")
+		rawRoutes = append(rawRoutes, webv2.RawRoute{
+			PathPrefix: "/webhooks/upload-events",
+			Handler: webhook.NewUploadEventHandler(
+				cfg.ToWebhookUploadEventHandlerConfig(), imageSvc),
+		})
+	}
 
 	slog.Info("Create web server")
 	healthCheckers := []port.HealthChecker{postgresClient, valkeyClient}
 	webServer, err := webv2.NewServer(cfg.ToWebV2Config(), healthCheckers, authSvc,
-		serviceAccountSvc, projectSvc, userSvc, imageSvc)
+		serviceAccountSvc, projectSvc, userSvc, imageSvc, watermarkSvc, auditSvc, rawRoutes)
 	if err != nil {
 		return nil, fmt.Errorf("creating web server: %w", err)
 	}
 
-	slog.Info("Create SQS image upload listener")
-	imageUploadListener, err := sqs.NewImageUploadListener(cfg.ToSQSImageUploadListenerConfig(),
-		imageSvc)
-	if err != nil {
-		return nil, fmt.Errorf("creating SQS image upload listener: %w", err)
+	var imageUploadListener *sqs.ImageUploadListener
+	if cfg.AWS.SQS.Enabled {
+		slog.Info("Create SQS image upload listener")
+		imageUploadListener, err = sqs.NewImageUploadListener(
+			cfg.ToSQSImageUploadListenerConfig(), imageSvc)
+		if err != nil {
+			return nil, fmt.Errorf("creating SQS image upload listener: %w", err)
+		}
 	}
 
 	slog.Info("Create Kafka image process result handler")
@@ -183,21 +245,58 @@ func newApplication(cfg config.Config) (*application, error) {
 	imageS3DeleteRequestHandler := kafka.NewImageS3DeleteRequestHandler(
 		cfg.ToKafkaImageS3DeleteRequestHandlerConfig(), imageSvc)
 
-	slog.Info("Create Kafka consumer")
-	kafkaConsumer := kafka.NewConsumer(kafkaClient, map[string]kafka.Handler{
+	slog.Info("Create Kafka image upload event handler")
+	imageUploadEventHandler := kafka.NewImageUploadEventHandler(
+		cfg.ToKafkaImageUploadEventHandlerConfig(), imageSvc)
+
+	kafkaHandlers := map[string]kafka.Handler{
 		cfg.Kafka.Topics.ImageProcessResult.Topic:        imageProcessResultHandler,
 		cfg.Kafka.Topics.ImageProcessResult.RetryTopic:   imageProcessResultHandler,
 		cfg.Kafka.Topics.ImageS3DeleteRequest.Topic:      imageS3DeleteRequestHandler,
 		cfg.Kafka.Topics.ImageS3DeleteRequest.RetryTopic: imageS3DeleteRequestHandler,
-	})
+	}
+	if cfg.Kafka.Topics.ImageUploadEvent.Enabled {
+		kafkaHandlers[cfg.Kafka.Topics.ImageUploadEvent.Topic] = imageUploadEventHandler
+		kafkaHandlers[cfg.Kafka.Topics.ImageUploadEvent.RetryTopic] = imageUploadEventHandler
+	}
+
+	slog.Info("Create Kafka consumer")
+	kafkaConsumer := kafka.NewConsumer(kafkaClient, kafkaHandlers)
 	imageProcessResultHandler.SetConsumer(kafkaConsumer)
 	imageS3DeleteRequestHandler.SetConsumer(kafkaConsumer)
+	imageUploadEventHandler.SetConsumer(kafkaConsumer)
 
 	slog.Info("Create image closer")
 	imageCloser := image.NewCloser(cfg.ToImageCloserConfig(), transactioner, imageRepo,
 		imageVarRepo)
 
-	handlers := []port.LeaderHandler{imageCloser}
+	slog.Info("Create image lifecycler")
+	imageLifecycler := image.NewLifecycler(cfg.ToImageLifecyclerConfig(), transactioner,
+		storageRouter, projectRepo, imageRepo, imageS3DeleteRequestQueue)
+
+	slog.Info("Create image purger")
+	imagePurger := image.NewPurger(cfg.ToImagePurgerConfig(), projectRepo, projectDeletionRepo,
+		imageRepo, watermarkRepo, imageS3DeleteRequestQueue)
+
+	slog.Info("Create audit purger")
+	auditPurger := audit.NewPurger(cfg.ToAuditPurgerConfig(), auditEntryRepo)
+
+	slog.Info("Create stale service account disabler")
+	staleServiceAccountDisabler := serviceaccount.NewStaleDisabler(
+		cfg.ToServiceAccountStaleDisablerConfig(), transactioner, serviceAccountRepo, auditSvc)
+
+	handlers := []port.LeaderHandler{imageCloser, imageLifecycler, imagePurger, auditPurger,
+		staleServiceAccountDisabler}
+	if cfg.Service.Image.UploadSweep.Enabled {
+		slog.Info("Create image upload sweeper")
+		handlers = append(handlers, image.NewUploadSweeper(cfg.ToImageUploadSweeperConfig(),
+			imageRepo, imageSvc))
+	}
+	if cfg.Service.Image.Reconcile.Enabled {
+		slog.Info("Create image reconciler")
+		handlers = append(handlers, image.NewReconciler(cfg.ToImageReconcilerConfig(),
+			storageRouter, projectRepo, imageRepo, imageVarRepo, watermarkRepo))
+	}
 
 	var elector leaderElector
 	if cfg.Kubernetes.Enabled {
@@ -221,6 +320,7 @@ func newApplication(cfg config.Config) (*application, error) {
 	return &application{
 		webServer:           webServer,
 		imageUploadListener: imageUploadListener,
+		usageTracker:        usageTracker,
 		postgresClient:      postgresClient,
 		valkeyClient:        valkeyClient,
 		kafkaClient:         kafkaClient,
@@ -248,8 +348,13 @@ func (a *application) initialize() error {
 }
 
 func (a *application) run() {
-	slog.Info("Run image upload listener")
-	a.imageUploadListener.Run()
+	if a.imageUploadListener != nil {
+		slog.Info("Run image upload listener")
+		a.imageUploadListener.Run()
+	}
+
+	slog.Info("Run service account usage tracker")
+	a.usageTracker.Run()
 
 	slog.Info("Run Kafka consumer")
 	a.kafkaConsumer.Run()
@@ -281,8 +386,13 @@ func (a *application) shutdown() {
 	slog.Info("Shutdown kubernetes leader elector")
 	a.leaderElector.Shutdown()
 
-	slog.Info("Shutdown image upload listener")
-	a.imageUploadListener.Shutdown()
+	if a.imageUploadListener != nil {
+		slog.Info("Shutdown image upload listener")
+		a.imageUploadListener.Shutdown()
+	}
+
+	slog.Info("Shutdown service account usage tracker")
+	a.usageTracker.Shutdown()
 
 	slog.Info("Shutdown Kafka consumer")
 	a.kafkaConsumer.Shutdown()
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/internal/gateway/azblob"
+	"github.com/isutare412/imageer/internal/gateway/config"
+	"github.com/isutare412/imageer/internal/gateway/gcs"
+	"github.com/isutare412/imageer/internal/gateway/localfs"
+	"github.com/isutare412/imageer/internal/gateway/s3"
+	"github.com/isutare412/imageer/internal/gateway/storage"
+)
+
+// newStorageRouter creates backends of all storage profiles. Local storages
+// are returned as well to be served by the web server.
+func newStorageRouter(cfg config.Config) (*storage.Router, []*localfs.Storage, error) {
+	profiles := lo.Keys(cfg.Storage.Profiles)
+	slices.Sort(profiles)
+
+	backends := make(map[string]storage.Backend, len(profiles))
+	var localStorages []*localfs.Storage
+	for _, profile := range profiles {
+		profileCfg := cfg.Storage.Profiles[profile]
+		slog.Info("Create storage backend", "profile", profile, "type", profileCfg.Type)
+
+		switch profileCfg.Type {
+		case config.StorageTypeS3:
+			presigner, err := s3.NewPresigner(cfg.ToS3PresignerConfig(profile))
+			if err != nil {
+				return nil, nil, fmt.Errorf("creating s3 presigner of %s: %w", profile, err)
+			}
+
+			objectStorage, err := s3.NewObjectStorage(cfg.ToS3ObjectStorageConfig(profile))
+			if err != nil {
+				return nil, nil, fmt.Errorf("creating s3 object storage of %s: %w", profile, err)
+			}
+
+			backends[profile] = storage.Backend{Presigner: presigner, ObjectStorage: objectStorage}
+
+		case config.StorageTypeGCS:
+			gcsStorage, err := gcs.NewStorage(cfg.ToGCSStorageConfig(profile))
+			if err != nil {
+				return nil, nil, fmt.Errorf("creating gcs storage of %s: %w", profile, err)
+			}
+
+			backends[profile] = storage.Backend{Presigner: gcsStorage, ObjectStorage: gcsStorage}
+
+		case config.StorageTypeAzure:
+			azStorage, err := azblob.NewStorage(cfg.ToAzblobStorageConfig(profile))
+			if err != nil {
+				return nil, nil, fmt.Errorf("creating azure blob storage of %s: %w", profile, err)
+			}
+
+			backends[profile] = storage.Backend{Presigner: azStorage, ObjectStorage: azStorage}
+
+		case config.StorageTypeLocal:
+			localStorage, err := localfs.NewStorage(cfg.ToLocalFSStorageConfig(profile))
+			if err != nil {
+				return nil, nil, fmt.Errorf("creating local storage of %s: %w", profile, err)
+			}
+
+			backends[profile] = storage.Backend{Presigner: localStorage, ObjectStorage: localStorage}
+			localStorages = append(localStorages, localStorage)
+
+		default:
+			return nil, nil, fmt.Errorf("unexpected storage type %s of %s", profileCfg.Type, profile)
+		}
+	}
+
+	router, err := storage.NewRouter(cfg.Storage.DefaultProfile, backends)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating storage router: %w", err)
+	}
+	return router, localStorages, nil
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/isutare412/imageer/internal/processor/config"
 	"github.com/isutare412/imageer/internal/processor/image"
 	"github.com/isutare412/imageer/internal/processor/kafka"
-	"github.com/isutare412/imageer/internal/processor/s3"
 	imagesvc "github.com/isutare412/imageer/internal/processor/service/image"
 	"github.com/isutare412/imageer/internal/processor/web"
 )
@@ -26,12 +25,15 @@ func newApplication(cfg config.Config) (*application, error) {
 	defer logDuration("Application creation")()
 
 	slog.Info("Create image processor")
-	imageProcessor := image.NewProcessor()
+	imageProcessor, err := image.NewProcessor(cfg.ToImageProcessorConfig())
+	if err != nil {
+		return nil, fmt.Errorf("creating image processor: %w", err)
+	}
 
-	slog.Info("Create S3 object storage")
-	objectStorage, err := s3.NewObjectStorage(cfg.ToS3ObjectStorageConfig())
+	slog.Info("Create storage router")
+	objectStorage, err := newStorageRouter(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("creating s3 object storage: %w", err)
+		return nil, fmt.Errorf("creating storage router: %w", err)
 	}
 
 	slog.Info("Create Kafka client")
@@ -45,7 +47,8 @@ func newApplication(cfg config.Config) (*application, error) {
 		cfg.ToKafkaImageProcessResultQueueConfig(), kafkaClient)
 
 	slog.Info("Create image service")
-	imageService := imagesvc.NewService(imageProcessor, objectStorage, imageProcessResultQueue)
+	imageService := imagesvc.NewService(cfg.ToImageServiceConfig(), imageProcessor, objectStorage,
+		imageProcessResultQueue)
 
 	slog.Info("Create Kafka image process request handler")
 	imageProcessRequestHandler := kafka.NewImageProcessRequestHandler(
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/internal/processor/azblob"
+	"github.com/isutare412/imageer/internal/processor/config"
+	"github.com/isutare412/imageer/internal/processor/gcs"
+	"github.com/isutare412/imageer/internal/processor/localfs"
+	"github.com/isutare412/imageer/internal/processor/s3"
+	"github.com/isutare412/imageer/internal/processor/storage"
+)
+
+// newStorageRouter creates object storages of all storage profiles.
+func newStorageRouter(cfg config.Config) (*storage.Router, error) {
+	profiles := lo.Keys(cfg.Storage.Profiles)
+	slices.Sort(profiles)
+
+	backends := make(map[string]storage.Backend, len(profiles))
+	for _, profile := range profiles {
+		profileCfg := cfg.Storage.Profiles[profile]
+		slog.Info("Create object storage", "profile", profile, "type", profileCfg.Type)
+
+		var (
+			backend storage.Backend
+			err     error
+		)
+		switch profileCfg.Type {
+		case config.StorageTypeS3:
+			backend, err = s3.NewObjectStorage(cfg.ToS3ObjectStorageConfig(profile))
+		case config.StorageTypeGCS:
+			backend, err = gcs.NewObjectStorage(cfg.ToGCSObjectStorageConfig(profile))
+		case config.StorageTypeAzure:
+			backend, err = azblob.NewObjectStorage(cfg.ToAzblobObjectStorageConfig(profile))
+		case config.StorageTypeLocal:
+			backend, err = localfs.NewObjectStorage(cfg.ToLocalFSObjectStorageConfig(profile))
+		default:
+			err = fmt.Errorf("unexpected storage type %s", profileCfg.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("creating object storage of %s: %w", profile, err)
+		}
+
+		backends[profile] = backend
+	}
+
+	router, err := storage.NewRouter(cfg.Storage.DefaultProfile, backends)
+	if err != nil {
+		return nil, fmt.Errorf("creating storage router: %w", err)
+	}
+	return router, nil
+}
@@ -0,0 +1,15 @@
+package azblob
+
+import (
+	"time"
+
+	"github.com/isutare412/imageer/pkg/azurehelpers"
+)
+
+type StorageConfig struct {
+	Expiry time.Duration
+	// InfrequentAccessTier is the access tier originals are moved to by
+	// retention policies. Cool is used if empty.
+	InfrequentAccessTier string
+	Client               azurehelpers.ContainerClientConfig
+}
@@ -0,0 +1,163 @@
+package azblob
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/azurehelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// Storage presigns and deletes blobs of an Azure Blob Storage container.
+type Storage struct {
+	client *container.Client
+	cfg    StorageConfig
+}
+
+func NewStorage(cfg StorageConfig) (*Storage, error) {
+	client, err := azurehelpers.NewContainerClient(cfg.Client)
+	if err != nil {
+		return nil, fmt.Errorf("creating azure container client: %w", err)
+	}
+
+	return &Storage{
+		client: client,
+		cfg:    cfg,
+	}, nil
+}
+
+func (s *Storage) PresignPutObject(ctx context.Context, req domain.PresignPutObjectRequest,
+) (domain.PresignPutObjectResponse, error) {
+	_, span := tracing.StartSpan(ctx, "azblob.Storage.PresignPutObject",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAzureBlob))
+	defer span.End()
+
+	url, err := s.client.NewBlobClient(req.S3Key).GetSASURL(
+		sas.BlobPermissions{Create: true, Write: true}, time.Now().Add(s.cfg.Expiry), nil)
+	if err != nil {
+		return domain.PresignPutObjectResponse{}, azurehelpers.WrapBlobError(err, "Failed to presign")
+	}
+
+	// Put Blob requires the blob type, and Content-Type is stored as the blob
+	// content type
+	header := make(http.Header)
+	header.Set("x-ms-blob-type", "BlockBlob")
+	if req.ContentType != "" {
+		header.Set("Content-Type", req.ContentType)
+	}
+
+	return domain.PresignPutObjectResponse{
+		URL:      url,
+		Header:   header,
+		ExpireAt: time.Now().UTC().Add(s.cfg.Expiry - 5*time.Second), // Subtract 5 seconds as buffer
+	}, nil
+}
+
+func (s *Storage) PresignGetObject(ctx context.Context, req domain.PresignGetObjectRequest,
+) (domain.PresignGetObjectResponse, error) {
+	_, span := tracing.StartSpan(ctx, "azblob.Storage.PresignGetObject",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAzureBlob))
+	defer span.End()
+
+	url, err := s.client.NewBlobClient(req.S3Key).GetSASURL(
+		sas.BlobPermissions{Read: true}, time.Now().Add(req.Expiry), nil)
+	if err != nil {
+		return domain.PresignGetObjectResponse{}, azurehelpers.WrapBlobError(err, "Failed to presign")
+	}
+
+	return domain.PresignGetObjectResponse{
+		URL:      url,
+		ExpireAt: time.Now().UTC().Add(req.Expiry - 5*time.Second), // Subtract 5 seconds as buffer
+	}, nil
+}
+
+func (s *Storage) HeadObject(ctx context.Context, key string) error {
+	ctx, span := tracing.StartSpan(ctx, "azblob.Storage.HeadObject",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAzureBlob))
+	defer span.End()
+
+	if _, err := s.client.NewBlobClient(key).GetProperties(ctx, nil); err != nil {
+		return azurehelpers.WrapBlobError(err, "Failed to head object %s", key)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListObjects(ctx context.Context, prefix string) ([]domain.StoredObject, error) {
+	ctx, span := tracing.StartSpan(ctx, "azblob.Storage.ListObjects",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAzureBlob))
+	defer span.End()
+
+	pager := s.client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{
+		Prefix: &prefix,
+	})
+
+	var objects []domain.StoredObject
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, azurehelpers.WrapBlobError(err, "Failed to list blobs under %s", prefix)
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			object := domain.StoredObject{Key: lo.FromPtr(item.Name)}
+			if item.Properties != nil {
+				object.LastModified = lo.FromPtr(item.Properties.LastModified)
+			}
+			objects = append(objects, object)
+		}
+	}
+
+	return objects, nil
+}
+
+// MoveToInfrequentAccess sets the infrequent access tier to the blob.
+func (s *Storage) MoveToInfrequentAccess(ctx context.Context, key string) error {
+	ctx, span := tracing.StartSpan(ctx, "azblob.Storage.MoveToInfrequentAccess",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAzureBlob))
+	defer span.End()
+
+	tier := blob.AccessTierCool
+	if s.cfg.InfrequentAccessTier != "" {
+		tier = blob.AccessTier(s.cfg.InfrequentAccessTier)
+	}
+
+	if _, err := s.client.NewBlobClient(key).SetTier(ctx, tier, nil); err != nil {
+		return azurehelpers.WrapBlobError(err, "Failed to set access tier of blob %s", key)
+	}
+	return nil
+}
+
+func (s *Storage) DeleteObjects(ctx context.Context, keys []string) error {
+	ctx, span := tracing.StartSpan(ctx, "azblob.Storage.DeleteObjects",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAzureBlob))
+	defer span.End()
+
+	for _, key := range keys {
+		_, err := s.client.NewBlobClient(key).Delete(ctx, nil)
+		switch {
+		case bloberror.HasCode(err, bloberror.BlobNotFound):
+			continue
+		case err != nil:
+			return azurehelpers.WrapBlobError(err, "Failed to delete blob %s", key)
+		}
+	}
+
+	return nil
+}
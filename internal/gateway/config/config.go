@@ -5,6 +5,8 @@ import (
 
 	"github.com/isutare412/imageer/internal/gateway/kafka"
 	"github.com/isutare412/imageer/pkg/log"
+	"github.com/isutare412/imageer/pkg/projects"
+	"github.com/isutare412/imageer/pkg/users"
 )
 
 type Config struct {
@@ -17,6 +19,7 @@ type Config struct {
 	Kafka      KafkaConfig      `koanf:"kafka"`
 	Auth       AuthConfig       `koanf:"auth"`
 	Crypt      CryptConfig      `koanf:"crypt"`
+	Storage    StorageConfig    `koanf:"storage"`
 	AWS        AWSConfig        `koanf:"aws"`
 	Service    ServiceConfig    `koanf:"service"`
 }
@@ -49,6 +52,18 @@ type WebConfig struct {
 		AllowCredentials bool          `koanf:"allow-credentials"`
 		MaxAge           time.Duration `koanf:"max-age" validate:"required,gt=0"`
 	} `koanf:"cors"`
+	// UploadEventWebhook receives bucket notifications of S3-compatible
+	// storages at /webhooks/upload-events.
+	UploadEventWebhook struct {
+		Enabled       bool          `koanf:"enabled"`
+		AuthToken     string        `koanf:"auth-token" validate:"required_if=Enabled true"`
+		HandleTimeout time.Duration `koanf:"handle-timeout" validate:"required,gt=0"`
+	} `koanf:"upload-event-webhook"`
+	TLS struct {
+		Enabled  bool   `koanf:"enabled"`
+		CertFile string `koanf:"cert-file" validate:"required_if=Enabled true"`
+		KeyFile  string `koanf:"key-file" validate:"required_if=Enabled true"`
+	} `koanf:"tls"`
 }
 
 type KubernetesConfig struct {
@@ -100,6 +115,10 @@ type ValkeyConfig struct {
 			MaxRetries    int    `koanf:"max-retries" validate:"gte=0"`
 		} `koanf:"image-process-done"`
 	} `koanf:"pubsub"`
+	Sessions struct {
+		KeyPrefix string        `koanf:"key-prefix" validate:"required"`
+		CacheTTL  time.Duration `koanf:"cache-ttl" validate:"required,gt=0"`
+	} `koanf:"sessions"`
 }
 
 type KafkaConfig struct {
@@ -133,6 +152,19 @@ type KafkaConfig struct {
 				RetryBaseDelay  time.Duration `koanf:"retry-base-delay" validate:"required,gt=0"`
 			} `koanf:"handler"`
 		} `koanf:"image-s3-delete-request"`
+
+		// ImageUploadEvent is a source of bucket notifications of S3-compatible
+		// storages.
+		ImageUploadEvent struct {
+			Enabled    bool   `koanf:"enabled"`
+			Topic      string `koanf:"topic" validate:"required"`
+			RetryTopic string `koanf:"retry-topic" validate:"required"`
+			Handler    struct {
+				Timeout         time.Duration `koanf:"timeout" validate:"required,gt=0"`
+				MaxRetryAttempt int           `koanf:"max-retry-attempt" validate:"required,gte=0"`
+				RetryBaseDelay  time.Duration `koanf:"retry-base-delay" validate:"required,gt=0"`
+			} `koanf:"handler"`
+		} `koanf:"image-upload-event"`
 	} `koanf:"topics"`
 }
 
@@ -152,6 +184,21 @@ type AuthConfig struct {
 
 	ServiceAccount struct {
 		APIKeyHeader string `koanf:"api-key-header" validate:"required"`
+
+		// MTLS authenticates service accounts by client certificates, which
+		// requires web.tls to be enabled.
+		MTLS struct {
+			Enabled      bool                       `koanf:"enabled"`
+			ClientCAFile string                     `koanf:"client-ca-file" validate:"required_if=Enabled true"`
+			Mappings     []CertificateMappingConfig `koanf:"mappings" validate:"dive"`
+		} `koanf:"mtls"`
+
+		// WorkloadToken authenticates service accounts by bearer JWTs issued by
+		// external issuers.
+		WorkloadToken struct {
+			Enabled bool                   `koanf:"enabled"`
+			Issuers []WorkloadIssuerConfig `koanf:"issuers" validate:"required_if=Enabled true,unique=Issuer,dive"`
+		} `koanf:"workload-token"`
 	} `koanf:"service-account"`
 
 	JWT struct {
@@ -159,11 +206,64 @@ type AuthConfig struct {
 		KeyPairs          map[string]AuthKeyPairConfig `koanf:"key-pairs" validate:"required,dive,keys,required,endkeys,required"`
 	} `koanf:"jwt"`
 
-	Google struct {
-		ClientID     string `koanf:"client-id" validate:"required"`
-		ClientSecret string `koanf:"client-secret" validate:"required"`
-		CallbackPath string `koanf:"callback-path" validate:"required"`
-	} `koanf:"google"`
+	OIDC struct {
+		CallbackPath string               `koanf:"callback-path" validate:"required,contains={provider}"`
+		Providers    []OIDCProviderConfig `koanf:"providers" validate:"required,min=1,unique=Name,dive"`
+	} `koanf:"oidc"`
+
+	RoleMapping struct {
+		Authoritative bool                    `koanf:"authoritative"`
+		Rules         []RoleMappingRuleConfig `koanf:"rules" validate:"dive"`
+	} `koanf:"role-mapping"`
+}
+
+type CertificateMappingConfig struct {
+	// Subject is the subject distinguished name or any URI SAN of client
+	// certificates, e.g. CN=uploader,O=platform or a SPIFFE ID.
+	Subject          string `koanf:"subject" validate:"required"`
+	ServiceAccountID string `koanf:"service-account-id" validate:"required,max=36"`
+}
+
+type WorkloadIssuerConfig struct {
+	Issuer   string               `koanf:"issuer" validate:"required"`
+	JWKSURL  string               `koanf:"jwks-url" validate:"required,url"`
+	Audience string               `koanf:"audience" validate:"required"`
+	Mappings []TokenMappingConfig `koanf:"mappings" validate:"required,min=1,dive"`
+}
+
+type TokenMappingConfig struct {
+	Claim            string `koanf:"claim" validate:"required"`
+	ClaimValue       string `koanf:"claim-value" validate:"required"`
+	ServiceAccountID string `koanf:"service-account-id" validate:"required,max=36"`
+}
+
+type OIDCProviderConfig struct {
+	Name          string            `koanf:"name" validate:"required,max=64"`
+	DisplayName   string            `koanf:"display-name" validate:"required"`
+	IssuerURL     string            `koanf:"issuer-url" validate:"required,url"`
+	ClientID      string            `koanf:"client-id" validate:"required"`
+	ClientSecret  string            `koanf:"client-secret" validate:"required"`
+	Scopes        []string          `koanf:"scopes"`
+	AuthURLParams map[string]string `koanf:"auth-url-params"`
+	Claims        struct {
+		Email   string `koanf:"email"`
+		Name    string `koanf:"name"`
+		Picture string `koanf:"picture"`
+	} `koanf:"claims"`
+}
+
+// RoleMappingRuleConfig matches users by a claim, an email domain or both.
+type RoleMappingRuleConfig struct {
+	Claim       string                     `koanf:"claim" validate:"required_without=EmailDomain"`
+	ClaimValue  string                     `koanf:"claim-value" validate:"required_with=Claim"`
+	EmailDomain string                     `koanf:"email-domain" validate:"required_without=Claim,omitempty,fqdn"`
+	Role        users.Role                 `koanf:"role" validate:"required_without=Projects,omitempty,validateFn=Validate"`
+	Projects    []ProjectRoleMappingConfig `koanf:"projects" validate:"dive"`
+}
+
+type ProjectRoleMappingConfig struct {
+	ProjectID string              `koanf:"project-id" validate:"required,max=36"`
+	Role      projects.MemberRole `koanf:"role" validate:"validateFn=Validate"`
 }
 
 type AuthKeyPairConfig struct {
@@ -177,20 +277,62 @@ type CryptConfig struct {
 	} `koanf:"aes"`
 }
 
-type AWSConfig struct {
-	S3         S3Config         `koanf:"s3"`
-	CloudFront CloudFrontConfig `koanf:"cloudfront"`
-	SQS        SQSConfig        `koanf:"sqs"`
-}
-
-type S3Config struct {
-	Bucket string `koanf:"bucket" validate:"required"`
-	Prefix struct {
+type StorageConfig struct {
+	DefaultProfile string `koanf:"default-profile" validate:"required"`
+	Prefix         struct {
 		Image string `koanf:"image" validate:"required"`
 	} `koanf:"prefix"`
 	Presign struct {
 		Expiry time.Duration `koanf:"expiry" validate:"required,gt=0"`
 	} `koanf:"presign"`
+	Profiles map[string]StorageProfileConfig `koanf:"profiles" validate:"required,dive,keys,max=64,endkeys"`
+}
+
+type StorageType string
+
+const (
+	StorageTypeS3    StorageType = "s3"
+	StorageTypeGCS   StorageType = "gcs"
+	StorageTypeAzure StorageType = "azure"
+	StorageTypeLocal StorageType = "local"
+)
+
+// StorageProfileConfig configures a storage backend. Fields irrelevant to the
+// type are ignored.
+type StorageProfileConfig struct {
+	Type StorageType `koanf:"type" validate:"oneof=s3 gcs azure local"`
+	// Bucket is the bucket of S3 and GCS, or the container of Azure Blob.
+	Bucket string `koanf:"bucket" validate:"required_unless=Type local"`
+	// Endpoint overrides the endpoint of the storage service, e.g. to use
+	// S3-compatible storages or emulators.
+	Endpoint        string `koanf:"endpoint"`
+	Region          string `koanf:"region"`
+	UsePathStyle    bool   `koanf:"use-path-style"`
+	AccessKeyID     string `koanf:"access-key-id"`
+	SecretAccessKey string `koanf:"secret-access-key"`
+	AccountName     string `koanf:"account-name" validate:"required_if=Type azure"`
+	AccountKey      string `koanf:"account-key" validate:"required_if=Type azure"`
+	CredentialsFile string `koanf:"credentials-file"`
+	// Root, BaseURL and SigningKey configure local storages. BaseURL is where
+	// the gateway serves the profile, i.e. <gateway>/storage/<profile>.
+	Root       string `koanf:"root" validate:"required_if=Type local"`
+	BaseURL    string `koanf:"base-url" validate:"required_if=Type local"`
+	SigningKey string `koanf:"signing-key" validate:"required_if=Type local"`
+	// AllowUnsignedReads lets local storages serve objects of public projects
+	// without signatures, for using the gateway as the CDN origin in
+	// development.
+	AllowUnsignedReads bool `koanf:"allow-unsigned-reads"`
+	// InfrequentAccessClass is the storage class, or the access tier of Azure
+	// Blob, originals are moved to by retention policies. The cheapest class
+	// readable without restoration is used if empty.
+	InfrequentAccessClass string `koanf:"infrequent-access-class"`
+	// CDNBaseURL overrides the CDN domain for images stored in the profile.
+	CDNBaseURL string `koanf:"cdn-base-url" validate:"omitempty,http_url,endsnotwith=/"`
+}
+
+type AWSConfig struct {
+	CloudFront CloudFrontConfig `koanf:"cloudfront"`
+	SQS        SQSConfig        `koanf:"sqs"`
 }
 
 type CloudFrontConfig struct {
@@ -200,6 +342,9 @@ type CloudFrontConfig struct {
 }
 
 type SQSConfig struct {
+	// Enabled is turned off when no profile is backed by AWS S3, e.g. in
+	// offline development with local storages.
+	Enabled               bool `koanf:"enabled"`
 	ImageUploadEventQueue struct {
 		QueueURL           string        `koanf:"queue-url" validate:"required"`
 		BatchCount         int32         `koanf:"batch-count" validate:"required,gt=0,lte=10"`
@@ -214,5 +359,54 @@ type ServiceConfig struct {
 	Image struct {
 		ProcessDoneWaitTimeout time.Duration `koanf:"process-done-wait-timeout" validate:"required,gt=0"`
 		ExpireCheckInterval    time.Duration `koanf:"expire-check-interval" validate:"required,gt=0"`
+		PrivateURLTTL          time.Duration `koanf:"private-url-ttl" validate:"required,gt=5s"`
+		Delivery               struct {
+			MaxAge         time.Duration `koanf:"max-age" validate:"required,gt=0"`
+			FallbackMaxAge time.Duration `koanf:"fallback-max-age" validate:"required,gt=0"`
+		} `koanf:"delivery"`
+		UploadSweep struct {
+			Enabled       bool          `koanf:"enabled"`
+			CheckInterval time.Duration `koanf:"check-interval" validate:"required,gt=0"`
+			CheckTimeout  time.Duration `koanf:"check-timeout" validate:"required,gt=0"`
+			PendingFor    time.Duration `koanf:"pending-for" validate:"required,gt=0"`
+		} `koanf:"upload-sweep"`
+		Retention struct {
+			CheckInterval time.Duration `koanf:"check-interval" validate:"required,gt=0"`
+			CheckTimeout  time.Duration `koanf:"check-timeout" validate:"required,gt=0"`
+		} `koanf:"retention"`
+		Purge struct {
+			CheckInterval time.Duration `koanf:"check-interval" validate:"required,gt=0"`
+			CheckTimeout  time.Duration `koanf:"check-timeout" validate:"required,gt=0"`
+			RestoreWindow time.Duration `koanf:"restore-window" validate:"required,gt=0"`
+			BatchSize     int           `koanf:"batch-size" validate:"required,gt=0"`
+		} `koanf:"purge"`
+		Reconcile struct {
+			Enabled       bool          `koanf:"enabled"`
+			CheckInterval time.Duration `koanf:"check-interval" validate:"required,gt=0"`
+			CheckTimeout  time.Duration `koanf:"check-timeout" validate:"required,gt=0"`
+			GracePeriod   time.Duration `koanf:"grace-period" validate:"required,gt=0"`
+			DeleteOrphans bool          `koanf:"delete-orphans"`
+		} `koanf:"reconcile"`
 	} `koanf:"image"`
+	ServiceAccount struct {
+		UsageFlush struct {
+			Interval time.Duration `koanf:"interval" validate:"required,gt=0"`
+			Timeout  time.Duration `koanf:"timeout" validate:"required,gt=0"`
+		} `koanf:"usage-flush"`
+		StaleDisable struct {
+			Enabled       bool          `koanf:"enabled"`
+			CheckInterval time.Duration `koanf:"check-interval" validate:"required,gt=0"`
+			CheckTimeout  time.Duration `koanf:"check-timeout" validate:"required,gt=0"`
+			UnusedFor     time.Duration `koanf:"unused-for" validate:"required,gt=0"`
+			WarnBefore    time.Duration `koanf:"warn-before" validate:"required,gt=0,ltfield=UnusedFor"`
+			BatchSize     int           `koanf:"batch-size" validate:"required,gt=0,lte=100"`
+		} `koanf:"stale-disable"`
+	} `koanf:"service-account"`
+	Audit struct {
+		Purge struct {
+			CheckInterval time.Duration `koanf:"check-interval" validate:"required,gt=0"`
+			CheckTimeout  time.Duration `koanf:"check-timeout" validate:"required,gt=0"`
+			Retention     time.Duration `koanf:"retention" validate:"required,gt=0"`
+		} `koanf:"purge"`
+	} `koanf:"audit"`
 }
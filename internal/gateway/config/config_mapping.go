@@ -1,23 +1,36 @@
 package config
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/samber/lo"
 
+	"github.com/isutare412/imageer/internal/gateway/azblob"
 	"github.com/isutare412/imageer/internal/gateway/crypt"
+	"github.com/isutare412/imageer/internal/gateway/gcs"
+	"github.com/isutare412/imageer/internal/gateway/jwks"
 	"github.com/isutare412/imageer/internal/gateway/jwt"
 	"github.com/isutare412/imageer/internal/gateway/kafka"
 	"github.com/isutare412/imageer/internal/gateway/kubernetes"
+	"github.com/isutare412/imageer/internal/gateway/localfs"
 	"github.com/isutare412/imageer/internal/gateway/oidc"
 	"github.com/isutare412/imageer/internal/gateway/postgres"
 	"github.com/isutare412/imageer/internal/gateway/s3"
+	"github.com/isutare412/imageer/internal/gateway/service/audit"
 	"github.com/isutare412/imageer/internal/gateway/service/auth"
 	"github.com/isutare412/imageer/internal/gateway/service/image"
+	"github.com/isutare412/imageer/internal/gateway/service/project"
+	"github.com/isutare412/imageer/internal/gateway/service/serviceaccount"
+	"github.com/isutare412/imageer/internal/gateway/service/watermark"
 	"github.com/isutare412/imageer/internal/gateway/sqs"
 	"github.com/isutare412/imageer/internal/gateway/valkey"
 	"github.com/isutare412/imageer/internal/gateway/web"
+	"github.com/isutare412/imageer/internal/gateway/webhook"
 	"github.com/isutare412/imageer/internal/gateway/webv2"
+	"github.com/isutare412/imageer/pkg/awshelpers"
+	"github.com/isutare412/imageer/pkg/azurehelpers"
+	"github.com/isutare412/imageer/pkg/gcphelpers"
 	"github.com/isutare412/imageer/pkg/log"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
@@ -72,6 +85,13 @@ func (c *Config) ToWebV2Config() webv2.Config {
 			AllowCredentials: c.Web.CORS.AllowCredentials,
 			MaxAge:           c.Web.CORS.MaxAge,
 		},
+		TLS: webv2.TLSConfig{
+			Enabled:  c.Web.TLS.Enabled,
+			CertFile: c.Web.TLS.CertFile,
+			KeyFile:  c.Web.TLS.KeyFile,
+			ClientCAFile: lo.Ternary(c.Auth.ServiceAccount.MTLS.Enabled,
+				c.Auth.ServiceAccount.MTLS.ClientCAFile, ""),
+		},
 	}
 }
 
@@ -120,6 +140,13 @@ func (c *Config) ToValkeyImageNotificationPublisherConfig() valkey.ImageNotifica
 	}
 }
 
+func (c *Config) ToValkeySessionStoreConfig() valkey.SessionStoreConfig {
+	return valkey.SessionStoreConfig{
+		KeyPrefix: c.Valkey.Sessions.KeyPrefix,
+		CacheTTL:  c.Valkey.Sessions.CacheTTL,
+	}
+}
+
 func (c *Config) ToValkeyImageUploadDoneSubscriberConfig() valkey.ImageUploadDoneSubscriberConfig {
 	return valkey.ImageUploadDoneSubscriberConfig{
 		ChannelPrefix: c.Valkey.PubSub.ImageUploadDone.ChannelPrefix,
@@ -135,18 +162,34 @@ func (c *Config) ToValkeyImageProcessDoneSubscriberConfig() valkey.ImageProcessD
 }
 
 func (c *Config) ToKafkaClientConfig() kafka.ClientConfig {
+	topics := []string{
+		c.Kafka.Topics.ImageProcessResult.Topic,
+		c.Kafka.Topics.ImageProcessResult.RetryTopic,
+		c.Kafka.Topics.ImageS3DeleteRequest.Topic,
+		c.Kafka.Topics.ImageS3DeleteRequest.RetryTopic,
+	}
+	if c.Kafka.Topics.ImageUploadEvent.Enabled {
+		topics = append(topics,
+			c.Kafka.Topics.ImageUploadEvent.Topic,
+			c.Kafka.Topics.ImageUploadEvent.RetryTopic)
+	}
+
 	return kafka.ClientConfig{
 		Addrs:         parseCSV(c.Kafka.Addresses, ","),
 		User:          c.Kafka.Username,
 		Password:      c.Kafka.Password,
 		ConsumerGroup: c.Kafka.ConsumerGroup,
 		Partitioner:   c.Kafka.Partitioner,
-		ConsumeTopics: []string{
-			c.Kafka.Topics.ImageProcessResult.Topic,
-			c.Kafka.Topics.ImageProcessResult.RetryTopic,
-			c.Kafka.Topics.ImageS3DeleteRequest.Topic,
-			c.Kafka.Topics.ImageS3DeleteRequest.RetryTopic,
-		},
+		ConsumeTopics: topics,
+	}
+}
+
+func (c *Config) ToKafkaImageUploadEventHandlerConfig() kafka.ImageUploadEventHandlerConfig {
+	return kafka.ImageUploadEventHandlerConfig{
+		RetryTopic:      c.Kafka.Topics.ImageUploadEvent.RetryTopic,
+		HandleTimeout:   c.Kafka.Topics.ImageUploadEvent.Handler.Timeout,
+		MaxRetryAttempt: c.Kafka.Topics.ImageUploadEvent.Handler.MaxRetryAttempt,
+		RetryBaseDelay:  c.Kafka.Topics.ImageUploadEvent.Handler.RetryBaseDelay,
 	}
 }
 
@@ -186,6 +229,21 @@ func (c *Config) ToAuthServiceConfig() auth.ServiceConfig {
 		StateCookieTTL:  c.Auth.Cookies.OIDCState.TTL,
 		UserCookieName:  c.Auth.Cookies.User.Name,
 		UserCookieTTL:   c.Auth.Cookies.User.TTL,
+		RoleMapping: auth.RoleMappingConfig{
+			Authoritative: c.Auth.RoleMapping.Authoritative,
+			Rules: lo.Map(c.Auth.RoleMapping.Rules, func(r RoleMappingRuleConfig, _ int) auth.RoleMappingRule {
+				return auth.RoleMappingRule{
+					Claim:       r.Claim,
+					ClaimValue:  r.ClaimValue,
+					EmailDomain: r.EmailDomain,
+					Role:        r.Role,
+					Projects: lo.Map(r.Projects,
+						func(p ProjectRoleMappingConfig, _ int) auth.ProjectRoleMapping {
+							return auth.ProjectRoleMapping(p)
+						}),
+				}
+			}),
+		},
 	}
 }
 
@@ -214,24 +272,130 @@ func (c *Config) ToJWTVerifierConfig() jwt.VerifierConfig {
 	}
 }
 
-func (c *Config) ToOIDCGoogleClientConfig() oidc.GoogleClientConfig {
-	return oidc.GoogleClientConfig(c.Auth.Google)
+func (c *Config) ToOIDCRouterConfig() oidc.RouterConfig {
+	return oidc.RouterConfig{
+		CallbackPath: c.Auth.OIDC.CallbackPath,
+		Providers: lo.Map(c.Auth.OIDC.Providers, func(p OIDCProviderConfig, _ int) oidc.ClientConfig {
+			return oidc.ClientConfig{
+				Name:          p.Name,
+				DisplayName:   p.DisplayName,
+				IssuerURL:     p.IssuerURL,
+				ClientID:      p.ClientID,
+				ClientSecret:  p.ClientSecret,
+				Scopes:        p.Scopes,
+				AuthURLParams: p.AuthURLParams,
+				Claims:        oidc.ClaimMapping(p.Claims),
+			}
+		}),
+	}
 }
 
 func (c *Config) ToAESCrypterConfig() crypt.AESCrypterConfig {
 	return crypt.AESCrypterConfig(c.Crypt.AES)
 }
 
-func (c *Config) ToS3PresignerConfig() s3.PresignerConfig {
+func (c *Config) ToS3PresignerConfig(profile string) s3.PresignerConfig {
+	p := c.Storage.Profiles[profile]
 	return s3.PresignerConfig{
-		Bucket: c.AWS.S3.Bucket,
-		Expiry: c.AWS.S3.Presign.Expiry,
+		Bucket: p.Bucket,
+		Expiry: c.Storage.Presign.Expiry,
+		Client: p.toS3ClientConfig(),
 	}
 }
 
-func (c *Config) ToS3ObjectStorageConfig() s3.ObjectStorageConfig {
+func (c *Config) ToS3ObjectStorageConfig(profile string) s3.ObjectStorageConfig {
+	p := c.Storage.Profiles[profile]
 	return s3.ObjectStorageConfig{
-		Bucket: c.AWS.S3.Bucket,
+		Bucket:                p.Bucket,
+		InfrequentAccessClass: p.InfrequentAccessClass,
+		Client:                p.toS3ClientConfig(),
+	}
+}
+
+func (c *Config) ToGCSStorageConfig(profile string) gcs.StorageConfig {
+	p := c.Storage.Profiles[profile]
+	return gcs.StorageConfig{
+		Bucket:                p.Bucket,
+		Expiry:                c.Storage.Presign.Expiry,
+		InfrequentAccessClass: p.InfrequentAccessClass,
+		Client: gcphelpers.StorageClientConfig{
+			Endpoint:        p.Endpoint,
+			CredentialsFile: p.CredentialsFile,
+		},
+	}
+}
+
+func (c *Config) ToAzblobStorageConfig(profile string) azblob.StorageConfig {
+	p := c.Storage.Profiles[profile]
+	return azblob.StorageConfig{
+		Expiry:               c.Storage.Presign.Expiry,
+		InfrequentAccessTier: p.InfrequentAccessClass,
+		Client: azurehelpers.ContainerClientConfig{
+			ServiceURL:  p.Endpoint,
+			AccountName: p.AccountName,
+			AccountKey:  p.AccountKey,
+			Container:   p.Bucket,
+		},
+	}
+}
+
+func (c *Config) ToLocalFSStorageConfig(profile string) localfs.StorageConfig {
+	p := c.Storage.Profiles[profile]
+	return localfs.StorageConfig{
+		Profile:    profile,
+		Root:       p.Root,
+		BaseURL:    p.BaseURL,
+		SigningKey: p.SigningKey,
+		Expiry:     c.Storage.Presign.Expiry,
+
+		AllowUnsignedReads: p.AllowUnsignedReads,
+	}
+}
+
+func (p StorageProfileConfig) toS3ClientConfig() awshelpers.S3ClientConfig {
+	return awshelpers.S3ClientConfig{
+		Region:          p.Region,
+		Endpoint:        p.Endpoint,
+		AccessKeyID:     p.AccessKeyID,
+		SecretAccessKey: p.SecretAccessKey,
+		UsePathStyle:    p.UsePathStyle,
+	}
+}
+
+// storageCDNBaseURLs returns CDN base URLs of storage profiles. Local profiles
+// are served by the gateway unless CDN base URLs are set.
+func (c *Config) storageCDNBaseURLs() map[string]string {
+	urls := make(map[string]string)
+	for name, p := range c.Storage.Profiles {
+		switch {
+		case p.CDNBaseURL != "":
+			urls[name] = p.CDNBaseURL
+		case p.Type == StorageTypeLocal:
+			urls[name] = p.BaseURL
+		}
+	}
+
+	// Projects created before storage profiles have no profile names
+	if url, ok := urls[c.Storage.DefaultProfile]; ok {
+		urls[""] = url
+	}
+	return urls
+}
+
+func (c *Config) ToProjectServiceConfig() project.Config {
+	profiles := lo.Keys(c.Storage.Profiles)
+	slices.Sort(profiles)
+	return project.Config{
+		DefaultStorageProfile: c.Storage.DefaultProfile,
+		StorageProfiles:       profiles,
+		RestoreWindow:         c.Service.Image.Purge.RestoreWindow,
+	}
+}
+
+func (c *Config) ToWebhookUploadEventHandlerConfig() webhook.UploadEventHandlerConfig {
+	return webhook.UploadEventHandlerConfig{
+		AuthToken:     c.Web.UploadEventWebhook.AuthToken,
+		HandleTimeout: c.Web.UploadEventWebhook.HandleTimeout,
 	}
 }
 
@@ -242,15 +406,124 @@ func (c *Config) ToSQSImageUploadListenerConfig() sqs.ImageUploadListenerConfig
 func (c *Config) ToImageServiceConfig() image.Config {
 	return image.Config{
 		CDNDomain:              c.AWS.CloudFront.Images.DistributionDomain,
-		S3KeyPrefix:            c.AWS.S3.Prefix.Image,
+		S3KeyPrefix:            c.Storage.Prefix.Image,
+		StorageCDNBaseURLs:     c.storageCDNBaseURLs(),
 		ProcessDoneWaitTimeout: c.Service.Image.ProcessDoneWaitTimeout,
+		DeliveryMaxAge:         c.Service.Image.Delivery.MaxAge,
+		DeliveryFallbackMaxAge: c.Service.Image.Delivery.FallbackMaxAge,
+		PrivateURLTTL:          c.Service.Image.PrivateURLTTL,
+	}
+}
+
+func (c *Config) ToWatermarkServiceConfig() watermark.Config {
+	return watermark.Config{
+		CDNDomain:          c.AWS.CloudFront.Images.DistributionDomain,
+		S3KeyPrefix:        c.Storage.Prefix.Image,
+		StorageCDNBaseURLs: c.storageCDNBaseURLs(),
 	}
 }
 
 func (c *Config) ToImageCloserConfig() image.CloserConfig {
 	return image.CloserConfig{
 		CheckInterval:  c.Service.Image.ExpireCheckInterval,
-		CloseThreshold: c.AWS.S3.Presign.Expiry,
+		CloseThreshold: c.Storage.Presign.Expiry,
+	}
+}
+
+func (c *Config) ToImageUploadSweeperConfig() image.UploadSweeperConfig {
+	return image.UploadSweeperConfig{
+		CheckInterval: c.Service.Image.UploadSweep.CheckInterval,
+		CheckTimeout:  c.Service.Image.UploadSweep.CheckTimeout,
+		PendingFor:    c.Service.Image.UploadSweep.PendingFor,
+	}
+}
+
+func (c *Config) ToServiceAccountServiceConfig() serviceaccount.Config {
+	var cfg serviceaccount.Config
+	if c.Auth.ServiceAccount.MTLS.Enabled {
+		cfg.CertificateMappings = lo.Map(c.Auth.ServiceAccount.MTLS.Mappings,
+			func(m CertificateMappingConfig, _ int) serviceaccount.CertificateMapping {
+				return serviceaccount.CertificateMapping(m)
+			})
+	}
+	if c.Auth.ServiceAccount.WorkloadToken.Enabled {
+		for _, issuer := range c.Auth.ServiceAccount.WorkloadToken.Issuers {
+			for _, m := range issuer.Mappings {
+				cfg.TokenMappings = append(cfg.TokenMappings, serviceaccount.TokenMapping{
+					Issuer:           issuer.Issuer,
+					Claim:            m.Claim,
+					ClaimValue:       m.ClaimValue,
+					ServiceAccountID: m.ServiceAccountID,
+				})
+			}
+		}
+	}
+	return cfg
+}
+
+func (c *Config) ToWorkloadTokenVerifierConfig() jwks.VerifierConfig {
+	return jwks.VerifierConfig{
+		Issuers: lo.Map(c.Auth.ServiceAccount.WorkloadToken.Issuers,
+			func(i WorkloadIssuerConfig, _ int) jwks.IssuerConfig {
+				return jwks.IssuerConfig{
+					Issuer:   i.Issuer,
+					JWKSURL:  i.JWKSURL,
+					Audience: i.Audience,
+				}
+			}),
+	}
+}
+
+func (c *Config) ToServiceAccountUsageTrackerConfig() serviceaccount.UsageTrackerConfig {
+	return serviceaccount.UsageTrackerConfig{
+		FlushInterval: c.Service.ServiceAccount.UsageFlush.Interval,
+		FlushTimeout:  c.Service.ServiceAccount.UsageFlush.Timeout,
+	}
+}
+
+func (c *Config) ToServiceAccountStaleDisablerConfig() serviceaccount.StaleDisablerConfig {
+	return serviceaccount.StaleDisablerConfig{
+		Enabled:       c.Service.ServiceAccount.StaleDisable.Enabled,
+		CheckInterval: c.Service.ServiceAccount.StaleDisable.CheckInterval,
+		CheckTimeout:  c.Service.ServiceAccount.StaleDisable.CheckTimeout,
+		UnusedFor:     c.Service.ServiceAccount.StaleDisable.UnusedFor,
+		WarnBefore:    c.Service.ServiceAccount.StaleDisable.WarnBefore,
+		BatchSize:     c.Service.ServiceAccount.StaleDisable.BatchSize,
+	}
+}
+
+func (c *Config) ToAuditPurgerConfig() audit.PurgerConfig {
+	return audit.PurgerConfig{
+		CheckInterval: c.Service.Audit.Purge.CheckInterval,
+		CheckTimeout:  c.Service.Audit.Purge.CheckTimeout,
+		Retention:     c.Service.Audit.Purge.Retention,
+	}
+}
+
+func (c *Config) ToImagePurgerConfig() image.PurgerConfig {
+	return image.PurgerConfig{
+		CheckInterval: c.Service.Image.Purge.CheckInterval,
+		CheckTimeout:  c.Service.Image.Purge.CheckTimeout,
+		RestoreWindow: c.Service.Image.Purge.RestoreWindow,
+		BatchSize:     c.Service.Image.Purge.BatchSize,
+	}
+}
+
+func (c *Config) ToImageReconcilerConfig() image.ReconcilerConfig {
+	return image.ReconcilerConfig{
+		CheckInterval:         c.Service.Image.Reconcile.CheckInterval,
+		CheckTimeout:          c.Service.Image.Reconcile.CheckTimeout,
+		S3KeyPrefix:           c.Storage.Prefix.Image,
+		GracePeriod:           c.Service.Image.Reconcile.GracePeriod,
+		DeleteOrphans:         c.Service.Image.Reconcile.DeleteOrphans,
+		DefaultStorageProfile: c.Storage.DefaultProfile,
+	}
+}
+
+func (c *Config) ToImageLifecyclerConfig() image.LifecyclerConfig {
+	return image.LifecyclerConfig{
+		CheckInterval: c.Service.Image.Retention.CheckInterval,
+		CheckTimeout:  c.Service.Image.Retention.CheckTimeout,
 	}
 }
 
@@ -10,6 +10,10 @@ import (
 type Bag struct {
 	// Identity holds the authentication and authorization information.
 	Identity domain.Identity
+	// RequestID identifies the request in logs and responses.
+	RequestID string
+	// RemoteIP is the IP address of the client.
+	RemoteIP string
 }
 
 // WithBag returns a new context with an empty Bag.
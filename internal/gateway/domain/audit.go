@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/pkg/audits"
+)
+
+// AuditEntry records an administrative or destructive change along with the
+// identity that made it.
+type AuditEntry struct {
+	ID        string
+	CreatedAt time.Time
+	ActorType audits.ActorType
+	// ActorID is the ID of the user or the service account, which is empty for
+	// the system.
+	ActorID    string
+	ActorName  string
+	Action     audits.Action
+	TargetType audits.TargetType
+	TargetID   string
+	RequestID  string
+	RemoteIP   string
+	Changes    []AuditChange
+}
+
+// AuditChange is a field of the target changed by the action. Before and After
+// are JSON values, which are nil if the field is absent or zero.
+type AuditChange struct {
+	Field  string
+	Before json.RawMessage
+	After  json.RawMessage
+}
+
+type AuditEntries struct {
+	Items []AuditEntry
+	Total int64
+}
+
+// RecordAuditRequest describes a change to be recorded. Before and After are
+// snapshots of the target, where Before is nil for created targets and After
+// is nil for deleted ones.
+type RecordAuditRequest struct {
+	Action     audits.Action     `validate:"validateFn=Validate"`
+	TargetType audits.TargetType `validate:"validateFn=Validate"`
+	TargetID   string            `validate:"required"`
+	Before     any               `validate:"-"`
+	After      any               `validate:"-"`
+}
+
+type ListAuditEntriesParams struct {
+	Offset *int `validate:"omitempty,min=0"`
+	Limit  *int `validate:"omitempty,min=1,max=100"`
+
+	SearchFilter AuditEntrySearchFilter
+}
+
+func (p ListAuditEntriesParams) OffsetOrDefault() int {
+	return lo.FromPtrOr(p.Offset, 0)
+}
+
+func (p ListAuditEntriesParams) LimitOrDefault() int {
+	return lo.FromPtrOr(p.Limit, 20)
+}
+
+type AuditEntrySearchFilter struct {
+	ActorID         *string
+	Action          *audits.Action
+	TargetType      *audits.TargetType
+	TargetID        *string
+	CreatedAtAfter  *time.Time
+	CreatedAtBefore *time.Time
+}
+
+// zeroTimeJSON is the encoding of the zero time.Time.
+var zeroTimeJSON = time.Time{}.Format(time.RFC3339Nano)
+
+// DiffAuditSnapshots compares the top-level fields of the JSON encodings of
+// before and after, returning the changed fields in alphabetical order.
+func DiffAuditSnapshots(before, after any) ([]AuditChange, error) {
+	beforeFields, err := snapshotFields(before)
+	if err != nil {
+		return nil, fmt.Errorf("encoding before snapshot: %w", err)
+	}
+	afterFields, err := snapshotFields(after)
+	if err != nil {
+		return nil, fmt.Errorf("encoding after snapshot: %w", err)
+	}
+
+	names := lo.Uniq(append(lo.Keys(beforeFields), lo.Keys(afterFields)...))
+	slices.Sort(names)
+
+	var changes []AuditChange
+	for _, name := range names {
+		b, a := beforeFields[name], afterFields[name]
+		if bytes.Equal(b, a) {
+			continue
+		}
+		changes = append(changes, AuditChange{
+			Field:  name,
+			Before: b,
+			After:  a,
+		})
+	}
+	return changes, nil
+}
+
+// snapshotFields encodes the snapshot into its top-level fields, omitting zero
+// values. Snapshots other than JSON objects have no fields.
+func snapshotFields(snapshot any) (map[string]json.RawMessage, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, nil
+	}
+
+	for name, value := range fields {
+		var v any
+		if err := json.Unmarshal(value, &v); err == nil && isZeroJSON(v) {
+			delete(fields, name)
+		}
+	}
+	return fields, nil
+}
+
+// isZeroJSON reports whether the decoded JSON value is the encoding of a zero
+// value, which is treated as an absent field when diffing snapshots.
+func isZeroJSON(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == "" || v == zeroTimeJSON
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		for _, value := range v {
+			if !isZeroJSON(value) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
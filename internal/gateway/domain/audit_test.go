@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffAuditSnapshots(t *testing.T) {
+	type snapshot struct {
+		Name   string
+		Tags   []string
+		Public bool
+	}
+
+	tests := []struct {
+		name   string // description of this test case
+		before any
+		after  any
+		want   []AuditChange
+	}{
+		{
+			name:  "created",
+			after: snapshot{Name: "project-1", Tags: []string{"a"}},
+			want: []AuditChange{
+				{Field: "Name", After: json.RawMessage(`"project-1"`)},
+				{Field: "Tags", After: json.RawMessage(`["a"]`)},
+			},
+		},
+		{
+			name:   "updated",
+			before: snapshot{Name: "project-1", Public: true},
+			after:  snapshot{Name: "project-2", Public: true},
+			want: []AuditChange{
+				{
+					Field:  "Name",
+					Before: json.RawMessage(`"project-1"`),
+					After:  json.RawMessage(`"project-2"`),
+				},
+			},
+		},
+		{
+			name:   "deleted",
+			before: snapshot{Name: "project-1", Public: true},
+			want: []AuditChange{
+				{Field: "Name", Before: json.RawMessage(`"project-1"`)},
+				{Field: "Public", Before: json.RawMessage(`true`)},
+			},
+		},
+		{
+			name:   "unchanged",
+			before: snapshot{Name: "project-1"},
+			after:  snapshot{Name: "project-1", Tags: []string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffAuditSnapshots(tt.before, tt.after)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
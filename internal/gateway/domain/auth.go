@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
@@ -22,11 +23,17 @@ type IDTokenPayload struct {
 	ProfileURL    *string
 	Expiry        time.Time
 	IssuedAt      time.Time
+
+	// Claims are all claims of the ID token.
+	Claims map[string]any
 }
 
 // UserTokenPayload represents the payload in a signed JWT for user
 // authentication.
 type UserTokenPayload struct {
+	// SessionID is the jti claim, which identifies the session the token
+	// belongs to. Tokens are rejected once their sessions are revoked.
+	SessionID  string
 	UserID     string
 	IssuedAt   time.Time
 	ExpireAt   time.Time
@@ -40,32 +47,62 @@ func (p UserTokenPayload) IsAdmin() bool {
 	return p.Role == users.RoleAdmin
 }
 
+// OIDCProvider is an identity provider users sign in with.
+type OIDCProvider struct {
+	Name        string
+	DisplayName string
+}
+
+// OIDCState binds the sign-in callback to the provider which the sign-in
+// started with.
 type OIDCState struct {
+	Provider    string `json:"provider"`
 	RedirectURL string `json:"redirectUrl"`
 }
 
-type StartGoogleSignInRequest struct {
+type StartSignInRequest struct {
 	HTTPReq      *http.Request
+	Provider     string
 	RedirectPath string
 }
 
-type StartGoogleSignInResponse struct {
+type StartSignInResponse struct {
 	RedirectURL string
 	OIDCCookie  *http.Cookie
 }
 
-type FinishGoogleSignInRequest struct {
+type FinishSignInRequest struct {
 	HTTPReq  *http.Request
+	Provider string
 	AuthCode string
 	State    string
 }
 
-type FinishGoogleSignInResponse struct {
+type FinishSignInResponse struct {
 	RedirectURL string
 	OIDCCookie  *http.Cookie
 	UserCookie  *http.Cookie
 }
 
+// Session is a sign-in of a user, kept until it expires or is revoked.
+// Refreshed tokens stay in the session of the token refreshed.
+// Session keeps the role of the user, so that verifying tokens needs no user
+// lookups. Sessions are updated on role changes and revoked on suspensions.
+type Session struct {
+	ID       string
+	UserID   string
+	Role     users.Role
+	ExpireAt time.Time
+}
+
+// SignOutRequest revokes the session, or all sessions of the user if
+// AllSessions is true.
+type SignOutRequest struct {
+	SessionID   string
+	UserID      string
+	AllSessions bool
+}
+
 type SignOutResponse struct {
 	UserCookie *http.Cookie
 }
@@ -73,3 +110,21 @@ type SignOutResponse struct {
 type RefreshUserTokenResponse struct {
 	UserCookie *http.Cookie
 }
+
+// ClaimContains reports whether the token claim equals value, or is a list
+// containing value.
+func ClaimContains(claim any, value string) bool {
+	switch v := claim.(type) {
+	case nil:
+		return false
+	case []any:
+		for _, item := range v {
+			if fmt.Sprint(item) == value {
+				return true
+			}
+		}
+		return false
+	default:
+		return fmt.Sprint(v) == value
+	}
+}
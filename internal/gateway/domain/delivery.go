@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+// deliveryFormats lists formats in the order they are delivered when clients
+// accept them.
+var deliveryFormats = []images.Format{
+	images.FormatAVIF,
+	images.FormatWebp,
+	images.FormatJXL,
+	images.FormatHEIC,
+	images.FormatJPEG,
+	images.FormatPNG,
+}
+
+type DeliverImageRequest struct {
+	ProjectID  string `validate:"required,max=36"`
+	ImageID    string `validate:"required,max=36"`
+	PresetName string `validate:"required,max=64,kebabcase"`
+
+	// Accept, DPR and Width are the Accept header and client hints of the
+	// request.
+	Accept string
+	DPR    *float64 `validate:"omitempty,gt=0,lte=10"`
+	Width  *int64   `validate:"omitempty,min=1"`
+}
+
+// PresetFamilyNames returns names of the presets delivered for the preset name.
+// The family consists of the preset itself and presets named after it with a
+// format suffix, such as "thumbnail-avif" and "thumbnail-webp".
+func (r DeliverImageRequest) PresetFamilyNames() []string {
+	names := []string{r.PresetName}
+	for _, f := range deliveryFormats {
+		names = append(names, r.PresetName+"-"+strings.ToLower(string(f)))
+	}
+	return names
+}
+
+type ImageDelivery struct {
+	URL          string
+	ETag         string
+	CacheControl string
+
+	// Variant is the negotiated variant. Nil if the original image is delivered
+	// because no variant of the preset family is ready yet.
+	Variant *ImageVariant
+}
+
+// NegotiateVariant picks the ready variant of the preset family that suits the
+// request best. Formats are picked first by the preference of deliveryFormats,
+// and then variant sets by the DPR and Width client hints.
+func (i Image) NegotiateVariant(family []Preset, req DeliverImageRequest) (ImageVariant, bool) {
+	presetsByID := lo.SliceToMap(family, func(p Preset) (string, Preset) { return p.ID, p })
+
+	candidates := lo.Filter(i.Variants, func(v ImageVariant, _ int) bool {
+		_, inFamily := presetsByID[v.Preset.ID]
+		return inFamily && v.State == images.VariantStateReady
+	})
+
+	for _, format := range deliveryFormats {
+		if !req.Accepts(format) {
+			continue
+		}
+
+		variants := lo.Filter(candidates, func(v ImageVariant, _ int) bool {
+			return v.Format == format
+		})
+		if len(variants) == 0 {
+			continue
+		}
+
+		// Prefer the family member named after the request
+		preset := presetsByID[variants[0].Preset.ID]
+		for _, v := range variants {
+			if p := presetsByID[v.Preset.ID]; p.Name == req.PresetName {
+				preset = p
+				break
+			}
+		}
+		variants = lo.Filter(variants, func(v ImageVariant, _ int) bool {
+			return v.Preset.ID == preset.ID
+		})
+
+		return pickVariantByHints(variants, preset, req.DPR, req.Width), true
+	}
+	return ImageVariant{}, false
+}
+
+// pickVariantByHints picks the smallest variant that covers the hinted DPR or
+// width, or the largest one if none covers it.
+func pickVariantByHints(variants []ImageVariant, preset Preset, dpr *float64, width *int64,
+) ImageVariant {
+	if len(variants) == 1 {
+		return variants[0]
+	}
+
+	var target float64
+	if _, ok := variants[0].Descriptor.Density(); ok {
+		target = lo.FromPtrOr(dpr, 1)
+	} else {
+		switch {
+		case width != nil:
+			target = float64(*width)
+		case preset.Width != nil:
+			target = float64(*preset.Width) * lo.FromPtrOr(dpr, 1)
+		default:
+			target = math.Inf(1)
+		}
+	}
+
+	slices.SortFunc(variants, func(a, b ImageVariant) int {
+		return cmp.Compare(descriptorSortKey(a.Descriptor), descriptorSortKey(b.Descriptor))
+	})
+	for _, v := range variants {
+		if descriptorSortKey(v.Descriptor) >= target {
+			return v
+		}
+	}
+	return variants[len(variants)-1]
+}
+
+// Accepts reports whether the client accepts the format.
+func (r DeliverImageRequest) Accepts(format images.Format) bool {
+	return acceptsFormat(r.Accept, format)
+}
+
+// acceptsFormat reports whether the Accept header allows the format. The most
+// specific media range matching the format decides, and a missing header
+// accepts any format as RFC 9110 defines.
+func acceptsFormat(accept string, format images.Format) bool {
+	if strings.TrimSpace(accept) == "" {
+		return true
+	}
+
+	contentType := format.ContentType()
+	mainType, _, _ := strings.Cut(contentType, "/")
+
+	quality, specificity := 0.0, -1
+	for mediaRange := range strings.SplitSeq(accept, ",") {
+		mediaType, params, _ := strings.Cut(mediaRange, ";")
+
+		var s int
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case contentType:
+			s = 2
+		case mainType + "/*":
+			s = 1
+		case "*/*":
+			s = 0
+		default:
+			continue
+		}
+		if s > specificity {
+			quality, specificity = acceptQuality(params), s
+		}
+	}
+	return quality > 0
+}
+
+func acceptQuality(params string) float64 {
+	for param := range strings.SplitSeq(params, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if key != "q" {
+			continue
+		}
+		q, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0
+		}
+		return q
+	}
+	return 1
+}
+
+// ETag returns a strong entity tag of the variant.
+func (i ImageVariant) ETag() string {
+	return deliveryETag(i.ID, i.UpdatedAt)
+}
+
+// ETag returns a strong entity tag of the original image.
+func (i Image) ETag() string {
+	return deliveryETag(i.ID, i.UpdatedAt)
+}
+
+func deliveryETag(id string, updatedAt time.Time) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s:%d", id, updatedAt.UnixNano()))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+func TestImage_NegotiateVariant(t *testing.T) {
+	family := []Preset{
+		{ID: "preset-1", Name: "thumbnail", Format: images.FormatJPEG, Width: new(int64(400))},
+		{ID: "preset-2", Name: "thumbnail-avif", Format: images.FormatAVIF,
+			Densities: []float64{1, 2, 3}},
+		{ID: "preset-3", Name: "thumbnail-webp", Format: images.FormatWebp, Width: new(int64(320)),
+			Widths: []int64{320, 640, 1280}},
+	}
+
+	variant := func(id, presetID string, format images.Format, d images.Descriptor,
+		state images.VariantState,
+	) ImageVariant {
+		return ImageVariant{
+			ID:         id,
+			Format:     format,
+			State:      state,
+			Descriptor: d,
+			Preset:     PresetReference{ID: presetID},
+		}
+	}
+
+	image := Image{
+		Variants: []ImageVariant{
+			variant("jpeg", "preset-1", images.FormatJPEG, "", images.VariantStateReady),
+			variant("avif-1x", "preset-2", images.FormatAVIF, images.NewDensityDescriptor(1),
+				images.VariantStateReady),
+			variant("avif-2x", "preset-2", images.FormatAVIF, images.NewDensityDescriptor(2),
+				images.VariantStateReady),
+			variant("avif-3x", "preset-2", images.FormatAVIF, images.NewDensityDescriptor(3),
+				images.VariantStateProcessing),
+			variant("webp-320w", "preset-3", images.FormatWebp, images.NewWidthDescriptor(320),
+				images.VariantStateReady),
+			variant("webp-640w", "preset-3", images.FormatWebp, images.NewWidthDescriptor(640),
+				images.VariantStateReady),
+			variant("webp-1280w", "preset-3", images.FormatWebp, images.NewWidthDescriptor(1280),
+				images.VariantStateReady),
+			variant("other", "preset-4", images.FormatAVIF, "", images.VariantStateReady),
+		},
+	}
+
+	tests := []struct {
+		name        string // description of this test case
+		req         DeliverImageRequest
+		wantVariant string
+		wantOK      bool
+	}{
+		{
+			name:        "avif preferred",
+			req:         DeliverImageRequest{Accept: "image/avif,image/webp,*/*"},
+			wantVariant: "avif-1x",
+			wantOK:      true,
+		},
+		{
+			name: "avif by dpr",
+			req: DeliverImageRequest{
+				Accept: "image/avif,image/webp,*/*",
+				DPR:    new(1.5),
+			},
+			wantVariant: "avif-2x",
+			wantOK:      true,
+		},
+		{
+			name: "largest ready avif if dpr is not covered",
+			req: DeliverImageRequest{
+				Accept: "image/avif,image/webp,*/*",
+				DPR:    new(3.0),
+			},
+			wantVariant: "avif-2x",
+			wantOK:      true,
+		},
+		{
+			name: "webp by width",
+			req: DeliverImageRequest{
+				Accept: "image/webp,image/jpeg",
+				Width:  new(int64(500)),
+			},
+			wantVariant: "webp-640w",
+			wantOK:      true,
+		},
+		{
+			name: "webp by dpr on preset width",
+			req: DeliverImageRequest{
+				Accept: "image/webp,image/jpeg",
+				DPR:    new(2.0),
+			},
+			wantVariant: "webp-640w",
+			wantOK:      true,
+		},
+		{
+			name:        "avif refused",
+			req:         DeliverImageRequest{Accept: "image/avif;q=0,image/webp,*/*"},
+			wantVariant: "webp-320w",
+			wantOK:      true,
+		},
+		{
+			name:        "any format without accept header",
+			req:         DeliverImageRequest{},
+			wantVariant: "avif-1x",
+			wantOK:      true,
+		},
+		{
+			name:        "any format by wildcard",
+			req:         DeliverImageRequest{Accept: "*/*"},
+			wantVariant: "avif-1x",
+			wantOK:      true,
+		},
+		{
+			name:        "image formats by wildcard",
+			req:         DeliverImageRequest{Accept: "text/html,image/*;q=0.8"},
+			wantVariant: "avif-1x",
+			wantOK:      true,
+		},
+		{
+			name:        "specific range overrides wildcard",
+			req:         DeliverImageRequest{Accept: "image/avif;q=0,*/*;q=0.8"},
+			wantVariant: "webp-320w",
+			wantOK:      true,
+		},
+		{
+			name:        "jpeg only",
+			req:         DeliverImageRequest{Accept: "image/jpeg"},
+			wantVariant: "jpeg",
+			wantOK:      true,
+		},
+		{
+			name:   "wildcard refused",
+			req:    DeliverImageRequest{Accept: "*/*;q=0"},
+			wantOK: false,
+		},
+		{
+			name:   "nothing acceptable",
+			req:    DeliverImageRequest{Accept: "image/jpeg;q=0,image/png;q=0"},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := image.NegotiateVariant(family, tt.req)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.wantVariant, got.ID)
+		})
+	}
+}
+
+func TestDeliverImageRequest_PresetFamilyNames(t *testing.T) {
+	req := DeliverImageRequest{PresetName: "thumbnail"}
+	require.Equal(t, []string{
+		"thumbnail",
+		"thumbnail-avif",
+		"thumbnail-webp",
+		"thumbnail-jxl",
+		"thumbnail-heic",
+		"thumbnail-jpeg",
+		"thumbnail-png",
+	}, req.PresetFamilyNames())
+}
+
+func TestDeliverImageRequest_Accepts(t *testing.T) {
+	tests := []struct {
+		name   string // description of this test case
+		accept string
+		format images.Format
+		want   bool
+	}{
+		{name: "missing header", accept: "", format: images.FormatHEIC, want: true},
+		{name: "exact type", accept: "image/webp", format: images.FormatWebp, want: true},
+		{name: "exact type case insensitive", accept: "Image/WebP", format: images.FormatWebp,
+			want: true},
+		{name: "type not listed", accept: "image/webp", format: images.FormatJPEG, want: false},
+		{name: "any image", accept: "image/*", format: images.FormatAVIF, want: true},
+		{name: "any type", accept: "*/*;q=0.1", format: images.FormatPNG, want: true},
+		{name: "other main type", accept: "text/*", format: images.FormatPNG, want: false},
+		{name: "refused exact type", accept: "image/heic;q=0, image/*", format: images.FormatHEIC,
+			want: false},
+		{name: "refused wildcard with exact type", accept: "image/*;q=0, image/png",
+			format: images.FormatPNG, want: true},
+		{name: "invalid quality", accept: "image/png;q=high", format: images.FormatPNG,
+			want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := DeliverImageRequest{Accept: tt.accept}
+			require.Equal(t, tt.want, req.Accepts(tt.format))
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"cmp"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/samber/lo"
@@ -16,27 +19,66 @@ type Image struct {
 	ID        string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// DeletedAt is set if the image is soft-deleted and waits for purge.
+	DeletedAt *time.Time
 	FileName  string
 	Format    images.Format
 	State     images.State
 	S3Key     string
-	URL       string
-	Variants  []ImageVariant
-	Project   ProjectReference
+	// URL is empty if the original is deleted by the retention policy of the
+	// project.
+	URL           string
+	OriginalState images.OriginalState
+	Focus         ImageFocus
+	// ExternalID, Tags and Metadata are set by clients to relate the image to
+	// their own data.
+	ExternalID string
+	Tags       []string
+	Metadata   map[string]string
+	// URLExpireAt is set if URLs of the image and its variants are presigned
+	// as the image belongs to a private project.
+	URLExpireAt *time.Time
+	Variants    []ImageVariant
+	Project     ProjectReference
 }
 
 func (i Image) ToProto() *imageerv1.Image {
 	return &imageerv1.Image{
-		Id:        i.ID,
-		CreatedAt: timestamppb.New(i.CreatedAt),
-		UpdatedAt: timestamppb.New(i.UpdatedAt),
-		FileName:  i.FileName,
-		Format:    i.Format.ToProto(),
-		State:     i.State.ToProto(),
-		S3Key:     i.S3Key,
-		Url:       i.URL,
-		ProjectId: i.Project.ID,
+		Id:             i.ID,
+		CreatedAt:      timestamppb.New(i.CreatedAt),
+		UpdatedAt:      timestamppb.New(i.UpdatedAt),
+		FileName:       i.FileName,
+		Format:         i.Format.ToProto(),
+		State:          i.State.ToProto(),
+		S3Key:          i.S3Key,
+		Url:            i.URL,
+		ProjectId:      i.Project.ID,
+		FocalPoint:     i.Focus.FocalPoint.ToProto(),
+		CropBox:        i.Focus.CropBox.ToProto(),
+		StorageProfile: i.Project.StorageProfile,
+	}
+}
+
+// HasOriginal reports whether the original object of the image is still
+// stored.
+func (i Image) HasOriginal() bool {
+	return i.OriginalState != images.OriginalStateDeleted
+}
+
+// VariantsReadyAt returns the time when the last variant of the image became
+// ready, or false if any variant is not ready. Skipped variants are regarded
+// as ready.
+func (i Image) VariantsReadyAt() (time.Time, bool) {
+	readyAt := i.CreatedAt
+	for _, v := range i.Variants {
+		if v.State != images.VariantStateReady && v.State != images.VariantStateSkipped {
+			return time.Time{}, false
+		}
+		if v.UpdatedAt.After(readyAt) {
+			readyAt = v.UpdatedAt
+		}
 	}
+	return readyAt, true
 }
 
 func (i Image) AllVariantsProcessed() bool {
@@ -51,6 +93,56 @@ func (i Image) AllVariantsProcessed() bool {
 	return true
 }
 
+// Srcsets returns srcset attribute values built from the ready variants of the
+// image, keyed by preset name.
+func (i Image) Srcsets() map[string]string {
+	variantsByPreset := make(map[string][]ImageVariant)
+	for _, v := range i.Variants {
+		if v.State != images.VariantStateReady {
+			continue
+		}
+		variantsByPreset[v.Preset.Name] = append(variantsByPreset[v.Preset.Name], v)
+	}
+
+	srcsets := make(map[string]string, len(variantsByPreset))
+	for name, variants := range variantsByPreset {
+		slices.SortFunc(variants, func(a, b ImageVariant) int {
+			return cmp.Compare(descriptorSortKey(a.Descriptor), descriptorSortKey(b.Descriptor))
+		})
+
+		candidates := lo.Map(variants, func(v ImageVariant, _ int) string {
+			if v.Descriptor == "" {
+				return v.URL
+			}
+			return v.URL + " " + string(v.Descriptor)
+		})
+		srcsets[name] = strings.Join(candidates, ", ")
+	}
+	return srcsets
+}
+
+func descriptorSortKey(d images.Descriptor) float64 {
+	if density, ok := d.Density(); ok {
+		return density
+	}
+	if width, ok := d.Width(); ok {
+		return float64(width)
+	}
+	return 0
+}
+
+// ImageFocus is the region of interest of an image set by editors. Cover
+// presets crop around it instead of their anchor. At most one of the fields is
+// set.
+type ImageFocus struct {
+	FocalPoint *images.FocalPoint `validate:"omitempty,validateFn=Validate"`
+	CropBox    *images.CropBox    `validate:"omitempty,validateFn=Validate,excluded_with=FocalPoint"`
+}
+
+func (f ImageFocus) IsZero() bool {
+	return f.FocalPoint == nil && f.CropBox == nil
+}
+
 type Images struct {
 	Items []Image
 	Total int64
@@ -72,10 +164,24 @@ func (p ListImagesParams) LimitOrDefault() int {
 	return lo.FromPtrOr(p.Limit, 20)
 }
 
+// ImageSearchFilter excludes soft-deleted images unless Deleted is set, in
+// which case only soft-deleted images are searched.
 type ImageSearchFilter struct {
 	ProjectID       *string
+	Deleted         bool
+	DeletedAtBefore *time.Time
 	State           *images.State
+	OriginalStates  []images.OriginalState
+	CreatedAtAfter  *time.Time
+	CreatedAtBefore *time.Time
 	UpdatedAtBefore *time.Time
+
+	ExternalID     *string
+	FileNamePrefix *string
+	Format         *images.Format
+	// Tags and Metadata match images having all of the tags and entries.
+	Tags     []string
+	Metadata map[string]string
 }
 
 type ImageSortFilter struct {
@@ -85,8 +191,29 @@ type ImageSortFilter struct {
 }
 
 type UpdateImageRequest struct {
-	ID    string
-	State *images.State
+	ID            string
+	State         *images.State
+	URL           *string
+	OriginalState *images.OriginalState
+	Focus         *ImageFocus
+	ExternalID    *string
+	Tags          []string
+	Metadata      map[string]string
+}
+
+type UpdateImageFocusRequest struct {
+	ImageID string `validate:"required,max=36"`
+	Focus   ImageFocus
+}
+
+// UpdateImageDetailsRequest replaces fields which are not nil. ExternalID is
+// cleared if set to empty, and Tags and Metadata are cleared if set to empty
+// values.
+type UpdateImageDetailsRequest struct {
+	ImageID    string            `validate:"required,max=36"`
+	ExternalID *string           `validate:"omitempty,max=256"`
+	Tags       []string          `validate:"max=32,dive,required,max=64"`
+	Metadata   map[string]string `validate:"max=32,dive,keys,required,max=64,endkeys,max=512"`
 }
 
 type ReprocessImagesRequest struct {
@@ -122,15 +249,21 @@ type UploadURL struct {
 }
 
 type CreateUploadURLRequest struct {
-	ProjectID   string        `validate:"required,max=36"`
-	FileName    string        `validate:"required,max=512"`
-	Format      images.Format `validate:"validateFn=Validate"`
-	PresetNames []string      `validate:"dive,required,max=64,kebabcase"`
+	ProjectID   string            `validate:"required,max=36"`
+	FileName    string            `validate:"required,max=512"`
+	Format      images.Format     `validate:"validateFn=ValidateForUpload"`
+	PresetNames []string          `validate:"dive,required,max=64,kebabcase"`
+	ExternalID  string            `validate:"max=256"`
+	Tags        []string          `validate:"max=32,dive,required,max=64"`
+	Metadata    map[string]string `validate:"max=32,dive,keys,required,max=64,endkeys,max=512"`
 }
 
+// PresignPutObjectRequest and PresignGetObjectRequest are routed to the
+// storage of StorageProfile, or of the default profile if empty.
 type PresignPutObjectRequest struct {
-	S3Key       string
-	ContentType string
+	StorageProfile string
+	S3Key          string
+	ContentType    string
 }
 
 type PresignPutObjectResponse struct {
@@ -138,3 +271,20 @@ type PresignPutObjectResponse struct {
 	Header   http.Header
 	ExpireAt time.Time
 }
+
+type PresignGetObjectRequest struct {
+	StorageProfile string
+	S3Key          string
+	Expiry         time.Duration
+}
+
+type PresignGetObjectResponse struct {
+	URL      string
+	ExpireAt time.Time
+}
+
+// StoredObject is an object listed from a storage.
+type StoredObject struct {
+	Key          string
+	LastModified time.Time
+}
@@ -1,8 +1,11 @@
 package domain
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/isutare412/imageer/pkg/images"
@@ -57,3 +60,273 @@ func TestCreateUploadURLRequest_Validation(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateImageFocusRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name    string // description of this test case
+		req     UpdateImageFocusRequest
+		wantErr bool
+	}{
+		{
+			name: "focal point",
+			req: UpdateImageFocusRequest{
+				ImageID: "image-1",
+				Focus: ImageFocus{
+					FocalPoint: &images.FocalPoint{X: 0.5, Y: 0.3},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "crop box",
+			req: UpdateImageFocusRequest{
+				ImageID: "image-1",
+				Focus: ImageFocus{
+					CropBox: &images.CropBox{X: 0.1, Y: 0.2, Width: 0.5, Height: 0.5},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "clear focus",
+			req: UpdateImageFocusRequest{
+				ImageID: "image-1",
+			},
+			wantErr: false,
+		},
+		{
+			name: "focal point out of range",
+			req: UpdateImageFocusRequest{
+				ImageID: "image-1",
+				Focus: ImageFocus{
+					FocalPoint: &images.FocalPoint{X: 1.5, Y: 0.3}, // invalid x
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "crop box exceeds image",
+			req: UpdateImageFocusRequest{
+				ImageID: "image-1",
+				Focus: ImageFocus{
+					CropBox: &images.CropBox{X: 0.6, Y: 0.2, Width: 0.5, Height: 0.5}, // invalid width
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "both focal point and crop box",
+			req: UpdateImageFocusRequest{
+				ImageID: "image-1",
+				Focus: ImageFocus{
+					FocalPoint: &images.FocalPoint{X: 0.5, Y: 0.3},
+					CropBox:    &images.CropBox{X: 0.1, Y: 0.2, Width: 0.5, Height: 0.5},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.Validate(tt.req)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestImage_Srcsets(t *testing.T) {
+	variant := func(presetName, url string, d images.Descriptor, state images.VariantState,
+	) ImageVariant {
+		return ImageVariant{
+			URL:        url,
+			State:      state,
+			Descriptor: d,
+			Preset:     PresetReference{Name: presetName},
+		}
+	}
+
+	tests := []struct {
+		name     string // description of this test case
+		variants []ImageVariant
+		want     map[string]string
+	}{
+		{
+			name:     "no variants",
+			variants: nil,
+			want:     map[string]string{},
+		},
+		{
+			name: "single variant without descriptor",
+			variants: []ImageVariant{
+				variant("thumbnail", "https://cdn/a.webp", "", images.VariantStateReady),
+			},
+			want: map[string]string{
+				"thumbnail": "https://cdn/a.webp",
+			},
+		},
+		{
+			name: "density set sorted by density",
+			variants: []ImageVariant{
+				variant("thumbnail", "https://cdn/3x.webp", images.NewDensityDescriptor(3),
+					images.VariantStateReady),
+				variant("thumbnail", "https://cdn/1x.webp", images.NewDensityDescriptor(1),
+					images.VariantStateReady),
+				variant("thumbnail", "https://cdn/1.5x.webp", images.NewDensityDescriptor(1.5),
+					images.VariantStateReady),
+			},
+			want: map[string]string{
+				"thumbnail": "https://cdn/1x.webp 1x, https://cdn/1.5x.webp 1.5x, " +
+					"https://cdn/3x.webp 3x",
+			},
+		},
+		{
+			name: "width sets per preset skip variants not ready",
+			variants: []ImageVariant{
+				variant("hero", "https://cdn/1280w.webp", images.NewWidthDescriptor(1280),
+					images.VariantStateReady),
+				variant("hero", "https://cdn/320w.webp", images.NewWidthDescriptor(320),
+					images.VariantStateReady),
+				variant("hero", "https://cdn/640w.webp", images.NewWidthDescriptor(640),
+					images.VariantStateProcessing),
+				variant("hero", "https://cdn/2560w.webp", images.NewWidthDescriptor(2560),
+					images.VariantStateSkipped),
+				variant("card", "https://cdn/card.webp", images.NewWidthDescriptor(480),
+					images.VariantStateReady),
+				variant("failed", "https://cdn/failed.webp", "", images.VariantStateFailed),
+			},
+			want: map[string]string{
+				"hero": "https://cdn/320w.webp 320w, https://cdn/1280w.webp 1280w",
+				"card": "https://cdn/card.webp 480w",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Image{Variants: tt.variants}.Srcsets()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImage_VariantsReadyAt(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	variant := func(state images.VariantState, updatedAt time.Time) ImageVariant {
+		return ImageVariant{State: state, UpdatedAt: updatedAt}
+	}
+
+	tests := []struct {
+		name      string // description of this test case
+		variants  []ImageVariant
+		want      time.Time
+		wantReady bool
+	}{
+		{
+			name:      "no variants",
+			variants:  nil,
+			want:      createdAt,
+			wantReady: true,
+		},
+		{
+			name: "latest ready variant",
+			variants: []ImageVariant{
+				variant(images.VariantStateReady, createdAt.Add(2*time.Minute)),
+				variant(images.VariantStateReady, createdAt.Add(5*time.Minute)),
+				variant(images.VariantStateSkipped, createdAt.Add(time.Minute)),
+			},
+			want:      createdAt.Add(5 * time.Minute),
+			wantReady: true,
+		},
+		{
+			name: "variant processing",
+			variants: []ImageVariant{
+				variant(images.VariantStateReady, createdAt.Add(time.Minute)),
+				variant(images.VariantStateProcessing, createdAt),
+			},
+			wantReady: false,
+		},
+		{
+			name: "variant failed",
+			variants: []ImageVariant{
+				variant(images.VariantStateFailed, createdAt.Add(time.Minute)),
+			},
+			wantReady: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := Image{CreatedAt: createdAt, Variants: tt.variants}
+			got, ready := img.VariantsReadyAt()
+			assert.Equal(t, tt.wantReady, ready)
+			if tt.wantReady {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateImageDetailsRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name    string // description of this test case
+		req     UpdateImageDetailsRequest
+		wantErr bool
+	}{
+		{
+			name: "normal case",
+			req: UpdateImageDetailsRequest{
+				ImageID:    "image-1",
+				ExternalID: new("external-1"),
+				Tags:       []string{"banner", "summer"},
+				Metadata:   map[string]string{"source": "cms"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "clear all",
+			req: UpdateImageDetailsRequest{
+				ImageID:    "image-1",
+				ExternalID: new(""),
+				Tags:       []string{},
+				Metadata:   map[string]string{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty tag",
+			req: UpdateImageDetailsRequest{
+				ImageID: "image-1",
+				Tags:    []string{""},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty metadata key",
+			req: UpdateImageDetailsRequest{
+				ImageID:  "image-1",
+				Metadata: map[string]string{"": "cms"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long metadata value",
+			req: UpdateImageDetailsRequest{
+				ImageID:  "image-1",
+				Metadata: map[string]string{"source": strings.Repeat("a", 513)},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.Validate(tt.req)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
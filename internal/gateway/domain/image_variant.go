@@ -18,6 +18,10 @@ type ImageVariant struct {
 	S3Key     string
 	URL       string
 
+	// Descriptor is the srcset descriptor of the variant within the variant set
+	// of its preset. Empty if the preset has no variant set.
+	Descriptor images.Descriptor
+
 	ImageID string
 	Preset  PresetReference
 }
@@ -38,4 +42,6 @@ func (i ImageVariant) ToProto() *imageerv1.ImageVariant {
 type UpdateImageVariantRequest struct {
 	ID    string
 	State *images.VariantState
+	S3Key *string
+	URL   *string
 }
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"math"
 	"time"
 
 	"github.com/samber/lo"
@@ -24,6 +25,18 @@ type Preset struct {
 	Anchor  *images.Anchor
 	Width   *int64
 	Height  *int64
+
+	// WithoutEnlargement keeps variants from being rendered larger than the
+	// original image.
+	WithoutEnlargement bool
+
+	// Densities and Widths declare a variant set. Each entry is rendered as a
+	// separate variant and listed in the srcset of the preset.
+	Densities []float64
+	Widths    []int64
+
+	Encoder   *PresetEncoder
+	Watermark *PresetWatermark
 }
 
 func (p Preset) ToProto() *imageerv1.Preset {
@@ -49,10 +62,109 @@ func (p Preset) ToProto() *imageerv1.Preset {
 	if p.Height != nil {
 		preset.Height = new(int32(*p.Height))
 	}
+	if p.Encoder != nil {
+		preset.Encoder = p.Encoder.ToProto()
+	}
+	if p.Watermark != nil {
+		preset.Watermark = p.Watermark.ToProto()
+	}
+	preset.WithoutEnlargement = p.WithoutEnlargement
 
 	return preset
 }
 
+// VariantDescriptors returns the srcset descriptors of the variants rendered
+// for the preset.
+func (p Preset) VariantDescriptors() []images.Descriptor {
+	switch {
+	case len(p.Densities) > 0:
+		return lo.Map(p.Densities, func(d float64, _ int) images.Descriptor {
+			return images.NewDensityDescriptor(d)
+		})
+	case len(p.Widths) > 0:
+		return lo.Map(p.Widths, func(w int64, _ int) images.Descriptor {
+			return images.NewWidthDescriptor(w)
+		})
+	default:
+		return []images.Descriptor{""}
+	}
+}
+
+// ForDescriptor returns the preset with dimensions of the variant of the given
+// descriptor.
+func (p Preset) ForDescriptor(d images.Descriptor) Preset {
+	if density, ok := d.Density(); ok {
+		p.Width = scaleDimension(p.Width, density)
+		p.Height = scaleDimension(p.Height, density)
+	} else if width, ok := d.Width(); ok {
+		if p.Width != nil && p.Height != nil {
+			p.Height = scaleDimension(p.Height, float64(width)/float64(*p.Width))
+		} else {
+			p.Height = nil
+		}
+		p.Width = &width
+	}
+	return p
+}
+
+func scaleDimension(dim *int64, factor float64) *int64 {
+	if dim == nil {
+		return nil
+	}
+	return new(max(int64(math.Round(float64(*dim)*factor)), 1))
+}
+
+// CropsAroundFocus reports whether variants of the preset are cropped, and so
+// follow the focus of the image.
+func (p Preset) CropsAroundFocus() bool {
+	return p.Fit != nil && *p.Fit == images.FitCover && p.Width != nil && p.Height != nil
+}
+
+// PresetEncoder tunes the encoder of AVIF, HEIC and JPEG XL variants. Other
+// formats ignore it.
+type PresetEncoder struct {
+	// Effort trades encoding time for smaller files, from 0 (fastest) to 9.
+	Effort            *int64 `validate:"omitempty,min=0,max=9"`
+	Lossless          bool
+	ChromaSubsampling *images.ChromaSubsampling `validate:"omitempty,validateFn=Validate"`
+}
+
+func (e PresetEncoder) ToProto() *imageerv1.PresetEncoder {
+	encoder := &imageerv1.PresetEncoder{
+		Lossless: e.Lossless,
+	}
+	if e.Effort != nil {
+		encoder.Effort = new(int32(*e.Effort))
+	}
+	if e.ChromaSubsampling != nil {
+		encoder.ChromaSubsampling = e.ChromaSubsampling.ToProto()
+	}
+	return encoder
+}
+
+// PresetWatermark describes how a project watermark is composited onto
+// variants of a preset.
+type PresetWatermark struct {
+	WatermarkID string        `validate:"required,max=36"`
+	Anchor      images.Anchor `validate:"validateFn=ValidateForWatermark"`
+	Opacity     float64       `validate:"gt=0,lte=1"`
+	Margin      int64         `validate:"min=0,max=1000"`
+	// Scale is the width of the watermark relative to the width of the variant.
+	Scale float64 `validate:"gt=0,lte=1"`
+}
+
+// ToProto converts the watermark settings into proto. S3 key of the watermark
+// is not known to presets, so callers must fill it.
+func (w PresetWatermark) ToProto() *imageerv1.PresetWatermark {
+	return &imageerv1.PresetWatermark{
+		WatermarkId: w.WatermarkID,
+		Anchor:      w.Anchor.ToProto(),
+		Opacity:     float32(w.Opacity),
+		Margin:      int32(w.Margin),
+		Scale:       float32(w.Scale),
+	}
+}
+
 type PresetReference struct {
 	ID   string
 	Name string
@@ -68,6 +180,14 @@ type CreatePresetRequest struct {
 	Anchor  *images.Anchor  `validate:"omitempty,validateFn=Validate"`
 	Width   *int64          `validate:"omitempty,min=1,max=4000"`
 	Height  *int64          `validate:"omitempty,min=1,max=4000"`
+
+	WithoutEnlargement bool
+
+	Densities []float64 `validate:"omitempty,excluded_with=Widths,excluded_without_all=Width Height,max=4,unique,dive,gt=0,lte=4"`
+	Widths    []int64   `validate:"omitempty,max=10,unique,dive,min=1,max=4000"`
+
+	Encoder   *PresetEncoder
+	Watermark *PresetWatermark
 }
 
 func (r CreatePresetRequest) ToPreset() Preset {
@@ -80,6 +200,14 @@ func (r CreatePresetRequest) ToPreset() Preset {
 		Anchor:  r.Anchor,
 		Width:   r.Width,
 		Height:  r.Height,
+
+		WithoutEnlargement: r.WithoutEnlargement,
+
+		Densities: r.Densities,
+		Widths:    r.Widths,
+
+		Encoder:   r.Encoder,
+		Watermark: r.Watermark,
 	}
 }
 
@@ -94,6 +222,17 @@ type UpsertPresetRequest struct {
 	Anchor  *images.Anchor  `validate:"omitempty,validateFn=Validate"`
 	Width   *int64          `validate:"omitempty,min=1,max=4000"`
 	Height  *int64          `validate:"omitempty,min=1,max=4000"`
+
+	WithoutEnlargement *bool
+
+	Densities []float64 `validate:"omitempty,excluded_with=Widths,excluded_without_all=Width Height,max=4,unique,dive,gt=0,lte=4"`
+	Widths    []int64   `validate:"omitempty,max=10,unique,dive,min=1,max=4000"`
+
+	Encoder   *PresetEncoder
+	Watermark *PresetWatermark `validate:"excluded_with=RemoveWatermark"`
+
+	// RemoveWatermark stops the preset from applying its watermark.
+	RemoveWatermark bool
 }
 
 func (r UpsertPresetRequest) IsUpdateRequest() bool {
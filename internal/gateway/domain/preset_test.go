@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/isutare412/imageer/pkg/images"
 	"github.com/isutare412/imageer/pkg/validation"
 )
 
@@ -50,6 +51,113 @@ func TestUpsertPresetRequest_Validation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "with watermark",
+			req: UpsertPresetRequest{
+				Name: new("w100h100"),
+				Watermark: &PresetWatermark{
+					WatermarkID: "watermark-1",
+					Anchor:      images.AnchorSouth,
+					Opacity:     0.5,
+					Margin:      16,
+					Scale:       0.2,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "remove watermark",
+			req: UpsertPresetRequest{
+				ID:              new("preset-1"),
+				RemoveWatermark: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "watermark with remove watermark",
+			req: UpsertPresetRequest{
+				ID: new("preset-1"),
+				Watermark: &PresetWatermark{
+					WatermarkID: "watermark-1",
+					Anchor:      images.AnchorSouth,
+					Opacity:     0.5,
+					Scale:       0.2,
+				},
+				RemoveWatermark: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid watermark anchor",
+			req: UpsertPresetRequest{
+				Name: new("w100h100"),
+				Watermark: &PresetWatermark{
+					WatermarkID: "watermark-1",
+					Anchor:      images.AnchorSmart,
+					Opacity:     0.5,
+					Scale:       0.2,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "with densities",
+			req: UpsertPresetRequest{
+				Name:      new("w100h100"),
+				Width:     new(int64(100)),
+				Densities: []float64{1, 2, 3},
+			},
+			wantErr: false,
+		},
+		{
+			name: "densities without dimensions",
+			req: UpsertPresetRequest{
+				Name:      new("w100h100"),
+				Densities: []float64{1, 2},
+			},
+			wantErr: true,
+		},
+		{
+			name: "densities with widths",
+			req: UpsertPresetRequest{
+				Name:      new("w100h100"),
+				Width:     new(int64(100)),
+				Densities: []float64{1, 2},
+				Widths:    []int64{320, 640},
+			},
+			wantErr: true,
+		},
+		{
+			name: "avif with encoder",
+			req: UpsertPresetRequest{
+				Name:   new("w100h100"),
+				Format: new(images.FormatAVIF),
+				Encoder: &PresetEncoder{
+					Effort:            new(int64(6)),
+					ChromaSubsampling: new(images.ChromaSubsampling444),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid encoder effort",
+			req: UpsertPresetRequest{
+				Name:   new("w100h100"),
+				Format: new(images.FormatJXL),
+				Encoder: &PresetEncoder{
+					Effort: new(int64(10)),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate widths",
+			req: UpsertPresetRequest{
+				Name:   new("w100h100"),
+				Widths: []int64{320, 320},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -62,3 +170,43 @@ func TestUpsertPresetRequest_Validation(t *testing.T) {
 		})
 	}
 }
+
+func TestPreset_ForDescriptor(t *testing.T) {
+	preset := Preset{
+		Width:  new(int64(400)),
+		Height: new(int64(300)),
+	}
+
+	tests := []struct {
+		name       string // description of this test case
+		descriptor images.Descriptor
+		wantWidth  *int64
+		wantHeight *int64
+	}{
+		{
+			name:       "no descriptor",
+			descriptor: "",
+			wantWidth:  new(int64(400)),
+			wantHeight: new(int64(300)),
+		},
+		{
+			name:       "density descriptor",
+			descriptor: images.NewDensityDescriptor(2),
+			wantWidth:  new(int64(800)),
+			wantHeight: new(int64(600)),
+		},
+		{
+			name:       "width descriptor keeps aspect ratio",
+			descriptor: images.NewWidthDescriptor(200),
+			wantWidth:  new(int64(200)),
+			wantHeight: new(int64(150)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := preset.ForDescriptor(tt.descriptor)
+			require.Equal(t, tt.wantWidth, got.Width)
+			require.Equal(t, tt.wantHeight, got.Height)
+		})
+	}
+}
@@ -6,30 +6,92 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/projects"
 )
 
 type Project struct {
-	ID         string
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	ID        string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// DeletedAt is set if the project is soft-deleted and waits for purge.
+	DeletedAt  *time.Time
 	Name       string
-	Presets    []Preset
-	ImageCount int64
+	Visibility projects.Visibility
+	// KeyTemplate and CDNBaseURL override the default layout of objects and
+	// the CDN domain of images of the project if set.
+	KeyTemplate *projects.KeyTemplate
+	CDNBaseURL  *string
+	// StorageProfile is the name of the storage profile objects of the project
+	// are stored in. Empty means the default profile.
+	StorageProfile string
+	Retention      RetentionPolicy
+	Presets        []Preset
+	ImageCount     int64
+}
+
+func (p Project) ToReference() ProjectReference {
+	return ProjectReference{
+		ID:             p.ID,
+		Name:           p.Name,
+		Visibility:     p.Visibility,
+		KeyTemplate:    p.KeyTemplate,
+		CDNBaseURL:     p.CDNBaseURL,
+		StorageProfile: p.StorageProfile,
+	}
 }
 
 type ProjectReference struct {
-	ID   string
-	Name string
+	ID             string
+	Name           string
+	Visibility     projects.Visibility
+	KeyTemplate    *projects.KeyTemplate
+	CDNBaseURL     *string
+	StorageProfile string
+}
+
+// RetentionPolicy decides how long objects of images of a project are kept.
+type RetentionPolicy struct {
+	// Original is applied to originals OriginalDays days after all variants of
+	// the image are ready.
+	Original     projects.OriginalRetention `validate:"validateFn=Validate"`
+	OriginalDays int                        `validate:"min=0,max=36500"`
+	// ImageExpireDays is the number of days after creation when images are
+	// deleted entirely. Images never expire if zero.
+	ImageExpireDays int `validate:"min=0,max=36500"`
+}
+
+// DefaultRetentionPolicy keeps images and their originals forever.
+func DefaultRetentionPolicy() RetentionPolicy {
+	return RetentionPolicy{Original: projects.OriginalRetentionKeep}
+}
+
+func (p RetentionPolicy) IsDefault() bool {
+	return p.Original == projects.OriginalRetentionKeep && p.ImageExpireDays == 0
 }
 
 type CreateProjectRequest struct {
-	Name    string                `validate:"required,max=128,kebabcase"`
-	Presets []CreatePresetRequest `validate:"dive,required"`
+	Name        string                `validate:"required,max=128,kebabcase"`
+	Visibility  projects.Visibility   `validate:"validateFn=Validate"`
+	KeyTemplate *projects.KeyTemplate `validate:"omitzero,max=512,validateFn=Validate"`
+	CDNBaseURL  *string               `validate:"omitzero,max=512,http_url,endsnotwith=/"`
+	Presets     []CreatePresetRequest `validate:"dive,required"`
+
+	// StorageProfile cannot be changed after creation, as objects are not
+	// moved between storages. The default profile is used if not set.
+	StorageProfile *string `validate:"omitzero,max=64"`
+
+	// Retention is the default policy if not set.
+	Retention *RetentionPolicy
 }
 
 func (r CreateProjectRequest) ToProject() Project {
 	return Project{
-		Name: r.Name,
+		Name:           r.Name,
+		Visibility:     r.Visibility,
+		KeyTemplate:    lo.EmptyableToPtr(lo.FromPtr(r.KeyTemplate)),
+		CDNBaseURL:     lo.EmptyableToPtr(lo.FromPtr(r.CDNBaseURL)),
+		StorageProfile: lo.FromPtr(r.StorageProfile),
+		Retention:      lo.FromPtrOr(r.Retention, DefaultRetentionPolicy()),
 		Presets: lo.Map(r.Presets, func(t CreatePresetRequest, _ int) Preset {
 			return t.ToPreset()
 		}),
@@ -37,9 +99,19 @@ func (r CreateProjectRequest) ToProject() Project {
 }
 
 type UpdateProjectRequest struct {
-	ID      string                `validate:"max=36"`
-	Name    *string               `validate:"omitempty,max=128,kebabcase"`
-	Presets []UpsertPresetRequest `validate:"dive,required"`
+	ID         string                `validate:"max=36"`
+	Name       *string               `validate:"omitempty,max=128,kebabcase"`
+	Visibility *projects.Visibility  `validate:"omitempty,validateFn=Validate"`
+	Presets    []UpsertPresetRequest `validate:"dive,required"`
+
+	// KeyTemplate and CDNBaseURL are reset to defaults if set to empty values.
+	// Objects of existing images are not moved.
+	KeyTemplate *projects.KeyTemplate `validate:"omitzero,max=512,validateFn=Validate"`
+	CDNBaseURL  *string               `validate:"omitzero,max=512,http_url,endsnotwith=/"`
+
+	// Retention replaces the retention policy if set. It applies to existing
+	// images as well.
+	Retention *RetentionPolicy
 }
 
 type Projects struct {
@@ -63,8 +135,15 @@ func (p ListProjectsParams) LimitOrDefault() int {
 	return lo.FromPtrOr(p.Limit, 20)
 }
 
+// ProjectSearchFilter excludes soft-deleted projects unless Deleted is set, in
+// which case only soft-deleted projects are searched. MemberUserID matches
+// projects the user is a member of.
 type ProjectSearchFilter struct {
-	Name *string
+	IDs             []string
+	Name            *string
+	MemberUserID    *string
+	Deleted         bool
+	DeletedAtBefore *time.Time
 }
 
 type ProjectSortFilter struct {
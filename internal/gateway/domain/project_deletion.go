@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/isutare412/imageer/pkg/projects"
+)
+
+// ProjectDeletion is the job purging a deleted project along with its images
+// and their objects. It starts once the restore window of the project passes,
+// and is canceled if the project is restored before.
+type ProjectDeletion struct {
+	ID          string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ProjectID   string
+	ProjectName string
+	// StorageProfile is the profile of the project, where objects of its images
+	// and watermarks are deleted from.
+	StorageProfile string
+	State          projects.DeletionState
+	StartAfter     time.Time
+	// TotalImages is counted when the job starts running.
+	TotalImages  int64
+	PurgedImages int64
+	// LastError is the error of the last run, which is retried later.
+	LastError  string
+	FinishedAt *time.Time
+}
+
+type ListProjectDeletionsParams struct {
+	SearchFilter ProjectDeletionSearchFilter
+}
+
+type ProjectDeletionSearchFilter struct {
+	ProjectID        *string
+	States           []projects.DeletionState
+	StartAfterBefore *time.Time
+}
+
+type UpdateProjectDeletionRequest struct {
+	ID           string
+	State        *projects.DeletionState
+	TotalImages  *int64
+	PurgedImages *int64
+	LastError    *string
+	FinishedAt   *time.Time
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/isutare412/imageer/pkg/projects"
+)
+
+// ProjectMember grants a user access to a project according to the role.
+type ProjectMember struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ProjectID string
+	Role      projects.MemberRole
+	User      User
+}
+
+// AddProjectMemberRequest refers to the user by email, as users are known to
+// teammates by email. The user must have signed in before.
+type AddProjectMemberRequest struct {
+	ProjectID string              `validate:"required,max=36"`
+	Email     string              `validate:"required,max=1024,email"`
+	Role      projects.MemberRole `validate:"validateFn=Validate"`
+}
+
+type UpdateProjectMemberRequest struct {
+	ProjectID string              `validate:"required,max=36"`
+	UserID    string              `validate:"required,max=36"`
+	Role      projects.MemberRole `validate:"validateFn=Validate"`
+}
@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/pkg/projects"
+	"github.com/isutare412/imageer/pkg/validation"
+)
+
+func TestCreateProjectRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name    string // description of this test case
+		req     CreateProjectRequest
+		wantErr bool
+	}{
+		{
+			name: "public project",
+			req: CreateProjectRequest{
+				Name:       "test-project",
+				Visibility: projects.VisibilityPublic,
+			},
+			wantErr: false,
+		},
+		{
+			name: "private project",
+			req: CreateProjectRequest{
+				Name:       "test-project",
+				Visibility: projects.VisibilityPrivate,
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing visibility",
+			req: CreateProjectRequest{
+				Name: "test-project",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid visibility",
+			req: CreateProjectRequest{
+				Name:       "test-project",
+				Visibility: projects.Visibility("INTERNAL"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "key template and CDN base URL",
+			req: CreateProjectRequest{
+				Name:        "test-project",
+				Visibility:  projects.VisibilityPublic,
+				KeyTemplate: new(projects.KeyTemplate("{project}/{yyyy}/{mm}/{dd}/{image}/{preset}-{variant}.{ext}")),
+				CDNBaseURL:  new("https://images.example.com"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "key template without variant",
+			req: CreateProjectRequest{
+				Name:        "test-project",
+				Visibility:  projects.VisibilityPublic,
+				KeyTemplate: new(projects.KeyTemplate("{project}/{image}/{preset}.{ext}")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "key template with unknown placeholder",
+			req: CreateProjectRequest{
+				Name:        "test-project",
+				Visibility:  projects.VisibilityPublic,
+				KeyTemplate: new(projects.KeyTemplate("{tenant}/{image}/{variant}.{ext}")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "key template with relative path",
+			req: CreateProjectRequest{
+				Name:        "test-project",
+				Visibility:  projects.VisibilityPublic,
+				KeyTemplate: new(projects.KeyTemplate("../{image}/{variant}.{ext}")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "storage profile",
+			req: CreateProjectRequest{
+				Name:           "test-project",
+				Visibility:     projects.VisibilityPublic,
+				StorageProfile: new("archive"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "storage profile too long",
+			req: CreateProjectRequest{
+				Name:           "test-project",
+				Visibility:     projects.VisibilityPublic,
+				StorageProfile: new(strings.Repeat("a", 65)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "retention policy",
+			req: CreateProjectRequest{
+				Name:       "test-project",
+				Visibility: projects.VisibilityPublic,
+				Retention: &RetentionPolicy{
+					Original:        projects.OriginalRetentionDelete,
+					OriginalDays:    30,
+					ImageExpireDays: 365,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid original retention",
+			req: CreateProjectRequest{
+				Name:       "test-project",
+				Visibility: projects.VisibilityPublic,
+				Retention:  &RetentionPolicy{Original: projects.OriginalRetention("ARCHIVE")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative original retention days",
+			req: CreateProjectRequest{
+				Name:       "test-project",
+				Visibility: projects.VisibilityPublic,
+				Retention: &RetentionPolicy{
+					Original:     projects.OriginalRetentionInfrequentAccess,
+					OriginalDays: -1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "CDN base URL with trailing slash",
+			req: CreateProjectRequest{
+				Name:       "test-project",
+				Visibility: projects.VisibilityPublic,
+				CDNBaseURL: new("https://images.example.com/"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.Validate(tt.req)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateProjectRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name    string // description of this test case
+		req     UpdateProjectRequest
+		wantErr bool
+	}{
+		{
+			name: "visibility unchanged",
+			req: UpdateProjectRequest{
+				ID:   "test-id",
+				Name: new("test-project"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "visibility changed",
+			req: UpdateProjectRequest{
+				ID:         "test-id",
+				Visibility: new(projects.VisibilityPrivate),
+			},
+			wantErr: false,
+		},
+		{
+			name: "key template and CDN base URL reset",
+			req: UpdateProjectRequest{
+				ID:          "test-id",
+				KeyTemplate: new(projects.KeyTemplate("")),
+				CDNBaseURL:  new(""),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid visibility",
+			req: UpdateProjectRequest{
+				ID:         "test-id",
+				Visibility: new(projects.Visibility("")),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.Validate(tt.req)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"slices"
 	"time"
 
 	"github.com/samber/lo"
@@ -16,8 +17,18 @@ type ServiceAccount struct {
 	ExpireAt    *time.Time
 	Name        string
 	AccessScope serviceaccounts.AccessScope
-	APIKeyHash  string
+	Permissions []serviceaccounts.Permission
 	Projects    []ProjectReference
+	// LastUsedAt and LastUsedIP are of the last authentication by any
+	// credential of the account, which are recorded with a delay.
+	LastUsedAt *time.Time
+	LastUsedIP string
+	// StaleWarnedAt is set while the account is warned to be disabled for not
+	// being used.
+	StaleWarnedAt *time.Time
+	// DisabledAt and DisabledReason are set while the account is disabled.
+	DisabledAt     *time.Time
+	DisabledReason serviceaccounts.DisabledReason
 }
 
 func (a ServiceAccount) IsExpired() bool {
@@ -27,28 +38,85 @@ func (a ServiceAccount) IsExpired() bool {
 	return a.ExpireAt.Before(time.Now())
 }
 
+func (a ServiceAccount) IsDisabled() bool {
+	return a.DisabledAt != nil
+}
+
 func (a ServiceAccount) HasFullAccess() bool {
 	return a.AccessScope == serviceaccounts.AccessScopeFull
 }
 
+func (a ServiceAccount) HasPermission(p serviceaccounts.Permission) bool {
+	return slices.Contains(a.Permissions, p)
+}
+
+// ServiceAccountWithAPIKey carries a newly minted API key, which is never
+// retrievable after its creation.
 type ServiceAccountWithAPIKey struct {
 	ServiceAccount
-	APIKey string
+	APIKeyID string
+	APIKey   string
+}
+
+// ServiceAccountAPIKey is one of the API keys of a service account. Keys of an
+// account are valid at the same time, so that keys are rotated without
+// downtime by minting a new key before revoking the old one.
+type ServiceAccountAPIKey struct {
+	ID               string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	ServiceAccountID string
+	Name             string
+	Prefix           string
+	Hash             string
+	LastUsedAt       *time.Time
+	ExpireAt         *time.Time
+}
+
+func (k ServiceAccountAPIKey) IsExpired() bool {
+	if k.ExpireAt == nil {
+		return false
+	}
+	return k.ExpireAt.Before(time.Now())
+}
+
+// ServiceAccountUsage is an authentication of a service account. APIKeyHash
+// is empty for authentications by credentials other than API keys.
+type ServiceAccountUsage struct {
+	ServiceAccountID string
+	APIKeyHash       string
+	UsedAt           time.Time
+	RemoteIP         string
+}
+
+// WorkloadTokenPayload represents the payload of a JWT issued to a workload by
+// a trusted external issuer.
+type WorkloadTokenPayload struct {
+	Issuer  string
+	Subject string
+	Claims  map[string]any
+}
+
+type CreateServiceAccountAPIKeyRequest struct {
+	ServiceAccountID string     `validate:"required,max=36"`
+	Name             string     `validate:"required,max=128,kebabcase"`
+	ExpireAt         *time.Time `validate:"omitempty,gt"`
 }
 
 type CreateServiceAccountRequest struct {
-	Name        string                      `validate:"required,max=128,kebabcase"`
-	AccessScope serviceaccounts.AccessScope `validate:"required,validateFn=Validate"`
-	ProjectIDs  []string                    `validate:"dive,required"`
-	ExpireAt    *time.Time                  `validate:"omitempty,gt"`
+	Name        string                       `validate:"required,max=128,kebabcase"`
+	AccessScope serviceaccounts.AccessScope  `validate:"required,validateFn=Validate"`
+	Permissions []serviceaccounts.Permission `validate:"required,min=1,dive,validateFn=Validate"`
+	ProjectIDs  []string                     `validate:"dive,required"`
+	ExpireAt    *time.Time                   `validate:"omitempty,gt"`
 }
 
-func (r CreateServiceAccountRequest) ToServiceAccount(apiKeyHash string) ServiceAccount {
+func (r CreateServiceAccountRequest) ToServiceAccount() ServiceAccount {
 	return ServiceAccount{
 		ExpireAt:    r.ExpireAt,
 		Name:        r.Name,
 		AccessScope: r.AccessScope,
-		APIKeyHash:  apiKeyHash,
+		Permissions: r.Permissions,
 		Projects: lo.Map(r.ProjectIDs, func(pid string, _ int) ProjectReference {
 			return ProjectReference{ID: pid}
 		}),
@@ -59,8 +127,12 @@ type UpdateServiceAccountRequest struct {
 	ID          string                       `validate:"required,max=36"`
 	Name        *string                      `validate:"omitempty,max=128,kebabcase"`
 	AccessScope *serviceaccounts.AccessScope `validate:"omitempty,validateFn=Validate"`
+	// Permissions replace the permissions of the service account unless nil.
+	Permissions []serviceaccounts.Permission `validate:"omitempty,min=1,dive,validateFn=Validate"`
 	ProjectIDs  []string                     `validate:"dive,required"`
 	ExpireAt    *time.Time                   `validate:"omitempty,gt"`
+	// Enable enables the service account again if disabled.
+	Enable bool
 }
 
 type ServiceAccounts struct {
@@ -86,6 +158,14 @@ func (p ListServiceAccountsParams) LimitOrDefault() int {
 
 type ServiceAccountSearchFilter struct {
 	Name *string
+	// Active matches accounts neither expired nor disabled.
+	Active bool
+	// UnusedBefore matches accounts not used since the time, where accounts
+	// never used count from their creation.
+	UnusedBefore *time.Time
+	StaleWarned  *bool
+	// StaleWarnedBefore matches accounts warned to be disabled before the time.
+	StaleWarnedBefore *time.Time
 }
 
 type ServiceAccountSortFilter struct {
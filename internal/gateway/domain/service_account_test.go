@@ -22,6 +22,7 @@ func TestUpdateServiceAccountRequest_Validation(t *testing.T) {
 				ID:          "test-id",
 				Name:        new("test-name"),
 				AccessScope: new(serviceaccounts.AccessScopeProject),
+				Permissions: []serviceaccounts.Permission{serviceaccounts.PermissionImagesRead},
 				ProjectIDs:  []string{"project-1", "project-2"},
 				ExpireAt:    new(time.Now().Add(time.Hour)),
 			},
@@ -50,6 +51,14 @@ func TestUpdateServiceAccountRequest_Validation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid permission",
+			req: UpdateServiceAccountRequest{
+				ID:          "test-id",
+				Permissions: []serviceaccounts.Permission{"images:purge"},
+			},
+			wantErr: true,
+		},
 		{
 			name: "blank project IDs",
 			req: UpdateServiceAccountRequest{
@@ -3,6 +3,9 @@ package domain
 import (
 	"time"
 
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/pkg/dbhelpers"
 	"github.com/isutare412/imageer/pkg/users"
 )
 
@@ -14,4 +17,59 @@ type User struct {
 	Nickname  string
 	Email     string
 	PhotoURL  string
+
+	// SuspendedAt is set while the user is suspended. Tokens of suspended users
+	// are rejected.
+	SuspendedAt *time.Time
+
+	// IDTokenClaims are claims of the ID token of the last sign-in, kept to
+	// re-evaluate role mappings on token refresh.
+	IDTokenClaims map[string]any
+}
+
+func (u User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+type Users struct {
+	Items []User
+	Total int64
+}
+
+type ListUsersParams struct {
+	Offset *int `validate:"omitempty,min=0"`
+	Limit  *int `validate:"omitempty,min=1,max=100"`
+
+	SearchFilter UserSearchFilter
+	SortFilter   UserSortFilter
+}
+
+func (p ListUsersParams) OffsetOrDefault() int {
+	return lo.FromPtrOr(p.Offset, 0)
+}
+
+func (p ListUsersParams) LimitOrDefault() int {
+	return lo.FromPtrOr(p.Limit, 20)
+}
+
+// UserSearchFilter matches users whose nicknames or emails contain Keyword,
+// ignoring case.
+type UserSearchFilter struct {
+	Keyword   *string `validate:"omitempty,max=256"`
+	Role      *users.Role
+	Suspended *bool
+}
+
+type UserSortFilter struct {
+	CreatedAt bool
+	UpdatedAt bool
+	Direction dbhelpers.SortDirection
+}
+
+// UpdateUserRequest suspends the user if Suspended is true, and unsuspends the
+// user if it is false.
+type UpdateUserRequest struct {
+	ID        string      `validate:"required,max=36"`
+	Role      *users.Role `validate:"omitempty,validateFn=Validate"`
+	Suspended *bool
 }
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+type Watermark struct {
+	ID        string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+	Format    images.Format
+	S3Key     string
+	URL       string
+	State     images.WatermarkState
+	Project   ProjectReference
+}
+
+type Watermarks struct {
+	Items []Watermark
+	Total int64
+}
+
+type CreateWatermarkUploadURLRequest struct {
+	ProjectID string        `validate:"required,max=36"`
+	Name      string        `validate:"required,max=64,kebabcase"`
+	Format    images.Format `validate:"validateFn=ValidateForWatermark"`
+}
+
+type WatermarkUploadURL struct {
+	Watermark Watermark
+	ExpiresAt time.Time
+	URL       string
+	Header    http.Header
+}
+
+type UpdateWatermarkRequest struct {
+	ID    string
+	State *images.WatermarkState
+}
+
+type ListWatermarksParams struct {
+	Offset *int `validate:"omitempty,min=0"`
+	Limit  *int `validate:"omitempty,min=1,max=100"`
+
+	SearchFilter WatermarkSearchFilter
+	SortFilter   WatermarkSortFilter
+}
+
+func (p ListWatermarksParams) OffsetOrDefault() int {
+	return lo.FromPtrOr(p.Offset, 0)
+}
+
+func (p ListWatermarksParams) LimitOrDefault() int {
+	return lo.FromPtrOr(p.Limit, 20)
+}
+
+type WatermarkSearchFilter struct {
+	ProjectID *string
+	IDs       []string
+}
+
+type WatermarkSortFilter struct {
+	CreatedAt bool
+	UpdatedAt bool
+	Direction dbhelpers.SortDirection
+}
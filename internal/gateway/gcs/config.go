@@ -0,0 +1,16 @@
+package gcs
+
+import (
+	"time"
+
+	"github.com/isutare412/imageer/pkg/gcphelpers"
+)
+
+type StorageConfig struct {
+	Bucket string
+	Expiry time.Duration
+	// InfrequentAccessClass is the storage class originals are moved to by
+	// retention policies. NEARLINE is used if empty.
+	InfrequentAccessClass string
+	Client                gcphelpers.StorageClientConfig
+}
@@ -0,0 +1,166 @@
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/api/iterator"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/gcphelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// Storage presigns and deletes objects of a Google Cloud Storage bucket.
+type Storage struct {
+	bucket *storage.BucketHandle
+	cfg    StorageConfig
+}
+
+func NewStorage(cfg StorageConfig) (*Storage, error) {
+	client, err := gcphelpers.NewStorageClient(context.Background(), cfg.Client)
+	if err != nil {
+		return nil, fmt.Errorf("creating gcs client: %w", err)
+	}
+
+	return &Storage{
+		bucket: client.Bucket(cfg.Bucket),
+		cfg:    cfg,
+	}, nil
+}
+
+func (s *Storage) PresignPutObject(ctx context.Context, req domain.PresignPutObjectRequest,
+) (domain.PresignPutObjectResponse, error) {
+	_, span := tracing.StartSpan(ctx, "gcs.Storage.PresignPutObject",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceGCS))
+	defer span.End()
+
+	url, err := s.bucket.SignedURL(req.S3Key, &storage.SignedURLOptions{
+		Method:      http.MethodPut,
+		Expires:     time.Now().Add(s.cfg.Expiry),
+		ContentType: req.ContentType,
+		Scheme:      storage.SigningSchemeV4,
+	})
+	if err != nil {
+		return domain.PresignPutObjectResponse{}, gcphelpers.WrapStorageError(err, "Failed to presign")
+	}
+
+	// Content-Type is signed, so the client must send the same one
+	header := make(http.Header)
+	if req.ContentType != "" {
+		header.Set("Content-Type", req.ContentType)
+	}
+
+	return domain.PresignPutObjectResponse{
+		URL:      url,
+		Header:   header,
+		ExpireAt: time.Now().UTC().Add(s.cfg.Expiry - 5*time.Second), // Subtract 5 seconds as buffer
+	}, nil
+}
+
+func (s *Storage) PresignGetObject(ctx context.Context, req domain.PresignGetObjectRequest,
+) (domain.PresignGetObjectResponse, error) {
+	_, span := tracing.StartSpan(ctx, "gcs.Storage.PresignGetObject",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceGCS))
+	defer span.End()
+
+	url, err := s.bucket.SignedURL(req.S3Key, &storage.SignedURLOptions{
+		Method:  http.MethodGet,
+		Expires: time.Now().Add(req.Expiry),
+		Scheme:  storage.SigningSchemeV4,
+	})
+	if err != nil {
+		return domain.PresignGetObjectResponse{}, gcphelpers.WrapStorageError(err, "Failed to presign")
+	}
+
+	return domain.PresignGetObjectResponse{
+		URL:      url,
+		ExpireAt: time.Now().UTC().Add(req.Expiry - 5*time.Second), // Subtract 5 seconds as buffer
+	}, nil
+}
+
+func (s *Storage) HeadObject(ctx context.Context, key string) error {
+	ctx, span := tracing.StartSpan(ctx, "gcs.Storage.HeadObject",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceGCS))
+	defer span.End()
+
+	if _, err := s.bucket.Object(key).Attrs(ctx); err != nil {
+		return gcphelpers.WrapStorageError(err, "Failed to head object %s", key)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListObjects(ctx context.Context, prefix string) ([]domain.StoredObject, error) {
+	ctx, span := tracing.StartSpan(ctx, "gcs.Storage.ListObjects",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceGCS))
+	defer span.End()
+
+	var objects []domain.StoredObject
+	it := s.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		switch {
+		case errors.Is(err, iterator.Done):
+			return objects, nil
+		case err != nil:
+			return nil, gcphelpers.WrapStorageError(err, "Failed to list objects under %s", prefix)
+		}
+
+		objects = append(objects, domain.StoredObject{
+			Key:          attrs.Name,
+			LastModified: attrs.Updated,
+		})
+	}
+}
+
+// MoveToInfrequentAccess rewrites the object with the infrequent access
+// storage class.
+func (s *Storage) MoveToInfrequentAccess(ctx context.Context, key string) error {
+	ctx, span := tracing.StartSpan(ctx, "gcs.Storage.MoveToInfrequentAccess",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceGCS))
+	defer span.End()
+
+	storageClass := "NEARLINE"
+	if s.cfg.InfrequentAccessClass != "" {
+		storageClass = s.cfg.InfrequentAccessClass
+	}
+
+	obj := s.bucket.Object(key)
+	copier := obj.CopierFrom(obj)
+	copier.StorageClass = storageClass
+	if _, err := copier.Run(ctx); err != nil {
+		return gcphelpers.WrapStorageError(err, "Failed to change storage class of object %s", key)
+	}
+	return nil
+}
+
+func (s *Storage) DeleteObjects(ctx context.Context, keys []string) error {
+	ctx, span := tracing.StartSpan(ctx, "gcs.Storage.DeleteObjects",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceGCS))
+	defer span.End()
+
+	// GCS has no batch deletion in its client library
+	for _, key := range keys {
+		err := s.bucket.Object(key).Delete(ctx)
+		switch {
+		case errors.Is(err, storage.ErrObjectNotExist):
+			continue
+		case err != nil:
+			return gcphelpers.WrapStorageError(err, "Failed to delete object %s", key)
+		}
+	}
+
+	return nil
+}
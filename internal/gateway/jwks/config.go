@@ -0,0 +1,13 @@
+package jwks
+
+type VerifierConfig struct {
+	Issuers []IssuerConfig
+}
+
+// IssuerConfig is an external issuer of workload tokens, whose signing keys
+// are fetched from JWKSURL. Tokens must be issued for Audience.
+type IssuerConfig struct {
+	Issuer   string
+	JWKSURL  string
+	Audience string
+}
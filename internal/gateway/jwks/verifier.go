@@ -0,0 +1,101 @@
+package jwks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// Verifier verifies workload tokens issued by trusted external issuers. Keys
+// of issuers are fetched on demand, and refetched when tokens are signed by
+// unknown keys.
+type Verifier struct {
+	verifiers map[string]*oidc.IDTokenVerifier
+}
+
+func NewVerifier(cfg VerifierConfig) (*Verifier, error) {
+	verifiers := make(map[string]*oidc.IDTokenVerifier, len(cfg.Issuers))
+	for _, issuerCfg := range cfg.Issuers {
+		if _, ok := verifiers[issuerCfg.Issuer]; ok {
+			return nil, fmt.Errorf("duplicate workload token issuer %q", issuerCfg.Issuer)
+		}
+
+		keySet := oidc.NewRemoteKeySet(context.Background(), issuerCfg.JWKSURL)
+		verifiers[issuerCfg.Issuer] = oidc.NewVerifier(issuerCfg.Issuer, keySet,
+			&oidc.Config{ClientID: issuerCfg.Audience})
+	}
+
+	return &Verifier{
+		verifiers: verifiers,
+	}, nil
+}
+
+func (v *Verifier) Trusts(token string) bool {
+	issuer, err := unverifiedIssuer(token)
+	if err != nil {
+		return false
+	}
+
+	_, ok := v.verifiers[issuer]
+	return ok
+}
+
+func (v *Verifier) VerifyWorkloadToken(ctx context.Context, token string,
+) (payload domain.WorkloadTokenPayload, err error) {
+	ctx, span := tracing.StartSpan(ctx, "jwks.Verifier.VerifyWorkloadToken")
+	defer span.End()
+
+	issuer, err := unverifiedIssuer(token)
+	if err != nil {
+		return payload, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Failed to parse workload token").
+			WithCause(err)
+	}
+
+	verifier, ok := v.verifiers[issuer]
+	if !ok {
+		return payload, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Issuer %s of workload token is not trusted", issuer)
+	}
+
+	idToken, err := verifier.Verify(ctx, token)
+	if err != nil {
+		return payload, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Failed to verify workload token").
+			WithCause(err)
+	}
+
+	var claims map[string]any
+	if err := idToken.Claims(&claims); err != nil {
+		return payload, apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Failed to decode claims of workload token").
+			WithCause(err)
+	}
+
+	return domain.WorkloadTokenPayload{
+		Issuer:  idToken.Issuer,
+		Subject: idToken.Subject,
+		Claims:  claims,
+	}, nil
+}
+
+// unverifiedIssuer reads the issuer claimed by the token, which is only used to
+// pick the verifier of the token.
+func unverifiedIssuer(token string) (string, error) {
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		return "", fmt.Errorf("parsing unverified token: %w", err)
+	}
+
+	issuer, err := parsed.Claims.GetIssuer()
+	if err != nil {
+		return "", fmt.Errorf("getting issuer: %w", err)
+	}
+	return issuer, nil
+}
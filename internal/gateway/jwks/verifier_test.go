@@ -0,0 +1,169 @@
+package jwks_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/jwks"
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+const (
+	testIssuer   = "https://token.workload.example.com"
+	testAudience = "imageer"
+)
+
+func newJWKSServer(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	t.Helper()
+
+	b64 := base64.RawURLEncoding
+	body, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test-key",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   b64.EncodeToString(key.N.Bytes()),
+			"e":   b64.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestVerifier_VerifyWorkloadToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	server := newJWKSServer(t, key)
+	verifier, err := jwks.NewVerifier(jwks.VerifierConfig{
+		Issuers: []jwks.IssuerConfig{{
+			Issuer:   testIssuer,
+			JWKSURL:  server.URL,
+			Audience: testAudience,
+		}},
+	})
+	require.NoError(t, err)
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":       testIssuer,
+			"sub":       "system:serviceaccount:ci:uploader",
+			"aud":       testAudience,
+			"exp":       time.Now().Add(time.Hour).Unix(),
+			"iat":       time.Now().Unix(),
+			"namespace": "ci",
+		}
+	}
+
+	tests := []struct {
+		name        string // description of this test case
+		token       string
+		wantTrusted bool
+		want        domain.WorkloadTokenPayload
+		wantErr     bool
+	}{
+		{
+			name:        "valid token",
+			token:       signToken(t, key, validClaims()),
+			wantTrusted: true,
+			want: domain.WorkloadTokenPayload{
+				Issuer:  testIssuer,
+				Subject: "system:serviceaccount:ci:uploader",
+			},
+		},
+		{
+			name: "untrusted issuer",
+			token: signToken(t, key, func() jwt.MapClaims {
+				c := validClaims()
+				c["iss"] = "https://untrusted.example.com"
+				return c
+			}()),
+			wantTrusted: false,
+			wantErr:     true,
+		},
+		{
+			name: "wrong audience",
+			token: signToken(t, key, func() jwt.MapClaims {
+				c := validClaims()
+				c["aud"] = "another-service"
+				return c
+			}()),
+			wantTrusted: true,
+			wantErr:     true,
+		},
+		{
+			name: "expired token",
+			token: signToken(t, key, func() jwt.MapClaims {
+				c := validClaims()
+				c["exp"] = time.Now().Add(-time.Hour).Unix()
+				return c
+			}()),
+			wantTrusted: true,
+			wantErr:     true,
+		},
+		{
+			name:        "unknown signing key",
+			token:       signToken(t, otherKey, validClaims()),
+			wantTrusted: true,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantTrusted, verifier.Trusts(tt.token))
+
+			payload, err := verifier.VerifyWorkloadToken(t.Context(), tt.token)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, apperr.IsErrorCode(err, apperr.CodeUnauthorized))
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.Issuer, payload.Issuer)
+			assert.Equal(t, tt.want.Subject, payload.Subject)
+			assert.Equal(t, "ci", payload.Claims["namespace"])
+		})
+	}
+}
+
+func TestVerifier_Trusts(t *testing.T) {
+	verifier, err := jwks.NewVerifier(jwks.VerifierConfig{
+		Issuers: []jwks.IssuerConfig{{Issuer: testIssuer, JWKSURL: "http://localhost"}},
+	})
+	require.NoError(t, err)
+
+	assert.False(t, verifier.Trusts("not-a-token"))
+}
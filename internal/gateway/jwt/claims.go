@@ -21,6 +21,7 @@ type appClaims struct {
 func newAppClaims(payload domain.UserTokenPayload) appClaims {
 	return appClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        payload.SessionID,
 			Issuer:    imageerGatewayIssuer,
 			IssuedAt:  jwt.NewNumericDate(payload.IssuedAt),
 			NotBefore: jwt.NewNumericDate(payload.IssuedAt),
@@ -36,6 +37,7 @@ func newAppClaims(payload domain.UserTokenPayload) appClaims {
 
 func (c *appClaims) toUserTokenPayload() domain.UserTokenPayload {
 	return domain.UserTokenPayload{
+		SessionID:  c.ID,
 		UserID:     c.UserID,
 		IssuedAt:   c.IssuedAt.Time,
 		ExpireAt:   c.ExpiresAt.Time,
@@ -41,6 +41,7 @@ func TestVerifySignedToken(t *testing.T) {
 		{
 			name: "normal case",
 			payload: domain.UserTokenPayload{
+				SessionID:  "session-123",
 				UserID:     "user-123",
 				IssuedAt:   time.Now(),
 				ExpireAt:   time.Now().Add(42 * time.Hour),
@@ -60,6 +61,8 @@ func TestVerifySignedToken(t *testing.T) {
 
 			verified, err := verifier.VerifyUserToken(token)
 			require.NoError(t, err)
+			assert.Equal(t, tt.payload.SessionID, verified.SessionID)
+			assert.Equal(t, tt.payload.UserID, verified.UserID)
 			assert.Equal(t, tt.payload.IssuedAt.Unix(), verified.IssuedAt.Unix())
 			assert.Equal(t, tt.payload.ExpireAt.Unix(), verified.ExpireAt.Unix())
 			assert.Equal(t, tt.payload.Role, verified.Role)
@@ -65,3 +65,10 @@ type ImageS3DeleteRequestHandlerConfig struct {
 	MaxRetryAttempt int
 	RetryBaseDelay  time.Duration
 }
+
+type ImageUploadEventHandlerConfig struct {
+	RetryTopic      string
+	HandleTimeout   time.Duration
+	MaxRetryAttempt int
+	RetryBaseDelay  time.Duration
+}
@@ -0,0 +1,98 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/awshelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// ImageUploadEventHandler consumes bucket notifications published to Kafka by
+// S3-compatible storages, whose records follow the AWS S3 event structure.
+type ImageUploadEventHandler struct {
+	imageSvc port.ImageService
+	consumer *Consumer
+	cfg      ImageUploadEventHandlerConfig
+}
+
+func NewImageUploadEventHandler(
+	cfg ImageUploadEventHandlerConfig,
+	imageSvc port.ImageService,
+) *ImageUploadEventHandler {
+	return &ImageUploadEventHandler{
+		imageSvc: imageSvc,
+		cfg:      cfg,
+	}
+}
+
+func (h *ImageUploadEventHandler) SetConsumer(c *Consumer)       { h.consumer = c }
+func (h *ImageUploadEventHandler) RetryTopic() string            { return h.cfg.RetryTopic }
+func (h *ImageUploadEventHandler) MaxRetryAttempt() int          { return h.cfg.MaxRetryAttempt }
+func (h *ImageUploadEventHandler) RetryBaseDelay() time.Duration { return h.cfg.RetryBaseDelay }
+
+func (h *ImageUploadEventHandler) HandleRecord(ctx context.Context, record *kgo.Record) {
+	handleCtx, cancel := context.WithTimeout(ctx, h.cfg.HandleTimeout)
+	defer cancel()
+
+	err := h.handleRecordData(handleCtx, record.Value)
+	switch {
+	case apperr.IsErrorStatusCode(err, http.StatusBadRequest):
+		slog.WarnContext(handleCtx, "Invalid image upload event data, dropping message",
+			"error", err)
+	case err != nil:
+		slog.ErrorContext(handleCtx, "Failed to handle image upload event", "error", err)
+		retryCount := parseRetryCount(record)
+		nextRetry := retryCount + 1
+		if nextRetry > h.cfg.MaxRetryAttempt {
+			slog.ErrorContext(handleCtx, "Max retry attempt reached, dropping message",
+				"retryCount", retryCount, "maxRetryAttempt", h.cfg.MaxRetryAttempt)
+			return
+		}
+		h.consumer.scheduleRetry(h, record, nextRetry)
+	}
+}
+
+func (h *ImageUploadEventHandler) handleRecordData(ctx context.Context, data []byte) error {
+	var event events.S3Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Failed to unmarshal image upload event").
+			WithCause(err)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "kafka.ImageUploadEventHandler.handleRecordData",
+		trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+
+	var errs error
+	for _, record := range event.Records {
+		if !awshelpers.IsObjectCreatedEvent(record) {
+			continue
+		}
+
+		s3Key := record.S3.Object.URLDecodedKey
+		err := h.imageSvc.StartImageProcessingOnUpload(ctx, s3Key)
+		switch {
+		case apperr.IsErrorCode(err, apperr.CodeBadRequest):
+			fallthrough
+		case apperr.IsErrorCode(err, apperr.CodeNotFound):
+			slog.WarnContext(ctx, "Skipping image processing for invalid upload",
+				"error", err, "s3Key", s3Key)
+		case err != nil:
+			errs = errors.Join(errs, fmt.Errorf("starting image processing of %s: %w", s3Key, err))
+		}
+	}
+	return errs
+}
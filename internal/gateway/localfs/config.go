@@ -0,0 +1,18 @@
+package localfs
+
+import "time"
+
+type StorageConfig struct {
+	// Profile is the name of the storage profile served by the storage.
+	Profile string
+	// Root is the directory where objects are stored.
+	Root string
+	// BaseURL is the URL the storage handler of the profile is served at.
+	BaseURL    string
+	SigningKey string
+	Expiry     time.Duration
+	// AllowUnsignedReads allows downloads without signatures of objects of
+	// public projects, so that the storage can be used as the CDN origin in
+	// development.
+	AllowUnsignedReads bool
+}
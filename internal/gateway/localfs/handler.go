@@ -0,0 +1,136 @@
+package localfs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+const uploadHandleTimeout = 30 * time.Second
+
+// Handler serves objects of local storages at /{profile}/{key}. Uploads and
+// downloads require presigned URLs, and uploads start image processing like
+// object storage events do. Storages allowing unsigned reads serve objects of
+// public projects without signatures.
+type Handler struct {
+	storages map[string]*Storage
+	imageSvc port.ImageService
+}
+
+func NewHandler(storages []*Storage, imageSvc port.ImageService) *Handler {
+	byProfile := make(map[string]*Storage, len(storages))
+	for _, s := range storages {
+		byProfile[s.cfg.Profile] = s
+	}
+
+	return &Handler{
+		storages: byProfile,
+		imageSvc: imageSvc,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	profile, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	storage, ok := h.storages[profile]
+	if !ok || key == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		h.serveObject(w, r, storage, key)
+	case http.MethodPut:
+		h.putObject(w, r, storage, key)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *Handler) serveObject(w http.ResponseWriter, r *http.Request, storage *Storage, key string) {
+	if err := h.authorizeRead(r, storage, key); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	file, err := storage.readObject(key)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	http.ServeContent(w, r, info.Name(), info.ModTime(), file)
+}
+
+// authorizeRead checks the signature of the request, or whether the object
+// belongs to a public project if the storage allows unsigned reads.
+func (h *Handler) authorizeRead(r *http.Request, storage *Storage, key string) error {
+	if !storage.cfg.AllowUnsignedReads || r.URL.Query().Has(querySignature) {
+		return storage.verify(http.MethodGet, key, r.URL.Query())
+	}
+
+	public, err := h.imageSvc.IsPublicObject(r.Context(), key)
+	if err != nil {
+		return fmt.Errorf("checking object visibility: %w", err)
+	}
+	if !public {
+		return apperr.NewError(apperr.CodeForbidden).WithSummary("Missing signature")
+	}
+	return nil
+}
+
+func (h *Handler) putObject(w http.ResponseWriter, r *http.Request, storage *Storage, key string) {
+	if err := storage.verify(http.MethodPut, key, r.URL.Query()); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if err := storage.writeObject(key, r.Body); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Uploads succeed regardless of image processing, as with object storage
+	// events
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), uploadHandleTimeout)
+	defer cancel()
+
+	err := h.imageSvc.StartImageProcessingOnUpload(ctx, key)
+	switch {
+	case apperr.IsErrorCode(err, apperr.CodeBadRequest):
+		fallthrough
+	case apperr.IsErrorCode(err, apperr.CodeNotFound):
+		slog.WarnContext(ctx, "Skipping image processing for invalid upload",
+			"error", err, "s3Key", key)
+	case err != nil:
+		slog.ErrorContext(ctx, "Failed to start image processing", "error", err, "s3Key", key)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	statusCode := http.StatusInternalServerError
+	if aerr, ok := apperr.AsError(err); ok {
+		statusCode = aerr.Code.HTTPStatusCode()
+	}
+	if statusCode >= http.StatusInternalServerError {
+		slog.ErrorContext(r.Context(), "Failed to handle local storage request", "error", err)
+	}
+
+	http.Error(w, http.StatusText(statusCode), statusCode)
+}
@@ -0,0 +1,282 @@
+package localfs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+)
+
+type fakeImageService struct {
+	port.ImageService
+	uploadedKeys []string
+	publicKeys   []string
+}
+
+func (s *fakeImageService) StartImageProcessingOnUpload(_ context.Context, s3Key string) error {
+	s.uploadedKeys = append(s.uploadedKeys, s3Key)
+	return nil
+}
+
+func (s *fakeImageService) IsPublicObject(_ context.Context, s3Key string) (bool, error) {
+	return slices.Contains(s.publicKeys, s3Key), nil
+}
+
+func newTestServer(t *testing.T, allowUnsignedReads bool,
+) (*Storage, *fakeImageService, *httptest.Server) {
+	t.Helper()
+
+	imageSvc := &fakeImageService{}
+	var handler http.Handler
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	storage, err := NewStorage(StorageConfig{
+		Profile:    "local",
+		Root:       t.TempDir(),
+		BaseURL:    server.URL + "/local",
+		SigningKey: "test-signing-key",
+		Expiry:     time.Minute,
+
+		AllowUnsignedReads: allowUnsignedReads,
+	})
+	require.NoError(t, err)
+
+	handler = NewHandler([]*Storage{storage}, imageSvc)
+	return storage, imageSvc, server
+}
+
+func TestHandler_PutObject(t *testing.T) {
+	storage, imageSvc, _ := newTestServer(t, false)
+
+	presigned, err := storage.PresignPutObject(t.Context(), domain.PresignPutObjectRequest{
+		S3Key:       "images/test image.jpg",
+		ContentType: "image/jpeg",
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPut, presigned.URL, strings.NewReader("image-data"))
+	require.NoError(t, err)
+	req.Header = presigned.Header
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"images/test image.jpg"}, imageSvc.uploadedKeys)
+
+	data, err := os.ReadFile(filepath.Join(storage.cfg.Root, "images", "test image.jpg"))
+	require.NoError(t, err)
+	assert.Equal(t, "image-data", string(data))
+}
+
+func TestHandler_PutObject_InvalidSignature(t *testing.T) {
+	storage, imageSvc, _ := newTestServer(t, false)
+
+	presigned, err := storage.PresignPutObject(t.Context(), domain.PresignPutObjectRequest{
+		S3Key: "images/a.jpg",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		modify func(u *url.URL)
+	}{
+		{
+			name: "other key",
+			modify: func(u *url.URL) {
+				u.Path = strings.Replace(u.Path, "a.jpg", "b.jpg", 1)
+			},
+		},
+		{
+			name: "extended expiry",
+			modify: func(u *url.URL) {
+				q := u.Query()
+				q.Set(queryExpires, "9999999999")
+				u.RawQuery = q.Encode()
+			},
+		},
+		{
+			name: "missing signature",
+			modify: func(u *url.URL) {
+				u.RawQuery = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(presigned.URL)
+			require.NoError(t, err)
+			tt.modify(u)
+
+			req, err := http.NewRequest(http.MethodPut, u.String(), strings.NewReader("data"))
+			require.NoError(t, err)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		})
+	}
+	assert.Empty(t, imageSvc.uploadedKeys)
+}
+
+func TestHandler_GetObject(t *testing.T) {
+	storage, _, server := newTestServer(t, false)
+	require.NoError(t, storage.writeObject("images/a.jpg", strings.NewReader("image-data")))
+
+	presigned, err := storage.PresignGetObject(t.Context(), domain.PresignGetObjectRequest{
+		S3Key:  "images/a.jpg",
+		Expiry: time.Minute,
+	})
+	require.NoError(t, err)
+	presignedMissing, err := storage.PresignGetObject(t.Context(), domain.PresignGetObjectRequest{
+		S3Key:  "images/b.jpg",
+		Expiry: time.Minute,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		url            string
+		wantStatusCode int
+	}{
+		{
+			name:           "presigned",
+			url:            presigned.URL,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "unsigned",
+			url:            server.URL + "/local/images/a.jpg",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "wrong signature",
+			url:            server.URL + "/local/images/a.jpg?expires=9999999999&signature=abc",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not found",
+			url:            presignedMissing.URL,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "unknown profile",
+			url:            server.URL + "/other/images/a.jpg",
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Get(tt.url)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantStatusCode, resp.StatusCode)
+			if tt.wantStatusCode == http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, "image-data", string(body))
+			}
+		})
+	}
+}
+
+func TestHandler_GetObject_UnsignedReads(t *testing.T) {
+	storage, imageSvc, server := newTestServer(t, true)
+	imageSvc.publicKeys = []string{"images/public.jpg"}
+	require.NoError(t, storage.writeObject("images/public.jpg", strings.NewReader("image-data")))
+	require.NoError(t, storage.writeObject("images/private.jpg", strings.NewReader("image-data")))
+
+	tests := []struct {
+		name           string
+		url            string
+		wantStatusCode int
+	}{
+		{
+			name:           "object of public project",
+			url:            server.URL + "/local/images/public.jpg",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "object of private project",
+			url:            server.URL + "/local/images/private.jpg",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "wrong signature of public object",
+			url:            server.URL + "/local/images/public.jpg?expires=9999999999&signature=abc",
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Get(tt.url)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestStorage_objectPath(t *testing.T) {
+	storage := &Storage{cfg: StorageConfig{Root: "/data"}}
+
+	tests := []struct {
+		name    string
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "nested key",
+			key:  "images/a/b.jpg",
+			want: filepath.Join("/data", "images", "a", "b.jpg"),
+		},
+		{
+			name:    "parent directory",
+			key:     "../etc/passwd",
+			wantErr: true,
+		},
+		{
+			name:    "absolute path",
+			key:     "/etc/passwd",
+			wantErr: true,
+		},
+		{
+			name:    "directory",
+			key:     "images/",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := storage.objectPath(tt.key)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package localfs
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+const (
+	queryExpires   = "expires"
+	querySignature = "signature"
+)
+
+// Storage stores objects in a local directory. Presigned URLs point at the
+// storage handler, which verifies HMAC signatures in place of a cloud
+// provider. It is meant for development and tests.
+type Storage struct {
+	cfg StorageConfig
+}
+
+func NewStorage(cfg StorageConfig) (*Storage, error) {
+	if err := os.MkdirAll(cfg.Root, 0o755); err != nil {
+		return nil, fmt.Errorf("creating storage root %s: %w", cfg.Root, err)
+	}
+
+	return &Storage{
+		cfg: cfg,
+	}, nil
+}
+
+func (s *Storage) PresignPutObject(ctx context.Context, req domain.PresignPutObjectRequest,
+) (domain.PresignPutObjectResponse, error) {
+	_, span := tracing.StartSpan(ctx, "localfs.Storage.PresignPutObject")
+	defer span.End()
+
+	expireAt := time.Now().Add(s.cfg.Expiry)
+	url, err := s.presign(http.MethodPut, req.S3Key, expireAt)
+	if err != nil {
+		return domain.PresignPutObjectResponse{}, err
+	}
+
+	header := make(http.Header)
+	if req.ContentType != "" {
+		header.Set("Content-Type", req.ContentType)
+	}
+
+	return domain.PresignPutObjectResponse{
+		URL:      url,
+		Header:   header,
+		ExpireAt: expireAt.UTC().Add(-5 * time.Second), // Subtract 5 seconds as buffer
+	}, nil
+}
+
+func (s *Storage) PresignGetObject(ctx context.Context, req domain.PresignGetObjectRequest,
+) (domain.PresignGetObjectResponse, error) {
+	_, span := tracing.StartSpan(ctx, "localfs.Storage.PresignGetObject")
+	defer span.End()
+
+	expireAt := time.Now().Add(req.Expiry)
+	url, err := s.presign(http.MethodGet, req.S3Key, expireAt)
+	if err != nil {
+		return domain.PresignGetObjectResponse{}, err
+	}
+
+	return domain.PresignGetObjectResponse{
+		URL:      url,
+		ExpireAt: expireAt.UTC().Add(-5 * time.Second), // Subtract 5 seconds as buffer
+	}, nil
+}
+
+func (s *Storage) HeadObject(ctx context.Context, key string) error {
+	_, span := tracing.StartSpan(ctx, "localfs.Storage.HeadObject")
+	defer span.End()
+
+	file, err := s.readObject(key)
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// ListObjects walks the storage root, so that it is slow for large storages.
+func (s *Storage) ListObjects(ctx context.Context, prefix string) ([]domain.StoredObject, error) {
+	_, span := tracing.StartSpan(ctx, "localfs.Storage.ListObjects")
+	defer span.End()
+
+	var objects []domain.StoredObject
+	err := filepath.WalkDir(s.cfg.Root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// Temporary files of objects being written are not objects yet
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".upload-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.cfg.Root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, domain.StoredObject{
+			Key:          key,
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking storage root: %w", err)
+	}
+
+	return objects, nil
+}
+
+// MoveToInfrequentAccess does nothing but checking the object exists, as local
+// file systems have no storage classes.
+func (s *Storage) MoveToInfrequentAccess(ctx context.Context, key string) error {
+	_, span := tracing.StartSpan(ctx, "localfs.Storage.MoveToInfrequentAccess")
+	defer span.End()
+
+	file, err := s.readObject(key)
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+func (s *Storage) DeleteObjects(ctx context.Context, keys []string) error {
+	_, span := tracing.StartSpan(ctx, "localfs.Storage.DeleteObjects")
+	defer span.End()
+
+	for _, key := range keys {
+		path, err := s.objectPath(key)
+		if err != nil {
+			return err
+		}
+
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("removing object %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// readObject opens the object of the key. The caller must close the file.
+func (s *Storage) readObject(key string) (*os.File, error) {
+	path, err := s.objectPath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, apperr.NewError(apperr.CodeNotFound).WithSummary("Object not found")
+	case err != nil:
+		return nil, fmt.Errorf("opening object %s: %w", key, err)
+	}
+	return file, nil
+}
+
+// writeObject writes the object of the key through a temporary file, so that
+// readers never see partially written objects.
+func (s *Storage) writeObject(key string, body io.Reader) error {
+	path, err := s.objectPath(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating object directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing object %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing temporary file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("renaming temporary file: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) objectPath(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) || strings.HasSuffix(key, "/") {
+		return "", apperr.NewError(apperr.CodeBadRequest).WithSummary("Invalid object key %q", key)
+	}
+	return filepath.Join(s.cfg.Root, filepath.FromSlash(key)), nil
+}
+
+func (s *Storage) presign(method, key string, expireAt time.Time) (string, error) {
+	if _, err := s.objectPath(key); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(expireAt.Unix(), 10)
+	query := url.Values{
+		queryExpires:   []string{expires},
+		querySignature: []string{s.sign(method, key, expires)},
+	}
+
+	return fmt.Sprintf("%s/%s?%s", s.cfg.BaseURL, (&url.URL{Path: key}).EscapedPath(), query.Encode()), nil
+}
+
+// verify checks the signature of a presigned URL.
+func (s *Storage) verify(method, key string, query url.Values) error {
+	expires := query.Get(queryExpires)
+	signature := query.Get(querySignature)
+	if expires == "" || signature == "" {
+		return apperr.NewError(apperr.CodeForbidden).WithSummary("Missing signature")
+	}
+
+	expireAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return apperr.NewError(apperr.CodeForbidden).WithSummary("Invalid expiry")
+	}
+	if time.Now().Unix() > expireAt {
+		return apperr.NewError(apperr.CodeForbidden).WithSummary("Presigned URL expired")
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(s.sign(method, key, expires))) {
+		return apperr.NewError(apperr.CodeForbidden).WithSummary("Signature mismatch")
+	}
+	return nil
+}
+
+func (s *Storage) sign(method, key, expires string) string {
+	mac := hmac.New(sha256.New, []byte(s.cfg.SigningKey))
+	mac.Write([]byte(method + "\n" + key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,152 @@
+package oidc
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// Client signs in users through an OIDC provider, whose endpoints are
+// discovered from the issuer.
+type Client struct {
+	oidcVerifier *oidc.IDTokenVerifier
+	oauthCfg     *oauth2.Config
+	callbackPath string
+	cfg          ClientConfig
+}
+
+func NewClient(ctx context.Context, cfg ClientConfig, callbackPath string) (*Client, error) {
+	oidcProvider, err := oidc.NewProvider(ctx, cfg.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("discovering OIDC provider %s: %w", cfg.IssuerURL, err)
+	}
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+	}
+
+	return &Client{
+		oidcVerifier: oidcProvider.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+		oauthCfg: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			Endpoint:     oidcProvider.Endpoint(),
+			Scopes:       scopes,
+		},
+		callbackPath: strings.ReplaceAll(callbackPath, "{provider}", cfg.Name),
+		cfg:          cfg,
+	}, nil
+}
+
+func (c *Client) BuildAuthenticationURL(baseURL, state string) string {
+	opts := []oauth2.AuthCodeOption{
+		oauth2.AccessTypeOnline,
+		oauth2.SetAuthURLParam("nonce", uuid.NewString()),
+		oauth2.SetAuthURLParam("redirect_uri", c.redirectURI(baseURL)),
+	}
+	for key, value := range c.cfg.AuthURLParams {
+		opts = append(opts, oauth2.SetAuthURLParam(key, value))
+	}
+	return c.oauthCfg.AuthCodeURL(state, opts...)
+}
+
+func (c *Client) ExchangeCode(ctx context.Context, baseURL, code string,
+) (payload domain.IDTokenPayload, err error) {
+	ctx, span := tracing.StartSpan(ctx, "oidc.Client.ExchangeCode",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceOIDC))
+	defer span.End()
+
+	token, err := c.oauthCfg.Exchange(ctx, code,
+		oauth2.SetAuthURLParam("redirect_uri", c.redirectURI(baseURL)))
+	if err != nil {
+		return payload, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("failed to exchange code for token")
+	}
+
+	idTokenRaw, ok := token.Extra("id_token").(string)
+	if !ok {
+		return payload, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("token response has invalid id_token")
+	}
+
+	idToken, err := c.oidcVerifier.Verify(ctx, idTokenRaw)
+	if err != nil {
+		return payload, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("failed to verify ID token")
+	}
+
+	idTokenPayload, err := c.idTokenToDomain(idToken)
+	if err != nil {
+		return payload, fmt.Errorf("building ID token payload: %w", err)
+	}
+
+	return idTokenPayload, nil
+}
+
+// idTokenToDomain extracts user attributes from claims named by the claim
+// mapping.
+// ref: https://openid.net/specs/openid-connect-core-1_0.html#StandardClaims
+func (c *Client) idTokenToDomain(token *oidc.IDToken) (payload domain.IDTokenPayload, err error) {
+	var claims map[string]any
+	if err := token.Claims(&claims); err != nil {
+		return payload, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("extracting claims from ID token").
+			WithCause(err)
+	}
+
+	email, _ := claims[cmp.Or(c.cfg.Claims.Email, "email")].(string)
+	if email == "" {
+		return payload, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("ID token has no email claim")
+	}
+
+	var emailVerified *bool
+	if v, ok := claims["email_verified"].(bool); ok {
+		emailVerified = &v
+	}
+
+	name, _ := claims[cmp.Or(c.cfg.Claims.Name, "name")].(string)
+	picture, _ := claims[cmp.Or(c.cfg.Claims.Picture, "picture")].(string)
+
+	return domain.IDTokenPayload{
+		Audience:      token.Audience[0],
+		Issuer:        token.Issuer,
+		Subject:       token.Subject,
+		Email:         email,
+		EmailVerified: emailVerified,
+		FamilyName:    claimString(claims, "family_name"),
+		GivenName:     claimString(claims, "given_name"),
+		FullName:      name,
+		PictureURL:    lo.EmptyableToPtr(picture),
+		ProfileURL:    claimString(claims, "profile"),
+		Expiry:        token.Expiry,
+		IssuedAt:      token.IssuedAt,
+		Claims:        claims,
+	}, nil
+}
+
+func (c *Client) redirectURI(baseURL string) string {
+	return lo.Must(url.JoinPath(baseURL, c.callbackPath))
+}
+
+func claimString(claims map[string]any, name string) *string {
+	v, ok := claims[name].(string)
+	if !ok {
+		return nil
+	}
+	return &v
+}
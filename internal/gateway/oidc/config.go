@@ -1,7 +1,27 @@
 package oidc
 
-type GoogleClientConfig struct {
-	ClientID     string
-	ClientSecret string
+type RouterConfig struct {
+	// CallbackPath is the path of the redirect URI, where {provider} is
+	// replaced with the provider name.
 	CallbackPath string
+	Providers    []ClientConfig
+}
+
+type ClientConfig struct {
+	Name          string
+	DisplayName   string
+	IssuerURL     string
+	ClientID      string
+	ClientSecret  string
+	Scopes        []string
+	AuthURLParams map[string]string
+	Claims        ClaimMapping
+}
+
+// ClaimMapping names ID token claims holding user attributes. Empty names
+// default to the standard claims.
+type ClaimMapping struct {
+	Email   string
+	Name    string
+	Picture string
 }
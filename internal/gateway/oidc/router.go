@@ -0,0 +1,72 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// Router routes sign-in requests to the client of an OIDC provider by name.
+type Router struct {
+	clients   map[string]*Client
+	providers []domain.OIDCProvider
+}
+
+func NewRouter(cfg RouterConfig) (*Router, error) {
+	clients := make(map[string]*Client, len(cfg.Providers))
+	providers := make([]domain.OIDCProvider, 0, len(cfg.Providers))
+	for _, providerCfg := range cfg.Providers {
+		if _, ok := clients[providerCfg.Name]; ok {
+			return nil, fmt.Errorf("duplicate OIDC provider %q", providerCfg.Name)
+		}
+
+		client, err := NewClient(context.Background(), providerCfg, cfg.CallbackPath)
+		if err != nil {
+			return nil, fmt.Errorf("creating client of OIDC provider %s: %w", providerCfg.Name, err)
+		}
+
+		clients[providerCfg.Name] = client
+		providers = append(providers, domain.OIDCProvider{
+			Name:        providerCfg.Name,
+			DisplayName: providerCfg.DisplayName,
+		})
+	}
+
+	return &Router{
+		clients:   clients,
+		providers: providers,
+	}, nil
+}
+
+// Providers returns providers in the configured order.
+func (r *Router) Providers() []domain.OIDCProvider {
+	return r.providers
+}
+
+func (r *Router) BuildAuthenticationURL(provider, baseURL, state string) (string, error) {
+	client, err := r.client(provider)
+	if err != nil {
+		return "", err
+	}
+	return client.BuildAuthenticationURL(baseURL, state), nil
+}
+
+func (r *Router) ExchangeCode(ctx context.Context, provider, baseURL, code string,
+) (domain.IDTokenPayload, error) {
+	client, err := r.client(provider)
+	if err != nil {
+		return domain.IDTokenPayload{}, err
+	}
+	return client.ExchangeCode(ctx, baseURL, code)
+}
+
+func (r *Router) client(provider string) (*Client, error) {
+	client, ok := r.clients[provider]
+	if !ok {
+		return nil, apperr.NewError(apperr.CodeNotFound).
+			WithSummary("OIDC provider %q not found", provider)
+	}
+	return client, nil
+}
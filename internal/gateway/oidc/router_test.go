@@ -0,0 +1,247 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+const (
+	testClientID = "test-client"
+	testKeyID    = "test-key"
+)
+
+// mockIdP is a minimal OIDC provider issuing ID tokens with fixed claims for
+// any authorization code.
+type mockIdP struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	claims jwt.MapClaims
+
+	redirectURI string
+}
+
+func newMockIdP(t *testing.T, claims jwt.MapClaims) *mockIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp := &mockIdP{key: key, claims: claims}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", idp.handleDiscovery)
+	mux.HandleFunc("GET /jwks", idp.handleJWKS)
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		idp.handleToken(t, w, r)
+	})
+
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *mockIdP) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	issuer := idp.server.URL
+	writeJSON(w, map[string]any{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/authorize",
+		"token_endpoint":                        issuer + "/token",
+		"jwks_uri":                              issuer + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (idp *mockIdP) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	pub := idp.key.PublicKey
+	writeJSON(w, map[string]any{
+		"keys": []map[string]any{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": testKeyID,
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func (idp *mockIdP) handleToken(t *testing.T, w http.ResponseWriter, r *http.Request) {
+	require.NoError(t, r.ParseForm())
+	idp.redirectURI = r.PostForm.Get("redirect_uri")
+
+	claims := jwt.MapClaims{
+		"iss": idp.server.URL,
+		"aud": testClientID,
+		"sub": "subject-1",
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range idp.claims {
+		claims[k] = v
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+	idToken, err := token.SignedString(idp.key)
+	require.NoError(t, err)
+
+	writeJSON(w, map[string]any{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestRouter(t *testing.T, idp *mockIdP, claims ClaimMapping) *Router {
+	t.Helper()
+
+	router, err := NewRouter(RouterConfig{
+		CallbackPath: "/api/v1/auth/{provider}/sign-in/callback",
+		Providers: []ClientConfig{{
+			Name:          "mock",
+			DisplayName:   "Mock",
+			IssuerURL:     idp.server.URL,
+			ClientID:      testClientID,
+			ClientSecret:  "test-secret",
+			AuthURLParams: map[string]string{"prompt": "consent"},
+			Claims:        claims,
+		}},
+	})
+	require.NoError(t, err)
+	return router
+}
+
+func TestRouter_BuildAuthenticationURL(t *testing.T) {
+	idp := newMockIdP(t, nil)
+	router := newTestRouter(t, idp, ClaimMapping{})
+
+	assert.Equal(t, []domain.OIDCProvider{{Name: "mock", DisplayName: "Mock"}}, router.Providers())
+
+	authURL, err := router.BuildAuthenticationURL("mock", "https://imageer.example.com", "state-1")
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, idp.server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+
+	query := parsed.Query()
+	assert.Equal(t, "state-1", query.Get("state"))
+	assert.Equal(t, testClientID, query.Get("client_id"))
+	assert.Equal(t, "consent", query.Get("prompt"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+	assert.Equal(t, "https://imageer.example.com/api/v1/auth/mock/sign-in/callback",
+		query.Get("redirect_uri"))
+	assert.NotEmpty(t, query.Get("nonce"))
+
+	_, err = router.BuildAuthenticationURL("unknown", "https://imageer.example.com", "state-1")
+	assert.True(t, apperr.IsErrorCode(err, apperr.CodeNotFound))
+}
+
+func TestRouter_ExchangeCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		claims  jwt.MapClaims
+		mapping ClaimMapping
+		want    domain.IDTokenPayload
+		wantErr apperr.Code
+	}{
+		{
+			name: "standard claims",
+			claims: jwt.MapClaims{
+				"email":          "user@example.com",
+				"email_verified": true,
+				"name":           "John Doe",
+				"picture":        "https://example.com/john.png",
+			},
+			want: domain.IDTokenPayload{
+				Email:         "user@example.com",
+				EmailVerified: new(true),
+				FullName:      "John Doe",
+				PictureURL:    new("https://example.com/john.png"),
+			},
+		},
+		{
+			name: "mapped claims",
+			claims: jwt.MapClaims{
+				"mail":         "user@example.com",
+				"display_name": "John Doe",
+				"avatar_url":   "https://example.com/john.png",
+			},
+			mapping: ClaimMapping{
+				Email:   "mail",
+				Name:    "display_name",
+				Picture: "avatar_url",
+			},
+			want: domain.IDTokenPayload{
+				Email:      "user@example.com",
+				FullName:   "John Doe",
+				PictureURL: new("https://example.com/john.png"),
+			},
+		},
+		{
+			name:    "missing email",
+			claims:  jwt.MapClaims{"name": "John Doe"},
+			wantErr: apperr.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := newMockIdP(t, tt.claims)
+			router := newTestRouter(t, idp, tt.mapping)
+
+			got, err := router.ExchangeCode(t.Context(), "mock", "https://imageer.example.com", "code-1")
+			if tt.wantErr != (apperr.Code{}) {
+				assert.True(t, apperr.IsErrorCode(err, tt.wantErr))
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "https://imageer.example.com/api/v1/auth/mock/sign-in/callback",
+				idp.redirectURI)
+			assert.Equal(t, idp.server.URL, got.Issuer)
+			assert.Equal(t, testClientID, got.Audience)
+			assert.Equal(t, "subject-1", got.Subject)
+			assert.Equal(t, tt.want.Email, got.Email)
+			assert.Equal(t, tt.want.EmailVerified, got.EmailVerified)
+			assert.Equal(t, tt.want.FullName, got.FullName)
+			assert.Equal(t, tt.want.PictureURL, got.PictureURL)
+		})
+	}
+}
+
+func TestNewRouter_DuplicateProvider(t *testing.T) {
+	idp := newMockIdP(t, nil)
+
+	provider := ClientConfig{
+		Name:      "mock",
+		IssuerURL: idp.server.URL,
+		ClientID:  testClientID,
+	}
+	_, err := NewRouter(RouterConfig{
+		CallbackPath: "/api/v1/auth/{provider}/sign-in/callback",
+		Providers:    []ClientConfig{provider, provider},
+	})
+	assert.Error(t, err)
+}
@@ -1,6 +1,10 @@
 package port
 
-import "github.com/isutare412/imageer/internal/gateway/domain"
+import (
+	"context"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+)
 
 //go:generate sh -c "go tool mockgen -package $GOPACKAGE -source=$GOFILE -destination=$(basename $GOFILE .go)_mock.go"
 
@@ -11,3 +15,12 @@ type JWTSigner interface {
 type JWTVerifier interface {
 	VerifyUserToken(token string) (payload domain.UserTokenPayload, err error)
 }
+
+// WorkloadTokenVerifier verifies JWTs issued to workloads by trusted external
+// issuers against their JWKS.
+type WorkloadTokenVerifier interface {
+	// Trusts reports whether the token claims to be issued by one of the
+	// trusted issuers, without verifying it.
+	Trusts(token string) bool
+	VerifyWorkloadToken(ctx context.Context, token string) (domain.WorkloadTokenPayload, error)
+}
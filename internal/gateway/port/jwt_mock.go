@@ -10,6 +10,7 @@
 package port
 
 import (
+	context "context"
 	reflect "reflect"
 
 	domain "github.com/isutare412/imageer/internal/gateway/domain"
@@ -93,3 +94,56 @@ func (mr *MockJWTVerifierMockRecorder) VerifyUserToken(token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyUserToken", reflect.TypeOf((*MockJWTVerifier)(nil).VerifyUserToken), token)
 }
+
+// MockWorkloadTokenVerifier is a mock of WorkloadTokenVerifier interface.
+type MockWorkloadTokenVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkloadTokenVerifierMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkloadTokenVerifierMockRecorder is the mock recorder for MockWorkloadTokenVerifier.
+type MockWorkloadTokenVerifierMockRecorder struct {
+	mock *MockWorkloadTokenVerifier
+}
+
+// NewMockWorkloadTokenVerifier creates a new mock instance.
+func NewMockWorkloadTokenVerifier(ctrl *gomock.Controller) *MockWorkloadTokenVerifier {
+	mock := &MockWorkloadTokenVerifier{ctrl: ctrl}
+	mock.recorder = &MockWorkloadTokenVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkloadTokenVerifier) EXPECT() *MockWorkloadTokenVerifierMockRecorder {
+	return m.recorder
+}
+
+// Trusts mocks base method.
+func (m *MockWorkloadTokenVerifier) Trusts(token string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trusts", token)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Trusts indicates an expected call of Trusts.
+func (mr *MockWorkloadTokenVerifierMockRecorder) Trusts(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trusts", reflect.TypeOf((*MockWorkloadTokenVerifier)(nil).Trusts), token)
+}
+
+// VerifyWorkloadToken mocks base method.
+func (m *MockWorkloadTokenVerifier) VerifyWorkloadToken(ctx context.Context, token string) (domain.WorkloadTokenPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyWorkloadToken", ctx, token)
+	ret0, _ := ret[0].(domain.WorkloadTokenPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyWorkloadToken indicates an expected call of VerifyWorkloadToken.
+func (mr *MockWorkloadTokenVerifierMockRecorder) VerifyWorkloadToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyWorkloadToken", reflect.TypeOf((*MockWorkloadTokenVerifier)(nil).VerifyWorkloadToken), ctx, token)
+}
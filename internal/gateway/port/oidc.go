@@ -8,7 +8,10 @@ import (
 
 //go:generate sh -c "go tool mockgen -package $GOPACKAGE -source=$GOFILE -destination=$(basename $GOFILE .go)_mock.go"
 
+// OIDCProvider signs in users through one of the configured identity
+// providers, which is chosen by name.
 type OIDCProvider interface {
-	BuildAuthenticationURL(baseURL, state string) string
-	ExchangeCode(ctx context.Context, baseURL, code string) (domain.IDTokenPayload, error)
+	Providers() []domain.OIDCProvider
+	BuildAuthenticationURL(provider, baseURL, state string) (string, error)
+	ExchangeCode(ctx context.Context, provider, baseURL, code string) (domain.IDTokenPayload, error)
 }
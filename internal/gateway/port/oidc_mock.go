@@ -42,30 +42,45 @@ func (m *MockOIDCProvider) EXPECT() *MockOIDCProviderMockRecorder {
 }
 
 // BuildAuthenticationURL mocks base method.
-func (m *MockOIDCProvider) BuildAuthenticationURL(baseURL, state string) string {
+func (m *MockOIDCProvider) BuildAuthenticationURL(provider, baseURL, state string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildAuthenticationURL", baseURL, state)
+	ret := m.ctrl.Call(m, "BuildAuthenticationURL", provider, baseURL, state)
 	ret0, _ := ret[0].(string)
-	return ret0
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // BuildAuthenticationURL indicates an expected call of BuildAuthenticationURL.
-func (mr *MockOIDCProviderMockRecorder) BuildAuthenticationURL(baseURL, state any) *gomock.Call {
+func (mr *MockOIDCProviderMockRecorder) BuildAuthenticationURL(provider, baseURL, state any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAuthenticationURL", reflect.TypeOf((*MockOIDCProvider)(nil).BuildAuthenticationURL), baseURL, state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAuthenticationURL", reflect.TypeOf((*MockOIDCProvider)(nil).BuildAuthenticationURL), provider, baseURL, state)
 }
 
 // ExchangeCode mocks base method.
-func (m *MockOIDCProvider) ExchangeCode(ctx context.Context, baseURL, code string) (domain.IDTokenPayload, error) {
+func (m *MockOIDCProvider) ExchangeCode(ctx context.Context, provider, baseURL, code string) (domain.IDTokenPayload, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExchangeCode", ctx, baseURL, code)
+	ret := m.ctrl.Call(m, "ExchangeCode", ctx, provider, baseURL, code)
 	ret0, _ := ret[0].(domain.IDTokenPayload)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExchangeCode indicates an expected call of ExchangeCode.
-func (mr *MockOIDCProviderMockRecorder) ExchangeCode(ctx, baseURL, code any) *gomock.Call {
+func (mr *MockOIDCProviderMockRecorder) ExchangeCode(ctx, provider, baseURL, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeCode", reflect.TypeOf((*MockOIDCProvider)(nil).ExchangeCode), ctx, provider, baseURL, code)
+}
+
+// Providers mocks base method.
+func (m *MockOIDCProvider) Providers() []domain.OIDCProvider {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Providers")
+	ret0, _ := ret[0].([]domain.OIDCProvider)
+	return ret0
+}
+
+// Providers indicates an expected call of Providers.
+func (mr *MockOIDCProviderMockRecorder) Providers() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeCode", reflect.TypeOf((*MockOIDCProvider)(nil).ExchangeCode), ctx, baseURL, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Providers", reflect.TypeOf((*MockOIDCProvider)(nil).Providers))
 }
@@ -3,8 +3,10 @@ package port
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/serviceaccounts"
 )
 
 //go:generate sh -c "go tool mockgen -package $GOPACKAGE -source=$GOFILE -destination=$(basename $GOFILE .go)_mock.go"
@@ -16,7 +18,10 @@ type Transactioner interface {
 
 type UserRepository interface {
 	FindByID(ctx context.Context, id string) (domain.User, error)
+	FindByEmail(ctx context.Context, email string) (domain.User, error)
+	List(context.Context, domain.ListUsersParams) (domain.Users, error)
 	Upsert(context.Context, domain.User) (domain.User, error)
+	Update(context.Context, domain.UpdateUserRequest) (domain.User, error)
 }
 
 type ProjectRepository interface {
@@ -24,27 +29,70 @@ type ProjectRepository interface {
 	List(context.Context, domain.ListProjectsParams) (domain.Projects, error)
 	Create(context.Context, domain.Project) (domain.Project, error)
 	Update(context.Context, domain.UpdateProjectRequest) (domain.Project, error)
+	// Delete soft-deletes the project along with its images, which are
+	// restored by Restore. Purge removes the project permanently, but not its
+	// images.
 	Delete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) (domain.Project, error)
+	Purge(ctx context.Context, id string) error
+}
+
+type ProjectDeletionRepository interface {
+	FindLatestByProjectID(ctx context.Context, projectID string) (domain.ProjectDeletion, error)
+	List(context.Context, domain.ListProjectDeletionsParams) ([]domain.ProjectDeletion, error)
+	Create(context.Context, domain.ProjectDeletion) (domain.ProjectDeletion, error)
+	Update(context.Context, domain.UpdateProjectDeletionRequest) (domain.ProjectDeletion, error)
+}
+
+type ProjectMemberRepository interface {
+	Find(ctx context.Context, projectID, userID string) (domain.ProjectMember, error)
+	List(ctx context.Context, projectID string) ([]domain.ProjectMember, error)
+	Create(context.Context, domain.ProjectMember) (domain.ProjectMember, error)
+	Update(context.Context, domain.UpdateProjectMemberRequest) (domain.ProjectMember, error)
+	Delete(ctx context.Context, projectID, userID string) error
 }
 
 type ServiceAccountRepository interface {
 	FindByID(ctx context.Context, id string) (domain.ServiceAccount, error)
+	// FindByAPIKeyHash returns the service account owning the unexpired API
+	// key with the hash.
 	FindByAPIKeyHash(ctx context.Context, hash string) (domain.ServiceAccount, error)
 	List(context.Context, domain.ListServiceAccountsParams) (domain.ServiceAccounts, error)
 	Create(context.Context, domain.ServiceAccount) (domain.ServiceAccount, error)
 	Update(context.Context, domain.UpdateServiceAccountRequest) (domain.ServiceAccount, error)
 	Delete(ctx context.Context, id string) error
+	// MarkUsed records the usage of the account unless a later one is
+	// recorded, and clears the stale warning of the account.
+	MarkUsed(context.Context, domain.ServiceAccountUsage) error
+	MarkStaleWarned(ctx context.Context, id string, warnedAt time.Time) error
+	Disable(ctx context.Context, id string, disabledAt time.Time,
+		reason serviceaccounts.DisabledReason) error
+}
+
+type ServiceAccountAPIKeyRepository interface {
+	List(ctx context.Context, serviceAccountID string) ([]domain.ServiceAccountAPIKey, error)
+	Create(context.Context, domain.ServiceAccountAPIKey) (domain.ServiceAccountAPIKey, error)
+	// MarkUsed records the usage of the key with the hash unless a later one
+	// is recorded.
+	MarkUsed(ctx context.Context, hash string, usedAt time.Time) error
+	Delete(ctx context.Context, serviceAccountID, id string) error
 }
 
 type ImageRepository interface {
 	FindByID(ctx context.Context, id string) (domain.Image, error)
+	FindByS3Key(ctx context.Context, s3Key string) (domain.Image, error)
 	List(context.Context, domain.ListImagesParams) (domain.Images, error)
 	Create(context.Context, domain.Image) (domain.Image, error)
 	Update(context.Context, domain.UpdateImageRequest) (domain.Image, error)
+	// Delete soft-deletes the image, which is restored by Restore or removed
+	// permanently by Purge.
 	Delete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) (domain.Image, error)
+	Purge(ctx context.Context, id string) error
 }
 
 type ImageVariantRepository interface {
+	FindByS3Key(ctx context.Context, s3Key string) (domain.ImageVariant, error)
 	Create(context.Context, domain.ImageVariant) (domain.ImageVariant, error)
 	Update(context.Context, domain.UpdateImageVariantRequest) (domain.ImageVariant, error)
 }
@@ -58,3 +106,19 @@ type PresetRepository interface {
 	FindByName(ctx context.Context, projectID, name string) (domain.Preset, error)
 	List(context.Context, domain.ListPresetsParams) ([]domain.Preset, error)
 }
+
+type WatermarkRepository interface {
+	FindByID(ctx context.Context, id string) (domain.Watermark, error)
+	List(context.Context, domain.ListWatermarksParams) (domain.Watermarks, error)
+	Create(context.Context, domain.Watermark) (domain.Watermark, error)
+	Update(context.Context, domain.UpdateWatermarkRequest) (domain.Watermark, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type AuditEntryRepository interface {
+	List(context.Context, domain.ListAuditEntriesParams) (domain.AuditEntries, error)
+	Create(context.Context, domain.AuditEntry) (domain.AuditEntry, error)
+	// DeleteCreatedBefore deletes entries created before the time, returning
+	// the number of deleted entries.
+	DeleteCreatedBefore(ctx context.Context, t time.Time) (count int64, err error)
+}
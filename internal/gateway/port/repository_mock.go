@@ -13,8 +13,10 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/isutare412/imageer/internal/gateway/domain"
+	serviceaccounts "github.com/isutare412/imageer/pkg/serviceaccounts"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -101,6 +103,21 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// FindByEmail mocks base method.
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
+	ret0, _ := ret[0].(domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockUserRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserRepository)(nil).FindByEmail), ctx, email)
+}
+
 // FindByID mocks base method.
 func (m *MockUserRepository) FindByID(ctx context.Context, id string) (domain.User, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +133,36 @@ func (mr *MockUserRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), ctx, id)
 }
 
+// List mocks base method.
+func (m *MockUserRepository) List(arg0 context.Context, arg1 domain.ListUsersParams) (domain.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(domain.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockUserRepository) Update(arg0 context.Context, arg1 domain.UpdateUserRequest) (domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), arg0, arg1)
+}
+
 // Upsert mocks base method.
 func (m *MockUserRepository) Upsert(arg0 context.Context, arg1 domain.User) (domain.User, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +261,35 @@ func (mr *MockProjectRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectRepository)(nil).List), arg0, arg1)
 }
 
+// Purge mocks base method.
+func (m *MockProjectRepository) Purge(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockProjectRepositoryMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockProjectRepository)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockProjectRepository) Restore(ctx context.Context, id string) (domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockProjectRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockProjectRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockProjectRepository) Update(arg0 context.Context, arg1 domain.UpdateProjectRequest) (domain.Project, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +305,188 @@ func (mr *MockProjectRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectRepository)(nil).Update), arg0, arg1)
 }
 
+// MockProjectDeletionRepository is a mock of ProjectDeletionRepository interface.
+type MockProjectDeletionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectDeletionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectDeletionRepositoryMockRecorder is the mock recorder for MockProjectDeletionRepository.
+type MockProjectDeletionRepositoryMockRecorder struct {
+	mock *MockProjectDeletionRepository
+}
+
+// NewMockProjectDeletionRepository creates a new mock instance.
+func NewMockProjectDeletionRepository(ctrl *gomock.Controller) *MockProjectDeletionRepository {
+	mock := &MockProjectDeletionRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectDeletionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectDeletionRepository) EXPECT() *MockProjectDeletionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProjectDeletionRepository) Create(arg0 context.Context, arg1 domain.ProjectDeletion) (domain.ProjectDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(domain.ProjectDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectDeletionRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectDeletionRepository)(nil).Create), arg0, arg1)
+}
+
+// FindLatestByProjectID mocks base method.
+func (m *MockProjectDeletionRepository) FindLatestByProjectID(ctx context.Context, projectID string) (domain.ProjectDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestByProjectID", ctx, projectID)
+	ret0, _ := ret[0].(domain.ProjectDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestByProjectID indicates an expected call of FindLatestByProjectID.
+func (mr *MockProjectDeletionRepositoryMockRecorder) FindLatestByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestByProjectID", reflect.TypeOf((*MockProjectDeletionRepository)(nil).FindLatestByProjectID), ctx, projectID)
+}
+
+// List mocks base method.
+func (m *MockProjectDeletionRepository) List(arg0 context.Context, arg1 domain.ListProjectDeletionsParams) ([]domain.ProjectDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]domain.ProjectDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectDeletionRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectDeletionRepository)(nil).List), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockProjectDeletionRepository) Update(arg0 context.Context, arg1 domain.UpdateProjectDeletionRequest) (domain.ProjectDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(domain.ProjectDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectDeletionRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectDeletionRepository)(nil).Update), arg0, arg1)
+}
+
+// MockProjectMemberRepository is a mock of ProjectMemberRepository interface.
+type MockProjectMemberRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectMemberRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectMemberRepositoryMockRecorder is the mock recorder for MockProjectMemberRepository.
+type MockProjectMemberRepositoryMockRecorder struct {
+	mock *MockProjectMemberRepository
+}
+
+// NewMockProjectMemberRepository creates a new mock instance.
+func NewMockProjectMemberRepository(ctrl *gomock.Controller) *MockProjectMemberRepository {
+	mock := &MockProjectMemberRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectMemberRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectMemberRepository) EXPECT() *MockProjectMemberRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProjectMemberRepository) Create(arg0 context.Context, arg1 domain.ProjectMember) (domain.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(domain.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectMemberRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectMemberRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockProjectMemberRepository) Delete(ctx context.Context, projectID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, projectID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectMemberRepositoryMockRecorder) Delete(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectMemberRepository)(nil).Delete), ctx, projectID, userID)
+}
+
+// Find mocks base method.
+func (m *MockProjectMemberRepository) Find(ctx context.Context, projectID, userID string) (domain.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, projectID, userID)
+	ret0, _ := ret[0].(domain.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockProjectMemberRepositoryMockRecorder) Find(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockProjectMemberRepository)(nil).Find), ctx, projectID, userID)
+}
+
+// List mocks base method.
+func (m *MockProjectMemberRepository) List(ctx context.Context, projectID string) ([]domain.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, projectID)
+	ret0, _ := ret[0].([]domain.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectMemberRepositoryMockRecorder) List(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectMemberRepository)(nil).List), ctx, projectID)
+}
+
+// Update mocks base method.
+func (m *MockProjectMemberRepository) Update(arg0 context.Context, arg1 domain.UpdateProjectMemberRequest) (domain.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(domain.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectMemberRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectMemberRepository)(nil).Update), arg0, arg1)
+}
+
 // MockServiceAccountRepository is a mock of ServiceAccountRepository interface.
 type MockServiceAccountRepository struct {
 	ctrl     *gomock.Controller
@@ -282,6 +540,20 @@ func (mr *MockServiceAccountRepositoryMockRecorder) Delete(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceAccountRepository)(nil).Delete), ctx, id)
 }
 
+// Disable mocks base method.
+func (m *MockServiceAccountRepository) Disable(ctx context.Context, id string, disabledAt time.Time, reason serviceaccounts.DisabledReason) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, id, disabledAt, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockServiceAccountRepositoryMockRecorder) Disable(ctx, id, disabledAt, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockServiceAccountRepository)(nil).Disable), ctx, id, disabledAt, reason)
+}
+
 // FindByAPIKeyHash mocks base method.
 func (m *MockServiceAccountRepository) FindByAPIKeyHash(ctx context.Context, hash string) (domain.ServiceAccount, error) {
 	m.ctrl.T.Helper()
@@ -327,6 +599,34 @@ func (mr *MockServiceAccountRepositoryMockRecorder) List(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServiceAccountRepository)(nil).List), arg0, arg1)
 }
 
+// MarkStaleWarned mocks base method.
+func (m *MockServiceAccountRepository) MarkStaleWarned(ctx context.Context, id string, warnedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStaleWarned", ctx, id, warnedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkStaleWarned indicates an expected call of MarkStaleWarned.
+func (mr *MockServiceAccountRepositoryMockRecorder) MarkStaleWarned(ctx, id, warnedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStaleWarned", reflect.TypeOf((*MockServiceAccountRepository)(nil).MarkStaleWarned), ctx, id, warnedAt)
+}
+
+// MarkUsed mocks base method.
+func (m *MockServiceAccountRepository) MarkUsed(arg0 context.Context, arg1 domain.ServiceAccountUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockServiceAccountRepositoryMockRecorder) MarkUsed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockServiceAccountRepository)(nil).MarkUsed), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockServiceAccountRepository) Update(arg0 context.Context, arg1 domain.UpdateServiceAccountRequest) (domain.ServiceAccount, error) {
 	m.ctrl.T.Helper()
@@ -342,6 +642,88 @@ func (mr *MockServiceAccountRepositoryMockRecorder) Update(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockServiceAccountRepository)(nil).Update), arg0, arg1)
 }
 
+// MockServiceAccountAPIKeyRepository is a mock of ServiceAccountAPIKeyRepository interface.
+type MockServiceAccountAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceAccountAPIKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceAccountAPIKeyRepositoryMockRecorder is the mock recorder for MockServiceAccountAPIKeyRepository.
+type MockServiceAccountAPIKeyRepositoryMockRecorder struct {
+	mock *MockServiceAccountAPIKeyRepository
+}
+
+// NewMockServiceAccountAPIKeyRepository creates a new mock instance.
+func NewMockServiceAccountAPIKeyRepository(ctrl *gomock.Controller) *MockServiceAccountAPIKeyRepository {
+	mock := &MockServiceAccountAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockServiceAccountAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceAccountAPIKeyRepository) EXPECT() *MockServiceAccountAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockServiceAccountAPIKeyRepository) Create(arg0 context.Context, arg1 domain.ServiceAccountAPIKey) (domain.ServiceAccountAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(domain.ServiceAccountAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceAccountAPIKeyRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockServiceAccountAPIKeyRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockServiceAccountAPIKeyRepository) Delete(ctx context.Context, serviceAccountID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, serviceAccountID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceAccountAPIKeyRepositoryMockRecorder) Delete(ctx, serviceAccountID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceAccountAPIKeyRepository)(nil).Delete), ctx, serviceAccountID, id)
+}
+
+// List mocks base method.
+func (m *MockServiceAccountAPIKeyRepository) List(ctx context.Context, serviceAccountID string) ([]domain.ServiceAccountAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, serviceAccountID)
+	ret0, _ := ret[0].([]domain.ServiceAccountAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceAccountAPIKeyRepositoryMockRecorder) List(ctx, serviceAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServiceAccountAPIKeyRepository)(nil).List), ctx, serviceAccountID)
+}
+
+// MarkUsed mocks base method.
+func (m *MockServiceAccountAPIKeyRepository) MarkUsed(ctx context.Context, hash string, usedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, hash, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockServiceAccountAPIKeyRepositoryMockRecorder) MarkUsed(ctx, hash, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockServiceAccountAPIKeyRepository)(nil).MarkUsed), ctx, hash, usedAt)
+}
+
 // MockImageRepository is a mock of ImageRepository interface.
 type MockImageRepository struct {
 	ctrl     *gomock.Controller
@@ -410,6 +792,21 @@ func (mr *MockImageRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockImageRepository)(nil).FindByID), ctx, id)
 }
 
+// FindByS3Key mocks base method.
+func (m *MockImageRepository) FindByS3Key(ctx context.Context, s3Key string) (domain.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByS3Key", ctx, s3Key)
+	ret0, _ := ret[0].(domain.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByS3Key indicates an expected call of FindByS3Key.
+func (mr *MockImageRepositoryMockRecorder) FindByS3Key(ctx, s3Key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByS3Key", reflect.TypeOf((*MockImageRepository)(nil).FindByS3Key), ctx, s3Key)
+}
+
 // List mocks base method.
 func (m *MockImageRepository) List(arg0 context.Context, arg1 domain.ListImagesParams) (domain.Images, error) {
 	m.ctrl.T.Helper()
@@ -425,6 +822,35 @@ func (mr *MockImageRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockImageRepository)(nil).List), arg0, arg1)
 }
 
+// Purge mocks base method.
+func (m *MockImageRepository) Purge(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockImageRepositoryMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockImageRepository)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockImageRepository) Restore(ctx context.Context, id string) (domain.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(domain.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockImageRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockImageRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockImageRepository) Update(arg0 context.Context, arg1 domain.UpdateImageRequest) (domain.Image, error) {
 	m.ctrl.T.Helper()
@@ -479,6 +905,21 @@ func (mr *MockImageVariantRepositoryMockRecorder) Create(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockImageVariantRepository)(nil).Create), arg0, arg1)
 }
 
+// FindByS3Key mocks base method.
+func (m *MockImageVariantRepository) FindByS3Key(ctx context.Context, s3Key string) (domain.ImageVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByS3Key", ctx, s3Key)
+	ret0, _ := ret[0].(domain.ImageVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByS3Key indicates an expected call of FindByS3Key.
+func (mr *MockImageVariantRepositoryMockRecorder) FindByS3Key(ctx, s3Key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByS3Key", reflect.TypeOf((*MockImageVariantRepository)(nil).FindByS3Key), ctx, s3Key)
+}
+
 // Update mocks base method.
 func (m *MockImageVariantRepository) Update(arg0 context.Context, arg1 domain.UpdateImageVariantRequest) (domain.ImageVariant, error) {
 	m.ctrl.T.Helper()
@@ -601,3 +1042,170 @@ func (mr *MockPresetRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPresetRepository)(nil).List), arg0, arg1)
 }
+
+// MockWatermarkRepository is a mock of WatermarkRepository interface.
+type MockWatermarkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatermarkRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWatermarkRepositoryMockRecorder is the mock recorder for MockWatermarkRepository.
+type MockWatermarkRepositoryMockRecorder struct {
+	mock *MockWatermarkRepository
+}
+
+// NewMockWatermarkRepository creates a new mock instance.
+func NewMockWatermarkRepository(ctrl *gomock.Controller) *MockWatermarkRepository {
+	mock := &MockWatermarkRepository{ctrl: ctrl}
+	mock.recorder = &MockWatermarkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatermarkRepository) EXPECT() *MockWatermarkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWatermarkRepository) Create(arg0 context.Context, arg1 domain.Watermark) (domain.Watermark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(domain.Watermark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWatermarkRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWatermarkRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockWatermarkRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWatermarkRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWatermarkRepository)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockWatermarkRepository) FindByID(ctx context.Context, id string) (domain.Watermark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(domain.Watermark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWatermarkRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWatermarkRepository)(nil).FindByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockWatermarkRepository) List(arg0 context.Context, arg1 domain.ListWatermarksParams) (domain.Watermarks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(domain.Watermarks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWatermarkRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWatermarkRepository)(nil).List), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockWatermarkRepository) Update(arg0 context.Context, arg1 domain.UpdateWatermarkRequest) (domain.Watermark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(domain.Watermark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWatermarkRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWatermarkRepository)(nil).Update), arg0, arg1)
+}
+
+// MockAuditEntryRepository is a mock of AuditEntryRepository interface.
+type MockAuditEntryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditEntryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditEntryRepositoryMockRecorder is the mock recorder for MockAuditEntryRepository.
+type MockAuditEntryRepositoryMockRecorder struct {
+	mock *MockAuditEntryRepository
+}
+
+// NewMockAuditEntryRepository creates a new mock instance.
+func NewMockAuditEntryRepository(ctrl *gomock.Controller) *MockAuditEntryRepository {
+	mock := &MockAuditEntryRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditEntryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditEntryRepository) EXPECT() *MockAuditEntryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditEntryRepository) Create(arg0 context.Context, arg1 domain.AuditEntry) (domain.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(domain.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditEntryRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditEntryRepository)(nil).Create), arg0, arg1)
+}
+
+// DeleteCreatedBefore mocks base method.
+func (m *MockAuditEntryRepository) DeleteCreatedBefore(ctx context.Context, t time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCreatedBefore", ctx, t)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCreatedBefore indicates an expected call of DeleteCreatedBefore.
+func (mr *MockAuditEntryRepositoryMockRecorder) DeleteCreatedBefore(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCreatedBefore", reflect.TypeOf((*MockAuditEntryRepository)(nil).DeleteCreatedBefore), ctx, t)
+}
+
+// List mocks base method.
+func (m *MockAuditEntryRepository) List(arg0 context.Context, arg1 domain.ListAuditEntriesParams) (domain.AuditEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(domain.AuditEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditEntryRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditEntryRepository)(nil).List), arg0, arg1)
+}
@@ -10,8 +10,16 @@ import (
 
 type S3Presigner interface {
 	PresignPutObject(context.Context, domain.PresignPutObjectRequest) (domain.PresignPutObjectResponse, error)
+	PresignGetObject(context.Context, domain.PresignGetObjectRequest) (domain.PresignGetObjectResponse, error)
 }
 
 type ObjectStorage interface {
-	DeleteObjects(ctx context.Context, keys []string) error
+	// HeadObject returns a NotFound error if the object does not exist.
+	HeadObject(ctx context.Context, storageProfile, key string) error
+	// ListObjects lists all objects whose keys start with prefix.
+	ListObjects(ctx context.Context, storageProfile, prefix string) ([]domain.StoredObject, error)
+	// MoveToInfrequentAccess moves the object to the infrequent access storage
+	// class of the storage.
+	MoveToInfrequentAccess(ctx context.Context, storageProfile, key string) error
+	DeleteObjects(ctx context.Context, storageProfile string, keys []string) error
 }
@@ -41,6 +41,21 @@ func (m *MockS3Presigner) EXPECT() *MockS3PresignerMockRecorder {
 	return m.recorder
 }
 
+// PresignGetObject mocks base method.
+func (m *MockS3Presigner) PresignGetObject(arg0 context.Context, arg1 domain.PresignGetObjectRequest) (domain.PresignGetObjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignGetObject", arg0, arg1)
+	ret0, _ := ret[0].(domain.PresignGetObjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignGetObject indicates an expected call of PresignGetObject.
+func (mr *MockS3PresignerMockRecorder) PresignGetObject(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignGetObject", reflect.TypeOf((*MockS3Presigner)(nil).PresignGetObject), arg0, arg1)
+}
+
 // PresignPutObject mocks base method.
 func (m *MockS3Presigner) PresignPutObject(arg0 context.Context, arg1 domain.PresignPutObjectRequest) (domain.PresignPutObjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -81,15 +96,58 @@ func (m *MockObjectStorage) EXPECT() *MockObjectStorageMockRecorder {
 }
 
 // DeleteObjects mocks base method.
-func (m *MockObjectStorage) DeleteObjects(ctx context.Context, keys []string) error {
+func (m *MockObjectStorage) DeleteObjects(ctx context.Context, storageProfile string, keys []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteObjects", ctx, keys)
+	ret := m.ctrl.Call(m, "DeleteObjects", ctx, storageProfile, keys)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteObjects indicates an expected call of DeleteObjects.
-func (mr *MockObjectStorageMockRecorder) DeleteObjects(ctx, keys any) *gomock.Call {
+func (mr *MockObjectStorageMockRecorder) DeleteObjects(ctx, storageProfile, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjects", reflect.TypeOf((*MockObjectStorage)(nil).DeleteObjects), ctx, storageProfile, keys)
+}
+
+// HeadObject mocks base method.
+func (m *MockObjectStorage) HeadObject(ctx context.Context, storageProfile, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeadObject", ctx, storageProfile, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HeadObject indicates an expected call of HeadObject.
+func (mr *MockObjectStorageMockRecorder) HeadObject(ctx, storageProfile, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeadObject", reflect.TypeOf((*MockObjectStorage)(nil).HeadObject), ctx, storageProfile, key)
+}
+
+// ListObjects mocks base method.
+func (m *MockObjectStorage) ListObjects(ctx context.Context, storageProfile, prefix string) ([]domain.StoredObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", ctx, storageProfile, prefix)
+	ret0, _ := ret[0].([]domain.StoredObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockObjectStorageMockRecorder) ListObjects(ctx, storageProfile, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockObjectStorage)(nil).ListObjects), ctx, storageProfile, prefix)
+}
+
+// MoveToInfrequentAccess mocks base method.
+func (m *MockObjectStorage) MoveToInfrequentAccess(ctx context.Context, storageProfile, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToInfrequentAccess", ctx, storageProfile, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToInfrequentAccess indicates an expected call of MoveToInfrequentAccess.
+func (mr *MockObjectStorageMockRecorder) MoveToInfrequentAccess(ctx, storageProfile, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjects", reflect.TypeOf((*MockObjectStorage)(nil).DeleteObjects), ctx, keys)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToInfrequentAccess", reflect.TypeOf((*MockObjectStorage)(nil).MoveToInfrequentAccess), ctx, storageProfile, key)
 }
@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"crypto/x509"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	imageerv1 "github.com/isutare412/imageer/pkg/protogen/imageer/v1"
@@ -10,24 +11,46 @@ import (
 //go:generate sh -c "go tool mockgen -package $GOPACKAGE -source=$GOFILE -destination=$(basename $GOFILE .go)_mock.go"
 
 type AuthService interface {
-	StartGoogleSignIn(context.Context, domain.StartGoogleSignInRequest) (domain.StartGoogleSignInResponse, error)
-	FinishGoogleSignIn(context.Context, domain.FinishGoogleSignInRequest) (domain.FinishGoogleSignInResponse, error)
-	SignOut(ctx context.Context) domain.SignOutResponse
+	ListSignInProviders(context.Context) []domain.OIDCProvider
+	StartSignIn(context.Context, domain.StartSignInRequest) (domain.StartSignInResponse, error)
+	FinishSignIn(context.Context, domain.FinishSignInRequest) (domain.FinishSignInResponse, error)
+	SignOut(context.Context, domain.SignOutRequest) (domain.SignOutResponse, error)
+	RevokeSessions(ctx context.Context, userID string) error
 	VerifyUserToken(ctx context.Context, userToken string) (domain.UserTokenPayload, error)
-	RefreshUserToken(ctx context.Context, userID string) (domain.RefreshUserTokenResponse, error)
+	RefreshUserToken(ctx context.Context, sessionID, userID string) (domain.RefreshUserTokenResponse, error)
 }
 
 type UserService interface {
 	GetByID(ctx context.Context, id string) (domain.User, error)
+	List(context.Context, domain.ListUsersParams) (domain.Users, error)
+	Update(context.Context, domain.UpdateUserRequest) (domain.User, error)
 }
 
 type ServiceAccountService interface {
 	GetByID(ctx context.Context, id string) (domain.ServiceAccount, error)
 	GetByAPIKey(ctx context.Context, key string) (domain.ServiceAccount, error)
+	// GetByClientCertificate finds the service account mapped to the subject of
+	// the verified client certificate.
+	GetByClientCertificate(ctx context.Context, cert *x509.Certificate) (domain.ServiceAccount, error)
+	// IsWorkloadToken reports whether the bearer token is issued by a trusted
+	// workload issuer rather than the gateway.
+	IsWorkloadToken(token string) bool
+	// GetByWorkloadToken finds the service account mapped to the claims of the
+	// verified workload token.
+	GetByWorkloadToken(ctx context.Context, token string) (domain.ServiceAccount, error)
+	// TrackAPIKeyUsage records the usage of the API key of the service account,
+	// which is written in the background.
+	TrackAPIKeyUsage(ctx context.Context, serviceAccountID, key, remoteIP string) error
+	// TrackUsage records the usage of the service account by credentials other
+	// than API keys, which is written in the background.
+	TrackUsage(ctx context.Context, serviceAccountID, remoteIP string)
 	List(context.Context, domain.ListServiceAccountsParams) (domain.ServiceAccounts, error)
 	Create(context.Context, domain.CreateServiceAccountRequest) (domain.ServiceAccountWithAPIKey, error)
 	Update(context.Context, domain.UpdateServiceAccountRequest) (domain.ServiceAccount, error)
 	Delete(ctx context.Context, id string) error
+	ListAPIKeys(ctx context.Context, serviceAccountID string) ([]domain.ServiceAccountAPIKey, error)
+	CreateAPIKey(context.Context, domain.CreateServiceAccountAPIKeyRequest) (domain.ServiceAccountWithAPIKey, error)
+	RevokeAPIKey(ctx context.Context, serviceAccountID, id string) error
 }
 
 type ProjectService interface {
@@ -35,16 +58,50 @@ type ProjectService interface {
 	List(context.Context, domain.ListProjectsParams) (domain.Projects, error)
 	Create(context.Context, domain.CreateProjectRequest) (domain.Project, error)
 	Update(context.Context, domain.UpdateProjectRequest) (domain.Project, error)
-	Delete(ctx context.Context, id string) error
+	// Delete soft-deletes the project and schedules a deletion job purging it
+	// after the restore window.
+	Delete(ctx context.Context, id string) (domain.ProjectDeletion, error)
+	Restore(ctx context.Context, id string) (domain.Project, error)
+	GetDeletion(ctx context.Context, projectID string) (domain.ProjectDeletion, error)
+	GetMember(ctx context.Context, projectID, userID string) (domain.ProjectMember, error)
+	ListMembers(ctx context.Context, projectID string) ([]domain.ProjectMember, error)
+	AddMember(context.Context, domain.AddProjectMemberRequest) (domain.ProjectMember, error)
+	UpdateMember(context.Context, domain.UpdateProjectMemberRequest) (domain.ProjectMember, error)
+	RemoveMember(ctx context.Context, projectID, userID string) error
 }
 
 type ImageService interface {
 	Get(ctx context.Context, imageID string) (domain.Image, error)
 	GetWaitUntilProcessed(ctx context.Context, imageID string) (domain.Image, error)
 	List(context.Context, domain.ListImagesParams) (domain.Images, error)
+	UpdateFocus(context.Context, domain.UpdateImageFocusRequest) (domain.Image, error)
+	UpdateDetails(context.Context, domain.UpdateImageDetailsRequest) (domain.Image, error)
+	Deliver(context.Context, domain.DeliverImageRequest) (domain.ImageDelivery, error)
 	Delete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) (domain.Image, error)
 	DeleteS3Objects(context.Context, *imageerv1.ImageS3DeleteRequest) error
 	CreateUploadURL(context.Context, domain.CreateUploadURLRequest) (domain.UploadURL, error)
 	StartImageProcessingOnUpload(ctx context.Context, s3Key string) error
+	IsPublicObject(ctx context.Context, s3Key string) (bool, error)
+	CompleteUpload(ctx context.Context, imageID string) (domain.Image, error)
 	ReceiveImageProcessResult(context.Context, *imageerv1.ImageProcessResult) error
 }
+
+type WatermarkService interface {
+	Get(ctx context.Context, id string) (domain.Watermark, error)
+	List(context.Context, domain.ListWatermarksParams) (domain.Watermarks, error)
+	CreateUploadURL(context.Context, domain.CreateWatermarkUploadURLRequest) (domain.WatermarkUploadURL, error)
+	CompleteUpload(ctx context.Context, id string) (domain.Watermark, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type AuditService interface {
+	List(context.Context, domain.ListAuditEntriesParams) (domain.AuditEntries, error)
+}
+
+// AuditRecorder records changes made by the identity in the context. Changes
+// should be recorded in their transactions, so that entries are written only
+// if the changes are committed.
+type AuditRecorder interface {
+	Record(context.Context, domain.RecordAuditRequest) error
+}
@@ -11,6 +11,7 @@ package port
 
 import (
 	context "context"
+	x509 "crypto/x509"
 	reflect "reflect"
 
 	domain "github.com/isutare412/imageer/internal/gateway/domain"
@@ -42,63 +43,92 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 	return m.recorder
 }
 
-// FinishGoogleSignIn mocks base method.
-func (m *MockAuthService) FinishGoogleSignIn(arg0 context.Context, arg1 domain.FinishGoogleSignInRequest) (domain.FinishGoogleSignInResponse, error) {
+// FinishSignIn mocks base method.
+func (m *MockAuthService) FinishSignIn(arg0 context.Context, arg1 domain.FinishSignInRequest) (domain.FinishSignInResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FinishGoogleSignIn", arg0, arg1)
-	ret0, _ := ret[0].(domain.FinishGoogleSignInResponse)
+	ret := m.ctrl.Call(m, "FinishSignIn", arg0, arg1)
+	ret0, _ := ret[0].(domain.FinishSignInResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FinishGoogleSignIn indicates an expected call of FinishGoogleSignIn.
-func (mr *MockAuthServiceMockRecorder) FinishGoogleSignIn(arg0, arg1 any) *gomock.Call {
+// FinishSignIn indicates an expected call of FinishSignIn.
+func (mr *MockAuthServiceMockRecorder) FinishSignIn(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishGoogleSignIn", reflect.TypeOf((*MockAuthService)(nil).FinishGoogleSignIn), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishSignIn", reflect.TypeOf((*MockAuthService)(nil).FinishSignIn), arg0, arg1)
+}
+
+// ListSignInProviders mocks base method.
+func (m *MockAuthService) ListSignInProviders(arg0 context.Context) []domain.OIDCProvider {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSignInProviders", arg0)
+	ret0, _ := ret[0].([]domain.OIDCProvider)
+	return ret0
+}
+
+// ListSignInProviders indicates an expected call of ListSignInProviders.
+func (mr *MockAuthServiceMockRecorder) ListSignInProviders(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSignInProviders", reflect.TypeOf((*MockAuthService)(nil).ListSignInProviders), arg0)
 }
 
 // RefreshUserToken mocks base method.
-func (m *MockAuthService) RefreshUserToken(ctx context.Context, userID string) (domain.RefreshUserTokenResponse, error) {
+func (m *MockAuthService) RefreshUserToken(ctx context.Context, sessionID, userID string) (domain.RefreshUserTokenResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshUserToken", ctx, userID)
+	ret := m.ctrl.Call(m, "RefreshUserToken", ctx, sessionID, userID)
 	ret0, _ := ret[0].(domain.RefreshUserTokenResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RefreshUserToken indicates an expected call of RefreshUserToken.
-func (mr *MockAuthServiceMockRecorder) RefreshUserToken(ctx, userID any) *gomock.Call {
+func (mr *MockAuthServiceMockRecorder) RefreshUserToken(ctx, sessionID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshUserToken", reflect.TypeOf((*MockAuthService)(nil).RefreshUserToken), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshUserToken", reflect.TypeOf((*MockAuthService)(nil).RefreshUserToken), ctx, sessionID, userID)
+}
+
+// RevokeSessions mocks base method.
+func (m *MockAuthService) RevokeSessions(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions.
+func (mr *MockAuthServiceMockRecorder) RevokeSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockAuthService)(nil).RevokeSessions), ctx, userID)
 }
 
 // SignOut mocks base method.
-func (m *MockAuthService) SignOut(ctx context.Context) domain.SignOutResponse {
+func (m *MockAuthService) SignOut(arg0 context.Context, arg1 domain.SignOutRequest) (domain.SignOutResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SignOut", ctx)
+	ret := m.ctrl.Call(m, "SignOut", arg0, arg1)
 	ret0, _ := ret[0].(domain.SignOutResponse)
-	return ret0
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SignOut indicates an expected call of SignOut.
-func (mr *MockAuthServiceMockRecorder) SignOut(ctx any) *gomock.Call {
+func (mr *MockAuthServiceMockRecorder) SignOut(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOut", reflect.TypeOf((*MockAuthService)(nil).SignOut), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOut", reflect.TypeOf((*MockAuthService)(nil).SignOut), arg0, arg1)
 }
 
-// StartGoogleSignIn mocks base method.
-func (m *MockAuthService) StartGoogleSignIn(arg0 context.Context, arg1 domain.StartGoogleSignInRequest) (domain.StartGoogleSignInResponse, error) {
+// StartSignIn mocks base method.
+func (m *MockAuthService) StartSignIn(arg0 context.Context, arg1 domain.StartSignInRequest) (domain.StartSignInResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartGoogleSignIn", arg0, arg1)
-	ret0, _ := ret[0].(domain.StartGoogleSignInResponse)
+	ret := m.ctrl.Call(m, "StartSignIn", arg0, arg1)
+	ret0, _ := ret[0].(domain.StartSignInResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StartGoogleSignIn indicates an expected call of StartGoogleSignIn.
-func (mr *MockAuthServiceMockRecorder) StartGoogleSignIn(arg0, arg1 any) *gomock.Call {
+// StartSignIn indicates an expected call of StartSignIn.
+func (mr *MockAuthServiceMockRecorder) StartSignIn(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGoogleSignIn", reflect.TypeOf((*MockAuthService)(nil).StartGoogleSignIn), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSignIn", reflect.TypeOf((*MockAuthService)(nil).StartSignIn), arg0, arg1)
 }
 
 // VerifyUserToken mocks base method.
@@ -155,6 +185,36 @@ func (mr *MockUserServiceMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserService)(nil).GetByID), ctx, id)
 }
 
+// List mocks base method.
+func (m *MockUserService) List(arg0 context.Context, arg1 domain.ListUsersParams) (domain.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(domain.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserService)(nil).List), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockUserService) Update(arg0 context.Context, arg1 domain.UpdateUserRequest) (domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserService)(nil).Update), arg0, arg1)
+}
+
 // MockServiceAccountService is a mock of ServiceAccountService interface.
 type MockServiceAccountService struct {
 	ctrl     *gomock.Controller
@@ -194,6 +254,21 @@ func (mr *MockServiceAccountServiceMockRecorder) Create(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockServiceAccountService)(nil).Create), arg0, arg1)
 }
 
+// CreateAPIKey mocks base method.
+func (m *MockServiceAccountService) CreateAPIKey(arg0 context.Context, arg1 domain.CreateServiceAccountAPIKeyRequest) (domain.ServiceAccountWithAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(domain.ServiceAccountWithAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockServiceAccountServiceMockRecorder) CreateAPIKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockServiceAccountService)(nil).CreateAPIKey), arg0, arg1)
+}
+
 // Delete mocks base method.
 func (m *MockServiceAccountService) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -223,6 +298,21 @@ func (mr *MockServiceAccountServiceMockRecorder) GetByAPIKey(ctx, key any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAPIKey", reflect.TypeOf((*MockServiceAccountService)(nil).GetByAPIKey), ctx, key)
 }
 
+// GetByClientCertificate mocks base method.
+func (m *MockServiceAccountService) GetByClientCertificate(ctx context.Context, cert *x509.Certificate) (domain.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByClientCertificate", ctx, cert)
+	ret0, _ := ret[0].(domain.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByClientCertificate indicates an expected call of GetByClientCertificate.
+func (mr *MockServiceAccountServiceMockRecorder) GetByClientCertificate(ctx, cert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByClientCertificate", reflect.TypeOf((*MockServiceAccountService)(nil).GetByClientCertificate), ctx, cert)
+}
+
 // GetByID mocks base method.
 func (m *MockServiceAccountService) GetByID(ctx context.Context, id string) (domain.ServiceAccount, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +328,35 @@ func (mr *MockServiceAccountServiceMockRecorder) GetByID(ctx, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockServiceAccountService)(nil).GetByID), ctx, id)
 }
 
+// GetByWorkloadToken mocks base method.
+func (m *MockServiceAccountService) GetByWorkloadToken(ctx context.Context, token string) (domain.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByWorkloadToken", ctx, token)
+	ret0, _ := ret[0].(domain.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByWorkloadToken indicates an expected call of GetByWorkloadToken.
+func (mr *MockServiceAccountServiceMockRecorder) GetByWorkloadToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByWorkloadToken", reflect.TypeOf((*MockServiceAccountService)(nil).GetByWorkloadToken), ctx, token)
+}
+
+// IsWorkloadToken mocks base method.
+func (m *MockServiceAccountService) IsWorkloadToken(token string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkloadToken", token)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkloadToken indicates an expected call of IsWorkloadToken.
+func (mr *MockServiceAccountServiceMockRecorder) IsWorkloadToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkloadToken", reflect.TypeOf((*MockServiceAccountService)(nil).IsWorkloadToken), token)
+}
+
 // List mocks base method.
 func (m *MockServiceAccountService) List(arg0 context.Context, arg1 domain.ListServiceAccountsParams) (domain.ServiceAccounts, error) {
 	m.ctrl.T.Helper()
@@ -253,6 +372,61 @@ func (mr *MockServiceAccountServiceMockRecorder) List(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServiceAccountService)(nil).List), arg0, arg1)
 }
 
+// ListAPIKeys mocks base method.
+func (m *MockServiceAccountService) ListAPIKeys(ctx context.Context, serviceAccountID string) ([]domain.ServiceAccountAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, serviceAccountID)
+	ret0, _ := ret[0].([]domain.ServiceAccountAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockServiceAccountServiceMockRecorder) ListAPIKeys(ctx, serviceAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockServiceAccountService)(nil).ListAPIKeys), ctx, serviceAccountID)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockServiceAccountService) RevokeAPIKey(ctx context.Context, serviceAccountID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, serviceAccountID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockServiceAccountServiceMockRecorder) RevokeAPIKey(ctx, serviceAccountID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockServiceAccountService)(nil).RevokeAPIKey), ctx, serviceAccountID, id)
+}
+
+// TrackAPIKeyUsage mocks base method.
+func (m *MockServiceAccountService) TrackAPIKeyUsage(ctx context.Context, serviceAccountID, key, remoteIP string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackAPIKeyUsage", ctx, serviceAccountID, key, remoteIP)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrackAPIKeyUsage indicates an expected call of TrackAPIKeyUsage.
+func (mr *MockServiceAccountServiceMockRecorder) TrackAPIKeyUsage(ctx, serviceAccountID, key, remoteIP any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackAPIKeyUsage", reflect.TypeOf((*MockServiceAccountService)(nil).TrackAPIKeyUsage), ctx, serviceAccountID, key, remoteIP)
+}
+
+// TrackUsage mocks base method.
+func (m *MockServiceAccountService) TrackUsage(ctx context.Context, serviceAccountID, remoteIP string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackUsage", ctx, serviceAccountID, remoteIP)
+}
+
+// TrackUsage indicates an expected call of TrackUsage.
+func (mr *MockServiceAccountServiceMockRecorder) TrackUsage(ctx, serviceAccountID, remoteIP any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackUsage", reflect.TypeOf((*MockServiceAccountService)(nil).TrackUsage), ctx, serviceAccountID, remoteIP)
+}
+
 // Update mocks base method.
 func (m *MockServiceAccountService) Update(arg0 context.Context, arg1 domain.UpdateServiceAccountRequest) (domain.ServiceAccount, error) {
 	m.ctrl.T.Helper()
@@ -292,6 +466,21 @@ func (m *MockProjectService) EXPECT() *MockProjectServiceMockRecorder {
 	return m.recorder
 }
 
+// AddMember mocks base method.
+func (m *MockProjectService) AddMember(arg0 context.Context, arg1 domain.AddProjectMemberRequest) (domain.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", arg0, arg1)
+	ret0, _ := ret[0].(domain.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockProjectServiceMockRecorder) AddMember(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockProjectService)(nil).AddMember), arg0, arg1)
+}
+
 // Create mocks base method.
 func (m *MockProjectService) Create(arg0 context.Context, arg1 domain.CreateProjectRequest) (domain.Project, error) {
 	m.ctrl.T.Helper()
@@ -308,11 +497,12 @@ func (mr *MockProjectServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockProjectService) Delete(ctx context.Context, id string) error {
+func (m *MockProjectService) Delete(ctx context.Context, id string) (domain.ProjectDeletion, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(domain.ProjectDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
@@ -336,6 +526,36 @@ func (mr *MockProjectServiceMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProjectService)(nil).GetByID), ctx, id)
 }
 
+// GetDeletion mocks base method.
+func (m *MockProjectService) GetDeletion(ctx context.Context, projectID string) (domain.ProjectDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletion", ctx, projectID)
+	ret0, _ := ret[0].(domain.ProjectDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletion indicates an expected call of GetDeletion.
+func (mr *MockProjectServiceMockRecorder) GetDeletion(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletion", reflect.TypeOf((*MockProjectService)(nil).GetDeletion), ctx, projectID)
+}
+
+// GetMember mocks base method.
+func (m *MockProjectService) GetMember(ctx context.Context, projectID, userID string) (domain.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, projectID, userID)
+	ret0, _ := ret[0].(domain.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockProjectServiceMockRecorder) GetMember(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockProjectService)(nil).GetMember), ctx, projectID, userID)
+}
+
 // List mocks base method.
 func (m *MockProjectService) List(arg0 context.Context, arg1 domain.ListProjectsParams) (domain.Projects, error) {
 	m.ctrl.T.Helper()
@@ -351,6 +571,50 @@ func (mr *MockProjectServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectService)(nil).List), arg0, arg1)
 }
 
+// ListMembers mocks base method.
+func (m *MockProjectService) ListMembers(ctx context.Context, projectID string) ([]domain.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, projectID)
+	ret0, _ := ret[0].([]domain.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockProjectServiceMockRecorder) ListMembers(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockProjectService)(nil).ListMembers), ctx, projectID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockProjectService) RemoveMember(ctx context.Context, projectID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, projectID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockProjectServiceMockRecorder) RemoveMember(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockProjectService)(nil).RemoveMember), ctx, projectID, userID)
+}
+
+// Restore mocks base method.
+func (m *MockProjectService) Restore(ctx context.Context, id string) (domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockProjectServiceMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockProjectService)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockProjectService) Update(arg0 context.Context, arg1 domain.UpdateProjectRequest) (domain.Project, error) {
 	m.ctrl.T.Helper()
@@ -366,6 +630,21 @@ func (mr *MockProjectServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectService)(nil).Update), arg0, arg1)
 }
 
+// UpdateMember mocks base method.
+func (m *MockProjectService) UpdateMember(arg0 context.Context, arg1 domain.UpdateProjectMemberRequest) (domain.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMember", arg0, arg1)
+	ret0, _ := ret[0].(domain.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMember indicates an expected call of UpdateMember.
+func (mr *MockProjectServiceMockRecorder) UpdateMember(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockProjectService)(nil).UpdateMember), arg0, arg1)
+}
+
 // MockImageService is a mock of ImageService interface.
 type MockImageService struct {
 	ctrl     *gomock.Controller
@@ -390,6 +669,21 @@ func (m *MockImageService) EXPECT() *MockImageServiceMockRecorder {
 	return m.recorder
 }
 
+// CompleteUpload mocks base method.
+func (m *MockImageService) CompleteUpload(ctx context.Context, imageID string) (domain.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", ctx, imageID)
+	ret0, _ := ret[0].(domain.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockImageServiceMockRecorder) CompleteUpload(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockImageService)(nil).CompleteUpload), ctx, imageID)
+}
+
 // CreateUploadURL mocks base method.
 func (m *MockImageService) CreateUploadURL(arg0 context.Context, arg1 domain.CreateUploadURLRequest) (domain.UploadURL, error) {
 	m.ctrl.T.Helper()
@@ -433,6 +727,21 @@ func (mr *MockImageServiceMockRecorder) DeleteS3Objects(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteS3Objects", reflect.TypeOf((*MockImageService)(nil).DeleteS3Objects), arg0, arg1)
 }
 
+// Deliver mocks base method.
+func (m *MockImageService) Deliver(arg0 context.Context, arg1 domain.DeliverImageRequest) (domain.ImageDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", arg0, arg1)
+	ret0, _ := ret[0].(domain.ImageDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockImageServiceMockRecorder) Deliver(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockImageService)(nil).Deliver), arg0, arg1)
+}
+
 // Get mocks base method.
 func (m *MockImageService) Get(ctx context.Context, imageID string) (domain.Image, error) {
 	m.ctrl.T.Helper()
@@ -463,6 +772,21 @@ func (mr *MockImageServiceMockRecorder) GetWaitUntilProcessed(ctx, imageID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitUntilProcessed", reflect.TypeOf((*MockImageService)(nil).GetWaitUntilProcessed), ctx, imageID)
 }
 
+// IsPublicObject mocks base method.
+func (m *MockImageService) IsPublicObject(ctx context.Context, s3Key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPublicObject", ctx, s3Key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPublicObject indicates an expected call of IsPublicObject.
+func (mr *MockImageServiceMockRecorder) IsPublicObject(ctx, s3Key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPublicObject", reflect.TypeOf((*MockImageService)(nil).IsPublicObject), ctx, s3Key)
+}
+
 // List mocks base method.
 func (m *MockImageService) List(arg0 context.Context, arg1 domain.ListImagesParams) (domain.Images, error) {
 	m.ctrl.T.Helper()
@@ -492,6 +816,21 @@ func (mr *MockImageServiceMockRecorder) ReceiveImageProcessResult(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveImageProcessResult", reflect.TypeOf((*MockImageService)(nil).ReceiveImageProcessResult), arg0, arg1)
 }
 
+// Restore mocks base method.
+func (m *MockImageService) Restore(ctx context.Context, id string) (domain.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(domain.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockImageServiceMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockImageService)(nil).Restore), ctx, id)
+}
+
 // StartImageProcessingOnUpload mocks base method.
 func (m *MockImageService) StartImageProcessingOnUpload(ctx context.Context, s3Key string) error {
 	m.ctrl.T.Helper()
@@ -505,3 +844,208 @@ func (mr *MockImageServiceMockRecorder) StartImageProcessingOnUpload(ctx, s3Key
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImageProcessingOnUpload", reflect.TypeOf((*MockImageService)(nil).StartImageProcessingOnUpload), ctx, s3Key)
 }
+
+// UpdateDetails mocks base method.
+func (m *MockImageService) UpdateDetails(arg0 context.Context, arg1 domain.UpdateImageDetailsRequest) (domain.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDetails", arg0, arg1)
+	ret0, _ := ret[0].(domain.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDetails indicates an expected call of UpdateDetails.
+func (mr *MockImageServiceMockRecorder) UpdateDetails(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDetails", reflect.TypeOf((*MockImageService)(nil).UpdateDetails), arg0, arg1)
+}
+
+// UpdateFocus mocks base method.
+func (m *MockImageService) UpdateFocus(arg0 context.Context, arg1 domain.UpdateImageFocusRequest) (domain.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFocus", arg0, arg1)
+	ret0, _ := ret[0].(domain.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFocus indicates an expected call of UpdateFocus.
+func (mr *MockImageServiceMockRecorder) UpdateFocus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFocus", reflect.TypeOf((*MockImageService)(nil).UpdateFocus), arg0, arg1)
+}
+
+// MockWatermarkService is a mock of WatermarkService interface.
+type MockWatermarkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatermarkServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWatermarkServiceMockRecorder is the mock recorder for MockWatermarkService.
+type MockWatermarkServiceMockRecorder struct {
+	mock *MockWatermarkService
+}
+
+// NewMockWatermarkService creates a new mock instance.
+func NewMockWatermarkService(ctrl *gomock.Controller) *MockWatermarkService {
+	mock := &MockWatermarkService{ctrl: ctrl}
+	mock.recorder = &MockWatermarkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatermarkService) EXPECT() *MockWatermarkServiceMockRecorder {
+	return m.recorder
+}
+
+// CompleteUpload mocks base method.
+func (m *MockWatermarkService) CompleteUpload(ctx context.Context, id string) (domain.Watermark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", ctx, id)
+	ret0, _ := ret[0].(domain.Watermark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockWatermarkServiceMockRecorder) CompleteUpload(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockWatermarkService)(nil).CompleteUpload), ctx, id)
+}
+
+// CreateUploadURL mocks base method.
+func (m *MockWatermarkService) CreateUploadURL(arg0 context.Context, arg1 domain.CreateWatermarkUploadURLRequest) (domain.WatermarkUploadURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUploadURL", arg0, arg1)
+	ret0, _ := ret[0].(domain.WatermarkUploadURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUploadURL indicates an expected call of CreateUploadURL.
+func (mr *MockWatermarkServiceMockRecorder) CreateUploadURL(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUploadURL", reflect.TypeOf((*MockWatermarkService)(nil).CreateUploadURL), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockWatermarkService) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWatermarkServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWatermarkService)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockWatermarkService) Get(ctx context.Context, id string) (domain.Watermark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.Watermark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWatermarkServiceMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWatermarkService)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockWatermarkService) List(arg0 context.Context, arg1 domain.ListWatermarksParams) (domain.Watermarks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(domain.Watermarks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWatermarkServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWatermarkService)(nil).List), arg0, arg1)
+}
+
+// MockAuditService is a mock of AuditService interface.
+type MockAuditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditServiceMockRecorder is the mock recorder for MockAuditService.
+type MockAuditServiceMockRecorder struct {
+	mock *MockAuditService
+}
+
+// NewMockAuditService creates a new mock instance.
+func NewMockAuditService(ctrl *gomock.Controller) *MockAuditService {
+	mock := &MockAuditService{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditService) EXPECT() *MockAuditServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockAuditService) List(arg0 context.Context, arg1 domain.ListAuditEntriesParams) (domain.AuditEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(domain.AuditEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditService)(nil).List), arg0, arg1)
+}
+
+// MockAuditRecorder is a mock of AuditRecorder interface.
+type MockAuditRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditRecorderMockRecorder is the mock recorder for MockAuditRecorder.
+type MockAuditRecorderMockRecorder struct {
+	mock *MockAuditRecorder
+}
+
+// NewMockAuditRecorder creates a new mock instance.
+func NewMockAuditRecorder(ctrl *gomock.Controller) *MockAuditRecorder {
+	mock := &MockAuditRecorder{ctrl: ctrl}
+	mock.recorder = &MockAuditRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRecorder) EXPECT() *MockAuditRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockAuditRecorder) Record(arg0 context.Context, arg1 domain.RecordAuditRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditRecorderMockRecorder) Record(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditRecorder)(nil).Record), arg0, arg1)
+}
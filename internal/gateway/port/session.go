@@ -0,0 +1,20 @@
+package port
+
+import (
+	"context"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/users"
+)
+
+//go:generate sh -c "go tool mockgen -package $GOPACKAGE -source=$GOFILE -destination=$(basename $GOFILE .go)_mock.go"
+
+type SessionStore interface {
+	// Save creates the session, or extends it if it exists.
+	Save(context.Context, domain.Session) error
+	Find(ctx context.Context, id string) (domain.Session, error)
+	Delete(ctx context.Context, userID, id string) error
+	DeleteAllOfUser(ctx context.Context, userID string) (count int64, err error)
+	// UpdateRoleOfUser changes the role kept in all sessions of the user.
+	UpdateRoleOfUser(ctx context.Context, userID string, role users.Role) error
+}
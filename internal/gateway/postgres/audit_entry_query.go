@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+)
+
+func applyAuditEntrySearchFilter(
+	q gorm.ChainInterface[entity.AuditEntry], filter domain.AuditEntrySearchFilter,
+) gorm.ChainInterface[entity.AuditEntry] {
+	if filter.ActorID != nil {
+		q = q.Where(gen.AuditEntry.ActorID.Eq(*filter.ActorID))
+	}
+	if filter.Action != nil {
+		q = q.Where(gen.AuditEntry.Action.Eq(*filter.Action))
+	}
+	if filter.TargetType != nil {
+		q = q.Where(gen.AuditEntry.TargetType.Eq(*filter.TargetType))
+	}
+	if filter.TargetID != nil {
+		q = q.Where(gen.AuditEntry.TargetID.Eq(*filter.TargetID))
+	}
+	if filter.CreatedAtAfter != nil {
+		q = q.Where(gen.AuditEntry.CreatedAt.Gte(*filter.CreatedAtAfter))
+	}
+	if filter.CreatedAtBefore != nil {
+		q = q.Where(gen.AuditEntry.CreatedAt.Lt(*filter.CreatedAtBefore))
+	}
+	return q
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+type AuditEntryRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditEntryRepository(client *Client) *AuditEntryRepository {
+	return &AuditEntryRepository{
+		db: client.db,
+	}
+}
+
+// List returns audit entries from the most recent.
+func (r *AuditEntryRepository) List(ctx context.Context, params domain.ListAuditEntriesParams,
+) (domain.AuditEntries, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.AuditEntryRepository.List",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	// Fetch audit entries
+	q := gorm.G[entity.AuditEntry](tx).Scopes()
+	q = applyAuditEntrySearchFilter(q, params.SearchFilter)
+	q = applyPagination(q, params.LimitOrDefault(), params.OffsetOrDefault())
+	entries, err := q.
+		Order(gen.AuditEntry.CreatedAt.Desc()).
+		Order(gen.AuditEntry.ID.Desc()).
+		Find(ctx)
+	if err != nil {
+		return domain.AuditEntries{}, dbhelpers.WrapGORMError(err, "Failed to list audit entries")
+	}
+
+	// Fetch total count
+	q = gorm.G[entity.AuditEntry](tx).Scopes()
+	q = applyAuditEntrySearchFilter(q, params.SearchFilter)
+	count, err := q.Count(ctx, "COUNT(1)")
+	if err != nil {
+		return domain.AuditEntries{}, dbhelpers.WrapGORMError(err, "Failed to count audit entries")
+	}
+
+	return domain.AuditEntries{
+		Items: lo.Map(entries, func(e entity.AuditEntry, _ int) domain.AuditEntry {
+			return e.ToDomain()
+		}),
+		Total: count,
+	}, nil
+}
+
+func (r *AuditEntryRepository) Create(ctx context.Context, entry domain.AuditEntry,
+) (domain.AuditEntry, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.AuditEntryRepository.Create",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	e := entity.NewAuditEntry(entry)
+	if err := gorm.G[entity.AuditEntry](tx).Create(ctx, &e); err != nil {
+		return domain.AuditEntry{}, dbhelpers.WrapGORMError(err, "Failed to create audit entry")
+	}
+
+	return e.ToDomain(), nil
+}
+
+func (r *AuditEntryRepository) DeleteCreatedBefore(ctx context.Context, t time.Time,
+) (int64, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.AuditEntryRepository.DeleteCreatedBefore",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	count, err := gorm.G[entity.AuditEntry](tx).
+		Where(gen.AuditEntry.CreatedAt.Lt(t)).
+		Delete(ctx)
+	if err != nil {
+		return 0, dbhelpers.WrapGORMError(err, "Failed to delete audit entries")
+	}
+	return int64(count), nil
+}
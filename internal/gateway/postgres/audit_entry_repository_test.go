@@ -0,0 +1,73 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/pkg/audits"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+)
+
+func TestAuditEntryRepository_List(t *testing.T) {
+	postgresClient, _, mock := postgres.NewClientWithMock(t)
+	auditEntryRepo := postgres.NewAuditEntryRepository(postgresClient)
+
+	mock.ExpectQuery(
+		`SELECT * FROM "audit_entries" WHERE "target_type" = $1 AND "target_id" = $2 `+
+			`ORDER BY "created_at" DESC,"id" DESC LIMIT $3`).
+		WithArgs(audits.TargetTypeProject, "project-1", 20).
+		WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.AuditEntry]()).
+			AddRow("entry-1", time.Now(), audits.ActorTypeUser, "user-1", "john@example.com",
+				audits.ActionProjectUpdate, audits.TargetTypeProject, "project-1", "request-1",
+				"203.0.113.7", `[{"field":"Name","before":"old-name","after":"new-name"}]`))
+	mock.ExpectQuery(
+		`SELECT COUNT(1) FROM "audit_entries" WHERE "target_type" = $1 AND "target_id" = $2`).
+		WithArgs(audits.TargetTypeProject, "project-1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	entries, err := auditEntryRepo.List(t.Context(), domain.ListAuditEntriesParams{
+		SearchFilter: domain.AuditEntrySearchFilter{
+			TargetType: new(audits.TargetTypeProject),
+			TargetID:   new("project-1"),
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, entries.Items, 1)
+	assert.Equal(t, int64(1), entries.Total)
+	assert.Equal(t, []domain.AuditChange{{
+		Field:  "Name",
+		Before: []byte(`"old-name"`),
+		After:  []byte(`"new-name"`),
+	}}, entries.Items[0].Changes)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestAuditEntryRepository_DeleteCreatedBefore(t *testing.T) {
+	postgresClient, _, mock := postgres.NewClientWithMock(t)
+	auditEntryRepo := postgres.NewAuditEntryRepository(postgresClient)
+
+	threshold := time.Now().Add(-time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "audit_entries" WHERE "created_at" < $1`).
+		WithArgs(threshold).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	count, err := auditEntryRepo.DeleteCreatedBefore(t.Context(), threshold)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
 	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/serviceaccounts"
 )
 
 type Client struct {
@@ -34,15 +35,30 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 }
 
 func (c *Client) MigrateSchemas(ctx context.Context) error {
+	// Variants are unique per descriptor since presets can declare variant sets
+	if c.db.Migrator().HasIndex(&entity.ImageVariant{}, "idx_image_id_preset_id") {
+		if err := c.db.Migrator().DropIndex(&entity.ImageVariant{}, "idx_image_id_preset_id"); err != nil {
+			return apperr.NewError(apperr.CodeInternalServerError).
+				WithSummary("Failed to drop legacy index of image variants").
+				WithCause(err)
+		}
+	}
+
 	if err := c.db.AutoMigrate(
 		&entity.User{},
 		&entity.Project{},
+		&entity.Watermark{},
 		&entity.Preset{},
 		&entity.ServiceAccount{},
 		&entity.ServiceAccountProject{},
+		&entity.ServiceAccountAPIKey{},
+		&entity.ProjectMember{},
 		&entity.Image{},
 		&entity.ImageVariant{},
 		&entity.ImageProcessingLog{},
+		&entity.ImageObjectKey{},
+		&entity.ProjectDeletion{},
+		&entity.AuditEntry{},
 	); err != nil {
 		return apperr.NewError(apperr.CodeInternalServerError).
 			WithSummary("Failed to migrate database schemas").
@@ -56,6 +72,49 @@ func (c *Client) MigrateSchemas(ctx context.Context) error {
 			WithCause(err)
 	}
 
+	// Images created before the object key lookup table existed are resolved
+	// by the keys they were stored with
+	if err := c.db.WithContext(ctx).Exec(
+		`INSERT INTO image_object_keys (s3_key, created_at, image_id) ` +
+			`SELECT s3_key, created_at, id FROM images WHERE s3_key <> '' ` +
+			`ON CONFLICT DO NOTHING`).Error; err != nil {
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Failed to backfill image object keys").
+			WithCause(err)
+	}
+
+	// Service accounts created before permissions existed keep being allowed
+	// every operation in their access scope
+	if err := c.db.WithContext(ctx).
+		Model(&entity.ServiceAccount{}).
+		Where("permissions IS NULL").
+		UpdateColumn("permissions", entity.ServiceAccountPermissions(
+			serviceaccounts.AllPermissions)).Error; err != nil {
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Failed to backfill permissions of service accounts").
+			WithCause(err)
+	}
+
+	// API keys of service accounts created before accounts had multiple keys
+	// are kept as their default keys
+	if c.db.Migrator().HasColumn(&entity.ServiceAccount{}, "api_key_hash") {
+		if err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(
+				`INSERT INTO service_account_api_keys ` +
+					`(id, created_at, updated_at, name, prefix, hash, service_account_id) ` +
+					`SELECT gen_random_uuid(), created_at, NOW(), 'default', 'ak_', api_key_hash, id ` +
+					`FROM service_accounts WHERE api_key_hash <> '' ` +
+					`ON CONFLICT DO NOTHING`).Error; err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&entity.ServiceAccount{}, "api_key_hash")
+		}); err != nil {
+			return apperr.NewError(apperr.CodeInternalServerError).
+				WithSummary("Failed to move API keys of service accounts").
+				WithCause(err)
+		}
+	}
+
 	return nil
 }
 
@@ -1,9 +1,59 @@
 package postgres
 
-import "gorm.io/gorm"
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
 
 func applyPagination[T any](q gorm.ChainInterface[T], limit, offset int) gorm.ChainInterface[T] {
 	q = q.Offset(offset)
 	q = q.Limit(limit)
 	return q
 }
+
+// unscoped lets queries include soft-deleted rows. It must be applied as a
+// scope, as generic queries start new sessions dropping db.Unscoped().
+func unscoped(stmt *gorm.Statement) {
+	stmt.Unscoped = true
+}
+
+// nullableSetter is a column of gorm-cli generated fields.
+type nullableSetter[T any] interface {
+	Set(T) clause.Assignment
+	SetExpr(clause.Expression) clause.Assignment
+}
+
+// setNullable assigns v to the column, or NULL if v is nil.
+func setNullable[T any](f nullableSetter[T], v *T) clause.Assignment {
+	if v == nil {
+		return f.SetExpr(gorm.Expr("NULL"))
+	}
+	return f.Set(*v)
+}
+
+// jsonbValue encodes v to be bound to a jsonb column.
+func jsonbValue(v any) string {
+	return string(lo.Must(json.Marshal(v)))
+}
+
+// jsonbContains matches rows whose jsonb column contains v, which is served by
+// GIN indexes.
+func jsonbContains(column clause.Column, v any) clause.Expression {
+	return clause.Expr{SQL: "? @> ?", Vars: []any{column, jsonbValue(v)}}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// likeContains returns a LIKE pattern matching strings containing substr.
+func likeContains(substr string) string {
+	return "%" + likeEscaper.Replace(substr) + "%"
+}
+
+// likePrefix returns a LIKE pattern matching strings starting with prefix.
+func likePrefix(prefix string) string {
+	return likeEscaper.Replace(prefix) + "%"
+}
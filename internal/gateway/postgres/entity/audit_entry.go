@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/audits"
+)
+
+// AuditEntry outlives its actor and target, so that it has no foreign keys.
+type AuditEntry struct {
+	ID         string            `gorm:"size:36"`
+	CreatedAt  time.Time         `gorm:"index"`
+	ActorType  audits.ActorType  `gorm:"size:32"`
+	ActorID    string            `gorm:"size:36; index"`
+	ActorName  string            `gorm:"size:256"`
+	Action     audits.Action     `gorm:"size:64; index"`
+	TargetType audits.TargetType `gorm:"size:32; index:idx_audit_entries_target"`
+	TargetID   string            `gorm:"size:256; index:idx_audit_entries_target"`
+	RequestID  string            `gorm:"size:64"`
+	RemoteIP   string            `gorm:"size:64"`
+	Changes    []AuditChange     `gorm:"type:jsonb; serializer:json"`
+}
+
+type AuditChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+func NewAuditEntry(e domain.AuditEntry) AuditEntry {
+	return AuditEntry{
+		ActorType:  e.ActorType,
+		ActorID:    e.ActorID,
+		ActorName:  e.ActorName,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		RequestID:  e.RequestID,
+		RemoteIP:   e.RemoteIP,
+		Changes: lo.Map(e.Changes, func(c domain.AuditChange, _ int) AuditChange {
+			return AuditChange{
+				Field:  c.Field,
+				Before: c.Before,
+				After:  c.After,
+			}
+		}),
+	}
+}
+
+func (e *AuditEntry) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == "" {
+		e.ID = uuid.NewString()
+	}
+	return nil
+}
+
+func (e AuditEntry) ToDomain() domain.AuditEntry {
+	return domain.AuditEntry{
+		ID:         e.ID,
+		CreatedAt:  e.CreatedAt,
+		ActorType:  e.ActorType,
+		ActorID:    e.ActorID,
+		ActorName:  e.ActorName,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		RequestID:  e.RequestID,
+		RemoteIP:   e.RemoteIP,
+		Changes: lo.Map(e.Changes, func(c AuditChange, _ int) domain.AuditChange {
+			return domain.AuditChange{
+				Field:  c.Field,
+				Before: c.Before,
+				After:  c.After,
+			}
+		}),
+	}
+}
@@ -6,30 +6,53 @@ import (
 	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
 	"github.com/isutare412/imageer/pkg/images"
 	"gorm.io/cli/gorm/field"
+	"gorm.io/gorm"
 )
 
 var Image = struct {
-	ID        field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	FileName  field.String
-	Format    field.Field[images.Format]
-	State     field.Field[images.State]
-	S3Key     field.String
-	URL       field.String
-	ProjectID field.String
-	Project   field.Struct[entity.Project]
-	Variants  field.Slice[entity.ImageVariant]
+	ID            field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+	DeletedAt     field.Field[gorm.DeletedAt]
+	FileName      field.String
+	Format        field.Field[images.Format]
+	State         field.Field[images.State]
+	S3Key         field.String
+	URL           field.String
+	OriginalState field.Field[images.OriginalState]
+	ExternalID    field.String
+	Tags          field.Slice[string]
+	Metadata      field.Field[any]
+	FocalX        field.Number[float64]
+	FocalY        field.Number[float64]
+	CropX         field.Number[float64]
+	CropY         field.Number[float64]
+	CropWidth     field.Number[float64]
+	CropHeight    field.Number[float64]
+	ProjectID     field.String
+	Project       field.Struct[entity.Project]
+	Variants      field.Slice[entity.ImageVariant]
 }{
-	ID:        field.String{}.WithColumn("id"),
-	CreatedAt: field.Time{}.WithColumn("created_at"),
-	UpdatedAt: field.Time{}.WithColumn("updated_at"),
-	FileName:  field.String{}.WithColumn("file_name"),
-	Format:    field.Field[images.Format]{}.WithColumn("format"),
-	State:     field.Field[images.State]{}.WithColumn("state"),
-	S3Key:     field.String{}.WithColumn("s3_key"),
-	URL:       field.String{}.WithColumn("url"),
-	ProjectID: field.String{}.WithColumn("project_id"),
-	Project:   field.Struct[entity.Project]{}.WithName("Project"),
-	Variants:  field.Slice[entity.ImageVariant]{}.WithName("Variants"),
+	ID:            field.String{}.WithColumn("id"),
+	CreatedAt:     field.Time{}.WithColumn("created_at"),
+	UpdatedAt:     field.Time{}.WithColumn("updated_at"),
+	DeletedAt:     field.Field[gorm.DeletedAt]{}.WithColumn("deleted_at"),
+	FileName:      field.String{}.WithColumn("file_name"),
+	Format:        field.Field[images.Format]{}.WithColumn("format"),
+	State:         field.Field[images.State]{}.WithColumn("state"),
+	S3Key:         field.String{}.WithColumn("s3_key"),
+	URL:           field.String{}.WithColumn("url"),
+	OriginalState: field.Field[images.OriginalState]{}.WithColumn("original_state"),
+	ExternalID:    field.String{}.WithColumn("external_id"),
+	Tags:          field.Slice[string]{}.WithName("Tags"),
+	Metadata:      field.Field[any]{}.WithColumn("metadata"),
+	FocalX:        field.Number[float64]{}.WithColumn("focal_x"),
+	FocalY:        field.Number[float64]{}.WithColumn("focal_y"),
+	CropX:         field.Number[float64]{}.WithColumn("crop_x"),
+	CropY:         field.Number[float64]{}.WithColumn("crop_y"),
+	CropWidth:     field.Number[float64]{}.WithColumn("crop_width"),
+	CropHeight:    field.Number[float64]{}.WithColumn("crop_height"),
+	ProjectID:     field.String{}.WithColumn("project_id"),
+	Project:       field.Struct[entity.Project]{}.WithName("Project"),
+	Variants:      field.Slice[entity.ImageVariant]{}.WithName("Variants"),
 }
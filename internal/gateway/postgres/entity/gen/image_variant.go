@@ -9,25 +9,27 @@ import (
 )
 
 var ImageVariant = struct {
-	ID        field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	Format    field.Field[images.Format]
-	State     field.Field[images.VariantState]
-	S3Key     field.String
-	URL       field.String
-	ImageID   field.String
-	PresetID  field.String
-	Preset    field.Struct[entity.Preset]
+	ID         field.String
+	CreatedAt  field.Time
+	UpdatedAt  field.Time
+	Format     field.Field[images.Format]
+	State      field.Field[images.VariantState]
+	S3Key      field.String
+	URL        field.String
+	Descriptor field.Field[images.Descriptor]
+	ImageID    field.String
+	PresetID   field.String
+	Preset     field.Struct[entity.Preset]
 }{
-	ID:        field.String{}.WithColumn("id"),
-	CreatedAt: field.Time{}.WithColumn("created_at"),
-	UpdatedAt: field.Time{}.WithColumn("updated_at"),
-	Format:    field.Field[images.Format]{}.WithColumn("format"),
-	State:     field.Field[images.VariantState]{}.WithColumn("state"),
-	S3Key:     field.String{}.WithColumn("s3_key"),
-	URL:       field.String{}.WithColumn("url"),
-	ImageID:   field.String{}.WithColumn("image_id"),
-	PresetID:  field.String{}.WithColumn("preset_id"),
-	Preset:    field.Struct[entity.Preset]{}.WithName("Preset"),
+	ID:         field.String{}.WithColumn("id"),
+	CreatedAt:  field.Time{}.WithColumn("created_at"),
+	UpdatedAt:  field.Time{}.WithColumn("updated_at"),
+	Format:     field.Field[images.Format]{}.WithColumn("format"),
+	State:      field.Field[images.VariantState]{}.WithColumn("state"),
+	S3Key:      field.String{}.WithColumn("s3_key"),
+	URL:        field.String{}.WithColumn("url"),
+	Descriptor: field.Field[images.Descriptor]{}.WithColumn("descriptor"),
+	ImageID:    field.String{}.WithColumn("image_id"),
+	PresetID:   field.String{}.WithColumn("preset_id"),
+	Preset:     field.Struct[entity.Preset]{}.WithName("Preset"),
 }
@@ -3,34 +3,59 @@
 package gen
 
 import (
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
 	"github.com/isutare412/imageer/pkg/images"
 	"gorm.io/cli/gorm/field"
 )
 
 var Preset = struct {
-	ID        field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	Name      field.String
-	Default   field.Bool
-	Format    field.Field[images.Format]
-	Quality   field.Field[images.Quality]
-	Fit       field.Field[images.Fit]
-	Anchor    field.Field[images.Anchor]
-	Width     field.Number[int64]
-	Height    field.Number[int64]
-	ProjectID field.String
+	ID                       field.String
+	CreatedAt                field.Time
+	UpdatedAt                field.Time
+	Name                     field.String
+	Default                  field.Bool
+	Format                   field.Field[images.Format]
+	Quality                  field.Field[images.Quality]
+	Fit                      field.Field[images.Fit]
+	Anchor                   field.Field[images.Anchor]
+	Width                    field.Number[int64]
+	Height                   field.Number[int64]
+	WithoutEnlargement       field.Bool
+	Densities                field.Struct[entity.JSONSlice[float64]]
+	Widths                   field.Struct[entity.JSONSlice[int64]]
+	EncoderEffort            field.Number[int64]
+	EncoderLossless          field.Bool
+	EncoderChromaSubsampling field.Field[images.ChromaSubsampling]
+	WatermarkID              field.String
+	Watermark                field.Struct[entity.Watermark]
+	WatermarkAnchor          field.Field[images.Anchor]
+	WatermarkOpacity         field.Number[float64]
+	WatermarkMargin          field.Number[int64]
+	WatermarkScale           field.Number[float64]
+	ProjectID                field.String
 }{
-	ID:        field.String{}.WithColumn("id"),
-	CreatedAt: field.Time{}.WithColumn("created_at"),
-	UpdatedAt: field.Time{}.WithColumn("updated_at"),
-	Name:      field.String{}.WithColumn("name"),
-	Default:   field.Bool{}.WithColumn("default"),
-	Format:    field.Field[images.Format]{}.WithColumn("format"),
-	Quality:   field.Field[images.Quality]{}.WithColumn("quality"),
-	Fit:       field.Field[images.Fit]{}.WithColumn("fit"),
-	Anchor:    field.Field[images.Anchor]{}.WithColumn("anchor"),
-	Width:     field.Number[int64]{}.WithColumn("width"),
-	Height:    field.Number[int64]{}.WithColumn("height"),
-	ProjectID: field.String{}.WithColumn("project_id"),
+	ID:                       field.String{}.WithColumn("id"),
+	CreatedAt:                field.Time{}.WithColumn("created_at"),
+	UpdatedAt:                field.Time{}.WithColumn("updated_at"),
+	Name:                     field.String{}.WithColumn("name"),
+	Default:                  field.Bool{}.WithColumn("default"),
+	Format:                   field.Field[images.Format]{}.WithColumn("format"),
+	Quality:                  field.Field[images.Quality]{}.WithColumn("quality"),
+	Fit:                      field.Field[images.Fit]{}.WithColumn("fit"),
+	Anchor:                   field.Field[images.Anchor]{}.WithColumn("anchor"),
+	Width:                    field.Number[int64]{}.WithColumn("width"),
+	Height:                   field.Number[int64]{}.WithColumn("height"),
+	WithoutEnlargement:       field.Bool{}.WithColumn("without_enlargement"),
+	Densities:                field.Struct[entity.JSONSlice[float64]]{}.WithName("Densities"),
+	Widths:                   field.Struct[entity.JSONSlice[int64]]{}.WithName("Widths"),
+	EncoderEffort:            field.Number[int64]{}.WithColumn("encoder_effort"),
+	EncoderLossless:          field.Bool{}.WithColumn("encoder_lossless"),
+	EncoderChromaSubsampling: field.Field[images.ChromaSubsampling]{}.WithColumn("encoder_chroma_subsampling"),
+	WatermarkID:              field.String{}.WithColumn("watermark_id"),
+	Watermark:                field.Struct[entity.Watermark]{}.WithName("Watermark"),
+	WatermarkAnchor:          field.Field[images.Anchor]{}.WithColumn("watermark_anchor"),
+	WatermarkOpacity:         field.Number[float64]{}.WithColumn("watermark_opacity"),
+	WatermarkMargin:          field.Number[int64]{}.WithColumn("watermark_margin"),
+	WatermarkScale:           field.Number[float64]{}.WithColumn("watermark_scale"),
+	ProjectID:                field.String{}.WithColumn("project_id"),
 }
@@ -4,19 +4,37 @@ package gen
 
 import (
 	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/pkg/projects"
 	"gorm.io/cli/gorm/field"
+	"gorm.io/gorm"
 )
 
 var Project = struct {
-	ID        field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	Name      field.String
-	Presets   field.Slice[entity.Preset]
+	ID                    field.String
+	CreatedAt             field.Time
+	UpdatedAt             field.Time
+	DeletedAt             field.Field[gorm.DeletedAt]
+	Name                  field.String
+	Visibility            field.Field[projects.Visibility]
+	KeyTemplate           field.Struct[projects.KeyTemplate]
+	CDNBaseURL            field.String
+	StorageProfile        field.String
+	OriginalRetention     field.Field[projects.OriginalRetention]
+	OriginalRetentionDays field.Number[int]
+	ImageExpireDays       field.Number[int]
+	Presets               field.Slice[entity.Preset]
 }{
-	ID:        field.String{}.WithColumn("id"),
-	CreatedAt: field.Time{}.WithColumn("created_at"),
-	UpdatedAt: field.Time{}.WithColumn("updated_at"),
-	Name:      field.String{}.WithColumn("name"),
-	Presets:   field.Slice[entity.Preset]{}.WithName("Presets"),
+	ID:                    field.String{}.WithColumn("id"),
+	CreatedAt:             field.Time{}.WithColumn("created_at"),
+	UpdatedAt:             field.Time{}.WithColumn("updated_at"),
+	DeletedAt:             field.Field[gorm.DeletedAt]{}.WithColumn("deleted_at"),
+	Name:                  field.String{}.WithColumn("name"),
+	Visibility:            field.Field[projects.Visibility]{}.WithColumn("visibility"),
+	KeyTemplate:           field.Struct[projects.KeyTemplate]{}.WithName("KeyTemplate"),
+	CDNBaseURL:            field.String{}.WithColumn("cdn_base_url"),
+	StorageProfile:        field.String{}.WithColumn("storage_profile"),
+	OriginalRetention:     field.Field[projects.OriginalRetention]{}.WithColumn("original_retention"),
+	OriginalRetentionDays: field.Number[int]{}.WithColumn("original_retention_days"),
+	ImageExpireDays:       field.Number[int]{}.WithColumn("image_expire_days"),
+	Presets:               field.Slice[entity.Preset]{}.WithName("Presets"),
 }
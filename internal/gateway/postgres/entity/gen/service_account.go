@@ -9,23 +9,33 @@ import (
 )
 
 var ServiceAccount = struct {
-	ID          field.String
-	CreatedAt   field.Time
-	UpdatedAt   field.Time
-	Name        field.String
-	AccessScope field.Field[serviceaccounts.AccessScope]
-	ExpireAt    field.Time
-	APIKeyHash  field.String
-	Projects    field.Slice[entity.Project]
+	ID             field.String
+	CreatedAt      field.Time
+	UpdatedAt      field.Time
+	Name           field.String
+	AccessScope    field.Field[serviceaccounts.AccessScope]
+	Permissions    field.Field[entity.ServiceAccountPermissions]
+	ExpireAt       field.Time
+	LastUsedAt     field.Time
+	LastUsedIP     field.String
+	StaleWarnedAt  field.Time
+	DisabledAt     field.Time
+	DisabledReason field.Field[serviceaccounts.DisabledReason]
+	Projects       field.Slice[entity.Project]
 }{
-	ID:          field.String{}.WithColumn("id"),
-	CreatedAt:   field.Time{}.WithColumn("created_at"),
-	UpdatedAt:   field.Time{}.WithColumn("updated_at"),
-	Name:        field.String{}.WithColumn("name"),
-	AccessScope: field.Field[serviceaccounts.AccessScope]{}.WithColumn("access_scope"),
-	ExpireAt:    field.Time{}.WithColumn("expire_at"),
-	APIKeyHash:  field.String{}.WithColumn("api_key_hash"),
-	Projects:    field.Slice[entity.Project]{}.WithName("Projects"),
+	ID:             field.String{}.WithColumn("id"),
+	CreatedAt:      field.Time{}.WithColumn("created_at"),
+	UpdatedAt:      field.Time{}.WithColumn("updated_at"),
+	Name:           field.String{}.WithColumn("name"),
+	AccessScope:    field.Field[serviceaccounts.AccessScope]{}.WithColumn("access_scope"),
+	Permissions:    field.Field[entity.ServiceAccountPermissions]{}.WithColumn("permissions"),
+	ExpireAt:       field.Time{}.WithColumn("expire_at"),
+	LastUsedAt:     field.Time{}.WithColumn("last_used_at"),
+	LastUsedIP:     field.String{}.WithColumn("last_used_ip"),
+	StaleWarnedAt:  field.Time{}.WithColumn("stale_warned_at"),
+	DisabledAt:     field.Time{}.WithColumn("disabled_at"),
+	DisabledReason: field.Field[serviceaccounts.DisabledReason]{}.WithColumn("disabled_reason"),
+	Projects:       field.Slice[entity.Project]{}.WithName("Projects"),
 }
 
 var ServiceAccountProject = struct {
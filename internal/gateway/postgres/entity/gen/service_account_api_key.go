@@ -0,0 +1,32 @@
+// Code generated by 'gorm.io/cli/gorm'. DO NOT EDIT.
+
+package gen
+
+import (
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"gorm.io/cli/gorm/field"
+)
+
+var ServiceAccountAPIKey = struct {
+	ID               field.String
+	CreatedAt        field.Time
+	UpdatedAt        field.Time
+	Name             field.String
+	Prefix           field.String
+	Hash             field.String
+	LastUsedAt       field.Time
+	ExpireAt         field.Time
+	ServiceAccountID field.String
+	ServiceAccount   field.Struct[entity.ServiceAccount]
+}{
+	ID:               field.String{}.WithColumn("id"),
+	CreatedAt:        field.Time{}.WithColumn("created_at"),
+	UpdatedAt:        field.Time{}.WithColumn("updated_at"),
+	Name:             field.String{}.WithColumn("name"),
+	Prefix:           field.String{}.WithColumn("prefix"),
+	Hash:             field.String{}.WithColumn("hash"),
+	LastUsedAt:       field.Time{}.WithColumn("last_used_at"),
+	ExpireAt:         field.Time{}.WithColumn("expire_at"),
+	ServiceAccountID: field.String{}.WithColumn("service_account_id"),
+	ServiceAccount:   field.Struct[entity.ServiceAccount]{}.WithName("ServiceAccount"),
+}
@@ -8,19 +8,23 @@ import (
 )
 
 var User = struct {
-	ID        field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	Role      field.Field[users.Role]
-	Nickname  field.String
-	Email     field.String
-	PhotoURL  field.String
+	ID            field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+	Role          field.Field[users.Role]
+	Nickname      field.String
+	Email         field.String
+	PhotoURL      field.String
+	SuspendedAt   field.Time
+	IDTokenClaims field.Field[any]
 }{
-	ID:        field.String{}.WithColumn("id"),
-	CreatedAt: field.Time{}.WithColumn("created_at"),
-	UpdatedAt: field.Time{}.WithColumn("updated_at"),
-	Role:      field.Field[users.Role]{}.WithColumn("role"),
-	Nickname:  field.String{}.WithColumn("nickname"),
-	Email:     field.String{}.WithColumn("email"),
-	PhotoURL:  field.String{}.WithColumn("photo_url"),
+	ID:            field.String{}.WithColumn("id"),
+	CreatedAt:     field.Time{}.WithColumn("created_at"),
+	UpdatedAt:     field.Time{}.WithColumn("updated_at"),
+	Role:          field.Field[users.Role]{}.WithColumn("role"),
+	Nickname:      field.String{}.WithColumn("nickname"),
+	Email:         field.String{}.WithColumn("email"),
+	PhotoURL:      field.String{}.WithColumn("photo_url"),
+	SuspendedAt:   field.Time{}.WithColumn("suspended_at"),
+	IDTokenClaims: field.Field[any]{}.WithColumn("id_token_claims"),
 }
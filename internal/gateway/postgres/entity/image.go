@@ -15,11 +15,25 @@ type Image struct {
 	ID        string `gorm:"size:36"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	FileName  string        `gorm:"size:512"`
-	Format    images.Format `gorm:"size:32"`
-	State     images.State  `gorm:"size:32"`
-	S3Key     string        `gorm:"size:1024"`
-	URL       string        `gorm:"size:1024"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	FileName  string         `gorm:"size:512"`
+	Format    images.Format  `gorm:"size:32"`
+	State     images.State   `gorm:"size:32"`
+	S3Key     string         `gorm:"size:1024"`
+	URL       string         `gorm:"size:1024"`
+
+	OriginalState images.OriginalState `gorm:"size:32; default:STORED"`
+
+	ExternalID string            `gorm:"size:256; index"`
+	Tags       []string          `gorm:"type:jsonb; serializer:json; index:,type:gin"`
+	Metadata   map[string]string `gorm:"type:jsonb; serializer:json; index:,type:gin"`
+
+	FocalX     *float64
+	FocalY     *float64
+	CropX      *float64
+	CropY      *float64
+	CropWidth  *float64
+	CropHeight *float64
 
 	ProjectID string  `gorm:"size:36; index"`
 	Project   Project `gorm:"constraint:OnDelete:SET NULL"`
@@ -28,15 +42,25 @@ type Image struct {
 }
 
 func NewImage(img domain.Image) Image {
-	return Image{
+	image := Image{
 		ID:        img.ID,
+		CreatedAt: img.CreatedAt,
 		FileName:  img.FileName,
 		Format:    img.Format,
 		State:     img.State,
 		S3Key:     img.S3Key,
 		URL:       img.URL,
+
+		OriginalState: img.OriginalState,
+
+		ExternalID: img.ExternalID,
+		Tags:       img.Tags,
+		Metadata:   img.Metadata,
+
 		ProjectID: img.Project.ID,
 	}
+	image.setFocus(img.Focus)
+	return image
 }
 
 func (i *Image) BeforeCreate(tx *gorm.DB) error {
@@ -51,14 +75,54 @@ func (i Image) ToDomain() domain.Image {
 		ID:        i.ID,
 		CreatedAt: i.CreatedAt,
 		UpdatedAt: i.UpdatedAt,
+		DeletedAt: deletedAtToDomain(i.DeletedAt),
 		FileName:  i.FileName,
 		Format:    i.Format,
 		State:     i.State,
 		S3Key:     i.S3Key,
 		URL:       i.URL,
-		Project:   i.Project.ToReference(),
+
+		OriginalState: i.OriginalState,
+
+		ExternalID: i.ExternalID,
+		Tags:       i.Tags,
+		Metadata:   i.Metadata,
+
+		Focus:   i.focusToDomain(),
+		Project: i.Project.ToReference(),
 		Variants: lo.Map(i.Variants, func(v ImageVariant, _ int) domain.ImageVariant {
 			return v.ToDomain()
 		}),
 	}
 }
+
+func (i *Image) setFocus(f domain.ImageFocus) {
+	i.FocalX, i.FocalY = nil, nil
+	i.CropX, i.CropY, i.CropWidth, i.CropHeight = nil, nil, nil, nil
+
+	if p := f.FocalPoint; p != nil {
+		i.FocalX, i.FocalY = &p.X, &p.Y
+	}
+	if b := f.CropBox; b != nil {
+		i.CropX, i.CropY, i.CropWidth, i.CropHeight = &b.X, &b.Y, &b.Width, &b.Height
+	}
+}
+
+func (i Image) focusToDomain() domain.ImageFocus {
+	var focus domain.ImageFocus
+	if i.FocalX != nil && i.FocalY != nil {
+		focus.FocalPoint = &images.FocalPoint{
+			X: *i.FocalX,
+			Y: *i.FocalY,
+		}
+	}
+	if i.CropX != nil && i.CropY != nil && i.CropWidth != nil && i.CropHeight != nil {
+		focus.CropBox = &images.CropBox{
+			X:      *i.CropX,
+			Y:      *i.CropY,
+			Width:  *i.CropWidth,
+			Height: *i.CropHeight,
+		}
+	}
+	return focus
+}
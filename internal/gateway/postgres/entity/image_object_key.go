@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// ImageObjectKey maps S3 keys of original images to images, so that images
+// are resolved from object events regardless of the key template of the
+// project.
+type ImageObjectKey struct {
+	S3Key     string `gorm:"size:1024; primaryKey"`
+	CreatedAt time.Time
+
+	ImageID string `gorm:"size:36; index"`
+	Image   Image  `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+func NewImageObjectKey(img Image) ImageObjectKey {
+	return ImageObjectKey{
+		S3Key:   img.S3Key,
+		ImageID: img.ID,
+	}
+}
@@ -19,20 +19,23 @@ type ImageVariant struct {
 	S3Key     string              `gorm:"size:1024"`
 	URL       string              `gorm:"size:1024"`
 
-	ImageID  string `gorm:"size:36; uniqueIndex:idx_image_id_preset_id,priority:1"`
-	PresetID string `gorm:"size:36; uniqueIndex:idx_image_id_preset_id,priority:2; index"`
+	Descriptor images.Descriptor `gorm:"size:16; uniqueIndex:idx_image_id_preset_id_descriptor,priority:3"`
+
+	ImageID  string `gorm:"size:36; uniqueIndex:idx_image_id_preset_id_descriptor,priority:1"`
+	PresetID string `gorm:"size:36; uniqueIndex:idx_image_id_preset_id_descriptor,priority:2; index"`
 	Preset   Preset `gorm:"constraint:OnDelete:SET NULL"`
 }
 
 func NewImageVariant(iv domain.ImageVariant) ImageVariant {
 	return ImageVariant{
-		ID:       iv.ID,
-		Format:   iv.Format,
-		State:    iv.State,
-		S3Key:    iv.S3Key,
-		URL:      iv.URL,
-		ImageID:  iv.ImageID,
-		PresetID: iv.Preset.ID,
+		ID:         iv.ID,
+		Format:     iv.Format,
+		State:      iv.State,
+		S3Key:      iv.S3Key,
+		URL:        iv.URL,
+		Descriptor: iv.Descriptor,
+		ImageID:    iv.ImageID,
+		PresetID:   iv.Preset.ID,
 	}
 }
 
@@ -45,14 +48,15 @@ func (i *ImageVariant) BeforeCreate(tx *gorm.DB) error {
 
 func (i ImageVariant) ToDomain() domain.ImageVariant {
 	return domain.ImageVariant{
-		ID:        i.ID,
-		CreatedAt: i.CreatedAt,
-		UpdatedAt: i.UpdatedAt,
-		Format:    i.Format,
-		State:     i.State,
-		S3Key:     i.S3Key,
-		URL:       i.URL,
-		ImageID:   i.ImageID,
-		Preset:    i.Preset.ToReference(),
+		ID:         i.ID,
+		CreatedAt:  i.CreatedAt,
+		UpdatedAt:  i.UpdatedAt,
+		Format:     i.Format,
+		State:      i.State,
+		S3Key:      i.S3Key,
+		URL:        i.URL,
+		Descriptor: i.Descriptor,
+		ImageID:    i.ImageID,
+		Preset:     i.Preset.ToReference(),
 	}
 }
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// JSONSlice stores a slice as a JSON array in a single column. Nil slices are
+// stored as NULL.
+type JSONSlice[T any] []T
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = JSONSlice[int]{}
+	_ sql.Scanner   = (*JSONSlice[int])(nil)
+)
+
+func (s JSONSlice[T]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal([]T(s))
+	if err != nil {
+		return nil, fmt.Errorf("marshaling json slice: %w", err)
+	}
+	return string(b), nil
+}
+
+func (s *JSONSlice[T]) Scan(value any) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+
+	var b []byte
+	switch v := value.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of json slice: %[1]T(%[1]v)", value)
+	}
+
+	if err := json.Unmarshal(b, (*[]T)(s)); err != nil {
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Failed to unmarshal json slice").
+			WithCause(err)
+	}
+	return nil
+}
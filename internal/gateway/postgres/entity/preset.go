@@ -25,11 +25,27 @@ type Preset struct {
 	Width   *int64         `gorm:"type:integer"`
 	Height  *int64         `gorm:"type:integer"`
 
+	WithoutEnlargement bool
+
+	Densities JSONSlice[float64] `gorm:"type:text"`
+	Widths    JSONSlice[int64]   `gorm:"type:text"`
+
+	EncoderEffort            *int64 `gorm:"type:smallint"`
+	EncoderLossless          bool
+	EncoderChromaSubsampling *images.ChromaSubsampling `gorm:"size:8"`
+
+	WatermarkID      *string        `gorm:"size:36; index"`
+	Watermark        *Watermark     `gorm:"constraint:OnDelete:SET NULL"`
+	WatermarkAnchor  *images.Anchor `gorm:"size:32"`
+	WatermarkOpacity *float64
+	WatermarkMargin  *int64 `gorm:"type:integer"`
+	WatermarkScale   *float64
+
 	ProjectID string `gorm:"size:36; uniqueIndex:idx_project_id_name,priority:1"`
 }
 
 func NewPreset(t domain.Preset) Preset {
-	return Preset{
+	preset := Preset{
 		Name:    t.Name,
 		Default: t.Default,
 		Format:  t.Format,
@@ -38,23 +54,59 @@ func NewPreset(t domain.Preset) Preset {
 		Anchor:  t.Anchor,
 		Width:   t.Width,
 		Height:  t.Height,
+
+		WithoutEnlargement: t.WithoutEnlargement,
+
+		Densities: t.Densities,
+		Widths:    t.Widths,
 	}
+	preset.setEncoder(t.Encoder)
+	preset.setWatermark(t.Watermark)
+	return preset
 }
 
 func NewPresetFromUpsert(
 	projID string, req domain.UpsertPresetRequest,
 ) Preset {
-	return Preset{
-		Name:      lo.FromPtr(req.Name),
-		Default:   lo.FromPtr(req.Default),
-		Format:    req.Format.GetOrDefault(),
-		Quality:   req.Quality.GetOrDefault(),
-		Fit:       req.Fit,
-		Anchor:    req.Anchor,
-		Width:     req.Width,
-		Height:    req.Height,
-		ProjectID: projID,
+	preset := Preset{
+		Name:               lo.FromPtr(req.Name),
+		Default:            lo.FromPtr(req.Default),
+		Format:             req.Format.GetOrDefault(),
+		Quality:            req.Quality.GetOrDefault(),
+		Fit:                req.Fit,
+		Anchor:             req.Anchor,
+		Width:              req.Width,
+		Height:             req.Height,
+		WithoutEnlargement: lo.FromPtr(req.WithoutEnlargement),
+		Densities:          req.Densities,
+		Widths:             req.Widths,
+		ProjectID:          projID,
+	}
+	preset.setEncoder(req.Encoder)
+	preset.setWatermark(req.Watermark)
+	return preset
+}
+
+func (t *Preset) setEncoder(e *domain.PresetEncoder) {
+	if e == nil {
+		return
 	}
+
+	t.EncoderEffort = e.Effort
+	t.EncoderLossless = e.Lossless
+	t.EncoderChromaSubsampling = e.ChromaSubsampling
+}
+
+func (t *Preset) setWatermark(w *domain.PresetWatermark) {
+	if w == nil {
+		return
+	}
+
+	t.WatermarkID = &w.WatermarkID
+	t.WatermarkAnchor = &w.Anchor
+	t.WatermarkOpacity = &w.Opacity
+	t.WatermarkMargin = &w.Margin
+	t.WatermarkScale = &w.Scale
 }
 
 func (t *Preset) BeforeCreate(tx *gorm.DB) error {
@@ -65,18 +117,43 @@ func (t *Preset) BeforeCreate(tx *gorm.DB) error {
 }
 
 func (t Preset) ToDomain() domain.Preset {
+	var encoder *domain.PresetEncoder
+	if t.EncoderEffort != nil || t.EncoderLossless || t.EncoderChromaSubsampling != nil {
+		encoder = &domain.PresetEncoder{
+			Effort:            t.EncoderEffort,
+			Lossless:          t.EncoderLossless,
+			ChromaSubsampling: t.EncoderChromaSubsampling,
+		}
+	}
+
+	var watermark *domain.PresetWatermark
+	if t.WatermarkID != nil {
+		watermark = &domain.PresetWatermark{
+			WatermarkID: *t.WatermarkID,
+			Anchor:      lo.FromPtr(t.WatermarkAnchor),
+			Opacity:     lo.FromPtr(t.WatermarkOpacity),
+			Margin:      lo.FromPtr(t.WatermarkMargin),
+			Scale:       lo.FromPtr(t.WatermarkScale),
+		}
+	}
+
 	return domain.Preset{
-		ID:        t.ID,
-		CreatedAt: t.CreatedAt,
-		UpdatedAt: t.UpdatedAt,
-		Name:      t.Name,
-		Default:   t.Default,
-		Format:    t.Format,
-		Quality:   t.Quality,
-		Fit:       t.Fit,
-		Anchor:    t.Anchor,
-		Width:     t.Width,
-		Height:    t.Height,
+		ID:                 t.ID,
+		CreatedAt:          t.CreatedAt,
+		UpdatedAt:          t.UpdatedAt,
+		Name:               t.Name,
+		Default:            t.Default,
+		Format:             t.Format,
+		Quality:            t.Quality,
+		Fit:                t.Fit,
+		Anchor:             t.Anchor,
+		Width:              t.Width,
+		Height:             t.Height,
+		WithoutEnlargement: t.WithoutEnlargement,
+		Densities:          t.Densities,
+		Widths:             t.Widths,
+		Encoder:            encoder,
+		Watermark:          watermark,
 	}
 }
 
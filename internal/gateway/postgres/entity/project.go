@@ -8,20 +8,41 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/projects"
 )
 
 type Project struct {
-	ID        string `gorm:"size:36"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	Name      string `gorm:"size:128"`
+	ID          string `gorm:"size:36"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt        `gorm:"index"`
+	Name        string                `gorm:"size:128"`
+	Visibility  projects.Visibility   `gorm:"size:32; default:PUBLIC"`
+	KeyTemplate *projects.KeyTemplate `gorm:"size:512"`
+	CDNBaseURL  *string               `gorm:"size:512"`
+	// StorageProfile is empty for projects created before storage profiles,
+	// which are stored in the default profile.
+	StorageProfile string `gorm:"size:64"`
+
+	OriginalRetention     projects.OriginalRetention `gorm:"size:32; default:KEEP"`
+	OriginalRetentionDays int
+	ImageExpireDays       int
 
 	Presets []Preset `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 func NewProject(req domain.Project) Project {
 	return Project{
-		Name: req.Name,
+		Name:           req.Name,
+		Visibility:     req.Visibility,
+		KeyTemplate:    req.KeyTemplate,
+		CDNBaseURL:     req.CDNBaseURL,
+		StorageProfile: req.StorageProfile,
+
+		OriginalRetention:     req.Retention.Original,
+		OriginalRetentionDays: req.Retention.OriginalDays,
+		ImageExpireDays:       req.Retention.ImageExpireDays,
+
 		Presets: lo.Map(req.Presets, func(t domain.Preset, _ int) Preset {
 			return NewPreset(t)
 		}),
@@ -37,10 +58,20 @@ func (p *Project) BeforeCreate(tx *gorm.DB) error {
 
 func (p Project) ToDomain() domain.Project {
 	return domain.Project{
-		ID:        p.ID,
-		CreatedAt: p.CreatedAt,
-		UpdatedAt: p.UpdatedAt,
-		Name:      p.Name,
+		ID:             p.ID,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+		DeletedAt:      deletedAtToDomain(p.DeletedAt),
+		Name:           p.Name,
+		Visibility:     p.Visibility,
+		KeyTemplate:    p.KeyTemplate,
+		CDNBaseURL:     p.CDNBaseURL,
+		StorageProfile: p.StorageProfile,
+		Retention: domain.RetentionPolicy{
+			Original:        p.OriginalRetention,
+			OriginalDays:    p.OriginalRetentionDays,
+			ImageExpireDays: p.ImageExpireDays,
+		},
 		Presets: lo.Map(p.Presets, func(t Preset, _ int) domain.Preset {
 			return t.ToDomain()
 		}),
@@ -49,7 +80,18 @@ func (p Project) ToDomain() domain.Project {
 
 func (p Project) ToReference() domain.ProjectReference {
 	return domain.ProjectReference{
-		ID:   p.ID,
-		Name: p.Name,
+		ID:             p.ID,
+		Name:           p.Name,
+		Visibility:     p.Visibility,
+		KeyTemplate:    p.KeyTemplate,
+		CDNBaseURL:     p.CDNBaseURL,
+		StorageProfile: p.StorageProfile,
+	}
+}
+
+func deletedAtToDomain(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
 	}
+	return &d.Time
 }
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/projects"
+)
+
+// ProjectDeletion outlives the project it purges, so that it has no foreign
+// key to the project.
+type ProjectDeletion struct {
+	ID          string `gorm:"size:36"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ProjectID   string `gorm:"size:36; index"`
+	ProjectName string `gorm:"size:128"`
+	// StorageProfile of the project, as objects are deleted after the project
+	// is purged.
+	StorageProfile string                 `gorm:"size:64"`
+	State          projects.DeletionState `gorm:"size:32; index"`
+	StartAfter     time.Time
+	TotalImages    int64
+	PurgedImages   int64
+	LastError      string `gorm:"type:text"`
+	FinishedAt     *time.Time
+}
+
+func NewProjectDeletion(d domain.ProjectDeletion) ProjectDeletion {
+	return ProjectDeletion{
+		ProjectID:      d.ProjectID,
+		ProjectName:    d.ProjectName,
+		StorageProfile: d.StorageProfile,
+		State:          d.State,
+		StartAfter:     d.StartAfter,
+	}
+}
+
+func (d *ProjectDeletion) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = uuid.NewString()
+	}
+	return nil
+}
+
+func (d ProjectDeletion) ToDomain() domain.ProjectDeletion {
+	return domain.ProjectDeletion{
+		ID:             d.ID,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+		ProjectID:      d.ProjectID,
+		ProjectName:    d.ProjectName,
+		StorageProfile: d.StorageProfile,
+		State:          d.State,
+		StartAfter:     d.StartAfter,
+		TotalImages:    d.TotalImages,
+		PurgedImages:   d.PurgedImages,
+		LastError:      d.LastError,
+		FinishedAt:     d.FinishedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/projects"
+)
+
+type ProjectMember struct {
+	ProjectID string `gorm:"size:36; primaryKey"`
+	UserID    string `gorm:"size:36; primaryKey; index"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Role      projects.MemberRole `gorm:"size:32"`
+
+	Project Project `gorm:"constraint:OnDelete:CASCADE"`
+	User    User    `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+func NewProjectMember(m domain.ProjectMember) ProjectMember {
+	return ProjectMember{
+		Role:      m.Role,
+		ProjectID: m.ProjectID,
+		UserID:    m.User.ID,
+	}
+}
+
+func (m ProjectMember) ToDomain() domain.ProjectMember {
+	return domain.ProjectMember{
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+		ProjectID: m.ProjectID,
+		Role:      m.Role,
+		User:      m.User.ToDomain(),
+	}
+}
@@ -17,18 +17,31 @@ type ServiceAccount struct {
 	UpdatedAt   time.Time
 	Name        string                      `gorm:"size:128"`
 	AccessScope serviceaccounts.AccessScope `gorm:"size:32"`
+	Permissions ServiceAccountPermissions   `gorm:"type:text"`
 	ExpireAt    *time.Time
-	APIKeyHash  string `gorm:"size:256; uniqueIndex"`
+	LastUsedAt  *time.Time
+	LastUsedIP  string `gorm:"size:64"`
+	// StaleWarnedAt is set when the account is warned to be disabled for not
+	// being used, and cleared when the account is used again.
+	StaleWarnedAt *time.Time
+	// DisabledAt is set when the account is disabled, and cleared when the
+	// account is enabled again.
+	DisabledAt     *time.Time
+	DisabledReason serviceaccounts.DisabledReason `gorm:"size:32"`
 
 	Projects []Project `gorm:"many2many:service_account_projects"`
 }
 
+// ServiceAccountPermissions names the column type of permissions, which the
+// query generator fails to render as a generic type of another package.
+type ServiceAccountPermissions = JSONSlice[serviceaccounts.Permission]
+
 func NewServiceAccount(acc domain.ServiceAccount) ServiceAccount {
 	return ServiceAccount{
 		Name:        acc.Name,
 		AccessScope: acc.AccessScope,
+		Permissions: acc.Permissions,
 		ExpireAt:    acc.ExpireAt,
-		APIKeyHash:  acc.APIKeyHash,
 	}
 }
 
@@ -41,13 +54,18 @@ func (sa *ServiceAccount) BeforeCreate(tx *gorm.DB) error {
 
 func (sa ServiceAccount) ToDomain() domain.ServiceAccount {
 	return domain.ServiceAccount{
-		ID:          sa.ID,
-		CreatedAt:   sa.CreatedAt,
-		UpdatedAt:   sa.UpdatedAt,
-		Name:        sa.Name,
-		AccessScope: sa.AccessScope,
-		ExpireAt:    sa.ExpireAt,
-		APIKeyHash:  sa.APIKeyHash,
+		ID:             sa.ID,
+		CreatedAt:      sa.CreatedAt,
+		UpdatedAt:      sa.UpdatedAt,
+		Name:           sa.Name,
+		AccessScope:    sa.AccessScope,
+		Permissions:    sa.Permissions,
+		ExpireAt:       sa.ExpireAt,
+		LastUsedAt:     sa.LastUsedAt,
+		LastUsedIP:     sa.LastUsedIP,
+		StaleWarnedAt:  sa.StaleWarnedAt,
+		DisabledAt:     sa.DisabledAt,
+		DisabledReason: sa.DisabledReason,
 		Projects: lo.Map(sa.Projects, func(p Project, _ int) domain.ProjectReference {
 			return p.ToReference()
 		}),
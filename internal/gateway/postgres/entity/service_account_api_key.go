@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+)
+
+type ServiceAccountAPIKey struct {
+	ID         string `gorm:"size:36"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Name       string `gorm:"size:128"`
+	Prefix     string `gorm:"size:16"`
+	Hash       string `gorm:"size:256; uniqueIndex"`
+	LastUsedAt *time.Time
+	ExpireAt   *time.Time
+
+	ServiceAccountID string         `gorm:"size:36; index"`
+	ServiceAccount   ServiceAccount `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+func NewServiceAccountAPIKey(key domain.ServiceAccountAPIKey) ServiceAccountAPIKey {
+	return ServiceAccountAPIKey{
+		Name:             key.Name,
+		Prefix:           key.Prefix,
+		Hash:             key.Hash,
+		ExpireAt:         key.ExpireAt,
+		ServiceAccountID: key.ServiceAccountID,
+	}
+}
+
+func (k *ServiceAccountAPIKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = uuid.NewString()
+	}
+	return nil
+}
+
+func (k ServiceAccountAPIKey) ToDomain() domain.ServiceAccountAPIKey {
+	return domain.ServiceAccountAPIKey{
+		ID:               k.ID,
+		CreatedAt:        k.CreatedAt,
+		UpdatedAt:        k.UpdatedAt,
+		ServiceAccountID: k.ServiceAccountID,
+		Name:             k.Name,
+		Prefix:           k.Prefix,
+		Hash:             k.Hash,
+		LastUsedAt:       k.LastUsedAt,
+		ExpireAt:         k.ExpireAt,
+	}
+}
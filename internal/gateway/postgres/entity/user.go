@@ -18,6 +18,9 @@ type User struct {
 	Nickname  string     `gorm:"size:128"`
 	Email     string     `gorm:"size:1024; uniqueIndex"`
 	PhotoURL  string     `gorm:"size:2048"`
+
+	SuspendedAt   *time.Time
+	IDTokenClaims map[string]any `gorm:"type:jsonb; serializer:json"`
 }
 
 func NewUser(u domain.User) User {
@@ -29,6 +32,9 @@ func NewUser(u domain.User) User {
 		Nickname:  u.Nickname,
 		Email:     u.Email,
 		PhotoURL:  u.PhotoURL,
+
+		SuspendedAt:   u.SuspendedAt,
+		IDTokenClaims: u.IDTokenClaims,
 	}
 }
 
@@ -48,5 +54,8 @@ func (u *User) ToDomain() domain.User {
 		Nickname:  u.Nickname,
 		Email:     u.Email,
 		PhotoURL:  u.PhotoURL,
+
+		SuspendedAt:   u.SuspendedAt,
+		IDTokenClaims: u.IDTokenClaims,
 	}
 }
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+type Watermark struct {
+	ID        string `gorm:"size:36"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string        `gorm:"size:64; uniqueIndex:idx_watermark_project_id_name,priority:2"`
+	Format    images.Format `gorm:"size:32"`
+	S3Key     string        `gorm:"size:1024"`
+	URL       string        `gorm:"size:1024"`
+	// Watermarks created before upload states were tracked are assumed to be
+	// uploaded.
+	State images.WatermarkState `gorm:"size:32; default:READY"`
+
+	ProjectID string  `gorm:"size:36; uniqueIndex:idx_watermark_project_id_name,priority:1"`
+	Project   Project `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+func NewWatermark(w domain.Watermark) Watermark {
+	return Watermark{
+		ID:        w.ID,
+		Name:      w.Name,
+		Format:    w.Format,
+		S3Key:     w.S3Key,
+		URL:       w.URL,
+		State:     w.State,
+		ProjectID: w.Project.ID,
+	}
+}
+
+func (w *Watermark) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == "" {
+		w.ID = uuid.NewString()
+	}
+	return nil
+}
+
+func (w Watermark) ToDomain() domain.Watermark {
+	return domain.Watermark{
+		ID:        w.ID,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+		Name:      w.Name,
+		Format:    w.Format,
+		S3Key:     w.S3Key,
+		URL:       w.URL,
+		State:     w.State,
+		Project:   w.Project.ToReference(),
+	}
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
@@ -16,15 +17,55 @@ func applyImageSearchFilter(
 	if filter.ProjectID != nil {
 		q = q.Where(gen.Image.ProjectID.Eq(*filter.ProjectID))
 	}
+	if filter.Deleted {
+		q = q.Scopes(unscoped).Where(gen.Image.DeletedAt.IsNotNull())
+	}
+	if filter.DeletedAtBefore != nil {
+		q = q.Where(clause.Lt{
+			Column: gen.Image.DeletedAt.Column(),
+			Value:  *filter.DeletedAtBefore,
+		})
+	}
 	if filter.State != nil {
 		q = q.Where(gen.Image.State.Eq(*filter.State))
 	}
+	if len(filter.OriginalStates) > 0 {
+		q = q.Where(clause.IN{
+			Column: gen.Image.OriginalState.Column(),
+			Values: lo.ToAnySlice(filter.OriginalStates),
+		})
+	}
+	if filter.CreatedAtAfter != nil {
+		q = q.Where(gen.Image.CreatedAt.Gte(*filter.CreatedAtAfter))
+	}
+	if filter.CreatedAtBefore != nil {
+		q = q.Where(gen.Image.CreatedAt.Lt(*filter.CreatedAtBefore))
+	}
 	if filter.UpdatedAtBefore != nil {
 		q = q.Where(gen.Image.UpdatedAt.Lt(*filter.UpdatedAtBefore))
 	}
+	if filter.ExternalID != nil {
+		q = q.Where(gen.Image.ExternalID.Eq(*filter.ExternalID))
+	}
+	if filter.FileNamePrefix != nil {
+		q = q.Where(gen.Image.FileName.Like(likePrefix(*filter.FileNamePrefix)))
+	}
+	if filter.Format != nil {
+		q = q.Where(gen.Image.Format.Eq(*filter.Format))
+	}
+	if len(filter.Tags) > 0 {
+		q = q.Where(jsonbContains(imageTagsColumn, filter.Tags))
+	}
+	if len(filter.Metadata) > 0 {
+		q = q.Where(jsonbContains(gen.Image.Metadata.Column(), filter.Metadata))
+	}
 	return q
 }
 
+// imageTagsColumn is missing in generated fields, which regard slices as
+// associations.
+var imageTagsColumn = clause.Column{Name: "tags"}
+
 func applyImageSortFilter(
 	q gorm.ChainInterface[entity.Image], filter domain.ImageSortFilter,
 ) gorm.ChainInterface[entity.Image] {
@@ -55,9 +96,49 @@ func buildImageUpdateAssigners(req domain.UpdateImageRequest) []clause.Assigner
 	if req.State != nil {
 		assigners = append(assigners, gen.Image.State.Set(*req.State))
 	}
+	if req.URL != nil {
+		assigners = append(assigners, gen.Image.URL.Set(*req.URL))
+	}
+	if req.OriginalState != nil {
+		assigners = append(assigners, gen.Image.OriginalState.Set(*req.OriginalState))
+	}
+	if req.Focus != nil {
+		assigners = append(assigners, buildImageFocusAssigners(*req.Focus)...)
+	}
+	if req.ExternalID != nil {
+		assigners = append(assigners, gen.Image.ExternalID.Set(*req.ExternalID))
+	}
+	if req.Tags != nil {
+		assigners = append(assigners, clause.Assignment{
+			Column: imageTagsColumn,
+			Value:  jsonbValue(req.Tags),
+		})
+	}
+	if req.Metadata != nil {
+		assigners = append(assigners, gen.Image.Metadata.Set(jsonbValue(req.Metadata)))
+	}
 
 	if len(assigners) > 0 {
 		assigners = append(assigners, gen.Image.UpdatedAt.Now())
 	}
 	return assigners
 }
+
+func buildImageFocusAssigners(focus domain.ImageFocus) []clause.Assigner {
+	var focalX, focalY, cropX, cropY, cropWidth, cropHeight *float64
+	if p := focus.FocalPoint; p != nil {
+		focalX, focalY = &p.X, &p.Y
+	}
+	if b := focus.CropBox; b != nil {
+		cropX, cropY, cropWidth, cropHeight = &b.X, &b.Y, &b.Width, &b.Height
+	}
+
+	return []clause.Assigner{
+		setNullable(gen.Image.FocalX, focalX),
+		setNullable(gen.Image.FocalY, focalY),
+		setNullable(gen.Image.CropX, cropX),
+		setNullable(gen.Image.CropY, cropY),
+		setNullable(gen.Image.CropWidth, cropWidth),
+		setNullable(gen.Image.CropHeight, cropHeight),
+	}
+}
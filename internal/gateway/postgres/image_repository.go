@@ -11,6 +11,7 @@ import (
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
 	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+	"github.com/isutare412/imageer/pkg/apperr"
 	"github.com/isutare412/imageer/pkg/dbhelpers"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
@@ -41,6 +42,29 @@ func (r *ImageRepository) FindByID(ctx context.Context, id string) (domain.Image
 	return img.ToDomain(), nil
 }
 
+func (r *ImageRepository) FindByS3Key(ctx context.Context, s3Key string) (domain.Image, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ImageRepository.FindByS3Key",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	key, err := gorm.G[entity.ImageObjectKey](tx).
+		Where(gen.ImageObjectKey.S3Key.Eq(s3Key)).
+		First(ctx)
+	if err != nil {
+		return domain.Image{}, dbhelpers.WrapGORMError(err, "Failed to find image of S3 key %s", s3Key)
+	}
+
+	img, err := r.get(ctx, tx, key.ImageID)
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("getting image: %w", err)
+	}
+
+	return img.ToDomain(), nil
+}
+
 func (r *ImageRepository) List(ctx context.Context, params domain.ListImagesParams,
 ) (domain.Images, error) {
 	ctx, span := tracing.StartSpan(ctx, "postgres.ImageRepository.List",
@@ -100,6 +124,11 @@ func (r *ImageRepository) Create(ctx context.Context, image domain.Image) (domai
 		return domain.Image{}, dbhelpers.WrapGORMError(err, "Failed to create image")
 	}
 
+	key := entity.NewImageObjectKey(img)
+	if err := gorm.G[entity.ImageObjectKey](tx).Create(ctx, &key); err != nil {
+		return domain.Image{}, dbhelpers.WrapGORMError(err, "Failed to create image object key")
+	}
+
 	img, err = r.get(ctx, tx, img.ID)
 	if err != nil {
 		return domain.Image{}, fmt.Errorf("getting image: %w", err)
@@ -142,10 +171,61 @@ func (r *ImageRepository) Delete(ctx context.Context, id string) error {
 
 	tx := GetTxOrDB(ctx, r.db)
 
+	rowsAffected, err := gorm.G[entity.Image](tx).
+		Where(gen.Image.ID.Eq(id)).
+		Delete(ctx)
+	if err != nil {
+		return dbhelpers.WrapGORMError(err, "Failed to delete image %s", id)
+	}
+	if rowsAffected == 0 {
+		return apperr.NewError(apperr.CodeNotFound).WithSummary("Image %s not found", id)
+	}
+	return nil
+}
+
+func (r *ImageRepository) Restore(ctx context.Context, id string) (domain.Image, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ImageRepository.Restore",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	rowsAffected, err := gorm.G[entity.Image](tx).
+		Scopes(unscoped).
+		Where(gen.Image.ID.Eq(id)).
+		Where(gen.Image.DeletedAt.IsNotNull()).
+		Set(gen.Image.DeletedAt.Set(gorm.DeletedAt{})).
+		Update(ctx)
+	if err != nil {
+		return domain.Image{}, dbhelpers.WrapGORMError(err, "Failed to restore image %s", id)
+	}
+	if rowsAffected == 0 {
+		return domain.Image{}, apperr.NewError(apperr.CodeNotFound).
+			WithSummary("Deleted image %s not found", id)
+	}
+
+	img, err := r.get(ctx, tx, id)
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("getting image: %w", err)
+	}
+
+	return img.ToDomain(), nil
+}
+
+func (r *ImageRepository) Purge(ctx context.Context, id string) error {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ImageRepository.Purge",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
 	if _, err := gorm.G[entity.Image](tx).
+		Scopes(unscoped).
 		Where(gen.Image.ID.Eq(id)).
 		Delete(ctx); err != nil {
-		return dbhelpers.WrapGORMError(err, "Failed to delete image %s", id)
+		return dbhelpers.WrapGORMError(err, "Failed to purge image %s", id)
 	}
 	return nil
 }
@@ -39,24 +39,27 @@ func TestImageRepository_FindByID(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT * FROM "images" WHERE "id" = $1 ORDER BY "images"."id" LIMIT $2`).
+					`SELECT * FROM "images" WHERE "id" = $1 AND "images"."deleted_at" IS NULL ORDER BY "images"."id" LIMIT $2`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
-						AddRow("image-1", time.Now(), time.Now(), "file-1.jpg", images.FormatJPEG,
-							images.StateReady, "s3-key-1", "url-1", "project-1"))
+						AddRow("image-1", time.Now(), time.Now(), nil, "file-1.jpg", images.FormatJPEG,
+							images.StateReady, "s3-key-1", "url-1", images.OriginalStateStored,
+							"", nil, nil,
+							nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "projects"."id" = $1`).
+					`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-1"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectQuery(
 					`SELECT * FROM "image_variants" WHERE "image_variants"."image_id" = $1`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()).
 						AddRow("variant-1", time.Now(), time.Now(), images.FormatWebp,
-							images.VariantStateReady, "s3-key-1", "url-1", "image-1", "preset-1"))
+							images.VariantStateReady, "s3-key-1", "url-1", "", "image-1", "preset-1"))
 				mock.ExpectQuery(
 					`SELECT * FROM "presets" WHERE "presets"."id" = $1`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -82,6 +85,80 @@ func TestImageRepository_FindByID(t *testing.T) {
 	}
 }
 
+func TestImageRepository_FindByS3Key(t *testing.T) {
+	type testSet struct {
+		name          string // description of this test case
+		transactioner *postgres.Transactioner
+		imageRepo     *postgres.ImageRepository
+		mock          sqlmock.Sqlmock
+
+		s3Key   string
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name:  "normal case",
+			s3Key: "s3-key-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.imageRepo = postgres.NewImageRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					`SELECT * FROM "image_object_keys" WHERE "s3_key" = $1 ORDER BY "image_object_keys"."s3_key" LIMIT $2`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageObjectKey]()).
+						AddRow("s3-key-1", time.Now(), "image-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "images" WHERE "id" = $1 AND "images"."deleted_at" IS NULL ORDER BY "images"."id" LIMIT $2`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
+						AddRow("image-1", time.Now(), time.Now(), nil, "file-1.jpg", images.FormatJPEG,
+							images.StateReady, "s3-key-1", "url-1", images.OriginalStateStored,
+							"", nil, nil,
+							nil, nil, nil, nil, nil, nil, "project-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
+				mock.ExpectQuery(
+					`SELECT * FROM "image_variants" WHERE "image_variants"."image_id" = $1`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()).
+						AddRow("variant-1", time.Now(), time.Now(), images.FormatWebp,
+							images.VariantStateReady, "s3-key-1", "url-1", "", "image-1", "preset-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "presets" WHERE "presets"."id" = $1`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
+						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			err := tt.transactioner.WithTx(t.Context(), func(ctx context.Context) error {
+				_, err := tt.imageRepo.FindByS3Key(ctx, tt.s3Key)
+				return err
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestImageRepository_List(t *testing.T) {
 	type testSet struct {
 		name          string // description of this test case
@@ -120,28 +197,32 @@ func TestImageRepository_List(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					`SELECT * FROM "images" WHERE "state" = $1 AND "updated_at" < $2 `+
-						`ORDER BY "updated_at" DESC `+
+						`AND "images"."deleted_at" IS NULL ORDER BY "updated_at" DESC `+
 						`LIMIT $3 OFFSET $4`).
 					WithArgs(images.StateUploadPending, updatedAtBefore, 20, 10).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
-						AddRow("image-1", time.Now(), time.Now(), "file-1.jpg", images.FormatJPEG,
-							images.StateUploadPending, "s3-key-1", "url-1", "project-1"))
-				mock.ExpectQuery(`SELECT * FROM "projects" WHERE "projects"."id" = $1`).
+						AddRow("image-1", time.Now(), time.Now(), nil, "file-1.jpg", images.FormatJPEG,
+							images.StateUploadPending, "s3-key-1", "url-1", images.OriginalStateStored,
+							"", nil, nil,
+							nil, nil, nil, nil, nil, nil, "project-1"))
+				mock.ExpectQuery(`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
 					WithArgs("project-1").
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-1"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectQuery(`SELECT * FROM "image_variants" WHERE "image_variants"."image_id" = $1`).
 					WithArgs("image-1").
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()).
 						AddRow("variant-1", time.Now(), time.Now(), images.FormatWebp,
-							images.VariantStateReady, "s3-key-1", "url-1", "image-1", "preset-1"))
+							images.VariantStateReady, "s3-key-1", "url-1", "", "image-1", "preset-1"))
 				mock.ExpectQuery(`SELECT * FROM "presets" WHERE "presets"."id" = $1`).
 					WithArgs("preset-1").
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectQuery(
-					`SELECT COUNT(1) FROM "images" WHERE "state" = $1 AND "updated_at" < $2`).
+					`SELECT COUNT(1) FROM "images" WHERE "state" = $1 AND "updated_at" < $2 `+
+						`AND "images"."deleted_at" IS NULL`).
 					WithArgs(images.StateUploadPending, updatedAtBefore).
 					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(5))
 				mock.ExpectCommit()
@@ -167,22 +248,75 @@ func TestImageRepository_List(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT * FROM "images" WHERE "project_id" = $1 `+
+					`SELECT * FROM "images" WHERE "project_id" = $1 AND "images"."deleted_at" IS NULL `+
 						`ORDER BY "created_at" DESC LIMIT $2`).
 					WithArgs("project-1", 20).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
-						AddRow("image-1", time.Now(), time.Now(), "file-1.jpg", images.FormatJPEG,
-							images.StateReady, "s3-key-1", "url-1", "project-1"))
-				mock.ExpectQuery(`SELECT * FROM "projects" WHERE "projects"."id" = $1`).
+						AddRow("image-1", time.Now(), time.Now(), nil, "file-1.jpg", images.FormatJPEG,
+							images.StateReady, "s3-key-1", "url-1", images.OriginalStateStored,
+							"", nil, nil,
+							nil, nil, nil, nil, nil, nil, "project-1"))
+				mock.ExpectQuery(`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
 					WithArgs("project-1").
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-1"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectQuery(`SELECT * FROM "image_variants" WHERE "image_variants"."image_id" = $1`).
 					WithArgs("image-1").
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()))
 				mock.ExpectQuery(
-					`SELECT COUNT(1) FROM "images" WHERE "project_id" = $1`).
+					`SELECT COUNT(1) FROM "images" WHERE "project_id" = $1 AND "images"."deleted_at" IS NULL`).
+					WithArgs("project-1").
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "filter by tags and metadata",
+			req: domain.ListImagesParams{
+				SearchFilter: domain.ImageSearchFilter{
+					ProjectID:      new("project-1"),
+					FileNamePrefix: new("banner_"),
+					Format:         new(images.FormatPNG),
+					Tags:           []string{"summer"},
+					Metadata:       map[string]string{"source": "cms"},
+				},
+				SortFilter: domain.ImageSortFilter{
+					CreatedAt: true,
+					Direction: dbhelpers.SortDirectionDesc,
+				},
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.imageRepo = postgres.NewImageRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					`SELECT * FROM "images" WHERE "project_id" = $1 AND "file_name" LIKE $2 `+
+						`AND "format" = $3 AND "tags" @> $4 AND "metadata" @> $5 `+
+						`AND "images"."deleted_at" IS NULL ORDER BY "created_at" DESC LIMIT $6`).
+					WithArgs("project-1", `banner\_%`, images.FormatPNG, `["summer"]`,
+						`{"source":"cms"}`, 20).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
+						AddRow("image-1", time.Now(), time.Now(), nil, "banner_1.png", images.FormatPNG,
+							images.StateReady, "s3-key-1", "url-1", images.OriginalStateStored,
+							"", `["summer"]`, `{"source":"cms"}`,
+							nil, nil, nil, nil, nil, nil, "project-1"))
+				mock.ExpectQuery(`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
 					WithArgs("project-1").
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
+				mock.ExpectQuery(`SELECT * FROM "image_variants" WHERE "image_variants"."image_id" = $1`).
+					WithArgs("image-1").
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()))
+				mock.ExpectQuery(
+					`SELECT COUNT(1) FROM "images" WHERE "project_id" = $1 AND "file_name" LIKE $2 `+
+						`AND "format" = $3 AND "tags" @> $4 AND "metadata" @> $5 `+
+						`AND "images"."deleted_at" IS NULL`).
+					WithArgs("project-1", `banner\_%`, images.FormatPNG, `["summer"]`,
+						`{"source":"cms"}`).
 					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -241,34 +375,42 @@ func TestImageRepository_Create(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "id" = $1 ORDER BY "projects"."id" LIMIT $2`).
+					`SELECT * FROM "projects" WHERE "id" = $1 AND "projects"."deleted_at" IS NULL ORDER BY "projects"."id" LIMIT $2`).
 					WithArgs(tt.req.Project.ID, sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-1"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectExec(
 					`INSERT INTO "images" ` +
-						`("id","created_at","updated_at","file_name","format","state","s3_key","url","project_id") VALUES ` +
-						`($1,$2,$3,$4,$5,$6,$7,$8,$9)`).
+						`("id","created_at","updated_at","deleted_at","file_name","format","state","s3_key","url",` +
+						`"original_state","external_id","tags","metadata",` +
+						`"focal_x","focal_y","crop_x","crop_y","crop_width","crop_height","project_id") ` +
+						`VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20)`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(
+					`INSERT INTO "image_object_keys" ("s3_key","created_at","image_id") VALUES ($1,$2,$3)`).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectQuery(
-					`SELECT * FROM "images" WHERE "id" = $1 ORDER BY "images"."id" LIMIT $2`).
+					`SELECT * FROM "images" WHERE "id" = $1 AND "images"."deleted_at" IS NULL ORDER BY "images"."id" LIMIT $2`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
-						AddRow("image-1", time.Now(), time.Now(), "file-1.jpg", images.FormatJPEG,
-							images.StateReady, "s3-key-1", "url-1", "project-1"))
+						AddRow("image-1", time.Now(), time.Now(), nil, "file-1.jpg", images.FormatJPEG,
+							images.StateReady, "s3-key-1", "url-1", images.OriginalStateStored,
+							"", nil, nil,
+							nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "projects"."id" = $1`).
+					`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-1"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectQuery(
 					`SELECT * FROM "image_variants" WHERE "image_variants"."image_id" = $1`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()).
 						AddRow("variant-1", time.Now(), time.Now(), images.FormatWebp,
-							images.VariantStateReady, "s3-key-1", "url-1", "image-1", "preset-1"))
+							images.VariantStateReady, "s3-key-1", "url-1", "", "image-1", "preset-1"))
 				mock.ExpectQuery(
 					`SELECT * FROM "presets" WHERE "presets"."id" = $1`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -321,27 +463,115 @@ func TestImageRepository_Update(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					`UPDATE "images" SET "state"=$1,"updated_at"=NOW() WHERE "id" = $2`).
+					`UPDATE "images" SET "state"=$1,"updated_at"=NOW() WHERE "id" = $2 AND "images"."deleted_at" IS NULL`).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectQuery(
-					`SELECT * FROM "images" WHERE "id" = $1 ORDER BY "images"."id" LIMIT $2`).
+					`SELECT * FROM "images" WHERE "id" = $1 AND "images"."deleted_at" IS NULL ORDER BY "images"."id" LIMIT $2`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
-						AddRow("image-1", time.Now(), time.Now(), "file-1.jpg", images.FormatJPEG,
-							images.StateReady, "s3-key-1", "url-1", "project-1"))
+						AddRow("image-1", time.Now(), time.Now(), nil, "file-1.jpg", images.FormatJPEG,
+							images.StateReady, "s3-key-1", "url-1", images.OriginalStateStored,
+							"", nil, nil,
+							nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "projects"."id" = $1`).
+					`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-1"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectQuery(
 					`SELECT * FROM "image_variants" WHERE "image_variants"."image_id" = $1`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()).
 						AddRow("variant-1", time.Now(), time.Now(), images.FormatWebp,
-							images.VariantStateReady, "s3-key-1", "url-1", "image-1", "preset-1"))
+							images.VariantStateReady, "s3-key-1", "url-1", "", "image-1", "preset-1"))
 				mock.ExpectQuery(
 					`SELECT * FROM "presets" WHERE "presets"."id" = $1`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "update details",
+			req: domain.UpdateImageRequest{
+				ID:         "image-1",
+				ExternalID: new("external-1"),
+				Tags:       []string{"summer"},
+				Metadata:   map[string]string{},
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.imageRepo = postgres.NewImageRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`UPDATE "images" SET "external_id"=$1,"tags"=$2,"metadata"=$3,"updated_at"=NOW() `+
+						`WHERE "id" = $4 AND "images"."deleted_at" IS NULL`).
+					WithArgs("external-1", `["summer"]`, `{}`, "image-1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(
+					`SELECT * FROM "images" WHERE "id" = $1 AND "images"."deleted_at" IS NULL ORDER BY "images"."id" LIMIT $2`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
+						AddRow("image-1", time.Now(), time.Now(), nil, "file-1.jpg", images.FormatJPEG,
+							images.StateReady, "s3-key-1", "url-1", images.OriginalStateStored,
+							"external-1", `["summer"]`, `{}`,
+							nil, nil, nil, nil, nil, nil, "project-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
+				mock.ExpectQuery(
+					`SELECT * FROM "image_variants" WHERE "image_variants"."image_id" = $1`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "update focus",
+			req: domain.UpdateImageRequest{
+				ID: "image-1",
+				Focus: &domain.ImageFocus{
+					FocalPoint: &images.FocalPoint{X: 0.5, Y: 0.3},
+				},
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.imageRepo = postgres.NewImageRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`UPDATE "images" SET "focal_x"=$1,"focal_y"=$2,"crop_x"=NULL,"crop_y"=NULL,`+
+						`"crop_width"=NULL,"crop_height"=NULL,"updated_at"=NOW() WHERE "id" = $3 `+
+						`AND "images"."deleted_at" IS NULL`).
+					WithArgs(0.5, 0.3, "image-1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(
+					`SELECT * FROM "images" WHERE "id" = $1 AND "images"."deleted_at" IS NULL ORDER BY "images"."id" LIMIT $2`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
+						AddRow("image-1", time.Now(), time.Now(), nil, "file-1.jpg", images.FormatJPEG,
+							images.StateReady, "s3-key-1", "url-1", images.OriginalStateStored,
+							"", nil, nil,
+							0.5, 0.3, nil, nil, nil, nil, "project-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
+				mock.ExpectQuery(
+					`SELECT * FROM "image_variants" WHERE "image_variants"."image_id" = $1`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()).
+						AddRow("variant-1", time.Now(), time.Now(), images.FormatWebp,
+							images.VariantStateReady, "s3-key-1", "url-1", "", "image-1", "preset-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "presets" WHERE "presets"."id" = $1`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
+						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -391,13 +621,31 @@ func TestImageRepository_Delete(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					`DELETE FROM "images" WHERE "id" = $1`).
-					WithArgs("image-1").
+					`UPDATE "images" SET "deleted_at"=$1 WHERE "id" = $2 AND "images"."deleted_at" IS NULL`).
+					WithArgs(sqlmock.AnyArg(), "image-1").
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
 		},
+		{
+			name: "already deleted",
+			id:   "image-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.imageRepo = postgres.NewImageRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`UPDATE "images" SET "deleted_at"=$1 WHERE "id" = $2 AND "images"."deleted_at" IS NULL`).
+					WithArgs(sqlmock.AnyArg(), "image-1").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -417,3 +665,53 @@ func TestImageRepository_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestImageRepository_Purge(t *testing.T) {
+	type testSet struct {
+		name          string // description of this test case
+		transactioner *postgres.Transactioner
+		imageRepo     *postgres.ImageRepository
+		mock          sqlmock.Sqlmock
+
+		id      string
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name: "normal case",
+			id:   "image-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.imageRepo = postgres.NewImageRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(`DELETE FROM "images" WHERE "id" = $1`).
+					WithArgs("image-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			err := tt.transactioner.WithTx(t.Context(), func(ctx context.Context) error {
+				return tt.imageRepo.Purge(ctx, tt.id)
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
@@ -12,6 +12,12 @@ func buildImageVariantUpdateAssigners(req domain.UpdateImageVariantRequest) []cl
 	if req.State != nil {
 		assigners = append(assigners, gen.ImageVariant.State.Set(*req.State))
 	}
+	if req.S3Key != nil {
+		assigners = append(assigners, gen.ImageVariant.S3Key.Set(*req.S3Key))
+	}
+	if req.URL != nil {
+		assigners = append(assigners, gen.ImageVariant.URL.Set(*req.URL))
+	}
 
 	if len(assigners) > 0 {
 		assigners = append(assigners, gen.ImageVariant.UpdatedAt.Now())
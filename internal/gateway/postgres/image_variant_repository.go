@@ -24,6 +24,27 @@ func NewImageVariantRepository(client *Client) *ImageVariantRepository {
 	}
 }
 
+func (r *ImageVariantRepository) FindByS3Key(ctx context.Context, s3Key string,
+) (domain.ImageVariant, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ImageVariantRepository.FindByS3Key",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	variant, err := gorm.G[entity.ImageVariant](tx).
+		Where(gen.ImageVariant.S3Key.Eq(s3Key)).
+		Preload(gen.ImageVariant.Preset.Name(), nil).
+		First(ctx)
+	if err != nil {
+		return domain.ImageVariant{},
+			dbhelpers.WrapGORMError(err, "Failed to find image variant of S3 key %s", s3Key)
+	}
+
+	return variant.ToDomain(), nil
+}
+
 func (r *ImageVariantRepository) Create(
 	ctx context.Context, variant domain.ImageVariant,
 ) (domain.ImageVariant, error) {
@@ -47,32 +47,36 @@ func TestImageVariantRepository_Create(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT * FROM "images" WHERE "id" = $1 ORDER BY "images"."id" LIMIT $2`).
+					`SELECT * FROM "images" WHERE "id" = $1 AND "images"."deleted_at" IS NULL ORDER BY "images"."id" LIMIT $2`).
 					WithArgs(tt.req.ImageID, sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Image]()).
-						AddRow("image-1", time.Now(), time.Now(), "file-1.jpg", images.FormatJPEG,
-							images.StateReady, "s3-key-1", "url-1", "project-1"))
+						AddRow("image-1", time.Now(), time.Now(), nil, "file-1.jpg", images.FormatJPEG,
+							images.StateReady, "s3-key-1", "url-1", images.OriginalStateStored,
+							"", nil, nil,
+							nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectQuery(
 					`SELECT * FROM "presets" WHERE "id" = $1 ORDER BY "presets"."id" LIMIT $2`).
 					WithArgs(tt.req.Preset.ID, sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectExec(
 					`INSERT INTO "image_variants" ` +
-						`("id","created_at","updated_at","format","state","s3_key","url","image_id","preset_id") VALUES ` +
-						`($1,$2,$3,$4,$5,$6,$7,$8,$9)`).
+						`("id","created_at","updated_at","format","state","s3_key","url","descriptor","image_id","preset_id") ` +
+						`VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectQuery(
 					`SELECT * FROM "image_variants" WHERE "id" = $1 ORDER BY "image_variants"."id" LIMIT $2`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()).
 						AddRow("variant-1", time.Now(), time.Now(), images.FormatWebp,
-							images.VariantStateReady, "s3-key-1", "url-1", "image-1", "preset-1"))
+							images.VariantStateReady, "s3-key-1", "url-1", "", "image-1", "preset-1"))
 				mock.ExpectQuery(
 					`SELECT * FROM "presets" WHERE "presets"."id" = $1`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -131,12 +135,46 @@ func TestImageVariantRepository_Update(t *testing.T) {
 					`SELECT * FROM "image_variants" WHERE "id" = $1 ORDER BY "image_variants"."id" LIMIT $2`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()).
 						AddRow("variant-1", time.Now(), time.Now(), images.FormatWebp,
-							images.VariantStateReady, "s3-key-1", "url-1", "image-1", "preset-1"))
+							images.VariantStateReady, "s3-key-1", "url-1", "", "image-1", "preset-1"))
 				mock.ExpectQuery(
 					`SELECT * FROM "presets" WHERE "presets"."id" = $1`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "move variant object",
+			req: domain.UpdateImageVariantRequest{
+				ID:    "variant-1",
+				State: new(images.VariantStateProcessing),
+				S3Key: new("s3-key-2"),
+				URL:   new("url-2"),
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.imageVarRepo = postgres.NewImageVariantRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`UPDATE "image_variants" SET "state"=$1,"s3_key"=$2,"url"=$3,"updated_at"=NOW() WHERE "id" = $4`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(
+					`SELECT * FROM "image_variants" WHERE "id" = $1 ORDER BY "image_variants"."id" LIMIT $2`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ImageVariant]()).
+						AddRow("variant-1", time.Now(), time.Now(), images.FormatWebp,
+							images.VariantStateProcessing, "s3-key-2", "url-2", "", "image-1", "preset-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "presets" WHERE "presets"."id" = $1`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
+						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -73,6 +73,43 @@ func buildPresetUpdateAssigners(req domain.UpsertPresetRequest) []clause.Assigne
 	if req.Height != nil {
 		assigners = append(assigners, gen.Preset.Height.Set(*req.Height))
 	}
+	if req.WithoutEnlargement != nil {
+		assigners = append(assigners, gen.Preset.WithoutEnlargement.Set(*req.WithoutEnlargement))
+	}
+	if req.Densities != nil {
+		assigners = append(assigners, clause.Assignment{
+			Column: clause.Column{Name: "densities"},
+			Value:  entity.JSONSlice[float64](req.Densities),
+		})
+	}
+	if req.Widths != nil {
+		assigners = append(assigners, clause.Assignment{
+			Column: clause.Column{Name: "widths"},
+			Value:  entity.JSONSlice[int64](req.Widths),
+		})
+	}
+	if req.Encoder != nil {
+		assigners = append(assigners,
+			setNullable(gen.Preset.EncoderEffort, req.Encoder.Effort),
+			gen.Preset.EncoderLossless.Set(req.Encoder.Lossless),
+			setNullable(gen.Preset.EncoderChromaSubsampling, req.Encoder.ChromaSubsampling))
+	}
+	if req.Watermark != nil {
+		assigners = append(assigners,
+			gen.Preset.WatermarkID.Set(req.Watermark.WatermarkID),
+			gen.Preset.WatermarkAnchor.Set(req.Watermark.Anchor),
+			gen.Preset.WatermarkOpacity.Set(req.Watermark.Opacity),
+			gen.Preset.WatermarkMargin.Set(req.Watermark.Margin),
+			gen.Preset.WatermarkScale.Set(req.Watermark.Scale))
+	}
+	if req.RemoveWatermark {
+		assigners = append(assigners,
+			setNullable(gen.Preset.WatermarkID, nil),
+			setNullable(gen.Preset.WatermarkAnchor, nil),
+			setNullable(gen.Preset.WatermarkOpacity, nil),
+			setNullable(gen.Preset.WatermarkMargin, nil),
+			setNullable(gen.Preset.WatermarkScale, nil))
+	}
 
 	if len(assigners) > 0 {
 		assigners = append(assigners, gen.Preset.UpdatedAt.Now())
@@ -43,7 +43,8 @@ func TestPresetRepository_FindByID(t *testing.T) {
 					WithArgs("preset-1", 1).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -100,7 +101,8 @@ func TestPresetRepository_FindByName(t *testing.T) {
 					WithArgs("project-1", "preset-name-1", 1).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -166,9 +168,11 @@ func TestPresetRepository_List(t *testing.T) {
 					WithArgs("project-1", "preset-name-1", "preset-name-2", 20, 20).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-name-1", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1").
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1").
 						AddRow("preset-2", time.Now(), time.Now(), "preset-name-2", false,
-							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, "project-1"))
+							images.FormatWebp, images.Quality(90), nil, nil, nil, nil, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
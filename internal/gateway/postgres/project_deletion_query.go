@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+)
+
+func applyProjectDeletionSearchFilter(
+	q gorm.ChainInterface[entity.ProjectDeletion], filter domain.ProjectDeletionSearchFilter,
+) gorm.ChainInterface[entity.ProjectDeletion] {
+	if filter.ProjectID != nil {
+		q = q.Where(gen.ProjectDeletion.ProjectID.Eq(*filter.ProjectID))
+	}
+	if len(filter.States) > 0 {
+		q = q.Where(clause.IN{
+			Column: gen.ProjectDeletion.State.Column(),
+			Values: lo.ToAnySlice(filter.States),
+		})
+	}
+	if filter.StartAfterBefore != nil {
+		q = q.Where(gen.ProjectDeletion.StartAfter.Lt(*filter.StartAfterBefore))
+	}
+	return q
+}
+
+func buildProjectDeletionUpdateAssigners(req domain.UpdateProjectDeletionRequest) []clause.Assigner {
+	var assigners []clause.Assigner
+	if req.State != nil {
+		assigners = append(assigners, gen.ProjectDeletion.State.Set(*req.State))
+	}
+	if req.TotalImages != nil {
+		assigners = append(assigners, gen.ProjectDeletion.TotalImages.Set(*req.TotalImages))
+	}
+	if req.PurgedImages != nil {
+		assigners = append(assigners, gen.ProjectDeletion.PurgedImages.Set(*req.PurgedImages))
+	}
+	if req.LastError != nil {
+		assigners = append(assigners, gen.ProjectDeletion.LastError.Set(*req.LastError))
+	}
+	if req.FinishedAt != nil {
+		assigners = append(assigners, gen.ProjectDeletion.FinishedAt.Set(*req.FinishedAt))
+	}
+
+	if len(assigners) > 0 {
+		assigners = append(assigners, gen.ProjectDeletion.UpdatedAt.Now())
+	}
+	return assigners
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+type ProjectDeletionRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectDeletionRepository(client *Client) *ProjectDeletionRepository {
+	return &ProjectDeletionRepository{
+		db: client.db,
+	}
+}
+
+// FindLatestByProjectID returns the most recent deletion job of the project.
+func (r *ProjectDeletionRepository) FindLatestByProjectID(ctx context.Context, projectID string,
+) (domain.ProjectDeletion, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectDeletionRepository.FindLatestByProjectID",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	deletion, err := gorm.G[entity.ProjectDeletion](tx).
+		Where(gen.ProjectDeletion.ProjectID.Eq(projectID)).
+		Order(gen.ProjectDeletion.CreatedAt.Desc()).
+		First(ctx)
+	if err != nil {
+		return domain.ProjectDeletion{}, dbhelpers.WrapGORMError(err,
+			"Failed to find deletion of project %s", projectID)
+	}
+
+	return deletion.ToDomain(), nil
+}
+
+// List returns deletion jobs in the order of creation.
+func (r *ProjectDeletionRepository) List(ctx context.Context,
+	params domain.ListProjectDeletionsParams,
+) ([]domain.ProjectDeletion, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectDeletionRepository.List",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	q := gorm.G[entity.ProjectDeletion](tx).Scopes()
+	q = applyProjectDeletionSearchFilter(q, params.SearchFilter)
+	deletions, err := q.
+		Order(gen.ProjectDeletion.CreatedAt.Asc()).
+		Find(ctx)
+	if err != nil {
+		return nil, dbhelpers.WrapGORMError(err, "Failed to list project deletions")
+	}
+
+	return lo.Map(deletions, func(d entity.ProjectDeletion, _ int) domain.ProjectDeletion {
+		return d.ToDomain()
+	}), nil
+}
+
+func (r *ProjectDeletionRepository) Create(ctx context.Context, deletion domain.ProjectDeletion,
+) (domain.ProjectDeletion, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectDeletionRepository.Create",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	d := entity.NewProjectDeletion(deletion)
+	if err := gorm.G[entity.ProjectDeletion](tx).Create(ctx, &d); err != nil {
+		return domain.ProjectDeletion{}, dbhelpers.WrapGORMError(err,
+			"Failed to create project deletion")
+	}
+
+	d, err := r.get(ctx, tx, d.ID)
+	if err != nil {
+		return domain.ProjectDeletion{}, fmt.Errorf("getting project deletion: %w", err)
+	}
+
+	return d.ToDomain(), nil
+}
+
+func (r *ProjectDeletionRepository) Update(ctx context.Context,
+	req domain.UpdateProjectDeletionRequest,
+) (domain.ProjectDeletion, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectDeletionRepository.Update",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	assigners := buildProjectDeletionUpdateAssigners(req)
+	_, err := gorm.G[entity.ProjectDeletion](tx).
+		Where(gen.ProjectDeletion.ID.Eq(req.ID)).
+		Set(assigners...).
+		Update(ctx)
+	if err != nil {
+		return domain.ProjectDeletion{}, dbhelpers.WrapGORMError(err,
+			"Failed to update project deletion %s", req.ID)
+	}
+
+	d, err := r.get(ctx, tx, req.ID)
+	if err != nil {
+		return domain.ProjectDeletion{}, fmt.Errorf("getting project deletion: %w", err)
+	}
+
+	return d.ToDomain(), nil
+}
+
+func (r *ProjectDeletionRepository) get(ctx context.Context, tx *gorm.DB, id string,
+) (entity.ProjectDeletion, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectDeletionRepository.get",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	d, err := gorm.G[entity.ProjectDeletion](tx).
+		Where(gen.ProjectDeletion.ID.Eq(id)).
+		First(ctx)
+	if err != nil {
+		return entity.ProjectDeletion{}, dbhelpers.WrapGORMError(err,
+			"Failed to find project deletion %s", id)
+	}
+	return d, nil
+}
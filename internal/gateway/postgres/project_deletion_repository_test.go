@@ -0,0 +1,195 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/projects"
+)
+
+func TestProjectDeletionRepository_FindLatestByProjectID(t *testing.T) {
+	type testSet struct {
+		name                string // description of this test case
+		projectDeletionRepo *postgres.ProjectDeletionRepository
+		mock                sqlmock.Sqlmock
+
+		projectID string
+		setup     func(t *testing.T, tt *testSet)
+		wantErr   bool
+	}
+
+	tests := []testSet{
+		{
+			name:      "normal case",
+			projectID: "project-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.projectDeletionRepo = postgres.NewProjectDeletionRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectQuery(
+					`SELECT * FROM "project_deletions" WHERE "project_id" = $1 ORDER BY "created_at" DESC,"project_deletions"."id" LIMIT $2`).
+					WithArgs(tt.projectID, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ProjectDeletion]()).
+						AddRow("deletion-1", time.Now(), time.Now(), "project-1", "project-name-1",
+							"default", projects.DeletionStatePending, time.Now(), 0, 0, "", nil))
+			},
+			wantErr: false,
+		},
+		{
+			name:      "not found",
+			projectID: "project-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.projectDeletionRepo = postgres.NewProjectDeletionRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectQuery(
+					`SELECT * FROM "project_deletions" WHERE "project_id" = $1 ORDER BY "created_at" DESC,"project_deletions"."id" LIMIT $2`).
+					WithArgs(tt.projectID, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ProjectDeletion]()))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			_, err := tt.projectDeletionRepo.FindLatestByProjectID(t.Context(), tt.projectID)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestProjectDeletionRepository_List(t *testing.T) {
+	type testSet struct {
+		name                string // description of this test case
+		projectDeletionRepo *postgres.ProjectDeletionRepository
+		mock                sqlmock.Sqlmock
+
+		params  domain.ListProjectDeletionsParams
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	now := time.Now()
+	tests := []testSet{
+		{
+			name: "due deletions",
+			params: domain.ListProjectDeletionsParams{
+				SearchFilter: domain.ProjectDeletionSearchFilter{
+					States: []projects.DeletionState{
+						projects.DeletionStatePending,
+						projects.DeletionStateRunning,
+					},
+					StartAfterBefore: &now,
+				},
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.projectDeletionRepo = postgres.NewProjectDeletionRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectQuery(
+					`SELECT * FROM "project_deletions" WHERE "state" IN ($1,$2) AND "start_after" < $3 ORDER BY "created_at"`).
+					WithArgs(projects.DeletionStatePending, projects.DeletionStateRunning, now).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ProjectDeletion]()).
+						AddRow("deletion-1", time.Now(), time.Now(), "project-1", "project-name-1",
+							"default", projects.DeletionStateRunning, time.Now(), 10, 5, "", nil))
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			_, err := tt.projectDeletionRepo.List(t.Context(), tt.params)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestProjectDeletionRepository_Update(t *testing.T) {
+	type testSet struct {
+		name                string // description of this test case
+		transactioner       *postgres.Transactioner
+		projectDeletionRepo *postgres.ProjectDeletionRepository
+		mock                sqlmock.Sqlmock
+
+		req     domain.UpdateProjectDeletionRequest
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name: "normal case",
+			req: domain.UpdateProjectDeletionRequest{
+				ID:           "deletion-1",
+				State:        new(projects.DeletionStateRunning),
+				PurgedImages: new(int64(5)),
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.projectDeletionRepo = postgres.NewProjectDeletionRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`UPDATE "project_deletions" SET "state"=$1,"purged_images"=$2,"updated_at"=NOW() WHERE "id" = $3`).
+					WithArgs(projects.DeletionStateRunning, int64(5), tt.req.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(
+					`SELECT * FROM "project_deletions" WHERE "id" = $1 ORDER BY "project_deletions"."id" LIMIT $2`).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ProjectDeletion]()).
+						AddRow("deletion-1", time.Now(), time.Now(), "project-1", "project-name-1",
+							"default", projects.DeletionStateRunning, time.Now(), 10, 5, "", nil))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			err := tt.transactioner.WithTx(t.Context(), func(ctx context.Context) error {
+				_, err := tt.projectDeletionRepo.Update(ctx, tt.req)
+				return err
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
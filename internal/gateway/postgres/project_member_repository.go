@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+type ProjectMemberRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectMemberRepository(client *Client) *ProjectMemberRepository {
+	return &ProjectMemberRepository{
+		db: client.db,
+	}
+}
+
+func (r *ProjectMemberRepository) Find(ctx context.Context, projectID, userID string,
+) (domain.ProjectMember, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectMemberRepository.Find",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	member, err := r.get(ctx, tx, projectID, userID)
+	if err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("getting project member: %w", err)
+	}
+
+	return member.ToDomain(), nil
+}
+
+// List returns members of the project in the order of joining.
+func (r *ProjectMemberRepository) List(ctx context.Context, projectID string,
+) ([]domain.ProjectMember, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectMemberRepository.List",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	members, err := gorm.G[entity.ProjectMember](tx).
+		Where(gen.ProjectMember.ProjectID.Eq(projectID)).
+		Preload(gen.ProjectMember.User.Name(), nil).
+		Order(gen.ProjectMember.CreatedAt.Asc()).
+		Find(ctx)
+	if err != nil {
+		return nil, dbhelpers.WrapGORMError(err, "Failed to list members of project %s",
+			projectID)
+	}
+
+	return lo.Map(members, func(m entity.ProjectMember, _ int) domain.ProjectMember {
+		return m.ToDomain()
+	}), nil
+}
+
+func (r *ProjectMemberRepository) Create(ctx context.Context, member domain.ProjectMember,
+) (domain.ProjectMember, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectMemberRepository.Create",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	m := entity.NewProjectMember(member)
+	if err := gorm.G[entity.ProjectMember](tx).Create(ctx, &m); err != nil {
+		return domain.ProjectMember{}, dbhelpers.WrapGORMError(err,
+			"Failed to create member of project %s", member.ProjectID)
+	}
+
+	m, err := r.get(ctx, tx, m.ProjectID, m.UserID)
+	if err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("getting project member: %w", err)
+	}
+
+	return m.ToDomain(), nil
+}
+
+func (r *ProjectMemberRepository) Update(ctx context.Context,
+	req domain.UpdateProjectMemberRequest,
+) (domain.ProjectMember, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectMemberRepository.Update",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	_, err := gorm.G[entity.ProjectMember](tx).
+		Where(gen.ProjectMember.ProjectID.Eq(req.ProjectID)).
+		Where(gen.ProjectMember.UserID.Eq(req.UserID)).
+		Set(
+			gen.ProjectMember.Role.Set(req.Role),
+			gen.ProjectMember.UpdatedAt.Now(),
+		).
+		Update(ctx)
+	if err != nil {
+		return domain.ProjectMember{}, dbhelpers.WrapGORMError(err,
+			"Failed to update member %s of project %s", req.UserID, req.ProjectID)
+	}
+
+	m, err := r.get(ctx, tx, req.ProjectID, req.UserID)
+	if err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("getting project member: %w", err)
+	}
+
+	return m.ToDomain(), nil
+}
+
+func (r *ProjectMemberRepository) Delete(ctx context.Context, projectID, userID string) error {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectMemberRepository.Delete",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	rowsAffected, err := gorm.G[entity.ProjectMember](tx).
+		Where(gen.ProjectMember.ProjectID.Eq(projectID)).
+		Where(gen.ProjectMember.UserID.Eq(userID)).
+		Delete(ctx)
+	if err != nil {
+		return dbhelpers.WrapGORMError(err, "Failed to delete member %s of project %s",
+			userID, projectID)
+	}
+	if rowsAffected == 0 {
+		return apperr.NewError(apperr.CodeNotFound).
+			WithSummary("Member %s of project %s not found", userID, projectID)
+	}
+	return nil
+}
+
+func (r *ProjectMemberRepository) get(ctx context.Context, tx *gorm.DB, projectID, userID string,
+) (entity.ProjectMember, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectMemberRepository.get",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	m, err := gorm.G[entity.ProjectMember](tx).
+		Where(gen.ProjectMember.ProjectID.Eq(projectID)).
+		Where(gen.ProjectMember.UserID.Eq(userID)).
+		Preload(gen.ProjectMember.User.Name(), nil).
+		First(ctx)
+	if err != nil {
+		return entity.ProjectMember{}, dbhelpers.WrapGORMError(err,
+			"Failed to find member %s of project %s", userID, projectID)
+	}
+	return m, nil
+}
@@ -0,0 +1,182 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/projects"
+	"github.com/isutare412/imageer/pkg/users"
+)
+
+func TestProjectMemberRepository_Find(t *testing.T) {
+	type testSet struct {
+		name              string // description of this test case
+		projectMemberRepo *postgres.ProjectMemberRepository
+		mock              sqlmock.Sqlmock
+
+		projectID string
+		userID    string
+		setup     func(t *testing.T, tt *testSet)
+		wantErr   bool
+	}
+
+	tests := []testSet{
+		{
+			name:      "normal case",
+			projectID: "project-1",
+			userID:    "user-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.projectMemberRepo = postgres.NewProjectMemberRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectQuery(
+					`SELECT * FROM "project_members" WHERE "project_id" = $1 AND "user_id" = $2 `+
+						`ORDER BY "project_members"."project_id" LIMIT $3`).
+					WithArgs(tt.projectID, tt.userID, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ProjectMember]()).
+						AddRow("project-1", "user-1", time.Now(), time.Now(), projects.MemberRoleEditor))
+				mock.ExpectQuery(`SELECT * FROM "users" WHERE "users"."id" = $1`).
+					WithArgs("user-1").
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.User]()).
+						AddRow("user-1", time.Now(), time.Now(), users.RoleGuest, "nickname-1",
+							"email-1", "photo-url-1", nil, nil))
+			},
+			wantErr: false,
+		},
+		{
+			name:      "not found",
+			projectID: "project-1",
+			userID:    "user-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.projectMemberRepo = postgres.NewProjectMemberRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectQuery(
+					`SELECT * FROM "project_members" WHERE "project_id" = $1 AND "user_id" = $2 `+
+						`ORDER BY "project_members"."project_id" LIMIT $3`).
+					WithArgs(tt.projectID, tt.userID, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ProjectMember]()))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			_, err := tt.projectMemberRepo.Find(t.Context(), tt.projectID, tt.userID)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestProjectMemberRepository_Update(t *testing.T) {
+	postgresClient, _, mock := postgres.NewClientWithMock(t)
+	projectMemberRepo := postgres.NewProjectMemberRepository(postgresClient)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(
+		`UPDATE "project_members" SET "role"=$1,"updated_at"=NOW() `+
+			`WHERE "project_id" = $2 AND "user_id" = $3`).
+		WithArgs(projects.MemberRoleOwner, "project-1", "user-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(
+		`SELECT * FROM "project_members" WHERE "project_id" = $1 AND "user_id" = $2 `+
+			`ORDER BY "project_members"."project_id" LIMIT $3`).
+		WithArgs("project-1", "user-1", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ProjectMember]()).
+			AddRow("project-1", "user-1", time.Now(), time.Now(), projects.MemberRoleOwner))
+	mock.ExpectQuery(`SELECT * FROM "users" WHERE "users"."id" = $1`).
+		WithArgs("user-1").
+		WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.User]()).
+			AddRow("user-1", time.Now(), time.Now(), users.RoleGuest, "nickname-1",
+				"email-1", "photo-url-1", nil, nil))
+
+	_, err := projectMemberRepo.Update(t.Context(), domain.UpdateProjectMemberRequest{
+		ProjectID: "project-1",
+		UserID:    "user-1",
+		Role:      projects.MemberRoleOwner,
+	})
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestProjectMemberRepository_Delete(t *testing.T) {
+	type testSet struct {
+		name              string // description of this test case
+		projectMemberRepo *postgres.ProjectMemberRepository
+		mock              sqlmock.Sqlmock
+
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name: "normal case",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.projectMemberRepo = postgres.NewProjectMemberRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`DELETE FROM "project_members" WHERE "project_id" = $1 AND "user_id" = $2`).
+					WithArgs("project-1", "user-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.projectMemberRepo = postgres.NewProjectMemberRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`DELETE FROM "project_members" WHERE "project_id" = $1 AND "user_id" = $2`).
+					WithArgs("project-1", "user-1").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			err := tt.projectMemberRepo.Delete(t.Context(), "project-1", "user-1")
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
@@ -13,9 +14,30 @@ import (
 func applyProjectSearchFilter(
 	q gorm.ChainInterface[entity.Project], filter domain.ProjectSearchFilter,
 ) gorm.ChainInterface[entity.Project] {
+	if filter.IDs != nil {
+		q = q.Where(clause.IN{
+			Column: gen.Project.ID.Column(),
+			Values: lo.ToAnySlice(filter.IDs),
+		})
+	}
 	if filter.Name != nil {
 		q = q.Where(gen.Project.Name.Eq(*filter.Name))
 	}
+	if filter.MemberUserID != nil {
+		q = q.Where(clause.Expr{
+			SQL:  "? IN (SELECT project_id FROM project_members WHERE user_id = ?)",
+			Vars: []any{gen.Project.ID.Column(), *filter.MemberUserID},
+		})
+	}
+	if filter.Deleted {
+		q = q.Scopes(unscoped).Where(gen.Project.DeletedAt.IsNotNull())
+	}
+	if filter.DeletedAtBefore != nil {
+		q = q.Where(clause.Lt{
+			Column: gen.Project.DeletedAt.Column(),
+			Value:  *filter.DeletedAtBefore,
+		})
+	}
 	return q
 }
 
@@ -49,6 +71,27 @@ func buildProjectUpdateAssigners(req domain.UpdateProjectRequest) []clause.Assig
 	if req.Name != nil {
 		assigners = append(assigners, gen.Project.Name.Set(*req.Name))
 	}
+	if req.Visibility != nil {
+		assigners = append(assigners, gen.Project.Visibility.Set(*req.Visibility))
+	}
+	if req.KeyTemplate != nil {
+		assigners = append(assigners, clause.Assignment{
+			Column: clause.Column{Name: "key_template"},
+			Value:  lo.EmptyableToPtr(*req.KeyTemplate),
+		})
+	}
+	if req.CDNBaseURL != nil {
+		assigners = append(assigners, setNullable(gen.Project.CDNBaseURL,
+			lo.EmptyableToPtr(*req.CDNBaseURL)))
+	}
+
+	if r := req.Retention; r != nil {
+		assigners = append(assigners,
+			gen.Project.OriginalRetention.Set(r.Original),
+			gen.Project.OriginalRetentionDays.Set(r.OriginalDays),
+			gen.Project.ImageExpireDays.Set(r.ImageExpireDays),
+		)
+	}
 
 	if len(assigners) > 0 {
 		assigners = append(assigners, gen.Project.UpdatedAt.Now())
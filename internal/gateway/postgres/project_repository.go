@@ -7,6 +7,7 @@ import (
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
@@ -67,7 +68,7 @@ func (r *ProjectRepository) List(
 	tx := GetTxOrDB(ctx, r.db)
 
 	// Fetch projects
-	q := gorm.G[entity.Project](r.db).Scopes()
+	q := gorm.G[entity.Project](tx).Scopes()
 	q = applyProjectSearchFilter(q, params.SearchFilter)
 	q = applyProjectSortFilter(q, params.SortFilter)
 	q = applyPagination(q, params.LimitOrDefault(), params.OffsetOrDefault())
@@ -167,12 +168,83 @@ func (r *ProjectRepository) Delete(ctx context.Context, id string) error {
 
 	tx := GetTxOrDB(ctx, r.db)
 
-	_, err := gorm.G[entity.Project](tx).
+	rowsAffected, err := gorm.G[entity.Project](tx).
 		Where(gen.Project.ID.Eq(id)).
 		Delete(ctx)
 	if err != nil {
 		return dbhelpers.WrapGORMError(err, "Failed to delete project %s", id)
 	}
+	if rowsAffected == 0 {
+		return apperr.NewError(apperr.CodeNotFound).WithSummary("Project %s not found", id)
+	}
+
+	// Images are deleted after the project, so that restoring the project
+	// restores images deleted since then
+	if _, err := gorm.G[entity.Image](tx).
+		Where(gen.Image.ProjectID.Eq(id)).
+		Delete(ctx); err != nil {
+		return dbhelpers.WrapGORMError(err, "Failed to delete images of project %s", id)
+	}
+	return nil
+}
+
+func (r *ProjectRepository) Restore(ctx context.Context, id string) (domain.Project, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectRepository.Restore",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	proj, err := gorm.G[entity.Project](tx).
+		Scopes(unscoped).
+		Where(gen.Project.ID.Eq(id)).
+		Where(gen.Project.DeletedAt.IsNotNull()).
+		First(ctx)
+	if err != nil {
+		return domain.Project{}, dbhelpers.WrapGORMError(err, "Failed to get deleted project %s", id)
+	}
+
+	if _, err := gorm.G[entity.Project](tx).
+		Scopes(unscoped).
+		Where(gen.Project.ID.Eq(id)).
+		Set(gen.Project.DeletedAt.Set(gorm.DeletedAt{})).
+		Update(ctx); err != nil {
+		return domain.Project{}, dbhelpers.WrapGORMError(err, "Failed to restore project %s", id)
+	}
+
+	// Images deleted before the project stay deleted
+	if _, err := gorm.G[entity.Image](tx).
+		Scopes(unscoped).
+		Where(gen.Image.ProjectID.Eq(id)).
+		Where(clause.Gte{Column: gen.Image.DeletedAt.Column(), Value: proj.DeletedAt.Time}).
+		Set(gen.Image.DeletedAt.Set(gorm.DeletedAt{})).
+		Update(ctx); err != nil {
+		return domain.Project{}, dbhelpers.WrapGORMError(err,
+			"Failed to restore images of project %s", id)
+	}
+
+	result, err := r.FindByID(ctx, id)
+	if err != nil {
+		return domain.Project{}, fmt.Errorf("finding project: %w", err)
+	}
+	return result, nil
+}
+
+func (r *ProjectRepository) Purge(ctx context.Context, id string) error {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ProjectRepository.Purge",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	if _, err := gorm.G[entity.Project](tx).
+		Scopes(unscoped).
+		Where(gen.Project.ID.Eq(id)).
+		Delete(ctx); err != nil {
+		return dbhelpers.WrapGORMError(err, "Failed to purge project %s", id)
+	}
 	return nil
 }
 
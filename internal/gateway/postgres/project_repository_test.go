@@ -13,6 +13,7 @@ import (
 	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
 	"github.com/isutare412/imageer/pkg/dbhelpers"
 	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
 )
 
 func TestProjectRepository_FindByID(t *testing.T) {
@@ -38,16 +39,17 @@ func TestProjectRepository_FindByID(t *testing.T) {
 				tt.mock = mock
 
 				mock.ExpectBegin()
-				mock.ExpectQuery(`SELECT * FROM "projects" WHERE "id" = $1 ORDER BY "projects"."id" LIMIT $2`).
+				mock.ExpectQuery(`SELECT * FROM "projects" WHERE "id" = $1 AND "projects"."deleted_at" IS NULL ORDER BY "projects"."id" LIMIT $2`).
 					WithArgs("project-1", 1).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-1"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectQuery(`SELECT * FROM "presets" WHERE "presets"."project_id" = $1`).
 					WithArgs("project-1").
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-1", false, images.FormatWebp,
-							images.Quality(90), images.FitCover, nil, 100, 100, "project-1"))
-				mock.ExpectQuery(`SELECT COUNT(1) FROM "images" WHERE "project_id" = $1`).
+							images.Quality(90), images.FitCover, nil, 100, 100, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
+				mock.ExpectQuery(`SELECT COUNT(1) FROM "images" WHERE "project_id" = $1 AND "images"."deleted_at" IS NULL`).
 					WithArgs("project-1").
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).
 						AddRow(5))
@@ -110,29 +112,64 @@ func TestProjectRepository_List(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "name" = $1 `+
+					`SELECT * FROM "projects" WHERE "name" = $1 AND "projects"."deleted_at" IS NULL `+
 						`ORDER BY "updated_at" DESC `+
 						`LIMIT $2 OFFSET $3`).
 					WithArgs("project-1", 20, 20).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-1"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectQuery(`SELECT * FROM "presets" WHERE "presets"."project_id" = $1`).
 					WithArgs("project-1").
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-1", false, images.FormatWebp,
-							images.Quality(90), images.FitCover, nil, 100, 100, "project-1"))
+							images.Quality(90), images.FitCover, nil, 100, 100, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectQuery(
-					`SELECT COUNT(1) FROM "projects" WHERE "name" = $1`).
+					`SELECT COUNT(1) FROM "projects" WHERE "name" = $1 AND "projects"."deleted_at" IS NULL`).
 					WithArgs(tt.req.SearchFilter.Name).
 					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(5))
 				mock.ExpectQuery(
-					`SELECT "project_id",COUNT(1) AS count FROM "images" WHERE "project_id" = $1 GROUP BY "project_id"`).
+					`SELECT "project_id",COUNT(1) AS count FROM "images" ` +
+						`WHERE "project_id" = $1 AND "images"."deleted_at" IS NULL GROUP BY "project_id"`).
 					WithArgs("project-1").
 					WillReturnRows(sqlmock.NewRows([]string{"project_id", "count"}).AddRow("project-1", 3))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
 		},
+		{
+			name: "filter by member",
+			req: domain.ListProjectsParams{
+				Limit: new(20),
+				SearchFilter: domain.ProjectSearchFilter{
+					MemberUserID: new("user-1"),
+				},
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.projectRepo = postgres.NewProjectRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" `+
+						`WHERE "id" IN (SELECT project_id FROM project_members WHERE user_id = $1) `+
+						`AND "projects"."deleted_at" IS NULL `+
+						`ORDER BY "updated_at" DESC `+
+						`LIMIT $2`).
+					WithArgs("user-1", 20).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()))
+				mock.ExpectQuery(
+					`SELECT COUNT(1) FROM "projects" ` +
+						`WHERE "id" IN (SELECT project_id FROM project_members WHERE user_id = $1) ` +
+						`AND "projects"."deleted_at" IS NULL`).
+					WithArgs("user-1").
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -170,7 +207,8 @@ func TestProjectRepository_Create(t *testing.T) {
 		{
 			name: "normal case",
 			req: domain.Project{
-				Name: "project-1",
+				Name:       "project-1",
+				Visibility: projects.VisibilityPrivate,
 				Presets: []domain.Preset{
 					{
 						Name:    "preset-name-1",
@@ -202,12 +240,17 @@ func TestProjectRepository_Create(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					`INSERT INTO "projects" ("id","created_at","updated_at","name") VALUES ($1,$2,$3,$4)`).
+					`INSERT INTO "projects" ("id","created_at","updated_at","deleted_at","name","visibility","key_template","cdn_base_url","storage_profile",` +
+						`"original_retention","original_retention_days","image_expire_days") ` +
+						`VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)`).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectExec(
 					`INSERT INTO "presets" ` +
-						`("id","created_at","updated_at","name","default","format","quality","fit","anchor","width","height","project_id") VALUES ` +
-						`($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12),($13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24) ` +
+						`("id","created_at","updated_at","name","default","format","quality","fit","anchor","width","height",` +
+						`"without_enlargement","densities","widths","encoder_effort","encoder_lossless","encoder_chroma_subsampling",` +
+						`"watermark_id","watermark_anchor","watermark_opacity","watermark_margin","watermark_scale","project_id") VALUES ` +
+						`($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23),` +
+						`($24,$25,$26,$27,$28,$29,$30,$31,$32,$33,$34,$35,$36,$37,$38,$39,$40,$41,$42,$43,$44,$45,$46) ` +
 						`ON CONFLICT ("id") DO UPDATE SET "project_id"="excluded"."project_id"`).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
@@ -282,27 +325,31 @@ func TestProjectRepository_Update(t *testing.T) {
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectExec(
 					`INSERT INTO "presets" ` +
-						`("id","created_at","updated_at","name","default","format","quality","fit","anchor","width","height","project_id") VALUES ` +
-						`($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)`).
+						`("id","created_at","updated_at","name","default","format","quality","fit","anchor","width","height",` +
+						`"without_enlargement","densities","widths","encoder_effort","encoder_lossless","encoder_chroma_subsampling",` +
+						`"watermark_id","watermark_anchor","watermark_opacity","watermark_margin","watermark_scale","project_id") VALUES ` +
+						`($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23)`).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectExec(
 					`DELETE FROM "presets" WHERE "project_id" = $1 AND "id" NOT IN ($2,$3)`).
 					WithArgs("project-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectExec(
-					`UPDATE "projects" SET "name"=$1,"updated_at"=NOW() WHERE "id" = $2`).
+					`UPDATE "projects" SET "name"=$1,"updated_at"=NOW() WHERE "id" = $2 AND "projects"."deleted_at" IS NULL`).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "id" = $1 ORDER BY "projects"."id" LIMIT $2`).
+					`SELECT * FROM "projects" WHERE "id" = $1 AND "projects"."deleted_at" IS NULL ORDER BY "projects"."id" LIMIT $2`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-1"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectQuery(
 					`SELECT * FROM "presets" WHERE "presets"."project_id" = $1`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()).
 						AddRow("preset-1", time.Now(), time.Now(), "preset-1", false, images.FormatWebp,
-							images.Quality(90), images.FitCover, nil, 100, 100, "project-1").
+							images.Quality(90), images.FitCover, nil, 100, 100, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1").
 						AddRow("preset-2", time.Now(), time.Now(), "preset-2", false, images.FormatWebp,
-							images.Quality(90), images.FitCover, nil, 100, 100, "project-1"))
+							images.Quality(90), images.FitCover, nil, 100, 100, false,
+							nil, nil, nil, false, nil, nil, nil, nil, nil, nil, "project-1"))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -351,9 +398,12 @@ func TestProjectRepository_Delete(t *testing.T) {
 				tt.mock = mock
 
 				mock.ExpectBegin()
-				mock.ExpectExec(`DELETE FROM "projects" WHERE "id" = $1`).
-					WithArgs(tt.id).
+				mock.ExpectExec(`UPDATE "projects" SET "deleted_at"=$1 WHERE "id" = $2 AND "projects"."deleted_at" IS NULL`).
+					WithArgs(sqlmock.AnyArg(), tt.id).
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`UPDATE "images" SET "deleted_at"=$1 WHERE "project_id" = $2 AND "images"."deleted_at" IS NULL`).
+					WithArgs(sqlmock.AnyArg(), tt.id).
+					WillReturnResult(sqlmock.NewResult(1, 3))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -378,3 +428,98 @@ func TestProjectRepository_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectRepository_Restore(t *testing.T) {
+	type testSet struct {
+		name          string // description of this test case
+		transactioner *postgres.Transactioner
+		projectRepo   *postgres.ProjectRepository
+		mock          sqlmock.Sqlmock
+
+		id      string
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name: "normal case",
+			id:   "project-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.projectRepo = postgres.NewProjectRepository(postgresClient)
+				tt.mock = mock
+
+				deletedAt := time.Now()
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" WHERE "id" = $1 AND "deleted_at" IS NOT NULL `+
+						`ORDER BY "projects"."id" LIMIT $2`).
+					WithArgs(tt.id, 1).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
+						AddRow("project-1", time.Now(), time.Now(), deletedAt, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
+				mock.ExpectExec(`UPDATE "projects" SET "deleted_at"=$1 WHERE "id" = $2`).
+					WithArgs(nil, tt.id).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(
+					`UPDATE "images" SET "deleted_at"=$1 `+
+						`WHERE "project_id" = $2 AND "deleted_at" >= $3`).
+					WithArgs(nil, tt.id, deletedAt).
+					WillReturnResult(sqlmock.NewResult(1, 3))
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" WHERE "id" = $1 AND "projects"."deleted_at" IS NULL `+
+						`ORDER BY "projects"."id" LIMIT $2`).
+					WithArgs(tt.id, 1).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
+				mock.ExpectQuery(`SELECT * FROM "presets" WHERE "presets"."project_id" = $1`).
+					WithArgs(tt.id).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Preset]()))
+				mock.ExpectQuery(`SELECT COUNT(1) FROM "images" WHERE "project_id" = $1 AND "images"."deleted_at" IS NULL`).
+					WithArgs(tt.id).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "not deleted",
+			id:   "project-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.projectRepo = postgres.NewProjectRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" WHERE "id" = $1 AND "deleted_at" IS NOT NULL `+
+						`ORDER BY "projects"."id" LIMIT $2`).
+					WithArgs(tt.id, 1).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			err := tt.transactioner.WithTx(t.Context(), func(ctx context.Context) error {
+				_, err := tt.projectRepo.Restore(ctx, tt.id)
+				return err
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+type ServiceAccountAPIKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewServiceAccountAPIKeyRepository(client *Client) *ServiceAccountAPIKeyRepository {
+	return &ServiceAccountAPIKeyRepository{
+		db: client.db,
+	}
+}
+
+// List returns API keys of the service account in the order of creation.
+func (r *ServiceAccountAPIKeyRepository) List(ctx context.Context, serviceAccountID string,
+) ([]domain.ServiceAccountAPIKey, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ServiceAccountAPIKeyRepository.List",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	keys, err := gorm.G[entity.ServiceAccountAPIKey](tx).
+		Where(gen.ServiceAccountAPIKey.ServiceAccountID.Eq(serviceAccountID)).
+		Order(gen.ServiceAccountAPIKey.CreatedAt.Asc()).
+		Find(ctx)
+	if err != nil {
+		return nil, dbhelpers.WrapGORMError(err,
+			"Failed to list API keys of service account %s", serviceAccountID)
+	}
+
+	return lo.Map(keys, func(k entity.ServiceAccountAPIKey, _ int) domain.ServiceAccountAPIKey {
+		return k.ToDomain()
+	}), nil
+}
+
+func (r *ServiceAccountAPIKeyRepository) Create(ctx context.Context,
+	key domain.ServiceAccountAPIKey,
+) (domain.ServiceAccountAPIKey, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ServiceAccountAPIKeyRepository.Create",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	k := entity.NewServiceAccountAPIKey(key)
+	if err := gorm.G[entity.ServiceAccountAPIKey](tx).Create(ctx, &k); err != nil {
+		return domain.ServiceAccountAPIKey{}, dbhelpers.WrapGORMError(err,
+			"Failed to create API key of service account %s", key.ServiceAccountID)
+	}
+
+	return k.ToDomain(), nil
+}
+
+func (r *ServiceAccountAPIKeyRepository) MarkUsed(ctx context.Context, hash string,
+	usedAt time.Time,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ServiceAccountAPIKeyRepository.MarkUsed",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	_, err := gorm.G[entity.ServiceAccountAPIKey](tx).
+		Where(gen.ServiceAccountAPIKey.Hash.Eq(hash)).
+		Where(clause.Or(
+			gen.ServiceAccountAPIKey.LastUsedAt.IsNull(),
+			gen.ServiceAccountAPIKey.LastUsedAt.Lt(usedAt),
+		)).
+		Set(gen.ServiceAccountAPIKey.LastUsedAt.Set(usedAt)).
+		Update(ctx)
+	if err != nil {
+		return dbhelpers.WrapGORMError(err, "Failed to mark usage of API key")
+	}
+	return nil
+}
+
+func (r *ServiceAccountAPIKeyRepository) Delete(ctx context.Context, serviceAccountID, id string,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ServiceAccountAPIKeyRepository.Delete",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	rowsAffected, err := gorm.G[entity.ServiceAccountAPIKey](tx).
+		Where(gen.ServiceAccountAPIKey.ServiceAccountID.Eq(serviceAccountID)).
+		Where(gen.ServiceAccountAPIKey.ID.Eq(id)).
+		Delete(ctx)
+	if err != nil {
+		return dbhelpers.WrapGORMError(err, "Failed to delete API key %s of service account %s",
+			id, serviceAccountID)
+	}
+	if rowsAffected == 0 {
+		return apperr.NewError(apperr.CodeNotFound).
+			WithSummary("API key %s of service account %s not found", id, serviceAccountID)
+	}
+	return nil
+}
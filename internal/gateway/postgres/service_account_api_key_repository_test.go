@@ -0,0 +1,93 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/postgres"
+)
+
+func TestServiceAccountAPIKeyRepository_MarkUsed(t *testing.T) {
+	postgresClient, _, mock := postgres.NewClientWithMock(t)
+	apiKeyRepo := postgres.NewServiceAccountAPIKeyRepository(postgresClient)
+
+	usedAt := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(
+		`UPDATE "service_account_api_keys" SET "last_used_at"=$1 WHERE "hash" = $2 AND `+
+			`("last_used_at" IS NULL OR "last_used_at" < $3)`).
+		WithArgs(usedAt, "test-hash-1", usedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := apiKeyRepo.MarkUsed(t.Context(), "test-hash-1", usedAt)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestServiceAccountAPIKeyRepository_Delete(t *testing.T) {
+	type testSet struct {
+		name       string // description of this test case
+		apiKeyRepo *postgres.ServiceAccountAPIKeyRepository
+		mock       sqlmock.Sqlmock
+
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name: "normal case",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.apiKeyRepo = postgres.NewServiceAccountAPIKeyRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`DELETE FROM "service_account_api_keys" WHERE "service_account_id" = $1 AND "id" = $2`).
+					WithArgs("account-1", "key-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.apiKeyRepo = postgres.NewServiceAccountAPIKeyRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`DELETE FROM "service_account_api_keys" WHERE "service_account_id" = $1 AND "id" = $2`).
+					WithArgs("account-1", "key-1").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			err := tt.apiKeyRepo.Delete(t.Context(), "account-1", "key-1")
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
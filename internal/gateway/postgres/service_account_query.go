@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
@@ -16,6 +18,33 @@ func applyServiceAccountSearchFilter(
 	if filter.Name != nil {
 		q = q.Where(gen.ServiceAccount.Name.Eq(*filter.Name))
 	}
+	if filter.Active {
+		q = q.Where(clause.Or(
+			gen.ServiceAccount.ExpireAt.IsNull(),
+			gen.ServiceAccount.ExpireAt.Gt(time.Now()),
+		))
+		q = q.Where(gen.ServiceAccount.DisabledAt.IsNull())
+	}
+	if filter.UnusedBefore != nil {
+		q = q.Where(clause.Expr{
+			SQL: "COALESCE(?, ?) < ?",
+			Vars: []any{
+				gen.ServiceAccount.LastUsedAt.Column(),
+				gen.ServiceAccount.CreatedAt.Column(),
+				*filter.UnusedBefore,
+			},
+		})
+	}
+	if filter.StaleWarned != nil {
+		if *filter.StaleWarned {
+			q = q.Where(gen.ServiceAccount.StaleWarnedAt.IsNotNull())
+		} else {
+			q = q.Where(gen.ServiceAccount.StaleWarnedAt.IsNull())
+		}
+	}
+	if filter.StaleWarnedBefore != nil {
+		q = q.Where(gen.ServiceAccount.StaleWarnedAt.Lt(*filter.StaleWarnedBefore))
+	}
 	return q
 }
 
@@ -51,9 +80,21 @@ func buildServiceAccountUpdateAssigners(req domain.UpdateServiceAccountRequest)
 	if req.AccessScope != nil {
 		assigners = append(assigners, gen.ServiceAccount.AccessScope.Set(*req.AccessScope))
 	}
+	if req.Permissions != nil {
+		assigners = append(assigners,
+			gen.ServiceAccount.Permissions.Set(entity.ServiceAccountPermissions(req.Permissions)))
+	}
 	if req.ExpireAt != nil {
 		assigners = append(assigners, gen.ServiceAccount.ExpireAt.Set(*req.ExpireAt))
 	}
+	if req.Enable {
+		// Warnings are cleared as well, so that accounts still unused are warned
+		// again ahead of being disabled.
+		assigners = append(assigners,
+			gen.ServiceAccount.DisabledAt.SetExpr(gorm.Expr("NULL")),
+			gen.ServiceAccount.DisabledReason.Set(""),
+			gen.ServiceAccount.StaleWarnedAt.SetExpr(gorm.Expr("NULL")))
+	}
 
 	if len(assigners) > 0 {
 		assigners = append(assigners, gen.ServiceAccount.UpdatedAt.Now())
@@ -2,15 +2,18 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
 	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
 	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/serviceaccounts"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
 
@@ -55,7 +58,11 @@ func (r *ServiceAccountRepository) FindByAPIKeyHash(ctx context.Context, hash st
 	tx := GetTxOrDB(ctx, r.db)
 
 	sa, err := gorm.G[entity.ServiceAccount](tx).
-		Where(gen.ServiceAccount.APIKeyHash.Eq(hash)).
+		Where(clause.Expr{
+			SQL: "? IN (SELECT service_account_id FROM service_account_api_keys " +
+				"WHERE hash = ? AND (expire_at IS NULL OR expire_at > ?))",
+			Vars: []any{gen.ServiceAccount.ID.Column(), hash, time.Now()},
+		}).
 		Preload(gen.ServiceAccount.Projects.Name(), nil).
 		First(ctx)
 	if err != nil {
@@ -224,3 +231,75 @@ func (r *ServiceAccountRepository) Delete(ctx context.Context, id string) error
 	}
 	return nil
 }
+
+func (r *ServiceAccountRepository) MarkUsed(ctx context.Context, usage domain.ServiceAccountUsage,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ServiceAccountRepository.MarkUsed",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	_, err := gorm.G[entity.ServiceAccount](tx).
+		Where(gen.ServiceAccount.ID.Eq(usage.ServiceAccountID)).
+		Where(clause.Or(
+			gen.ServiceAccount.LastUsedAt.IsNull(),
+			gen.ServiceAccount.LastUsedAt.Lt(usage.UsedAt),
+		)).
+		Set(
+			gen.ServiceAccount.LastUsedAt.Set(usage.UsedAt),
+			gen.ServiceAccount.LastUsedIP.Set(usage.RemoteIP),
+			gen.ServiceAccount.StaleWarnedAt.SetExpr(gorm.Expr("NULL")),
+		).
+		Update(ctx)
+	if err != nil {
+		return dbhelpers.WrapGORMError(err,
+			"Failed to mark usage of service account %s", usage.ServiceAccountID)
+	}
+	return nil
+}
+
+func (r *ServiceAccountRepository) MarkStaleWarned(ctx context.Context, id string,
+	warnedAt time.Time,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ServiceAccountRepository.MarkStaleWarned",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	_, err := gorm.G[entity.ServiceAccount](tx).
+		Where(gen.ServiceAccount.ID.Eq(id)).
+		Set(gen.ServiceAccount.StaleWarnedAt.Set(warnedAt)).
+		Update(ctx)
+	if err != nil {
+		return dbhelpers.WrapGORMError(err, "Failed to mark stale warning of service account %s", id)
+	}
+	return nil
+}
+
+func (r *ServiceAccountRepository) Disable(ctx context.Context, id string, disabledAt time.Time,
+	reason serviceaccounts.DisabledReason,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "postgres.ServiceAccountRepository.Disable",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	_, err := gorm.G[entity.ServiceAccount](tx).
+		Where(gen.ServiceAccount.ID.Eq(id)).
+		Set(
+			gen.ServiceAccount.DisabledAt.Set(disabledAt),
+			gen.ServiceAccount.DisabledReason.Set(reason),
+			gen.ServiceAccount.UpdatedAt.Now(),
+		).
+		Update(ctx)
+	if err != nil {
+		return dbhelpers.WrapGORMError(err, "Failed to disable service account %s", id)
+	}
+	return nil
+}
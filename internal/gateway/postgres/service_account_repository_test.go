@@ -42,7 +42,7 @@ func TestServiceAccountRepository_FindByID(t *testing.T) {
 					`SELECT * FROM "service_accounts" WHERE "id" = $1 ORDER BY "service_accounts"."id" LIMIT $2`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ServiceAccount]()).
 						AddRow("account-1", time.Now(), time.Now(), "account-name-1",
-							serviceaccounts.AccessScopeFull, time.Now(), "test-api-key"))
+							serviceaccounts.AccessScopeFull, `["images:read"]`, time.Now(), nil, "", nil, nil, ""))
 				mock.ExpectQuery(
 					`SELECT * FROM "service_account_projects" WHERE ` +
 						`"service_account_projects"."service_account_id" = $1`).
@@ -50,10 +50,10 @@ func TestServiceAccountRepository_FindByID(t *testing.T) {
 						AddRow("account-1", "project-1").
 						AddRow("account-1", "project-2"))
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2)`).
+					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2) AND "projects"."deleted_at" IS NULL`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-name-1").
-						AddRow("project-2", time.Now(), time.Now(), "project-name-2"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-name-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0).
+						AddRow("project-2", time.Now(), time.Now(), nil, "project-name-2", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -103,11 +103,14 @@ func TestServiceAccountRepository_FindByAPIKeyHash(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT * FROM "service_accounts" WHERE "api_key_hash" = $1 ` +
-						`ORDER BY "service_accounts"."id" LIMIT $2`).
+					`SELECT * FROM "service_accounts" WHERE "id" IN (SELECT service_account_id `+
+						`FROM service_account_api_keys WHERE hash = $1 AND `+
+						`(expire_at IS NULL OR expire_at > $2)) `+
+						`ORDER BY "service_accounts"."id" LIMIT $3`).
+					WithArgs("test-hash-1", sqlmock.AnyArg(), 1).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ServiceAccount]()).
 						AddRow("account-1", time.Now(), time.Now(), "account-name-1",
-							serviceaccounts.AccessScopeFull, time.Now(), "test-api-key"))
+							serviceaccounts.AccessScopeFull, `["images:read"]`, time.Now(), nil, "", nil, nil, ""))
 				mock.ExpectQuery(
 					`SELECT * FROM "service_account_projects" WHERE ` +
 						`"service_account_projects"."service_account_id" = $1`).
@@ -115,10 +118,10 @@ func TestServiceAccountRepository_FindByAPIKeyHash(t *testing.T) {
 						AddRow("account-1", "project-1").
 						AddRow("account-1", "project-2"))
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2)`).
+					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2) AND "projects"."deleted_at" IS NULL`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-name-1").
-						AddRow("project-2", time.Now(), time.Now(), "project-name-2"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-name-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0).
+						AddRow("project-2", time.Now(), time.Now(), nil, "project-name-2", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -183,7 +186,7 @@ func TestServiceAccountRepository_List(t *testing.T) {
 					WithArgs("account-1", 20, 20).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ServiceAccount]()).
 						AddRow("account-1", time.Now(), time.Now(), "account-name-1",
-							serviceaccounts.AccessScopeFull, time.Now(), "test-api-key"))
+							serviceaccounts.AccessScopeFull, `["images:read"]`, time.Now(), nil, "", nil, nil, ""))
 				mock.ExpectQuery(
 					`SELECT * FROM "service_account_projects" WHERE ` +
 						`"service_account_projects"."service_account_id" = $1`).
@@ -191,10 +194,10 @@ func TestServiceAccountRepository_List(t *testing.T) {
 						AddRow("account-1", "project-1").
 						AddRow("account-1", "project-2"))
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2)`).
+					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2) AND "projects"."deleted_at" IS NULL`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-name-1").
-						AddRow("project-2", time.Now(), time.Now(), "project-name-2"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-name-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0).
+						AddRow("project-2", time.Now(), time.Now(), nil, "project-name-2", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectQuery(
 					`SELECT COUNT(1) FROM "service_accounts" WHERE "name" = $1`).
 					WithArgs(tt.req.SearchFilter.Name).
@@ -243,7 +246,7 @@ func TestServiceAccountRepository_Create(t *testing.T) {
 				ExpireAt:    new(time.Now().Add(24 * time.Hour)),
 				Name:        "account-name-1",
 				AccessScope: serviceaccounts.AccessScopeFull,
-				APIKeyHash:  "test-api-key",
+				Permissions: []serviceaccounts.Permission{serviceaccounts.PermissionImagesRead},
 				Projects: []domain.ProjectReference{
 					{ID: "project-1"},
 					{ID: "project-2"},
@@ -258,8 +261,10 @@ func TestServiceAccountRepository_Create(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectExec(
 					`INSERT INTO "service_accounts" ` +
-						`("id","created_at","updated_at","name","access_scope","expire_at","api_key_hash") VALUES ` +
-						`($1,$2,$3,$4,$5,$6,$7)`).
+						`("id","created_at","updated_at","name","access_scope","permissions","expire_at",` +
+						`"last_used_at","last_used_ip","stale_warned_at","disabled_at","disabled_reason") ` +
+						`VALUES ` +
+						`($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)`).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectExec(`INSERT INTO "service_account_projects" `+
 					`("service_account_id","project_id") VALUES `+
@@ -270,7 +275,7 @@ func TestServiceAccountRepository_Create(t *testing.T) {
 					`SELECT * FROM "service_accounts" WHERE "id" = $1 ORDER BY "service_accounts"."id" LIMIT $2`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ServiceAccount]()).
 						AddRow("account-1", time.Now(), time.Now(),
-							tt.req.Name, tt.req.AccessScope, tt.req.ExpireAt, tt.req.APIKeyHash))
+							tt.req.Name, tt.req.AccessScope, `["images:read"]`, tt.req.ExpireAt, nil, "", nil, nil, ""))
 				mock.ExpectQuery(
 					`SELECT * FROM "service_account_projects" WHERE ` +
 						`"service_account_projects"."service_account_id" = $1`).
@@ -278,10 +283,10 @@ func TestServiceAccountRepository_Create(t *testing.T) {
 						AddRow("account-1", "project-1").
 						AddRow("account-1", "project-2"))
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2)`).
+					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2) AND "projects"."deleted_at" IS NULL`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-name-1").
-						AddRow("project-2", time.Now(), time.Now(), "project-name-2"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-name-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0).
+						AddRow("project-2", time.Now(), time.Now(), nil, "project-name-2", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -326,6 +331,7 @@ func TestServiceAccountRepository_Update(t *testing.T) {
 				ID:          "account-1",
 				Name:        new("account-name-1"),
 				AccessScope: new(serviceaccounts.AccessScopeProject),
+				Permissions: []serviceaccounts.Permission{serviceaccounts.PermissionImagesRead},
 				ProjectIDs: []string{
 					"project-1",
 					"project-2",
@@ -341,7 +347,8 @@ func TestServiceAccountRepository_Update(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectExec(
 					`UPDATE "service_accounts" SET ` +
-						`"name"=$1,"access_scope"=$2,"expire_at"=$3,"updated_at"=NOW() WHERE "id" = $4`).
+						`"name"=$1,"access_scope"=$2,"permissions"=$3,"expire_at"=$4,"updated_at"=NOW() ` +
+						`WHERE "id" = $5`).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectExec(
 					`DELETE FROM "service_account_projects" WHERE "service_account_id" = $1`).
@@ -354,7 +361,7 @@ func TestServiceAccountRepository_Update(t *testing.T) {
 					`SELECT * FROM "service_accounts" WHERE "id" = $1 ORDER BY "service_accounts"."id" LIMIT $2`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.ServiceAccount]()).
 						AddRow("account-1", time.Now(), time.Now(),
-							tt.req.Name, tt.req.AccessScope, tt.req.ExpireAt, "test-api-key"))
+							tt.req.Name, tt.req.AccessScope, `["images:read"]`, tt.req.ExpireAt, nil, "", nil, nil, ""))
 				mock.ExpectQuery(
 					`SELECT * FROM "service_account_projects" WHERE ` +
 						`"service_account_projects"."service_account_id" = $1`).
@@ -362,10 +369,10 @@ func TestServiceAccountRepository_Update(t *testing.T) {
 						AddRow("account-1", "project-1").
 						AddRow("account-1", "project-2"))
 				mock.ExpectQuery(
-					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2)`).
+					`SELECT * FROM "projects" WHERE "projects"."id" IN ($1,$2) AND "projects"."deleted_at" IS NULL`).
 					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
-						AddRow("project-1", time.Now(), time.Now(), "project-name-1").
-						AddRow("project-2", time.Now(), time.Now(), "project-name-2"))
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-name-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0).
+						AddRow("project-2", time.Now(), time.Now(), nil, "project-name-2", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
 				mock.ExpectCommit()
 			},
 			wantErr: false,
@@ -441,3 +448,51 @@ func TestServiceAccountRepository_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceAccountRepository_MarkUsed(t *testing.T) {
+	postgresClient, _, mock := postgres.NewClientWithMock(t)
+	serviceAccountRepo := postgres.NewServiceAccountRepository(postgresClient)
+
+	usage := domain.ServiceAccountUsage{
+		ServiceAccountID: "account-1",
+		APIKeyHash:       "test-hash-1",
+		UsedAt:           time.Now(),
+		RemoteIP:         "203.0.113.7",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(
+		`UPDATE "service_accounts" SET "last_used_at"=$1,"last_used_ip"=$2,"stale_warned_at"=NULL `+
+			`WHERE "id" = $3 AND ("last_used_at" IS NULL OR "last_used_at" < $4)`).
+		WithArgs(usage.UsedAt, usage.RemoteIP, "account-1", usage.UsedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := serviceAccountRepo.MarkUsed(t.Context(), usage)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestServiceAccountRepository_Disable(t *testing.T) {
+	postgresClient, _, mock := postgres.NewClientWithMock(t)
+	serviceAccountRepo := postgres.NewServiceAccountRepository(postgresClient)
+
+	disabledAt := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(
+		`UPDATE "service_accounts" SET "disabled_at"=$1,"disabled_reason"=$2,"updated_at"=NOW() `+
+			`WHERE "id" = $3`).
+		WithArgs(disabledAt, serviceaccounts.DisabledReasonUnused, "account-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := serviceAccountRepo.Disable(t.Context(), "account-1", disabledAt,
+		serviceaccounts.DisabledReasonUnused)
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+)
+
+func applyUserSearchFilter(
+	q gorm.ChainInterface[entity.User], filter domain.UserSearchFilter,
+) gorm.ChainInterface[entity.User] {
+	if filter.Keyword != nil {
+		pattern := likeContains(*filter.Keyword)
+		q = q.Where(clause.Or(
+			gen.User.Nickname.ILike(pattern),
+			gen.User.Email.ILike(pattern),
+		))
+	}
+	if filter.Role != nil {
+		q = q.Where(gen.User.Role.Eq(*filter.Role))
+	}
+	if filter.Suspended != nil {
+		if *filter.Suspended {
+			q = q.Where(gen.User.SuspendedAt.IsNotNull())
+		} else {
+			q = q.Where(gen.User.SuspendedAt.IsNull())
+		}
+	}
+	return q
+}
+
+func applyUserSortFilter(
+	q gorm.ChainInterface[entity.User], filter domain.UserSortFilter,
+) gorm.ChainInterface[entity.User] {
+	switch {
+	case filter.CreatedAt:
+		order := gen.User.CreatedAt.Desc()
+		if filter.Direction == dbhelpers.SortDirectionAsc {
+			order = gen.User.CreatedAt.Asc()
+		}
+		q = q.Order(order)
+
+	case filter.UpdatedAt:
+		fallthrough
+
+	default:
+		order := gen.User.UpdatedAt.Desc()
+		if filter.Direction == dbhelpers.SortDirectionAsc {
+			order = gen.User.UpdatedAt.Asc()
+		}
+		q = q.Order(order)
+	}
+
+	return q
+}
+
+func buildUserUpdateAssigners(req domain.UpdateUserRequest) []clause.Assigner {
+	var assigners []clause.Assigner
+	if req.Role != nil {
+		assigners = append(assigners, gen.User.Role.Set(*req.Role))
+	}
+	if req.Suspended != nil {
+		if *req.Suspended {
+			assigners = append(assigners, gen.User.SuspendedAt.Now())
+		} else {
+			assigners = append(assigners, gen.User.SuspendedAt.SetExpr(gorm.Expr("NULL")))
+		}
+	}
+
+	if len(assigners) > 0 {
+		assigners = append(assigners, gen.User.UpdatedAt.Now())
+	}
+	return assigners
+}
@@ -2,7 +2,9 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -42,6 +44,59 @@ func (r *UserRepository) FindByID(ctx context.Context, id string) (domain.User,
 	return user.ToDomain(), nil
 }
 
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (domain.User, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.UserRepository.FindByEmail",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	user, err := gorm.G[entity.User](tx).
+		Where(gen.User.Email.Eq(email)).
+		First(ctx)
+	if err != nil {
+		return domain.User{}, dbhelpers.WrapGORMError(err, "Failed to find user by email %s", email)
+	}
+
+	return user.ToDomain(), nil
+}
+
+func (r *UserRepository) List(ctx context.Context, params domain.ListUsersParams,
+) (domain.Users, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.UserRepository.List",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	// Fetch users
+	q := gorm.G[entity.User](tx).Scopes()
+	q = applyUserSearchFilter(q, params.SearchFilter)
+	q = applyUserSortFilter(q, params.SortFilter)
+	q = applyPagination(q, params.LimitOrDefault(), params.OffsetOrDefault())
+	users, err := q.Find(ctx)
+	if err != nil {
+		return domain.Users{}, dbhelpers.WrapGORMError(err, "Failed to list users")
+	}
+
+	// Fetch total count
+	q = gorm.G[entity.User](tx).Scopes()
+	q = applyUserSearchFilter(q, params.SearchFilter)
+	count, err := q.Count(ctx, "COUNT(1)")
+	if err != nil {
+		return domain.Users{}, dbhelpers.WrapGORMError(err, "Failed to count users")
+	}
+
+	return domain.Users{
+		Items: lo.Map(users, func(u entity.User, _ int) domain.User {
+			return u.ToDomain()
+		}),
+		Total: count,
+	}, nil
+}
+
 func (r *UserRepository) Upsert(ctx context.Context, user domain.User) (domain.User, error) {
 	ctx, span := tracing.StartSpan(ctx, "postgres.UserRepository.Upsert",
 		trace.WithSpanKind(trace.SpanKindClient),
@@ -59,6 +114,7 @@ func (r *UserRepository) Upsert(ctx context.Context, user domain.User) (domain.U
 				gen.User.Nickname.Column().Name,
 				gen.User.Email.Column().Name,
 				gen.User.PhotoURL.Column().Name,
+				gen.User.IDTokenClaims.Column().Name,
 			}),
 		}).
 		Create(ctx, &usr); err != nil {
@@ -74,3 +130,31 @@ func (r *UserRepository) Upsert(ctx context.Context, user domain.User) (domain.U
 
 	return usr.ToDomain(), nil
 }
+
+func (r *UserRepository) Update(ctx context.Context, req domain.UpdateUserRequest,
+) (domain.User, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.UserRepository.Update",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	assigners := buildUserUpdateAssigners(req)
+	if len(assigners) > 0 {
+		_, err := gorm.G[entity.User](tx).
+			Where(gen.User.ID.Eq(req.ID)).
+			Set(assigners...).
+			Update(ctx)
+		if err != nil {
+			return domain.User{}, dbhelpers.WrapGORMError(err, "Failed to update user %s", req.ID)
+		}
+	}
+
+	user, err := r.FindByID(ctx, req.ID)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("finding user: %w", err)
+	}
+
+	return user, nil
+}
@@ -21,19 +21,20 @@ func TestUserRepository_Upsert(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(
-		`INSERT INTO "users" ("id","created_at","updated_at","role","nickname","email","photo_url") `+
-			`VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT ("email") `+
+		`INSERT INTO "users" ("id","created_at","updated_at","role","nickname","email","photo_url","suspended_at","id_token_claims") `+
+			`VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) ON CONFLICT ("email") `+
 			`DO UPDATE SET `+
 			`"updated_at"="excluded"."updated_at",`+
 			`"nickname"="excluded"."nickname",`+
 			`"email"="excluded"."email",`+
-			`"photo_url"="excluded"."photo_url"`).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			`"photo_url"="excluded"."photo_url",`+
+			`"id_token_claims"="excluded"."id_token_claims"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectQuery(
 		`SELECT * FROM "users" WHERE "email" = $1 ORDER BY "users"."id" LIMIT $2`).
 		WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.User]()).
-			AddRow("user-1", time.Now(), time.Now(), users.RoleGuest, "nickname-1", "email-1", "photo-url-1"))
+			AddRow("user-1", time.Now(), time.Now(), users.RoleGuest, "nickname-1", "email-1", "photo-url-1", nil, nil))
 	mock.ExpectCommit()
 
 	err := transactioner.WithTx(t.Context(), func(ctx context.Context) error {
@@ -45,3 +46,117 @@ func TestUserRepository_Upsert(t *testing.T) {
 	err = mock.ExpectationsWereMet()
 	require.NoError(t, err)
 }
+
+func TestUserRepository_List(t *testing.T) {
+	postgresClient, _, mock := postgres.NewClientWithMock(t)
+	userRepo := postgres.NewUserRepository(postgresClient)
+
+	mock.ExpectQuery(
+		`SELECT * FROM "users" WHERE ("nickname" ILIKE $1 OR "email" ILIKE $2) `+
+			`AND "role" = $3 AND "suspended_at" IS NOT NULL `+
+			`ORDER BY "updated_at" DESC LIMIT $4`).
+		WithArgs(`%john\_%`, `%john\_%`, users.RoleAdmin, 20).
+		WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.User]()).
+			AddRow("user-1", time.Now(), time.Now(), users.RoleAdmin, "john_doe", "email-1",
+				"photo-url-1", time.Now(), nil))
+	mock.ExpectQuery(
+		`SELECT COUNT(1) FROM "users" WHERE ("nickname" ILIKE $1 OR "email" ILIKE $2) `+
+			`AND "role" = $3 AND "suspended_at" IS NOT NULL`).
+		WithArgs(`%john\_%`, `%john\_%`, users.RoleAdmin).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+
+	_, err := userRepo.List(t.Context(), domain.ListUsersParams{
+		SearchFilter: domain.UserSearchFilter{
+			Keyword:   new("john_"),
+			Role:      new(users.RoleAdmin),
+			Suspended: new(true),
+		},
+	})
+	require.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	require.NoError(t, err)
+}
+
+func TestUserRepository_Update(t *testing.T) {
+	type testSet struct {
+		name     string // description of this test case
+		userRepo *postgres.UserRepository
+		mock     sqlmock.Sqlmock
+
+		req     domain.UpdateUserRequest
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name: "suspend",
+			req: domain.UpdateUserRequest{
+				ID:        "user-1",
+				Suspended: new(true),
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.userRepo = postgres.NewUserRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`UPDATE "users" SET "suspended_at"=NOW(),"updated_at"=NOW() WHERE "id" = $1`).
+					WithArgs("user-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				mock.ExpectQuery(
+					`SELECT * FROM "users" WHERE "id" = $1 ORDER BY "users"."id" LIMIT $2`).
+					WithArgs("user-1", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.User]()).
+						AddRow("user-1", time.Now(), time.Now(), users.RoleGuest, "nickname-1",
+							"email-1", "photo-url-1", time.Now(), nil))
+			},
+			wantErr: false,
+		},
+		{
+			name: "unsuspend and change role",
+			req: domain.UpdateUserRequest{
+				ID:        "user-1",
+				Role:      new(users.RoleAdmin),
+				Suspended: new(false),
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, _, mock := postgres.NewClientWithMock(t)
+				tt.userRepo = postgres.NewUserRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`UPDATE "users" SET "role"=$1,"suspended_at"=NULL,"updated_at"=NOW() WHERE "id" = $2`).
+					WithArgs(users.RoleAdmin, "user-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				mock.ExpectQuery(
+					`SELECT * FROM "users" WHERE "id" = $1 ORDER BY "users"."id" LIMIT $2`).
+					WithArgs("user-1", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.User]()).
+						AddRow("user-1", time.Now(), time.Now(), users.RoleAdmin, "nickname-1",
+							"email-1", "photo-url-1", nil, nil))
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			_, err := tt.userRepo.Update(t.Context(), tt.req)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+)
+
+func applyWatermarkSearchFilter(
+	q gorm.ChainInterface[entity.Watermark], filter domain.WatermarkSearchFilter,
+) gorm.ChainInterface[entity.Watermark] {
+	if filter.ProjectID != nil {
+		q = q.Where(gen.Watermark.ProjectID.Eq(*filter.ProjectID))
+	}
+	if len(filter.IDs) > 0 {
+		q = q.Where(gen.Watermark.ID.In(filter.IDs...))
+	}
+	return q
+}
+
+func buildWatermarkUpdateAssigners(req domain.UpdateWatermarkRequest) []clause.Assigner {
+	var assigners []clause.Assigner
+	if req.State != nil {
+		assigners = append(assigners, gen.Watermark.State.Set(*req.State))
+	}
+
+	if len(assigners) > 0 {
+		assigners = append(assigners, gen.Watermark.UpdatedAt.Now())
+	}
+	return assigners
+}
+
+func applyWatermarkSortFilter(
+	q gorm.ChainInterface[entity.Watermark], filter domain.WatermarkSortFilter,
+) gorm.ChainInterface[entity.Watermark] {
+	switch {
+	case filter.CreatedAt:
+		order := gen.Watermark.CreatedAt.Desc()
+		if filter.Direction == dbhelpers.SortDirectionAsc {
+			order = gen.Watermark.CreatedAt.Asc()
+		}
+		q = q.Order(order)
+
+	case filter.UpdatedAt:
+		fallthrough
+
+	default:
+		order := gen.Watermark.UpdatedAt.Desc()
+		if filter.Direction == dbhelpers.SortDirectionAsc {
+			order = gen.Watermark.UpdatedAt.Asc()
+		}
+		q = q.Order(order)
+	}
+
+	return q
+}
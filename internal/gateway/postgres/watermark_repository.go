@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity/gen"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+type WatermarkRepository struct {
+	db *gorm.DB
+}
+
+func NewWatermarkRepository(client *Client) *WatermarkRepository {
+	return &WatermarkRepository{
+		db: client.db,
+	}
+}
+
+func (r *WatermarkRepository) FindByID(ctx context.Context, id string) (domain.Watermark, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.WatermarkRepository.FindByID",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	watermark, err := r.get(ctx, tx, id)
+	if err != nil {
+		return domain.Watermark{}, fmt.Errorf("getting watermark: %w", err)
+	}
+
+	return watermark.ToDomain(), nil
+}
+
+func (r *WatermarkRepository) List(ctx context.Context, params domain.ListWatermarksParams,
+) (domain.Watermarks, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.WatermarkRepository.List",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	// Fetch watermarks
+	q := gorm.G[entity.Watermark](tx).Scopes()
+	q = applyWatermarkSearchFilter(q, params.SearchFilter)
+	q = applyWatermarkSortFilter(q, params.SortFilter)
+	q = applyPagination(q, params.LimitOrDefault(), params.OffsetOrDefault())
+	watermarks, err := q.
+		Preload(gen.Watermark.Project.Name(), nil).
+		Find(ctx)
+	if err != nil {
+		return domain.Watermarks{}, dbhelpers.WrapGORMError(err, "Failed to list watermarks")
+	}
+
+	// Fetch total count
+	q = gorm.G[entity.Watermark](tx).Scopes()
+	q = applyWatermarkSearchFilter(q, params.SearchFilter)
+	totalCount, err := q.Count(ctx, "COUNT(1)")
+	if err != nil {
+		return domain.Watermarks{}, dbhelpers.WrapGORMError(err, "Failed to count watermarks")
+	}
+
+	return domain.Watermarks{
+		Items: lo.Map(watermarks, func(w entity.Watermark, _ int) domain.Watermark {
+			return w.ToDomain()
+		}),
+		Total: totalCount,
+	}, nil
+}
+
+func (r *WatermarkRepository) Create(ctx context.Context, watermark domain.Watermark,
+) (domain.Watermark, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.WatermarkRepository.Create",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	wm := entity.NewWatermark(watermark)
+	if err := gorm.G[entity.Watermark](tx).Create(ctx, &wm); err != nil {
+		return domain.Watermark{}, dbhelpers.WrapGORMError(err, "Failed to create watermark")
+	}
+
+	wm, err := r.get(ctx, tx, wm.ID)
+	if err != nil {
+		return domain.Watermark{}, fmt.Errorf("getting watermark: %w", err)
+	}
+
+	return wm.ToDomain(), nil
+}
+
+func (r *WatermarkRepository) Update(ctx context.Context, req domain.UpdateWatermarkRequest,
+) (domain.Watermark, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.WatermarkRepository.Update",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	assigners := buildWatermarkUpdateAssigners(req)
+	_, err := gorm.G[entity.Watermark](tx).
+		Where(gen.Watermark.ID.Eq(req.ID)).
+		Set(assigners...).
+		Update(ctx)
+	if err != nil {
+		return domain.Watermark{}, dbhelpers.WrapGORMError(err, "Failed to update watermark %s",
+			req.ID)
+	}
+
+	wm, err := r.get(ctx, tx, req.ID)
+	if err != nil {
+		return domain.Watermark{}, fmt.Errorf("getting watermark: %w", err)
+	}
+
+	return wm.ToDomain(), nil
+}
+
+func (r *WatermarkRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := tracing.StartSpan(ctx, "postgres.WatermarkRepository.Delete",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	tx := GetTxOrDB(ctx, r.db)
+
+	if _, err := gorm.G[entity.Watermark](tx).
+		Where(gen.Watermark.ID.Eq(id)).
+		Delete(ctx); err != nil {
+		return dbhelpers.WrapGORMError(err, "Failed to delete watermark %s", id)
+	}
+	return nil
+}
+
+func (r *WatermarkRepository) get(ctx context.Context, tx *gorm.DB, id string,
+) (entity.Watermark, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres.WatermarkRepository.get",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServicePostgres))
+	defer span.End()
+
+	wm, err := gorm.G[entity.Watermark](tx).
+		Where(gen.Watermark.ID.Eq(id)).
+		Preload(gen.Watermark.Project.Name(), nil).
+		First(ctx)
+	if err != nil {
+		return entity.Watermark{}, dbhelpers.WrapGORMError(err, "Failed to find watermark %s", id)
+	}
+	return wm, nil
+}
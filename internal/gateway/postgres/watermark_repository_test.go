@@ -0,0 +1,218 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/postgres"
+	"github.com/isutare412/imageer/internal/gateway/postgres/entity"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+func TestWatermarkRepository_FindByID(t *testing.T) {
+	type testSet struct {
+		name          string // description of this test case
+		transactioner *postgres.Transactioner
+		watermarkRepo *postgres.WatermarkRepository
+		mock          sqlmock.Sqlmock
+
+		id      string
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name: "normal case",
+			id:   "watermark-1",
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.watermarkRepo = postgres.NewWatermarkRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					`SELECT * FROM "watermarks" WHERE "id" = $1 ORDER BY "watermarks"."id" LIMIT $2`).
+					WithArgs("watermark-1", 1).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Watermark]()).
+						AddRow("watermark-1", time.Now(), time.Now(), "logo", images.FormatPNG,
+							"s3-key-1", "url-1", images.WatermarkStateReady, "project-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
+					WithArgs("project-1").
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-name-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			err := tt.transactioner.WithTx(t.Context(), func(ctx context.Context) error {
+				_, err := tt.watermarkRepo.FindByID(ctx, tt.id)
+				return err
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWatermarkRepository_List(t *testing.T) {
+	type testSet struct {
+		name          string // description of this test case
+		transactioner *postgres.Transactioner
+		watermarkRepo *postgres.WatermarkRepository
+		mock          sqlmock.Sqlmock
+
+		req     domain.ListWatermarksParams
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name: "normal case",
+			req: domain.ListWatermarksParams{
+				Offset: new(0),
+				Limit:  new(20),
+				SearchFilter: domain.WatermarkSearchFilter{
+					ProjectID: new("project-1"),
+					IDs:       []string{"watermark-1", "watermark-2"},
+				},
+				SortFilter: domain.WatermarkSortFilter{
+					CreatedAt: true,
+					Direction: dbhelpers.SortDirectionDesc,
+				},
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.watermarkRepo = postgres.NewWatermarkRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					`SELECT * FROM "watermarks" WHERE "project_id" = $1 AND "id" IN ($2,$3) `+
+						`ORDER BY "created_at" DESC LIMIT $4`).
+					WithArgs("project-1", "watermark-1", "watermark-2", 20).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Watermark]()).
+						AddRow("watermark-1", time.Now(), time.Now(), "logo", images.FormatPNG,
+							"s3-key-1", "url-1", images.WatermarkStateReady, "project-1").
+						AddRow("watermark-2", time.Now(), time.Now(), "badge", images.FormatWebp,
+							"s3-key-2", "url-2", images.WatermarkStateReady, "project-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
+					WithArgs("project-1").
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-name-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
+				mock.ExpectQuery(
+					`SELECT COUNT(1) FROM "watermarks" WHERE "project_id" = $1 AND "id" IN ($2,$3)`).
+					WithArgs("project-1", "watermark-1", "watermark-2").
+					WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(2))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			err := tt.transactioner.WithTx(t.Context(), func(ctx context.Context) error {
+				_, err := tt.watermarkRepo.List(ctx, tt.req)
+				return err
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWatermarkRepository_Update(t *testing.T) {
+	type testSet struct {
+		name          string // description of this test case
+		transactioner *postgres.Transactioner
+		watermarkRepo *postgres.WatermarkRepository
+		mock          sqlmock.Sqlmock
+
+		req     domain.UpdateWatermarkRequest
+		setup   func(t *testing.T, tt *testSet)
+		wantErr bool
+	}
+
+	tests := []testSet{
+		{
+			name: "normal case",
+			req: domain.UpdateWatermarkRequest{
+				ID:    "watermark-1",
+				State: new(images.WatermarkStateReady),
+			},
+			setup: func(t *testing.T, tt *testSet) {
+				postgresClient, transactioner, mock := postgres.NewClientWithMock(t)
+				tt.transactioner = transactioner
+				tt.watermarkRepo = postgres.NewWatermarkRepository(postgresClient)
+				tt.mock = mock
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					`UPDATE "watermarks" SET "state"=$1,"updated_at"=NOW() WHERE "id" = $2`).
+					WithArgs(images.WatermarkStateReady, "watermark-1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(
+					`SELECT * FROM "watermarks" WHERE "id" = $1 ORDER BY "watermarks"."id" LIMIT $2`).
+					WithArgs("watermark-1", 1).
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Watermark]()).
+						AddRow("watermark-1", time.Now(), time.Now(), "logo", images.FormatPNG,
+							"s3-key-1", "url-1", images.WatermarkStateReady, "project-1"))
+				mock.ExpectQuery(
+					`SELECT * FROM "projects" WHERE "projects"."id" = $1 AND "projects"."deleted_at" IS NULL`).
+					WithArgs("project-1").
+					WillReturnRows(sqlmock.NewRows(dbhelpers.ColumnNamesFor[entity.Project]()).
+						AddRow("project-1", time.Now(), time.Now(), nil, "project-name-1", "PUBLIC", nil, nil, "", "KEEP", 0, 0))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t, &tt)
+
+			err := tt.transactioner.WithTx(t.Context(), func(ctx context.Context) error {
+				_, err := tt.watermarkRepo.Update(ctx, tt.req)
+				return err
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tt.mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
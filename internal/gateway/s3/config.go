@@ -1,12 +1,21 @@
 package s3
 
-import "time"
+import (
+	"time"
+
+	"github.com/isutare412/imageer/pkg/awshelpers"
+)
 
 type PresignerConfig struct {
 	Bucket string
 	Expiry time.Duration
+	Client awshelpers.S3ClientConfig
 }
 
 type ObjectStorageConfig struct {
 	Bucket string
+	// InfrequentAccessClass is the storage class originals are moved to by
+	// retention policies. STANDARD_IA is used if empty.
+	InfrequentAccessClass string
+	Client                awshelpers.S3ClientConfig
 }
@@ -3,13 +3,14 @@ package s3
 import (
 	"context"
 	"fmt"
+	"net/url"
 
-	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/pkg/awshelpers"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
@@ -20,19 +21,92 @@ type ObjectStorage struct {
 }
 
 func NewObjectStorage(cfg ObjectStorageConfig) (*ObjectStorage, error) {
-	awsCfg, err := config.LoadDefaultConfig(context.Background())
+	client, err := awshelpers.NewS3Client(context.Background(), cfg.Client)
 	if err != nil {
-		return nil, fmt.Errorf("loading default aws config: %w", err)
+		return nil, fmt.Errorf("creating s3 client: %w", err)
 	}
 
-	client := s3.NewFromConfig(awsCfg)
-
 	return &ObjectStorage{
 		client: client,
 		cfg:    cfg,
 	}, nil
 }
 
+func (s *ObjectStorage) HeadObject(ctx context.Context, key string) error {
+	ctx, span := tracing.StartSpan(ctx, "s3.ObjectStorage.HeadObject",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAWSS3))
+	defer span.End()
+
+	_, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: &s.cfg.Bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		return awshelpers.WrapS3Error(err, "Failed to head object %s", key)
+	}
+
+	return nil
+}
+
+func (s *ObjectStorage) ListObjects(ctx context.Context, prefix string,
+) ([]domain.StoredObject, error) {
+	ctx, span := tracing.StartSpan(ctx, "s3.ObjectStorage.ListObjects",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAWSS3))
+	defer span.End()
+
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: &s.cfg.Bucket,
+		Prefix: &prefix,
+	})
+
+	var objects []domain.StoredObject
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, awshelpers.WrapS3Error(err, "Failed to list objects under %s", prefix)
+		}
+
+		for _, obj := range page.Contents {
+			objects = append(objects, domain.StoredObject{
+				Key:          lo.FromPtr(obj.Key),
+				LastModified: lo.FromPtr(obj.LastModified),
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+// MoveToInfrequentAccess copies the object onto itself with the infrequent
+// access storage class.
+func (s *ObjectStorage) MoveToInfrequentAccess(ctx context.Context, key string) error {
+	ctx, span := tracing.StartSpan(ctx, "s3.ObjectStorage.MoveToInfrequentAccess",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAWSS3))
+	defer span.End()
+
+	storageClass := types.StorageClassStandardIa
+	if s.cfg.InfrequentAccessClass != "" {
+		storageClass = types.StorageClass(s.cfg.InfrequentAccessClass)
+	}
+
+	copySource := (&url.URL{Path: s.cfg.Bucket + "/" + key}).EscapedPath()
+	_, err := s.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:            &s.cfg.Bucket,
+		Key:               &key,
+		CopySource:        &copySource,
+		StorageClass:      storageClass,
+		MetadataDirective: types.MetadataDirectiveCopy,
+	})
+	if err != nil {
+		return awshelpers.WrapS3Error(err, "Failed to change storage class of object %s", key)
+	}
+
+	return nil
+}
+
 func (s *ObjectStorage) DeleteObjects(ctx context.Context, keys []string) error {
 	ctx, span := tracing.StartSpan(ctx, "s3.ObjectStorage.DeleteObjects",
 		trace.WithSpanKind(trace.SpanKindClient),
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
@@ -21,12 +20,11 @@ type Presigner struct {
 }
 
 func NewPresigner(cfg PresignerConfig) (*Presigner, error) {
-	awsCfg, err := config.LoadDefaultConfig(context.Background())
+	client, err := awshelpers.NewS3Client(context.Background(), cfg.Client)
 	if err != nil {
-		return nil, fmt.Errorf("loading default aws config: %w", err)
+		return nil, fmt.Errorf("creating s3 client: %w", err)
 	}
 
-	client := s3.NewFromConfig(awsCfg)
 	presigner := s3.NewPresignClient(client)
 
 	return &Presigner{
@@ -63,3 +61,24 @@ func (p *Presigner) PresignPutObject(ctx context.Context, req domain.PresignPutO
 		ExpireAt: time.Now().UTC().Add(p.cfg.Expiry - 5*time.Second), // Subtract 5 seconds as buffer
 	}, nil
 }
+
+func (p *Presigner) PresignGetObject(ctx context.Context, req domain.PresignGetObjectRequest,
+) (domain.PresignGetObjectResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "s3.Presigner.PresignGetObject",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAWSS3))
+	defer span.End()
+
+	resp, err := p.client.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: &p.cfg.Bucket,
+		Key:    &req.S3Key,
+	}, s3.WithPresignExpires(req.Expiry))
+	if err != nil {
+		return domain.PresignGetObjectResponse{}, awshelpers.WrapS3Error(err, "Failed to presign")
+	}
+
+	return domain.PresignGetObjectResponse{
+		URL:      resp.URL,
+		ExpireAt: time.Now().UTC().Add(req.Expiry - 5*time.Second), // Subtract 5 seconds as buffer
+	}, nil
+}
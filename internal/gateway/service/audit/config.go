@@ -0,0 +1,10 @@
+package audit
+
+import "time"
+
+type PurgerConfig struct {
+	CheckInterval time.Duration
+	CheckTimeout  time.Duration
+	// Retention is how long audit entries are kept.
+	Retention time.Duration
+}
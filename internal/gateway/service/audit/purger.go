@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// Purger deletes audit entries older than the retention.
+type Purger struct {
+	auditEntryRepo port.AuditEntryRepository
+	cfg            PurgerConfig
+
+	ticker *time.Ticker
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func NewPurger(cfg PurgerConfig, auditEntryRepo port.AuditEntryRepository) *Purger {
+	return &Purger{
+		auditEntryRepo: auditEntryRepo,
+		cfg:            cfg,
+	}
+}
+
+func (p *Purger) OnStartedLeading(ctx context.Context) {
+	p.ticker = time.NewTicker(p.cfg.CheckInterval)
+	p.stopCh = make(chan struct{})
+	p.doneCh = make(chan struct{})
+
+	go p.run(ctx)
+}
+
+func (p *Purger) OnStoppedLeading() {
+	if p.stopCh != nil {
+		close(p.stopCh)
+		<-p.doneCh
+	}
+}
+
+func (p *Purger) run(ctx context.Context) {
+	defer close(p.doneCh)
+	defer p.ticker.Stop()
+
+	for {
+		if err := p.purgeExpired(); err != nil {
+			slog.ErrorContext(ctx, "Failed to purge expired audit entries", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.stopCh:
+			return
+		case <-p.ticker.C:
+		}
+	}
+}
+
+func (p *Purger) purgeExpired() error {
+	ctx, span := tracing.StartSpanNonSampled(context.Background(), "audit.Purger.purgeExpired")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.CheckTimeout)
+	defer cancel()
+
+	count, err := p.auditEntryRepo.DeleteCreatedBefore(ctx, time.Now().Add(-p.cfg.Retention))
+	if err != nil {
+		return fmt.Errorf("deleting audit entries: %w", err)
+	}
+
+	if count > 0 {
+		slog.InfoContext(ctx, "Purged expired audit entries", "count", count)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/isutare412/imageer/internal/gateway/contextbag"
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/audits"
+	"github.com/isutare412/imageer/pkg/validation"
+)
+
+type Service struct {
+	auditEntryRepo port.AuditEntryRepository
+}
+
+func NewService(auditEntryRepo port.AuditEntryRepository) *Service {
+	return &Service{
+		auditEntryRepo: auditEntryRepo,
+	}
+}
+
+func (s *Service) List(ctx context.Context, params domain.ListAuditEntriesParams,
+) (domain.AuditEntries, error) {
+	if err := validation.Validate(params); err != nil {
+		return domain.AuditEntries{}, fmt.Errorf("validating params: %w", err)
+	}
+
+	entries, err := s.auditEntryRepo.List(ctx, params)
+	if err != nil {
+		return domain.AuditEntries{}, fmt.Errorf("listing audit entries: %w", err)
+	}
+	return entries, nil
+}
+
+// Record writes the audit entry of the change, attributing it to the identity
+// in the context bag. The entry is written in the transaction of the context
+// if any.
+func (s *Service) Record(ctx context.Context, req domain.RecordAuditRequest) error {
+	if err := validation.Validate(req); err != nil {
+		return fmt.Errorf("validating request: %w", err)
+	}
+
+	changes, err := domain.DiffAuditSnapshots(req.Before, req.After)
+	if err != nil {
+		return fmt.Errorf("diffing snapshots: %w", err)
+	}
+
+	entry := domain.AuditEntry{
+		ActorType:  audits.ActorTypeSystem,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Changes:    changes,
+	}
+	if bag, ok := contextbag.BagFromContext(ctx); ok {
+		entry.RequestID = bag.RequestID
+		entry.RemoteIP = bag.RemoteIP
+		applyActor(&entry, bag.Identity)
+	}
+
+	if _, err := s.auditEntryRepo.Create(ctx, entry); err != nil {
+		return fmt.Errorf("creating audit entry: %w", err)
+	}
+	return nil
+}
+
+func applyActor(entry *domain.AuditEntry, identity domain.Identity) {
+	switch id := identity.(type) {
+	case domain.UserTokenIdentity:
+		entry.ActorType = audits.ActorTypeUser
+		entry.ActorID = id.Payload.UserID
+		entry.ActorName = id.Payload.Email
+	case domain.ServiceAccountIdentity:
+		entry.ActorType = audits.ActorTypeServiceAccount
+		entry.ActorID = id.ServiceAccount.ID
+		entry.ActorName = id.ServiceAccount.Name
+	}
+}
@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/contextbag"
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/audits"
+)
+
+type fakeAuditEntryRepository struct {
+	port.AuditEntryRepository
+	entries []domain.AuditEntry
+}
+
+func (r *fakeAuditEntryRepository) Create(_ context.Context, entry domain.AuditEntry,
+) (domain.AuditEntry, error) {
+	r.entries = append(r.entries, entry)
+	return entry, nil
+}
+
+func TestService_Record(t *testing.T) {
+	tests := []struct {
+		name     string // description of this test case
+		identity domain.Identity
+		want     domain.AuditEntry
+	}{
+		{
+			name: "user",
+			identity: domain.NewUserTokenIdentity(domain.UserTokenPayload{
+				UserID: "user-1",
+				Email:  "john@example.com",
+			}),
+			want: domain.AuditEntry{
+				ActorType: audits.ActorTypeUser,
+				ActorID:   "user-1",
+				ActorName: "john@example.com",
+			},
+		},
+		{
+			name: "service account",
+			identity: domain.NewServiceAccountIdentity(domain.ServiceAccount{
+				ID:   "account-1",
+				Name: "ci-uploader",
+			}),
+			want: domain.AuditEntry{
+				ActorType: audits.ActorTypeServiceAccount,
+				ActorID:   "account-1",
+				ActorName: "ci-uploader",
+			},
+		},
+		{
+			name: "system",
+			want: domain.AuditEntry{
+				ActorType: audits.ActorTypeSystem,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeAuditEntryRepository{}
+			svc := NewService(repo)
+
+			ctx := contextbag.WithBag(t.Context())
+			bag, _ := contextbag.BagFromContext(ctx)
+			bag.Identity = tt.identity
+			bag.RequestID = "request-1"
+			bag.RemoteIP = "203.0.113.7"
+
+			err := svc.Record(ctx, domain.RecordAuditRequest{
+				Action:     audits.ActionProjectDelete,
+				TargetType: audits.TargetTypeProject,
+				TargetID:   "project-1",
+				Before:     domain.Project{ID: "project-1", Name: "project-name-1"},
+			})
+			require.NoError(t, err)
+
+			want := tt.want
+			want.Action = audits.ActionProjectDelete
+			want.TargetType = audits.TargetTypeProject
+			want.TargetID = "project-1"
+			want.RequestID = "request-1"
+			want.RemoteIP = "203.0.113.7"
+			want.Changes = []domain.AuditChange{
+				{Field: "ID", Before: json.RawMessage(`"project-1"`)},
+				{Field: "Name", Before: json.RawMessage(`"project-name-1"`)},
+			}
+			assert.Equal(t, []domain.AuditEntry{want}, repo.entries)
+		})
+	}
+}
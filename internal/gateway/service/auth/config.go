@@ -1,10 +1,42 @@
 package auth
 
-import "time"
+import (
+	"time"
+
+	"github.com/isutare412/imageer/pkg/projects"
+	"github.com/isutare412/imageer/pkg/users"
+)
 
 type ServiceConfig struct {
 	StateCookieName string
 	StateCookieTTL  time.Duration
 	UserCookieName  string
 	UserCookieTTL   time.Duration
+	RoleMapping     RoleMappingConfig
+}
+
+type RoleMappingConfig struct {
+	// Authoritative makes roles follow the rules, demoting users no rule grants
+	// a role to. Otherwise rules only raise roles, keeping roles changed by
+	// admins.
+	Authoritative bool
+	Rules         []RoleMappingRule
+}
+
+// RoleMappingRule matches users whose claim named Claim is or contains
+// ClaimValue, and whose verified email belongs to EmailDomain. Empty
+// conditions match any user.
+type RoleMappingRule struct {
+	Claim       string
+	ClaimValue  string
+	EmailDomain string
+
+	// Role is granted to matched users if not empty.
+	Role     users.Role
+	Projects []ProjectRoleMapping
+}
+
+type ProjectRoleMapping struct {
+	ProjectID string
+	Role      projects.MemberRole
 }
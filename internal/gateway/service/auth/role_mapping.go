@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/projects"
+	"github.com/isutare412/imageer/pkg/users"
+)
+
+// mappedRoles are the highest roles granted by matched rules.
+type mappedRoles struct {
+	Role         *users.Role
+	ProjectRoles map[string]projects.MemberRole
+}
+
+func (c RoleMappingConfig) evaluate(email string, claims map[string]any) mappedRoles {
+	mapped := mappedRoles{ProjectRoles: make(map[string]projects.MemberRole)}
+	for _, rule := range c.Rules {
+		if !rule.matches(email, claims) {
+			continue
+		}
+
+		if rule.Role != "" && (mapped.Role == nil || !mapped.Role.Includes(rule.Role)) {
+			mapped.Role = new(rule.Role)
+		}
+		for _, p := range rule.Projects {
+			if role, ok := mapped.ProjectRoles[p.ProjectID]; !ok || !role.Includes(p.Role) {
+				mapped.ProjectRoles[p.ProjectID] = p.Role
+			}
+		}
+	}
+
+	if mapped.Role == nil && c.Authoritative {
+		mapped.Role = new(users.RoleGuest)
+	}
+	return mapped
+}
+
+func (r RoleMappingRule) matches(email string, claims map[string]any) bool {
+	if r.Claim != "" && !domain.ClaimContains(claims[r.Claim], r.ClaimValue) {
+		return false
+	}
+
+	if r.EmailDomain != "" {
+		// Domains of unverified emails are not trusted
+		if verified, ok := claims["email_verified"].(bool); ok && !verified {
+			return false
+		}
+
+		_, domain, ok := strings.Cut(email, "@")
+		if !ok || !strings.EqualFold(domain, r.EmailDomain) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// applyRoleMappings re-evaluates role mapping rules against the email and the
+// ID token claims of the user. Project roles are granted but never revoked, as
+// members may have been added by project owners.
+func (s *Service) applyRoleMappings(ctx context.Context, user domain.User,
+) (domain.User, error) {
+	if len(s.cfg.RoleMapping.Rules) == 0 {
+		return user, nil
+	}
+
+	mapped := s.cfg.RoleMapping.evaluate(user.Email, user.IDTokenClaims)
+
+	if mapped.Role != nil && *mapped.Role != user.Role &&
+		(s.cfg.RoleMapping.Authoritative || mapped.Role.Includes(user.Role)) {
+		updated, err := s.userRepo.Update(ctx, domain.UpdateUserRequest{
+			ID:   user.ID,
+			Role: mapped.Role,
+		})
+		if err != nil {
+			return domain.User{}, fmt.Errorf("updating role of user: %w", err)
+		}
+		if err := s.sessionStore.UpdateRoleOfUser(ctx, user.ID, updated.Role); err != nil {
+			return domain.User{}, fmt.Errorf("updating role of sessions: %w", err)
+		}
+
+		slog.InfoContext(ctx, "Changed user role by role mapping", "userId", user.ID,
+			"from", user.Role, "to", updated.Role)
+		user = updated
+	}
+
+	for projectID, role := range mapped.ProjectRoles {
+		if err := s.grantProjectRole(ctx, projectID, user.ID, role); err != nil {
+			// Mapped projects may have been deleted
+			slog.WarnContext(ctx, "Failed to grant project role by role mapping",
+				"projectId", projectID, "userId", user.ID, "error", err)
+		}
+	}
+
+	return user, nil
+}
+
+// grantProjectRole adds the user to the project, or raises the role of the
+// member to role.
+func (s *Service) grantProjectRole(ctx context.Context, projectID, userID string,
+	role projects.MemberRole,
+) error {
+	member, err := s.projectMemberRepo.Find(ctx, projectID, userID)
+	switch {
+	case apperr.IsErrorCode(err, apperr.CodeNotFound):
+		_, err := s.projectMemberRepo.Create(ctx, domain.ProjectMember{
+			ProjectID: projectID,
+			Role:      role,
+			User:      domain.User{ID: userID},
+		})
+		if err != nil {
+			return fmt.Errorf("creating project member: %w", err)
+		}
+		return nil
+	case err != nil:
+		return fmt.Errorf("finding project member: %w", err)
+	case member.Role.Includes(role):
+		return nil
+	}
+
+	if _, err := s.projectMemberRepo.Update(ctx, domain.UpdateProjectMemberRequest{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      role,
+	}); err != nil {
+		return fmt.Errorf("updating project member: %w", err)
+	}
+	return nil
+}
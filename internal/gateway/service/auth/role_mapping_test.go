@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/isutare412/imageer/pkg/projects"
+	"github.com/isutare412/imageer/pkg/users"
+)
+
+func TestRoleMappingConfig_evaluate(t *testing.T) {
+	rules := []RoleMappingRule{
+		{
+			Claim:      "groups",
+			ClaimValue: "imageer-admins",
+			Role:       users.RoleAdmin,
+		},
+		{
+			EmailDomain: "example.com",
+			Role:        users.RoleGuest,
+			Projects: []ProjectRoleMapping{
+				{ProjectID: "project-1", Role: projects.MemberRoleViewer},
+			},
+		},
+		{
+			Claim:       "department",
+			ClaimValue:  "design",
+			EmailDomain: "example.com",
+			Projects: []ProjectRoleMapping{
+				{ProjectID: "project-1", Role: projects.MemberRoleEditor},
+				{ProjectID: "project-2", Role: projects.MemberRoleOwner},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		authoritative bool
+		email         string
+		claims        map[string]any
+		want          mappedRoles
+	}{
+		{
+			name:   "group claim",
+			email:  "john@other.com",
+			claims: map[string]any{"groups": []any{"staff", "imageer-admins"}},
+			want: mappedRoles{
+				Role:         new(users.RoleAdmin),
+				ProjectRoles: map[string]projects.MemberRole{},
+			},
+		},
+		{
+			name:   "highest roles of matched rules",
+			email:  "john@Example.com",
+			claims: map[string]any{"groups": "imageer-admins", "department": "design"},
+			want: mappedRoles{
+				Role: new(users.RoleAdmin),
+				ProjectRoles: map[string]projects.MemberRole{
+					"project-1": projects.MemberRoleEditor,
+					"project-2": projects.MemberRoleOwner,
+				},
+			},
+		},
+		{
+			name:   "unverified email",
+			email:  "john@example.com",
+			claims: map[string]any{"email_verified": false, "department": "design"},
+			want: mappedRoles{
+				ProjectRoles: map[string]projects.MemberRole{},
+			},
+		},
+		{
+			name:          "authoritative without match",
+			authoritative: true,
+			email:         "john@other.com",
+			claims:        map[string]any{"groups": []any{"staff"}},
+			want: mappedRoles{
+				Role:         new(users.RoleGuest),
+				ProjectRoles: map[string]projects.MemberRole{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := RoleMappingConfig{
+				Authoritative: tt.authoritative,
+				Rules:         rules,
+			}
+
+			got := cfg.evaluate(tt.email, tt.claims)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
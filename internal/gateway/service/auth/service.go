@@ -4,14 +4,18 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/audits"
 	"github.com/isutare412/imageer/pkg/users"
 )
 
@@ -22,10 +26,16 @@ type Service struct {
 	jwtVerifier  port.JWTVerifier
 	userRepo     port.UserRepository
 	cfg          ServiceConfig
+
+	projectMemberRepo port.ProjectMemberRepository
+	sessionStore      port.SessionStore
+	auditRecorder     port.AuditRecorder
 }
 
 func NewService(cfg ServiceConfig, oidcProvider port.OIDCProvider, crypter port.Crypter,
 	jwtSigner port.JWTSigner, jwtVerifier port.JWTVerifier, userRepo port.UserRepository,
+	projectMemberRepo port.ProjectMemberRepository, sessionStore port.SessionStore,
+	auditRecorder port.AuditRecorder,
 ) *Service {
 	return &Service{
 		oidcProvider: oidcProvider,
@@ -34,32 +44,51 @@ func NewService(cfg ServiceConfig, oidcProvider port.OIDCProvider, crypter port.
 		jwtVerifier:  jwtVerifier,
 		userRepo:     userRepo,
 		cfg:          cfg,
+
+		projectMemberRepo: projectMemberRepo,
+		sessionStore:      sessionStore,
+		auditRecorder:     auditRecorder,
 	}
 }
 
-func (s *Service) StartGoogleSignIn(ctx context.Context, req domain.StartGoogleSignInRequest,
-) (domain.StartGoogleSignInResponse, error) {
-	state, err := s.createOIDCState(req.HTTPReq, req.RedirectPath)
+func (s *Service) ListSignInProviders(ctx context.Context) []domain.OIDCProvider {
+	return s.oidcProvider.Providers()
+}
+
+func (s *Service) StartSignIn(ctx context.Context, req domain.StartSignInRequest,
+) (domain.StartSignInResponse, error) {
+	state, err := s.createOIDCState(req.HTTPReq, req.Provider, req.RedirectPath)
 	if err != nil {
-		return domain.StartGoogleSignInResponse{}, fmt.Errorf("creating OIDC state: %w", err)
+		return domain.StartSignInResponse{}, fmt.Errorf("creating OIDC state: %w", err)
 	}
 
-	return domain.StartGoogleSignInResponse{
-		RedirectURL: s.oidcProvider.BuildAuthenticationURL(httpBaseURL(req.HTTPReq), state),
+	redirectURL, err := s.oidcProvider.BuildAuthenticationURL(req.Provider,
+		httpBaseURL(req.HTTPReq), state)
+	if err != nil {
+		return domain.StartSignInResponse{}, fmt.Errorf("building authentication URL: %w", err)
+	}
+
+	return domain.StartSignInResponse{
+		RedirectURL: redirectURL,
 		OIDCCookie:  s.createOIDCStateCookie(state),
 	}, nil
 }
 
-func (s *Service) FinishGoogleSignIn(ctx context.Context, req domain.FinishGoogleSignInRequest,
-) (domain.FinishGoogleSignInResponse, error) {
+func (s *Service) FinishSignIn(ctx context.Context, req domain.FinishSignInRequest,
+) (domain.FinishSignInResponse, error) {
 	state, err := s.decryptOIDCState(req.State)
 	if err != nil {
-		return domain.FinishGoogleSignInResponse{}, fmt.Errorf("decrypting OIDC state: %w", err)
+		return domain.FinishSignInResponse{}, fmt.Errorf("decrypting OIDC state: %w", err)
+	}
+	if state.Provider != req.Provider {
+		return domain.FinishSignInResponse{}, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("OIDC state was issued for another provider")
 	}
 
-	idToken, err := s.oidcProvider.ExchangeCode(ctx, httpBaseURL(req.HTTPReq), req.AuthCode)
+	idToken, err := s.oidcProvider.ExchangeCode(ctx, req.Provider, httpBaseURL(req.HTTPReq),
+		req.AuthCode)
 	if err != nil {
-		return domain.FinishGoogleSignInResponse{}, fmt.Errorf("exchanging code: %w", err)
+		return domain.FinishSignInResponse{}, fmt.Errorf("exchanging code: %w", err)
 	}
 
 	user := domain.User{
@@ -67,15 +96,27 @@ func (s *Service) FinishGoogleSignIn(ctx context.Context, req domain.FinishGoogl
 		Nickname: idToken.FullName,
 		Email:    idToken.Email,
 		PhotoURL: lo.FromPtr(idToken.PictureURL),
+
+		IDTokenClaims: idToken.Claims,
 	}
 
 	user, err = s.userRepo.Upsert(ctx, user)
 	if err != nil {
-		return domain.FinishGoogleSignInResponse{}, fmt.Errorf("upserting user: %w", err)
+		return domain.FinishSignInResponse{}, fmt.Errorf("upserting user: %w", err)
+	}
+	if user.IsSuspended() {
+		return domain.FinishSignInResponse{}, apperr.NewError(apperr.CodeForbidden).
+			WithSummary("User %s is suspended", user.ID)
+	}
+
+	user, err = s.applyRoleMappings(ctx, user)
+	if err != nil {
+		return domain.FinishSignInResponse{}, fmt.Errorf("applying role mappings: %w", err)
 	}
 
 	issuedAt := time.Now()
 	userPayload := domain.UserTokenPayload{
+		SessionID:  uuid.NewString(),
 		UserID:     user.ID,
 		IssuedAt:   issuedAt,
 		ExpireAt:   issuedAt.Add(s.cfg.UserCookieTTL),
@@ -85,18 +126,26 @@ func (s *Service) FinishGoogleSignIn(ctx context.Context, req domain.FinishGoogl
 		PictureURL: user.PhotoURL,
 	}
 
+	if err := s.saveSession(ctx, userPayload); err != nil {
+		return domain.FinishSignInResponse{}, fmt.Errorf("saving session: %w", err)
+	}
+
 	token, err := s.jwtSigner.SignUserToken(userPayload)
 	if err != nil {
-		return domain.FinishGoogleSignInResponse{}, fmt.Errorf("signing user token: %w", err)
+		return domain.FinishSignInResponse{}, fmt.Errorf("signing user token: %w", err)
 	}
 
-	return domain.FinishGoogleSignInResponse{
+	return domain.FinishSignInResponse{
 		RedirectURL: state.RedirectURL,
 		OIDCCookie:  s.deleteOIDCStateCookie(),
 		UserCookie:  s.createUserCookie(token),
 	}, nil
 }
 
+// VerifyUserToken rejects tokens of revoked sessions, including the ones of
+// suspended users. The role in the payload is replaced with the one of the
+// session, which follows role changes, so that they apply to tokens issued
+// before.
 func (s *Service) VerifyUserToken(
 	ctx context.Context, userToken string,
 ) (domain.UserTokenPayload, error) {
@@ -104,24 +153,82 @@ func (s *Service) VerifyUserToken(
 	if err != nil {
 		return domain.UserTokenPayload{}, fmt.Errorf("verifying user token: %w", err)
 	}
+
+	session, err := s.findSession(ctx, payload)
+	if err != nil {
+		return domain.UserTokenPayload{}, fmt.Errorf("finding session: %w", err)
+	}
+
+	// Sessions saved before roles were kept in them need user lookups
+	if session.Role == "" {
+		user, err := s.findActiveUser(ctx, payload.UserID)
+		if err != nil {
+			return domain.UserTokenPayload{}, fmt.Errorf("finding active user: %w", err)
+		}
+		session.Role = user.Role
+	}
+
+	payload.Role = session.Role
 	return payload, nil
 }
 
-func (s *Service) SignOut(ctx context.Context) domain.SignOutResponse {
+// SignOut revokes the session of the caller, or all sessions of the caller, so
+// that tokens of the sessions are rejected even if they are stolen.
+func (s *Service) SignOut(ctx context.Context, req domain.SignOutRequest,
+) (domain.SignOutResponse, error) {
+	if req.AllSessions {
+		if err := s.RevokeSessions(ctx, req.UserID); err != nil {
+			return domain.SignOutResponse{}, fmt.Errorf("revoking sessions: %w", err)
+		}
+	} else if err := s.sessionStore.Delete(ctx, req.UserID, req.SessionID); err != nil {
+		return domain.SignOutResponse{}, fmt.Errorf("deleting session: %w", err)
+	}
+
 	return domain.SignOutResponse{
 		UserCookie: s.deleteUserCookie(),
+	}, nil
+}
+
+// RevokeSessions revokes all sessions of the user, signing the user out
+// everywhere.
+func (s *Service) RevokeSessions(ctx context.Context, userID string) error {
+	count, err := s.sessionStore.DeleteAllOfUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("deleting sessions of user: %w", err)
 	}
+
+	slog.InfoContext(ctx, "Revoked user sessions", "userId", userID, "count", count)
+
+	// Sessions are not stored in the database, so that the entry is recorded
+	// after they are revoked.
+	if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+		Action:     audits.ActionUserSessionsRevoke,
+		TargetType: audits.TargetTypeUser,
+		TargetID:   userID,
+		After:      revokedSessions{Count: count},
+	}); err != nil {
+		return fmt.Errorf("recording audit entry: %w", err)
+	}
+	return nil
 }
 
-func (s *Service) RefreshUserToken(ctx context.Context, userID string,
+// RefreshUserToken issues a token of the same session with a new expiry, and
+// extends the session accordingly.
+func (s *Service) RefreshUserToken(ctx context.Context, sessionID, userID string,
 ) (domain.RefreshUserTokenResponse, error) {
-	user, err := s.userRepo.FindByID(ctx, userID)
+	user, err := s.findActiveUser(ctx, userID)
 	if err != nil {
-		return domain.RefreshUserTokenResponse{}, fmt.Errorf("getting user: %w", err)
+		return domain.RefreshUserTokenResponse{}, fmt.Errorf("finding active user: %w", err)
+	}
+
+	user, err = s.applyRoleMappings(ctx, user)
+	if err != nil {
+		return domain.RefreshUserTokenResponse{}, fmt.Errorf("applying role mappings: %w", err)
 	}
 
 	issuedAt := time.Now()
 	payload := domain.UserTokenPayload{
+		SessionID:  sessionID,
 		UserID:     user.ID,
 		IssuedAt:   issuedAt,
 		ExpireAt:   issuedAt.Add(s.cfg.UserCookieTTL),
@@ -131,6 +238,10 @@ func (s *Service) RefreshUserToken(ctx context.Context, userID string,
 		PictureURL: user.PhotoURL,
 	}
 
+	if err := s.saveSession(ctx, payload); err != nil {
+		return domain.RefreshUserTokenResponse{}, fmt.Errorf("saving session: %w", err)
+	}
+
 	token, err := s.jwtSigner.SignUserToken(payload)
 	if err != nil {
 		return domain.RefreshUserTokenResponse{}, fmt.Errorf("signing user token: %w", err)
@@ -141,6 +252,23 @@ func (s *Service) RefreshUserToken(ctx context.Context, userID string,
 	}, nil
 }
 
+// findActiveUser fails with unauthorized if the user no longer exists, and
+// with forbidden if the user is suspended.
+func (s *Service) findActiveUser(ctx context.Context, userID string) (domain.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	switch {
+	case apperr.IsErrorCode(err, apperr.CodeNotFound):
+		return domain.User{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("User %s not found", userID)
+	case err != nil:
+		return domain.User{}, fmt.Errorf("finding user: %w", err)
+	case user.IsSuspended():
+		return domain.User{}, apperr.NewError(apperr.CodeForbidden).
+			WithSummary("User %s is suspended", userID)
+	}
+	return user, nil
+}
+
 func httpBaseURL(r *http.Request) string {
 	scheme := "http"
 	switch {
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// revokedSessions is the audited result of revoking sessions of a user.
+type revokedSessions struct {
+	Count int64
+}
+
+func (s *Service) saveSession(ctx context.Context, payload domain.UserTokenPayload) error {
+	if err := s.sessionStore.Save(ctx, domain.Session{
+		ID:       payload.SessionID,
+		UserID:   payload.UserID,
+		Role:     payload.Role,
+		ExpireAt: payload.ExpireAt,
+	}); err != nil {
+		return fmt.Errorf("saving session %s: %w", payload.SessionID, err)
+	}
+	return nil
+}
+
+// findSession fails with unauthorized if the session of the token has been
+// revoked. Tokens issued without sessions are rejected as well.
+func (s *Service) findSession(ctx context.Context, payload domain.UserTokenPayload,
+) (domain.Session, error) {
+	if payload.SessionID == "" {
+		return domain.Session{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Token has no session")
+	}
+
+	session, err := s.sessionStore.Find(ctx, payload.SessionID)
+	switch {
+	case apperr.IsErrorCode(err, apperr.CodeNotFound):
+		return domain.Session{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Session %s is revoked or expired", payload.SessionID)
+	case err != nil:
+		return domain.Session{}, fmt.Errorf("finding session: %w", err)
+	case session.UserID != payload.UserID:
+		return domain.Session{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Session %s belongs to another user", payload.SessionID)
+	}
+	return session, nil
+}
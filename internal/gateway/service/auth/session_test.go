@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/users"
+)
+
+type fakeSessionStore struct {
+	port.SessionStore
+	sessions map[string]domain.Session
+}
+
+func (s *fakeSessionStore) Find(_ context.Context, id string) (domain.Session, error) {
+	session, ok := s.sessions[id]
+	if !ok {
+		return domain.Session{}, apperr.NewError(apperr.CodeNotFound)
+	}
+	return session, nil
+}
+
+func TestService_findSession(t *testing.T) {
+	svc := &Service{
+		sessionStore: &fakeSessionStore{
+			sessions: map[string]domain.Session{
+				"session-1": {ID: "session-1", UserID: "user-1", ExpireAt: time.Now().Add(time.Hour)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		payload domain.UserTokenPayload
+		wantErr bool
+	}{
+		{
+			name:    "live session",
+			payload: domain.UserTokenPayload{SessionID: "session-1", UserID: "user-1"},
+		},
+		{
+			name:    "revoked session",
+			payload: domain.UserTokenPayload{SessionID: "session-2", UserID: "user-1"},
+			wantErr: true,
+		},
+		{
+			name:    "session of another user",
+			payload: domain.UserTokenPayload{SessionID: "session-1", UserID: "user-2"},
+			wantErr: true,
+		},
+		{
+			name:    "token without session",
+			payload: domain.UserTokenPayload{UserID: "user-1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.findSession(t.Context(), tt.payload)
+			if tt.wantErr {
+				assert.True(t, apperr.IsErrorCode(err, apperr.CodeUnauthorized))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type fakeJWTVerifier struct {
+	payloads map[string]domain.UserTokenPayload
+}
+
+func (v *fakeJWTVerifier) VerifyUserToken(token string) (domain.UserTokenPayload, error) {
+	payload, ok := v.payloads[token]
+	if !ok {
+		return domain.UserTokenPayload{}, apperr.NewError(apperr.CodeUnauthorized)
+	}
+	return payload, nil
+}
+
+type fakeUserRepository struct {
+	port.UserRepository
+	users   map[string]domain.User
+	lookups int
+}
+
+func (r *fakeUserRepository) FindByID(_ context.Context, id string) (domain.User, error) {
+	r.lookups++
+	user, ok := r.users[id]
+	if !ok {
+		return domain.User{}, apperr.NewError(apperr.CodeNotFound)
+	}
+	return user, nil
+}
+
+func TestService_VerifyUserToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		session     domain.Session
+		wantRole    users.Role
+		wantLookups int
+	}{
+		{
+			name: "role of session",
+			session: domain.Session{
+				ID:     "session-1",
+				UserID: "user-1",
+				Role:   users.RoleAdmin,
+			},
+			wantRole:    users.RoleAdmin,
+			wantLookups: 0,
+		},
+		{
+			name: "session without role",
+			session: domain.Session{
+				ID:     "session-1",
+				UserID: "user-1",
+			},
+			wantRole:    users.RoleGuest,
+			wantLookups: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := &fakeUserRepository{
+				users: map[string]domain.User{
+					"user-1": {ID: "user-1", Role: users.RoleGuest},
+				},
+			}
+			svc := &Service{
+				jwtVerifier: &fakeJWTVerifier{
+					payloads: map[string]domain.UserTokenPayload{
+						"token-1": {SessionID: "session-1", UserID: "user-1", Role: users.RoleGuest},
+					},
+				},
+				userRepo: userRepo,
+				sessionStore: &fakeSessionStore{
+					sessions: map[string]domain.Session{tt.session.ID: tt.session},
+				},
+			}
+
+			payload, err := svc.VerifyUserToken(t.Context(), "token-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRole, payload.Role)
+			assert.Equal(t, tt.wantLookups, userRepo.lookups)
+		})
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/isutare412/imageer/pkg/apperr"
 )
 
-func (s *Service) createOIDCState(r *http.Request, redirectPath string) (string, error) {
+func (s *Service) createOIDCState(r *http.Request, provider, redirectPath string,
+) (string, error) {
 	state := domain.OIDCState{
+		Provider:    provider,
 		RedirectURL: httpRedirectURL(r, redirectPath),
 	}
 
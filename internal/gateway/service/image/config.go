@@ -6,9 +6,60 @@ type Config struct {
 	CDNDomain              string
 	S3KeyPrefix            string
 	ProcessDoneWaitTimeout time.Duration
+
+	// StorageCDNBaseURLs are CDN base URLs of storage profiles, which override
+	// CDNDomain for images of projects in the profiles. The default profile is
+	// also keyed by the empty name.
+	StorageCDNBaseURLs map[string]string
+
+	// DeliveryMaxAge is the max-age of delivered variants, and
+	// DeliveryFallbackMaxAge is the one of original images delivered while no
+	// variant is ready yet.
+	DeliveryMaxAge         time.Duration
+	DeliveryFallbackMaxAge time.Duration
+
+	// PrivateURLTTL is the lifetime of presigned URLs of images in private
+	// projects.
+	PrivateURLTTL time.Duration
 }
 
 type CloserConfig struct {
 	CheckInterval  time.Duration
 	CloseThreshold time.Duration
 }
+
+type UploadSweeperConfig struct {
+	CheckInterval time.Duration
+	CheckTimeout  time.Duration
+	// PendingFor is how long images stay pending before their objects are
+	// checked, which leaves time for upload events to arrive.
+	PendingFor time.Duration
+}
+
+type PurgerConfig struct {
+	CheckInterval time.Duration
+	CheckTimeout  time.Duration
+	// RestoreWindow is how long soft-deleted images and projects can be
+	// restored before they are purged.
+	RestoreWindow time.Duration
+	// BatchSize is the number of images purged at once by project deletion
+	// jobs, whose progress is recorded after each batch.
+	BatchSize int
+}
+
+type ReconcilerConfig struct {
+	CheckInterval time.Duration
+	CheckTimeout  time.Duration
+	S3KeyPrefix   string
+	// GracePeriod keeps objects and rows changed recently out of the diff, as
+	// uploads and processing may be in flight.
+	GracePeriod time.Duration
+	// DeleteOrphans deletes orphaned objects reported by the previous run.
+	DeleteOrphans         bool
+	DefaultStorageProfile string
+}
+
+type LifecyclerConfig struct {
+	CheckInterval time.Duration
+	CheckTimeout  time.Duration
+}
@@ -2,47 +2,103 @@ package image
 
 import (
 	"fmt"
-	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
 )
 
-var imageS3KeyPattern = regexp.MustCompile(`projects/([^/]+)/images/([^/]+)`)
+const originalObjectName = "original"
 
 func imageBasePath(projectID, imageID string) string {
 	return fmt.Sprintf("projects/%s/images/%s", projectID, imageID)
 }
 
-func (s *Service) imageS3BasePath(projectID, imageID string) string {
-	return fmt.Sprintf("%s/%s", s.cfg.S3KeyPrefix, imageBasePath(projectID, imageID))
-}
+// projectObjectPath returns the longest path prefix of objects of images of
+// the project relative to the S3 key prefix. The prefix may be shared with
+// other projects if the key template does not start with the project ID.
+func projectObjectPath(project domain.ProjectReference) string {
+	if project.KeyTemplate == nil {
+		return imageBasePath(project.ID, "")
+	}
 
-func (s *Service) imageS3Key(projectID, imageID string, format images.Format) string {
-	base := s.imageS3BasePath(projectID, imageID)
-	return fmt.Sprintf("%s/original.%s", base, format.Extension())
+	path := strings.ReplaceAll(string(*project.KeyTemplate), "{project}", project.ID)
+	if i := strings.Index(path, "{"); i >= 0 {
+		path = path[:i]
+	}
+	return path[:strings.LastIndex(path, "/")+1]
 }
 
-func (s *Service) imagePublicURL(projectID, imageID string, format images.Format) string {
-	return fmt.Sprintf("%s/%s/original.%s", s.cfg.CDNDomain, imageBasePath(projectID, imageID),
-		format.Extension())
+// imageObjectPath returns the path of the original image object relative to
+// the S3 key prefix and the CDN base URL.
+func imageObjectPath(project domain.ProjectReference, imageID string, createdAt time.Time,
+	format images.Format,
+) string {
+	if project.KeyTemplate == nil {
+		return fmt.Sprintf("%s/original.%s", imageBasePath(project.ID, imageID), format.Extension())
+	}
+	return project.KeyTemplate.Render(projects.KeyTemplateParams{
+		ProjectID: project.ID,
+		ImageID:   imageID,
+		Preset:    originalObjectName,
+		Variant:   originalObjectName,
+		Extension: format.Extension(),
+		CreatedAt: createdAt,
+	})
 }
 
-func (s *Service) imageVariantS3Key(projectID, imageID, variantID string, format images.Format,
+// imageVariantObjectPath returns the path of a variant object named name
+// relative to the S3 key prefix and the CDN base URL.
+func imageVariantObjectPath(project domain.ProjectReference, imageID string, createdAt time.Time,
+	presetName, name string, format images.Format,
 ) string {
-	base := s.imageS3BasePath(projectID, imageID)
-	return fmt.Sprintf("%s/variants/%s.%s", base, variantID, format.Extension())
+	if project.KeyTemplate == nil {
+		return fmt.Sprintf("%s/variants/%s.%s", imageBasePath(project.ID, imageID), name,
+			format.Extension())
+	}
+	return project.KeyTemplate.Render(projects.KeyTemplateParams{
+		ProjectID: project.ID,
+		ImageID:   imageID,
+		Preset:    presetName,
+		Variant:   name,
+		Extension: format.Extension(),
+		CreatedAt: createdAt,
+	})
 }
 
-func (s *Service) imageVariantPublicURL(projectID, imageID, variantID string, format images.Format,
-) string {
-	return fmt.Sprintf("%s/%s/variants/%s.%s", s.cfg.CDNDomain, imageBasePath(projectID, imageID),
-		variantID, format.Extension())
+func (s *Service) objectS3Key(path string) string {
+	return fmt.Sprintf("%s/%s", s.cfg.S3KeyPrefix, path)
 }
 
-func parseImageS3Key(key string) (projectID, imageID string, ok bool) {
-	matches := imageS3KeyPattern.FindStringSubmatch(key)
-	if len(matches) != 3 {
-		return "", "", false
+// objectPublicURL prefers the CDN base URL of the project, then the one of its
+// storage profile, then the default CDN domain.
+func (s *Service) objectPublicURL(project domain.ProjectReference, path string) string {
+	baseURL := s.cfg.CDNDomain
+	if profileURL, ok := s.cfg.StorageCDNBaseURLs[project.StorageProfile]; ok {
+		baseURL = profileURL
 	}
-	return matches[1], matches[2], true
+	if project.CDNBaseURL != nil {
+		baseURL = *project.CDNBaseURL
+	}
+	return fmt.Sprintf("%s/%s", baseURL, path)
+}
+
+// imageVariantRevisionName names the object of a re-rendered variant. Objects
+// of each rendering get distinct keys and URLs so that caches never serve a
+// previous rendering.
+func imageVariantRevisionName(variantID string, renderedAt time.Time) string {
+	return fmt.Sprintf("%s-%s", variantID, strconv.FormatInt(renderedAt.UnixMilli(), 36))
+}
+
+func publicCacheControl(maxAge time.Duration) string {
+	return fmt.Sprintf("public, max-age=%d", int64(maxAge.Seconds()))
+}
+
+// imageSnapshot is the audited view of the image, which leaves out variants.
+func imageSnapshot(image domain.Image) domain.Image {
+	image.Variants = nil
+	return image
 }
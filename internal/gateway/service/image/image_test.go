@@ -2,45 +2,111 @@ package image
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
 )
 
-func Test_parseImageS3Key(t *testing.T) {
+func Test_imageObjectPath(t *testing.T) {
+	createdAt := time.Date(2025, 3, 9, 23, 30, 0, 0, time.FixedZone("KST", 9*60*60))
+
+	tests := []struct {
+		name        string // description of this test case
+		project     domain.ProjectReference
+		wantImage   string
+		wantVariant string
+	}{
+		{
+			name:        "default layout",
+			project:     domain.ProjectReference{ID: "project-1"},
+			wantImage:   "projects/project-1/images/image-1/original.jpg",
+			wantVariant: "projects/project-1/images/image-1/variants/variant-1.webp",
+		},
+		{
+			name: "key template",
+			project: domain.ProjectReference{
+				ID:          "project-1",
+				KeyTemplate: new(projects.KeyTemplate("{project}/{yyyy}/{mm}/{dd}/{image}/{preset}-{variant}.{ext}")),
+			},
+			wantImage:   "project-1/2025/03/09/image-1/original-original.jpg",
+			wantVariant: "project-1/2025/03/09/image-1/thumbnail-variant-1.webp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantImage,
+				imageObjectPath(tt.project, "image-1", createdAt, images.FormatJPEG))
+			assert.Equal(t, tt.wantVariant,
+				imageVariantObjectPath(tt.project, "image-1", createdAt, "thumbnail", "variant-1",
+					images.FormatWebp))
+		})
+	}
+}
+
+func Test_projectObjectPath(t *testing.T) {
 	tests := []struct {
-		name string // description of this test case
-		// Named input parameters for target function.
-		key           string
-		wantProjectID string
-		wantImageID   string
-		wantOK        bool
+		name        string // description of this test case
+		keyTemplate *projects.KeyTemplate
+		want        string
 	}{
 		{
-			name:          "normal case",
-			key:           "local/foo/projects/5480727a-98a0-4b49-974a-790d2e18e3f5/images/44d2c777-1d83-418c-8359-0a810acaf8cb/original.jpg",
-			wantProjectID: "5480727a-98a0-4b49-974a-790d2e18e3f5",
-			wantImageID:   "44d2c777-1d83-418c-8359-0a810acaf8cb",
-			wantOK:        true,
+			name: "default layout",
+			want: "projects/project-1/images/",
+		},
+		{
+			name:        "key template starting with project",
+			keyTemplate: new(projects.KeyTemplate("{project}/{yyyy}/{image}/{variant}.{ext}")),
+			want:        "project-1/",
 		},
 		{
-			name:          "invalid format",
-			key:           "local/foo/bar/original.jpg",
-			wantProjectID: "",
-			wantImageID:   "",
-			wantOK:        false,
+			name:        "key template with static prefix",
+			keyTemplate: new(projects.KeyTemplate("media/{project}/img-{image}/{variant}.{ext}")),
+			want:        "media/project-1/",
+		},
+		{
+			name:        "key template without prefix",
+			keyTemplate: new(projects.KeyTemplate("{yyyy}/{project}/{image}/{variant}.{ext}")),
+			want:        "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			projectID, imageID, ok := parseImageS3Key(tt.key)
-			if tt.wantOK {
-				require.True(t, ok)
-				assert.Equal(t, tt.wantProjectID, projectID)
-				assert.Equal(t, tt.wantImageID, imageID)
-			} else {
-				require.False(t, ok)
-			}
+			project := domain.ProjectReference{ID: "project-1", KeyTemplate: tt.keyTemplate}
+			assert.Equal(t, tt.want, projectObjectPath(project))
 		})
 	}
 }
+
+func TestService_objectPublicURL(t *testing.T) {
+	s := &Service{cfg: Config{
+		CDNDomain: "https://cdn.example.com",
+		StorageCDNBaseURLs: map[string]string{
+			"local": "http://localhost:8080/storage/local",
+		},
+	}}
+
+	assert.Equal(t, "https://cdn.example.com/a/original.jpg",
+		s.objectPublicURL(domain.ProjectReference{}, "a/original.jpg"))
+	assert.Equal(t, "https://images.example.org/a/original.jpg",
+		s.objectPublicURL(domain.ProjectReference{CDNBaseURL: new("https://images.example.org")},
+			"a/original.jpg"))
+	assert.Equal(t, "http://localhost:8080/storage/local/a/original.jpg",
+		s.objectPublicURL(domain.ProjectReference{StorageProfile: "local"}, "a/original.jpg"))
+	assert.Equal(t, "https://images.example.org/a/original.jpg",
+		s.objectPublicURL(domain.ProjectReference{
+			StorageProfile: "local",
+			CDNBaseURL:     new("https://images.example.org"),
+		}, "a/original.jpg"))
+}
+
+func Test_imageVariantRevisionName(t *testing.T) {
+	renderedAt := time.UnixMilli(1_700_000_000_000)
+
+	name := imageVariantRevisionName("variant-1", renderedAt)
+	assert.Equal(t, "variant-1-loyw3v28", name)
+	assert.NotEqual(t, name, imageVariantRevisionName("variant-1", renderedAt.Add(time.Millisecond)))
+}
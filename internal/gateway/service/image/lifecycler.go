@@ -0,0 +1,263 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
+	imageerv1 "github.com/isutare412/imageer/pkg/protogen/imageer/v1"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+const retentionDay = 24 * time.Hour
+
+// Lifecycler applies retention policies of projects. It deletes or moves
+// originals of images whose variants are all ready, and deletes expired images
+// entirely.
+type Lifecycler struct {
+	transactioner             port.Transactioner
+	objectStorage             port.ObjectStorage
+	projectRepo               port.ProjectRepository
+	imageRepo                 port.ImageRepository
+	imageS3DeleteRequestQueue port.ImageS3DeleteRequestQueue
+	cfg                       LifecyclerConfig
+
+	ticker *time.Ticker
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func NewLifecycler(
+	cfg LifecyclerConfig,
+	transactioner port.Transactioner,
+	objectStorage port.ObjectStorage,
+	projectRepo port.ProjectRepository,
+	imageRepo port.ImageRepository,
+	imageS3DeleteRequestQueue port.ImageS3DeleteRequestQueue,
+) *Lifecycler {
+	return &Lifecycler{
+		transactioner:             transactioner,
+		objectStorage:             objectStorage,
+		projectRepo:               projectRepo,
+		imageRepo:                 imageRepo,
+		imageS3DeleteRequestQueue: imageS3DeleteRequestQueue,
+		cfg:                       cfg,
+	}
+}
+
+func (l *Lifecycler) OnStartedLeading(ctx context.Context) {
+	l.ticker = time.NewTicker(l.cfg.CheckInterval)
+	l.stopCh = make(chan struct{})
+	l.doneCh = make(chan struct{})
+
+	go l.run(ctx)
+}
+
+func (l *Lifecycler) OnStoppedLeading() {
+	if l.stopCh != nil {
+		close(l.stopCh)
+		<-l.doneCh
+	}
+}
+
+func (l *Lifecycler) run(ctx context.Context) {
+	defer close(l.doneCh)
+	defer l.ticker.Stop()
+
+	for {
+		if err := l.applyRetentionPolicies(); err != nil {
+			slog.ErrorContext(ctx, "Failed to apply retention policies", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-l.stopCh:
+			return
+		case <-l.ticker.C:
+		}
+	}
+}
+
+func (l *Lifecycler) applyRetentionPolicies() error {
+	ctx, span := tracing.StartSpanNonSampled(context.Background(),
+		"image.Lifecycler.applyRetentionPolicies")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, l.cfg.CheckTimeout)
+	defer cancel()
+
+	projs, err := l.projectRepo.List(ctx, domain.ListProjectsParams{Limit: new(-1)})
+	if err != nil {
+		return fmt.Errorf("listing projects: %w", err)
+	}
+
+	for _, proj := range projs.Items {
+		if proj.Retention.IsDefault() {
+			continue
+		}
+
+		if err := l.expireImages(ctx, proj); err != nil {
+			slog.ErrorContext(ctx, "Failed to expire images", "projectId", proj.ID,
+				"error", err)
+		}
+		if err := l.retainOriginals(ctx, proj); err != nil {
+			slog.ErrorContext(ctx, "Failed to retain originals", "projectId", proj.ID,
+				"error", err)
+		}
+	}
+
+	return nil
+}
+
+// expireImages purges images created more than ImageExpireDays ago. Expired
+// images are not restorable.
+func (l *Lifecycler) expireImages(ctx context.Context, proj domain.Project) error {
+	if proj.Retention.ImageExpireDays == 0 {
+		return nil
+	}
+
+	threshold := time.Now().Add(-time.Duration(proj.Retention.ImageExpireDays) * retentionDay)
+	result, err := l.imageRepo.List(ctx, domain.ListImagesParams{
+		Limit: new(-1),
+		SearchFilter: domain.ImageSearchFilter{
+			ProjectID:       &proj.ID,
+			CreatedAtBefore: &threshold,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing expired images: %w", err)
+	}
+
+	for _, img := range result.Items {
+		if err := l.imageRepo.Purge(ctx, img.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to purge expired image", "imageId", img.ID,
+				"error", err)
+			continue
+		}
+
+		var s3Keys []string
+		if img.HasOriginal() {
+			s3Keys = append(s3Keys, img.S3Key)
+		}
+		for _, variant := range img.Variants {
+			s3Keys = append(s3Keys, variant.S3Key)
+		}
+		pushS3DeleteRequest(ctx, l.imageS3DeleteRequestQueue, img, s3Keys)
+
+		slog.InfoContext(ctx, "Purged expired image", "imageId", img.ID,
+			"projectId", proj.ID)
+	}
+
+	return nil
+}
+
+// retainOriginals applies the original retention of the project to originals
+// whose variants have all been ready for more than OriginalDays.
+func (l *Lifecycler) retainOriginals(ctx context.Context, proj domain.Project) error {
+	var (
+		originalStates []images.OriginalState
+		nextState      images.OriginalState
+	)
+	switch proj.Retention.Original {
+	case projects.OriginalRetentionDelete:
+		originalStates = []images.OriginalState{
+			images.OriginalStateStored, images.OriginalStateInfrequentAccess,
+		}
+		nextState = images.OriginalStateDeleted
+	case projects.OriginalRetentionInfrequentAccess:
+		originalStates = []images.OriginalState{images.OriginalStateStored}
+		nextState = images.OriginalStateInfrequentAccess
+	default:
+		return nil
+	}
+
+	threshold := time.Now().Add(-time.Duration(proj.Retention.OriginalDays) * retentionDay)
+	result, err := l.imageRepo.List(ctx, domain.ListImagesParams{
+		Limit: new(-1),
+		SearchFilter: domain.ImageSearchFilter{
+			ProjectID:       &proj.ID,
+			State:           new(images.StateReady),
+			OriginalStates:  originalStates,
+			CreatedAtBefore: &threshold,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing images: %w", err)
+	}
+
+	for _, img := range result.Items {
+		// Originals are kept while any variant is not ready, so that failed
+		// variants can be reprocessed
+		readyAt, ok := img.VariantsReadyAt()
+		if !ok || readyAt.After(threshold) {
+			continue
+		}
+
+		if err := l.retainOriginal(ctx, img, nextState); err != nil {
+			slog.ErrorContext(ctx, "Failed to retain original", "imageId", img.ID,
+				"originalState", nextState, "error", err)
+			continue
+		}
+
+		slog.InfoContext(ctx, "Retained original", "imageId", img.ID,
+			"originalState", nextState)
+	}
+
+	return nil
+}
+
+func (l *Lifecycler) retainOriginal(ctx context.Context, img domain.Image,
+	state images.OriginalState,
+) error {
+	switch state {
+	case images.OriginalStateDeleted:
+		_, err := l.imageRepo.Update(ctx, domain.UpdateImageRequest{
+			ID:            img.ID,
+			URL:           new(""),
+			OriginalState: &state,
+		})
+		if err != nil {
+			return fmt.Errorf("updating image: %w", err)
+		}
+
+		pushS3DeleteRequest(ctx, l.imageS3DeleteRequestQueue, img, []string{img.S3Key})
+
+	case images.OriginalStateInfrequentAccess:
+		err := l.objectStorage.MoveToInfrequentAccess(ctx, img.Project.StorageProfile, img.S3Key)
+		if err != nil {
+			return fmt.Errorf("moving original to infrequent access: %w", err)
+		}
+
+		_, err = l.imageRepo.Update(ctx, domain.UpdateImageRequest{
+			ID:            img.ID,
+			OriginalState: &state,
+		})
+		if err != nil {
+			return fmt.Errorf("updating image: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func pushS3DeleteRequest(ctx context.Context, queue port.ImageS3DeleteRequestQueue,
+	img domain.Image, s3Keys []string,
+) {
+	req := &imageerv1.ImageS3DeleteRequest{
+		ImageId:        img.ID,
+		ProjectId:      img.Project.ID,
+		S3Keys:         s3Keys,
+		StorageProfile: img.Project.StorageProfile,
+	}
+	if err := queue.Push(ctx, req); err != nil {
+		// Log error but don't fail as objects are no longer referenced
+		slog.ErrorContext(ctx, "Failed to push S3 delete request", "imageId", img.ID,
+			"error", err)
+	}
+}
@@ -1,9 +1,14 @@
 package image
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/images"
+	imageerv1 "github.com/isutare412/imageer/pkg/protogen/imageer/v1"
 )
 
 func findPresetNameDifference(requested []string, existing []domain.Preset) []string {
@@ -14,3 +19,59 @@ func findPresetNameDifference(requested []string, existing []domain.Preset) []st
 	left, _ := lo.Difference(requested, existingNames)
 	return left
 }
+
+// presetToProto converts the preset into proto, resolving the S3 key of its
+// watermark so that processors can fetch it.
+func (s *Service) presetToProto(ctx context.Context, preset domain.Preset,
+) (*imageerv1.Preset, error) {
+	presetProto := preset.ToProto()
+	if preset.Watermark == nil {
+		return presetProto, nil
+	}
+
+	watermark, err := s.watermarkRepo.FindByID(ctx, preset.Watermark.WatermarkID)
+	if err != nil {
+		return nil, fmt.Errorf("finding watermark by ID: %w", err)
+	}
+	presetProto.Watermark.S3Key = watermark.S3Key
+
+	return presetProto, nil
+}
+
+// startVariantProcessing marks the variant as processing and builds the
+// request for processors to render it.
+func (s *Service) startVariantProcessing(ctx context.Context, image domain.Image,
+	variant domain.ImageVariant, preset domain.Preset,
+) (*imageerv1.ImageProcessRequest, error) {
+	variant, err := s.imageVarRepo.Update(ctx, domain.UpdateImageVariantRequest{
+		ID:    variant.ID,
+		State: new(images.VariantStateProcessing),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("updating image variant: %w", err)
+	}
+
+	presetProto, err := s.presetToProto(ctx, preset.ForDescriptor(variant.Descriptor))
+	if err != nil {
+		return nil, fmt.Errorf("converting preset to proto: %w", err)
+	}
+	// Variants of a set other than the base density are not worth upscaling
+	presetProto.SkipUpscale = variant.Descriptor != "" &&
+		variant.Descriptor != images.NewDensityDescriptor(1)
+
+	return &imageerv1.ImageProcessRequest{
+		Image:   image.ToProto(),
+		Variant: variant.ToProto(),
+		Preset:  presetProto,
+	}, nil
+}
+
+func (s *Service) pushProcessRequests(ctx context.Context, reqs []*imageerv1.ImageProcessRequest,
+) error {
+	for _, req := range reqs {
+		if err := s.imageProcRequestQueue.Push(ctx, req); err != nil {
+			return fmt.Errorf("enqueuing image process request: %w", err)
+		}
+	}
+	return nil
+}
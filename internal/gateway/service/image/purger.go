@@ -0,0 +1,281 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/projects"
+	imageerv1 "github.com/isutare412/imageer/pkg/protogen/imageer/v1"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// Purger permanently deletes images soft-deleted longer than the restore
+// window along with their objects, and runs due project deletion jobs.
+type Purger struct {
+	projectRepo               port.ProjectRepository
+	projectDeletionRepo       port.ProjectDeletionRepository
+	imageRepo                 port.ImageRepository
+	watermarkRepo             port.WatermarkRepository
+	imageS3DeleteRequestQueue port.ImageS3DeleteRequestQueue
+	cfg                       PurgerConfig
+
+	ticker *time.Ticker
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func NewPurger(
+	cfg PurgerConfig,
+	projectRepo port.ProjectRepository,
+	projectDeletionRepo port.ProjectDeletionRepository,
+	imageRepo port.ImageRepository,
+	watermarkRepo port.WatermarkRepository,
+	imageS3DeleteRequestQueue port.ImageS3DeleteRequestQueue,
+) *Purger {
+	return &Purger{
+		projectRepo:               projectRepo,
+		projectDeletionRepo:       projectDeletionRepo,
+		imageRepo:                 imageRepo,
+		watermarkRepo:             watermarkRepo,
+		imageS3DeleteRequestQueue: imageS3DeleteRequestQueue,
+		cfg:                       cfg,
+	}
+}
+
+func (p *Purger) OnStartedLeading(ctx context.Context) {
+	p.ticker = time.NewTicker(p.cfg.CheckInterval)
+	p.stopCh = make(chan struct{})
+	p.doneCh = make(chan struct{})
+
+	go p.run(ctx)
+}
+
+func (p *Purger) OnStoppedLeading() {
+	if p.stopCh != nil {
+		close(p.stopCh)
+		<-p.doneCh
+	}
+}
+
+func (p *Purger) run(ctx context.Context) {
+	defer close(p.doneCh)
+	defer p.ticker.Stop()
+
+	for {
+		if err := p.purgeDeleted(); err != nil {
+			slog.ErrorContext(ctx, "Failed to purge deleted images and projects", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.stopCh:
+			return
+		case <-p.ticker.C:
+		}
+	}
+}
+
+func (p *Purger) purgeDeleted() error {
+	ctx, span := tracing.StartSpanNonSampled(context.Background(), "image.Purger.purgeDeleted")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.CheckTimeout)
+	defer cancel()
+
+	threshold := time.Now().Add(-p.cfg.RestoreWindow)
+
+	if err := p.purgeImages(ctx, domain.ImageSearchFilter{
+		Deleted:         true,
+		DeletedAtBefore: &threshold,
+	}); err != nil {
+		return fmt.Errorf("purging deleted images: %w", err)
+	}
+
+	now := time.Now()
+	deletions, err := p.projectDeletionRepo.List(ctx, domain.ListProjectDeletionsParams{
+		SearchFilter: domain.ProjectDeletionSearchFilter{
+			States: []projects.DeletionState{
+				projects.DeletionStatePending,
+				projects.DeletionStateRunning,
+			},
+			StartAfterBefore: &now,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing due project deletions: %w", err)
+	}
+
+	for _, deletion := range deletions {
+		if err := p.runDeletion(ctx, deletion); err != nil {
+			slog.ErrorContext(ctx, "Failed to run project deletion", "projectDeletionId",
+				deletion.ID, "projectId", deletion.ProjectID, "error", err)
+
+			if _, err := p.projectDeletionRepo.Update(ctx, domain.UpdateProjectDeletionRequest{
+				ID:        deletion.ID,
+				LastError: new(err.Error()),
+			}); err != nil {
+				slog.ErrorContext(ctx, "Failed to record project deletion error",
+					"projectDeletionId", deletion.ID, "error", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// runDeletion purges images of the deleted project in batches, recording
+// progress after each batch so that an interrupted job resumes on the next run.
+// The project is purged after its images and watermark objects.
+func (p *Purger) runDeletion(ctx context.Context, deletion domain.ProjectDeletion) error {
+	filter := domain.ImageSearchFilter{
+		ProjectID: &deletion.ProjectID,
+		Deleted:   true,
+	}
+
+	if deletion.State == projects.DeletionStatePending {
+		remaining, err := p.imageRepo.List(ctx, domain.ListImagesParams{
+			Limit:        new(1),
+			SearchFilter: filter,
+		})
+		if err != nil {
+			return fmt.Errorf("counting images: %w", err)
+		}
+
+		deletion, err = p.projectDeletionRepo.Update(ctx, domain.UpdateProjectDeletionRequest{
+			ID:          deletion.ID,
+			State:       new(projects.DeletionStateRunning),
+			TotalImages: &remaining.Total,
+		})
+		if err != nil {
+			return fmt.Errorf("starting project deletion: %w", err)
+		}
+		slog.InfoContext(ctx, "Started project deletion", "projectDeletionId", deletion.ID,
+			"projectId", deletion.ProjectID, "totalImages", deletion.TotalImages)
+	}
+
+	for {
+		imgs, err := p.imageRepo.List(ctx, domain.ListImagesParams{
+			Limit:        &p.cfg.BatchSize,
+			SearchFilter: filter,
+		})
+		if err != nil {
+			return fmt.Errorf("listing images: %w", err)
+		}
+		if len(imgs.Items) == 0 {
+			break
+		}
+
+		for _, img := range imgs.Items {
+			if err := p.imageRepo.Purge(ctx, img.ID); err != nil {
+				return fmt.Errorf("purging image %s: %w", img.ID, err)
+			}
+
+			// Images lose the reference to the deleted project
+			img.Project.ID = deletion.ProjectID
+			img.Project.StorageProfile = deletion.StorageProfile
+			pushS3DeleteRequest(ctx, p.imageS3DeleteRequestQueue, img, imageS3Keys(img))
+		}
+
+		deletion, err = p.projectDeletionRepo.Update(ctx, domain.UpdateProjectDeletionRequest{
+			ID:           deletion.ID,
+			PurgedImages: new(deletion.PurgedImages + int64(len(imgs.Items))),
+		})
+		if err != nil {
+			return fmt.Errorf("recording project deletion progress: %w", err)
+		}
+	}
+
+	watermarks, err := p.watermarkRepo.List(ctx, domain.ListWatermarksParams{
+		Limit:        new(-1),
+		SearchFilter: domain.WatermarkSearchFilter{ProjectID: &deletion.ProjectID},
+	})
+	if err != nil {
+		return fmt.Errorf("listing watermarks: %w", err)
+	}
+
+	// Watermarks are deleted along with the project by the foreign key constraint
+	if err := p.projectRepo.Purge(ctx, deletion.ProjectID); err != nil {
+		return fmt.Errorf("purging project: %w", err)
+	}
+
+	if len(watermarks.Items) > 0 {
+		s3Keys := lo.Map(watermarks.Items, func(w domain.Watermark, _ int) string {
+			return w.S3Key
+		})
+		if err := p.imageS3DeleteRequestQueue.Push(ctx, &imageerv1.ImageS3DeleteRequest{
+			ProjectId:      deletion.ProjectID,
+			S3Keys:         s3Keys,
+			StorageProfile: deletion.StorageProfile,
+		}); err != nil {
+			// Log error but don't fail as objects are no longer referenced
+			slog.ErrorContext(ctx, "Failed to push S3 delete request of watermarks",
+				"projectId", deletion.ProjectID, "error", err)
+		}
+	}
+
+	if _, err := p.projectDeletionRepo.Update(ctx, domain.UpdateProjectDeletionRequest{
+		ID:         deletion.ID,
+		State:      new(projects.DeletionStateSucceeded),
+		LastError:  new(""),
+		FinishedAt: new(time.Now()),
+	}); err != nil {
+		return fmt.Errorf("finishing project deletion: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Finished project deletion", "projectDeletionId", deletion.ID,
+		"projectId", deletion.ProjectID, "purgedImages", deletion.PurgedImages)
+	return nil
+}
+
+func (p *Purger) purgeImages(ctx context.Context, filter domain.ImageSearchFilter) error {
+	result, err := p.imageRepo.List(ctx, domain.ListImagesParams{
+		Limit:        new(-1),
+		SearchFilter: filter,
+	})
+	if err != nil {
+		return fmt.Errorf("listing deleted images: %w", err)
+	}
+
+	var failed int
+	for _, img := range result.Items {
+		if img.Project.ID == "" {
+			// Images of deleted projects are purged by project deletion jobs
+			continue
+		}
+
+		if err := p.imageRepo.Purge(ctx, img.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to purge deleted image", "imageId", img.ID,
+				"error", err)
+			failed++
+			continue
+		}
+
+		pushS3DeleteRequest(ctx, p.imageS3DeleteRequestQueue, img, imageS3Keys(img))
+
+		slog.InfoContext(ctx, "Purged deleted image", "imageId", img.ID)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to purge %d images", failed)
+	}
+	return nil
+}
+
+// imageS3Keys returns keys of all stored objects of the image.
+func imageS3Keys(img domain.Image) []string {
+	var s3Keys []string
+	if img.HasOriginal() {
+		s3Keys = append(s3Keys, img.S3Key)
+	}
+	for _, variant := range img.Variants {
+		s3Keys = append(s3Keys, variant.S3Key)
+	}
+	return s3Keys
+}
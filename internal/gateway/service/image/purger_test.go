@@ -0,0 +1,155 @@
+package image
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
+	imageerv1 "github.com/isutare412/imageer/pkg/protogen/imageer/v1"
+)
+
+type fakeDeletedImageRepository struct {
+	port.ImageRepository
+	images   []domain.Image
+	purgeErr error
+}
+
+func (r *fakeDeletedImageRepository) List(_ context.Context, params domain.ListImagesParams,
+) (domain.Images, error) {
+	items := slices.Clone(r.images)
+	if limit := *params.Limit; limit >= 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return domain.Images{Items: items, Total: int64(len(r.images))}, nil
+}
+
+func (r *fakeDeletedImageRepository) Purge(_ context.Context, id string) error {
+	if r.purgeErr != nil {
+		return r.purgeErr
+	}
+	for i, img := range r.images {
+		if img.ID == id {
+			r.images = append(r.images[:i], r.images[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+type fakeProjectRepository struct {
+	port.ProjectRepository
+	purgedIDs []string
+}
+
+func (r *fakeProjectRepository) Purge(_ context.Context, id string) error {
+	r.purgedIDs = append(r.purgedIDs, id)
+	return nil
+}
+
+type fakeProjectDeletionRepository struct {
+	port.ProjectDeletionRepository
+	deletion domain.ProjectDeletion
+}
+
+func (r *fakeProjectDeletionRepository) Update(_ context.Context,
+	req domain.UpdateProjectDeletionRequest,
+) (domain.ProjectDeletion, error) {
+	if req.State != nil {
+		r.deletion.State = *req.State
+	}
+	if req.TotalImages != nil {
+		r.deletion.TotalImages = *req.TotalImages
+	}
+	if req.PurgedImages != nil {
+		r.deletion.PurgedImages = *req.PurgedImages
+	}
+	if req.LastError != nil {
+		r.deletion.LastError = *req.LastError
+	}
+	if req.FinishedAt != nil {
+		r.deletion.FinishedAt = req.FinishedAt
+	}
+	return r.deletion, nil
+}
+
+type fakeImageS3DeleteRequestQueue struct {
+	port.ImageS3DeleteRequestQueue
+	reqs []*imageerv1.ImageS3DeleteRequest
+}
+
+func (q *fakeImageS3DeleteRequestQueue) Push(_ context.Context,
+	req *imageerv1.ImageS3DeleteRequest,
+) error {
+	q.reqs = append(q.reqs, req)
+	return nil
+}
+
+func TestPurger_runDeletion(t *testing.T) {
+	deletedImages := func() []domain.Image {
+		return []domain.Image{
+			{ID: "image-1", S3Key: "images/1.jpg", Variants: []domain.ImageVariant{{S3Key: "images/1.webp"}}},
+			{ID: "image-2", S3Key: "images/2.jpg", OriginalState: images.OriginalStateDeleted},
+			{ID: "image-3", S3Key: "images/3.jpg"},
+		}
+	}
+
+	t.Run("purges images in batches then the project", func(t *testing.T) {
+		imageRepo := &fakeDeletedImageRepository{images: deletedImages()}
+		projectRepo := &fakeProjectRepository{}
+		deletionRepo := &fakeProjectDeletionRepository{deletion: domain.ProjectDeletion{
+			ID:             "deletion-1",
+			ProjectID:      "project-1",
+			StorageProfile: "secondary",
+			State:          projects.DeletionStatePending,
+		}}
+		queue := &fakeImageS3DeleteRequestQueue{}
+		p := NewPurger(PurgerConfig{BatchSize: 2}, projectRepo, deletionRepo, imageRepo,
+			&fakeWatermarkRepository{watermarks: []domain.Watermark{{S3Key: "images/w.png"}}},
+			queue)
+
+		require.NoError(t, p.runDeletion(t.Context(), deletionRepo.deletion))
+
+		assert.Equal(t, projects.DeletionStateSucceeded, deletionRepo.deletion.State)
+		assert.Equal(t, int64(3), deletionRepo.deletion.TotalImages)
+		assert.Equal(t, int64(3), deletionRepo.deletion.PurgedImages)
+		assert.NotNil(t, deletionRepo.deletion.FinishedAt)
+		assert.Equal(t, []string{"project-1"}, projectRepo.purgedIDs)
+
+		require.Len(t, queue.reqs, 4)
+		assert.Equal(t, []string{"images/1.jpg", "images/1.webp"}, queue.reqs[0].S3Keys)
+		assert.Empty(t, queue.reqs[1].S3Keys)
+		assert.Equal(t, []string{"images/w.png"}, queue.reqs[3].S3Keys)
+		for _, req := range queue.reqs {
+			assert.Equal(t, "project-1", req.ProjectId)
+			assert.Equal(t, "secondary", req.StorageProfile)
+		}
+	})
+
+	t.Run("keeps the project on failure", func(t *testing.T) {
+		imageRepo := &fakeDeletedImageRepository{
+			images:   deletedImages(),
+			purgeErr: assert.AnError,
+		}
+		projectRepo := &fakeProjectRepository{}
+		deletionRepo := &fakeProjectDeletionRepository{deletion: domain.ProjectDeletion{
+			ID:        "deletion-1",
+			ProjectID: "project-1",
+			State:     projects.DeletionStatePending,
+		}}
+		p := NewPurger(PurgerConfig{BatchSize: 2}, projectRepo, deletionRepo, imageRepo,
+			&fakeWatermarkRepository{}, &fakeImageS3DeleteRequestQueue{})
+
+		require.Error(t, p.runDeletion(t.Context(), deletionRepo.deletion))
+
+		assert.Equal(t, projects.DeletionStateRunning, deletionRepo.deletion.State)
+		assert.Zero(t, deletionRepo.deletion.PurgedImages)
+		assert.Empty(t, projectRepo.purgedIDs)
+	})
+}
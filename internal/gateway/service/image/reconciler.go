@@ -0,0 +1,313 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// Reconciler finds drift between objects in storages and the database. It
+// reports orphaned objects no image or watermark refers to, and marks variants
+// whose objects are missing as failed. Orphans are deleted if enabled, but only
+// after they have been reported by a previous run.
+type Reconciler struct {
+	objectStorage port.ObjectStorage
+	projectRepo   port.ProjectRepository
+	imageRepo     port.ImageRepository
+	imageVarRepo  port.ImageVariantRepository
+	watermarkRepo port.WatermarkRepository
+	cfg           ReconcilerConfig
+
+	// reportedOrphans are orphaned keys found by the previous run, keyed by
+	// storage profile.
+	reportedOrphans map[string]map[string]struct{}
+
+	ticker *time.Ticker
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func NewReconciler(
+	cfg ReconcilerConfig,
+	objectStorage port.ObjectStorage,
+	projectRepo port.ProjectRepository,
+	imageRepo port.ImageRepository,
+	imageVariantRepo port.ImageVariantRepository,
+	watermarkRepo port.WatermarkRepository,
+) *Reconciler {
+	return &Reconciler{
+		objectStorage: objectStorage,
+		projectRepo:   projectRepo,
+		imageRepo:     imageRepo,
+		imageVarRepo:  imageVariantRepo,
+		watermarkRepo: watermarkRepo,
+		cfg:           cfg,
+	}
+}
+
+func (r *Reconciler) OnStartedLeading(ctx context.Context) {
+	r.reportedOrphans = make(map[string]map[string]struct{})
+	r.ticker = time.NewTicker(r.cfg.CheckInterval)
+	r.stopCh = make(chan struct{})
+	r.doneCh = make(chan struct{})
+
+	go r.run(ctx)
+}
+
+func (r *Reconciler) OnStoppedLeading() {
+	if r.stopCh != nil {
+		close(r.stopCh)
+		<-r.doneCh
+	}
+}
+
+func (r *Reconciler) run(ctx context.Context) {
+	defer close(r.doneCh)
+	defer r.ticker.Stop()
+
+	for {
+		if err := r.reconcile(); err != nil {
+			slog.ErrorContext(ctx, "Failed to reconcile storages", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.stopCh:
+			return
+		case <-r.ticker.C:
+		}
+	}
+}
+
+func (r *Reconciler) reconcile() error {
+	ctx, span := tracing.StartSpanNonSampled(context.Background(), "image.Reconciler.reconcile")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.CheckTimeout)
+	defer cancel()
+
+	projs, err := r.projectRepo.List(ctx, domain.ListProjectsParams{Limit: new(-1)})
+	if err != nil {
+		return fmt.Errorf("listing projects: %w", err)
+	}
+
+	// Objects of deleted projects are kept until purge
+	deletedProjs, err := r.projectRepo.List(ctx, domain.ListProjectsParams{
+		Limit:        new(-1),
+		SearchFilter: domain.ProjectSearchFilter{Deleted: true},
+	})
+	if err != nil {
+		return fmt.Errorf("listing deleted projects: %w", err)
+	}
+
+	projsByProfile := lo.GroupBy(projs.Items, r.storageProfileOf)
+	deletedProjsByProfile := lo.GroupBy(deletedProjs.Items, r.storageProfileOf)
+
+	for profile, projs := range projsByProfile {
+		if err := r.reconcileProfile(ctx, profile, projs, deletedProjsByProfile[profile]); err != nil {
+			slog.ErrorContext(ctx, "Failed to reconcile storage profile", "storageProfile", profile,
+				"error", err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileProfile diffs objects under prefixes of projs against the database.
+// Prefixes may be shared between projects, so that objects of all projects in
+// the profile are regarded as known.
+func (r *Reconciler) reconcileProfile(ctx context.Context, profile string,
+	projs, deletedProjs []domain.Project,
+) error {
+	threshold := time.Now().Add(-r.cfg.GracePeriod)
+
+	knownKeys := make(map[string]struct{})
+	var liveImages []domain.Image
+	for _, proj := range slices.Concat(projs, deletedProjs) {
+		imgs, err := r.listImages(ctx, proj.ID)
+		if err != nil {
+			return fmt.Errorf("listing images of project %s: %w", proj.ID, err)
+		}
+		for _, img := range imgs {
+			if img.DeletedAt == nil && proj.DeletedAt == nil {
+				liveImages = append(liveImages, img)
+			}
+			knownKeys[img.S3Key] = struct{}{}
+			for _, variant := range img.Variants {
+				knownKeys[variant.S3Key] = struct{}{}
+			}
+		}
+
+		watermarks, err := r.watermarkRepo.List(ctx, domain.ListWatermarksParams{
+			Limit:        new(-1),
+			SearchFilter: domain.WatermarkSearchFilter{ProjectID: &proj.ID},
+		})
+		if err != nil {
+			return fmt.Errorf("listing watermarks of project %s: %w", proj.ID, err)
+		}
+		for _, watermark := range watermarks.Items {
+			knownKeys[watermark.S3Key] = struct{}{}
+		}
+	}
+
+	storedObjects := make(map[string]domain.StoredObject)
+	for _, prefix := range r.objectPrefixes(projs) {
+		objects, err := r.objectStorage.ListObjects(ctx, profile, prefix)
+		if err != nil {
+			return fmt.Errorf("listing objects under %s: %w", prefix, err)
+		}
+		for _, obj := range objects {
+			storedObjects[obj.Key] = obj
+		}
+	}
+
+	// Objects just uploaded may not be recorded yet
+	var orphanKeys []string
+	for key, obj := range storedObjects {
+		if _, ok := knownKeys[key]; !ok && obj.LastModified.Before(threshold) {
+			orphanKeys = append(orphanKeys, key)
+		}
+	}
+	slices.Sort(orphanKeys)
+	for _, key := range orphanKeys {
+		slog.WarnContext(ctx, "Found orphaned object", "storageProfile", profile, "key", key)
+	}
+
+	missingOriginals, missingVariants := r.markMissingObjects(ctx, liveImages, storedObjects,
+		threshold)
+
+	var deletedOrphans int
+	if r.cfg.DeleteOrphans {
+		deletedOrphans = r.deleteReportedOrphans(ctx, profile, orphanKeys)
+	}
+	r.reportedOrphans[profile] = lo.Keyify(orphanKeys)
+
+	slog.InfoContext(ctx, "Reconciled storage profile", "storageProfile", profile,
+		"objects", len(storedObjects), "orphanedObjects", len(orphanKeys),
+		"deletedOrphanedObjects", deletedOrphans, "missingOriginals", missingOriginals,
+		"missingVariants", missingVariants)
+
+	return nil
+}
+
+// markMissingObjects reports ready images whose originals are missing, and
+// marks ready variants whose objects are missing as failed so that they can be
+// reprocessed.
+func (r *Reconciler) markMissingObjects(ctx context.Context, imgs []domain.Image,
+	storedObjects map[string]domain.StoredObject, threshold time.Time,
+) (missingOriginals, missingVariants int) {
+	for _, img := range imgs {
+		if img.State != images.StateReady || img.CreatedAt.After(threshold) {
+			continue
+		}
+
+		if _, ok := storedObjects[img.S3Key]; !ok && img.HasOriginal() {
+			slog.WarnContext(ctx, "Found missing original", "imageId", img.ID, "key", img.S3Key)
+			missingOriginals++
+		}
+
+		for _, variant := range img.Variants {
+			if variant.State != images.VariantStateReady || variant.UpdatedAt.After(threshold) {
+				continue
+			}
+			if _, ok := storedObjects[variant.S3Key]; ok {
+				continue
+			}
+
+			slog.WarnContext(ctx, "Found missing variant", "imageId", img.ID,
+				"imageVariantId", variant.ID, "key", variant.S3Key)
+			missingVariants++
+
+			if _, err := r.imageVarRepo.Update(ctx, domain.UpdateImageVariantRequest{
+				ID:    variant.ID,
+				State: new(images.VariantStateFailed),
+			}); err != nil {
+				slog.ErrorContext(ctx, "Failed to mark missing variant as failed",
+					"imageVariantId", variant.ID, "error", err)
+			}
+		}
+	}
+	return missingOriginals, missingVariants
+}
+
+// deleteReportedOrphans deletes orphaned keys which have been reported by the
+// previous run as well, and returns the number of deleted objects.
+func (r *Reconciler) deleteReportedOrphans(ctx context.Context, profile string,
+	orphanKeys []string,
+) int {
+	reported := r.reportedOrphans[profile]
+	keys := lo.Filter(orphanKeys, func(key string, _ int) bool {
+		_, ok := reported[key]
+		return ok
+	})
+	if len(keys) == 0 {
+		return 0
+	}
+
+	if err := r.objectStorage.DeleteObjects(ctx, profile, keys); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete orphaned objects", "storageProfile", profile,
+			"error", err)
+		return 0
+	}
+	return len(keys)
+}
+
+// listImages lists both live and soft-deleted images of the project, as
+// objects of deleted images are kept until purge.
+func (r *Reconciler) listImages(ctx context.Context, projectID string) ([]domain.Image, error) {
+	live, err := r.imageRepo.List(ctx, domain.ListImagesParams{
+		Limit:        new(-1),
+		SearchFilter: domain.ImageSearchFilter{ProjectID: &projectID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing images: %w", err)
+	}
+
+	deleted, err := r.imageRepo.List(ctx, domain.ListImagesParams{
+		Limit:        new(-1),
+		SearchFilter: domain.ImageSearchFilter{ProjectID: &projectID, Deleted: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing deleted images: %w", err)
+	}
+
+	return slices.Concat(live.Items, deleted.Items), nil
+}
+
+// objectPrefixes returns distinct object key prefixes of projs, dropping ones
+// covered by shorter prefixes.
+func (r *Reconciler) objectPrefixes(projs []domain.Project) []string {
+	prefixes := lo.Uniq(lo.Map(projs, func(p domain.Project, _ int) string {
+		return fmt.Sprintf("%s/%s", r.cfg.S3KeyPrefix, projectObjectPath(p.ToReference()))
+	}))
+	slices.Sort(prefixes)
+
+	var result []string
+	for _, prefix := range prefixes {
+		if len(result) > 0 && strings.HasPrefix(prefix, result[len(result)-1]) {
+			continue
+		}
+		result = append(result, prefix)
+	}
+	return result
+}
+
+// storageProfileOf names the default profile explicitly, so that projects
+// created before storage profiles are reconciled with the others in it.
+func (r *Reconciler) storageProfileOf(p domain.Project) string {
+	if p.StorageProfile == "" {
+		return r.cfg.DefaultStorageProfile
+	}
+	return p.StorageProfile
+}
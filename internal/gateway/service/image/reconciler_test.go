@@ -0,0 +1,126 @@
+package image
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
+)
+
+type fakeObjectStorage struct {
+	port.ObjectStorage
+	objects     []domain.StoredObject
+	deletedKeys []string
+}
+
+func (s *fakeObjectStorage) ListObjects(_ context.Context, _, _ string,
+) ([]domain.StoredObject, error) {
+	return s.objects, nil
+}
+
+func (s *fakeObjectStorage) DeleteObjects(_ context.Context, _ string, keys []string) error {
+	s.deletedKeys = append(s.deletedKeys, keys...)
+	return nil
+}
+
+type fakeImageRepository struct {
+	port.ImageRepository
+	images []domain.Image
+}
+
+func (r *fakeImageRepository) List(_ context.Context, params domain.ListImagesParams,
+) (domain.Images, error) {
+	if params.SearchFilter.Deleted {
+		return domain.Images{}, nil
+	}
+	return domain.Images{Items: r.images}, nil
+}
+
+type fakeImageVariantRepository struct {
+	port.ImageVariantRepository
+	failedIDs []string
+}
+
+func (r *fakeImageVariantRepository) Update(_ context.Context, req domain.UpdateImageVariantRequest,
+) (domain.ImageVariant, error) {
+	if req.State != nil && *req.State == images.VariantStateFailed {
+		r.failedIDs = append(r.failedIDs, req.ID)
+	}
+	return domain.ImageVariant{ID: req.ID}, nil
+}
+
+type fakeWatermarkRepository struct {
+	port.WatermarkRepository
+	watermarks []domain.Watermark
+}
+
+func (r *fakeWatermarkRepository) List(context.Context, domain.ListWatermarksParams,
+) (domain.Watermarks, error) {
+	return domain.Watermarks{Items: r.watermarks}, nil
+}
+
+func TestReconciler_reconcileProfile(t *testing.T) {
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now()
+
+	objectStorage := &fakeObjectStorage{
+		objects: []domain.StoredObject{
+			{Key: "images/a/original.jpg", LastModified: old},
+			{Key: "images/w.png", LastModified: old},
+			{Key: "images/orphan.jpg", LastModified: old},
+			{Key: "images/uploading.jpg", LastModified: recent},
+		},
+	}
+	imageVarRepo := &fakeImageVariantRepository{}
+	r := NewReconciler(ReconcilerConfig{
+		S3KeyPrefix:   "images",
+		GracePeriod:   24 * time.Hour,
+		DeleteOrphans: true,
+	}, objectStorage, nil, &fakeImageRepository{
+		images: []domain.Image{{
+			ID:        "image-1",
+			CreatedAt: old,
+			State:     images.StateReady,
+			S3Key:     "images/a/original.jpg",
+			Variants: []domain.ImageVariant{
+				{ID: "variant-1", UpdatedAt: old, State: images.VariantStateReady, S3Key: "images/a/v1.webp"},
+				{ID: "variant-2", UpdatedAt: old, State: images.VariantStateFailed, S3Key: "images/a/v2.webp"},
+			},
+		}},
+	}, imageVarRepo, &fakeWatermarkRepository{
+		watermarks: []domain.Watermark{{S3Key: "images/w.png"}},
+	})
+	r.reportedOrphans = make(map[string]map[string]struct{})
+
+	projs := []domain.Project{{ID: "project-1"}}
+
+	// Orphans are only reported by the first run
+	require.NoError(t, r.reconcileProfile(t.Context(), "default", projs, nil))
+	assert.Empty(t, objectStorage.deletedKeys)
+	assert.Equal(t, []string{"variant-1"}, imageVarRepo.failedIDs)
+
+	require.NoError(t, r.reconcileProfile(t.Context(), "default", projs, nil))
+	assert.Equal(t, []string{"images/orphan.jpg"}, objectStorage.deletedKeys)
+}
+
+func TestReconciler_objectPrefixes(t *testing.T) {
+	r := &Reconciler{cfg: ReconcilerConfig{S3KeyPrefix: "images"}}
+
+	projs := []domain.Project{
+		{ID: "project-1"},
+		{ID: "project-2", KeyTemplate: new(projects.KeyTemplate("{project}/{image}/{variant}.{ext}"))},
+		{ID: "project-3", KeyTemplate: new(projects.KeyTemplate("projects/{project}/{image}/{variant}.{ext}"))},
+		{ID: "project-4", KeyTemplate: new(projects.KeyTemplate("{yyyy}/{project}/{image}/{variant}.{ext}"))},
+	}
+
+	assert.Equal(t, []string{"images/project-2/", "images/projects/project-1/images/",
+		"images/projects/project-3/"}, r.objectPrefixes(projs[:3]))
+	assert.Equal(t, []string{"images/"}, r.objectPrefixes(projs))
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/port"
 	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/audits"
 	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
 	imageerv1 "github.com/isutare412/imageer/pkg/protogen/imageer/v1"
 	"github.com/isutare412/imageer/pkg/validation"
 )
@@ -25,11 +30,14 @@ type Service struct {
 	imageVarRepo               port.ImageVariantRepository
 	imageProcLogRepo           port.ImageProcessingLogRepository
 	presetRepo                 port.PresetRepository
+	projectRepo                port.ProjectRepository
+	watermarkRepo              port.WatermarkRepository
 	imageProcRequestQueue      port.ImageProcessRequestQueue
 	imageNotificationPublisher port.ImageNotificationPublisher
 	imageUploadDoneSubscriber  port.ImageUploadDoneSubscriber
 	imageProcDoneSubscriber    port.ImageProcessDoneSubscriber
 	imageS3DeleteRequestQueue  port.ImageS3DeleteRequestQueue
+	auditRecorder              port.AuditRecorder
 
 	cfg Config
 }
@@ -38,11 +46,13 @@ func NewService(cfg Config, s3Presigner port.S3Presigner, objectStorage port.Obj
 	transactioner port.Transactioner,
 	imageRepo port.ImageRepository, imageVarRepo port.ImageVariantRepository,
 	imageProcLogRepo port.ImageProcessingLogRepository, presetRepo port.PresetRepository,
+	projectRepo port.ProjectRepository, watermarkRepo port.WatermarkRepository,
 	imageProcRequestQueue port.ImageProcessRequestQueue,
 	imageNotificationPublisher port.ImageNotificationPublisher,
 	imageUploadDoneSubscriber port.ImageUploadDoneSubscriber,
 	imageProcDoneSubscriber port.ImageProcessDoneSubscriber,
 	imageS3DeleteRequestQueue port.ImageS3DeleteRequestQueue,
+	auditRecorder port.AuditRecorder,
 ) *Service {
 	return &Service{
 		s3Presigner:                s3Presigner,
@@ -52,11 +62,14 @@ func NewService(cfg Config, s3Presigner port.S3Presigner, objectStorage port.Obj
 		imageVarRepo:               imageVarRepo,
 		imageProcLogRepo:           imageProcLogRepo,
 		presetRepo:                 presetRepo,
+		projectRepo:                projectRepo,
+		watermarkRepo:              watermarkRepo,
 		imageProcRequestQueue:      imageProcRequestQueue,
 		imageNotificationPublisher: imageNotificationPublisher,
 		imageUploadDoneSubscriber:  imageUploadDoneSubscriber,
 		imageProcDoneSubscriber:    imageProcDoneSubscriber,
 		imageS3DeleteRequestQueue:  imageS3DeleteRequestQueue,
+		auditRecorder:              auditRecorder,
 		cfg:                        cfg,
 	}
 }
@@ -66,10 +79,28 @@ func (s *Service) Get(ctx context.Context, imageID string) (domain.Image, error)
 	if err != nil {
 		return domain.Image{}, fmt.Errorf("finding image by ID: %w", err)
 	}
+
+	image, err = s.presignPrivateURLs(ctx, image)
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("presigning private URLs: %w", err)
+	}
 	return image, nil
 }
 
 func (s *Service) GetWaitUntilProcessed(ctx context.Context, imageID string) (domain.Image, error) {
+	image, err := s.waitUntilProcessed(ctx, imageID)
+	if err != nil {
+		return domain.Image{}, err
+	}
+
+	image, err = s.presignPrivateURLs(ctx, image)
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("presigning private URLs: %w", err)
+	}
+	return image, nil
+}
+
+func (s *Service) waitUntilProcessed(ctx context.Context, imageID string) (domain.Image, error) {
 	image, err := s.imageRepo.FindByID(ctx, imageID)
 	if err != nil {
 		return domain.Image{}, fmt.Errorf("finding image by ID: %w", err)
@@ -193,53 +224,284 @@ func (s *Service) List(ctx context.Context, params domain.ListImagesParams,
 	if err != nil {
 		return domain.Images{}, fmt.Errorf("listing images: %w", err)
 	}
+
+	for i, image := range images.Items {
+		images.Items[i], err = s.presignPrivateURLs(ctx, image)
+		if err != nil {
+			return domain.Images{}, fmt.Errorf("presigning private URLs: %w", err)
+		}
+	}
 	return images, nil
 }
 
-func (s *Service) Delete(ctx context.Context, id string) error {
-	var s3Keys []string
-	var projectID string
+func (s *Service) UpdateFocus(ctx context.Context, req domain.UpdateImageFocusRequest,
+) (domain.Image, error) {
+	if err := validation.Validate(req); err != nil {
+		return domain.Image{}, fmt.Errorf("validating request: %w", err)
+	}
 
+	var (
+		image        domain.Image
+		procRequests []*imageerv1.ImageProcessRequest
+		staleS3Keys  []string
+	)
 	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
-		// Fetch image with variants to collect S3 keys
-		image, err := s.imageRepo.FindByID(ctx, id)
+		var err error
+		image, err = s.imageRepo.Update(ctx, domain.UpdateImageRequest{
+			ID:    req.ImageID,
+			Focus: &req.Focus,
+		})
 		if err != nil {
-			return fmt.Errorf("finding image by ID: %w", err)
+			return fmt.Errorf("updating image: %w", err)
 		}
 
-		projectID = image.Project.ID
-		s3Keys = append(s3Keys, image.S3Key)
+		// Variants are rendered with the new focus once the upload is done
+		if image.State != images.StateReady {
+			return nil
+		}
+		if !image.HasOriginal() {
+			return apperr.NewError(apperr.CodeConflict).
+				WithSummary("Original image is deleted by the retention policy of the project")
+		}
+
+		// Re-render variants cropped around the focus. Re-rendered variants are
+		// moved to new objects as caches keep serving the old crop otherwise.
+		renderedAt := time.Now()
 		for _, variant := range image.Variants {
-			s3Keys = append(s3Keys, variant.S3Key)
+			preset, err := s.presetRepo.FindByID(ctx, variant.Preset.ID)
+			if err != nil {
+				return fmt.Errorf("finding preset by ID: %w", err)
+			}
+			if !preset.CropsAroundFocus() {
+				continue
+			}
+
+			name := imageVariantRevisionName(variant.ID, renderedAt)
+			path := imageVariantObjectPath(image.Project, image.ID, image.CreatedAt, preset.Name,
+				name, variant.Format)
+			staleS3Keys = append(staleS3Keys, variant.S3Key)
+			variant, err = s.imageVarRepo.Update(ctx, domain.UpdateImageVariantRequest{
+				ID:    variant.ID,
+				S3Key: new(s.objectS3Key(path)),
+				URL:   new(s.objectPublicURL(image.Project, path)),
+			})
+			if err != nil {
+				return fmt.Errorf("moving image variant: %w", err)
+			}
+
+			procReq, err := s.startVariantProcessing(ctx, image, variant, preset)
+			if err != nil {
+				return fmt.Errorf("starting variant processing: %w", err)
+			}
+			procRequests = append(procRequests, procReq)
+		}
+
+		if len(procRequests) > 0 {
+			image, err = s.imageRepo.FindByID(ctx, image.ID)
+			if err != nil {
+				return fmt.Errorf("finding image by ID: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("during transaction: %w", err)
+	}
+
+	if err := s.pushProcessRequests(ctx, procRequests); err != nil {
+		return domain.Image{}, fmt.Errorf("pushing image process requests: %w", err)
+	}
+
+	if len(procRequests) > 0 {
+		slog.InfoContext(ctx, "Request image reprocessing after focus change",
+			"imageId", image.ID, "variantCount", len(procRequests))
+	}
+
+	if len(staleS3Keys) > 0 {
+		req := &imageerv1.ImageS3DeleteRequest{
+			ImageId:        image.ID,
+			ProjectId:      image.Project.ID,
+			S3Keys:         staleS3Keys,
+			StorageProfile: image.Project.StorageProfile,
+		}
+		if err := s.imageS3DeleteRequestQueue.Push(ctx, req); err != nil {
+			// Log error but don't fail as stale objects are no longer referenced
+			slog.ErrorContext(ctx, "Failed to push S3 delete request", "imageId", image.ID,
+				"error", err)
+		}
+	}
+
+	image, err = s.presignPrivateURLs(ctx, image)
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("presigning private URLs: %w", err)
+	}
+	return image, nil
+}
+
+// UpdateDetails replaces the external ID, tags or metadata of the image.
+func (s *Service) UpdateDetails(ctx context.Context, req domain.UpdateImageDetailsRequest,
+) (domain.Image, error) {
+	if err := validation.Validate(req); err != nil {
+		return domain.Image{}, fmt.Errorf("validating request: %w", err)
+	}
+
+	var tags []string
+	if req.Tags != nil {
+		tags = lo.Uniq(req.Tags)
+	}
+
+	image, err := s.imageRepo.Update(ctx, domain.UpdateImageRequest{
+		ID:         req.ImageID,
+		ExternalID: req.ExternalID,
+		Tags:       tags,
+		Metadata:   req.Metadata,
+	})
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("updating image: %w", err)
+	}
+
+	image, err = s.presignPrivateURLs(ctx, image)
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("presigning private URLs: %w", err)
+	}
+	return image, nil
+}
+
+func (s *Service) Deliver(ctx context.Context, req domain.DeliverImageRequest,
+) (domain.ImageDelivery, error) {
+	if err := validation.Validate(req); err != nil {
+		return domain.ImageDelivery{}, fmt.Errorf("validating request: %w", err)
+	}
+
+	image, err := s.imageRepo.FindByID(ctx, req.ImageID)
+	if err != nil {
+		return domain.ImageDelivery{}, fmt.Errorf("finding image by ID: %w", err)
+	}
+	if image.Project.ID != req.ProjectID {
+		return domain.ImageDelivery{}, apperr.NewError(apperr.CodeNotFound).
+			WithSummary("Image not found in the specified project")
+	}
+	// Delivered responses are publicly cached, so private images are never
+	// delivered
+	if image.Project.Visibility == projects.VisibilityPrivate {
+		return domain.ImageDelivery{}, apperr.NewError(apperr.CodeNotFound).
+			WithSummary("Image not found in the specified project")
+	}
+
+	family, err := s.presetRepo.List(ctx, domain.ListPresetsParams{
+		SearchFilter: domain.PresetSearchFilter{
+			ProjectID: &req.ProjectID,
+			Names:     req.PresetFamilyNames(),
+		},
+	})
+	if err != nil {
+		return domain.ImageDelivery{}, fmt.Errorf("listing presets: %w", err)
+	}
+	if !lo.ContainsBy(family, func(p domain.Preset) bool { return p.Name == req.PresetName }) {
+		return domain.ImageDelivery{}, apperr.NewError(apperr.CodeNotFound).
+			WithSummary("Preset %s not found", req.PresetName)
+	}
+
+	if variant, ok := image.NegotiateVariant(family, req); ok {
+		return domain.ImageDelivery{
+			URL:          variant.URL,
+			ETag:         variant.ETag(),
+			CacheControl: publicCacheControl(s.cfg.DeliveryMaxAge),
+			Variant:      &variant,
+		}, nil
+	}
+
+	if image.State != images.StateReady {
+		return domain.ImageDelivery{}, apperr.NewError(apperr.CodeNotFound).
+			WithSummary("Image is not uploaded yet")
+	}
+	if !image.HasOriginal() {
+		return domain.ImageDelivery{}, apperr.NewError(apperr.CodeNotFound).
+			WithSummary("No variant of preset %s is ready", req.PresetName).
+			WithDetail("Original image is deleted by the retention policy of the project")
+	}
+	if !req.Accepts(image.Format) {
+		return domain.ImageDelivery{}, apperr.NewError(apperr.CodeNotAcceptable).
+			WithSummary("No acceptable variant of preset %s is ready", req.PresetName).
+			WithDetail("Original image of format %s is not accepted", image.Format)
+	}
+
+	return domain.ImageDelivery{
+		URL:          image.URL,
+		ETag:         image.ETag(),
+		CacheControl: publicCacheControl(s.cfg.DeliveryFallbackMaxAge),
+	}, nil
+}
+
+// Delete soft-deletes the image. Objects of the image are deleted when the
+// image is purged after the restore window.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		before, err := s.imageRepo.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("finding image: %w", err)
 		}
 
-		// Delete image from DB
 		if err := s.imageRepo.Delete(ctx, id); err != nil {
 			return fmt.Errorf("deleting image: %w", err)
 		}
 
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionImageDelete,
+			TargetType: audits.TargetTypeImage,
+			TargetID:   id,
+			Before:     imageSnapshot(before),
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
 		return nil
 	})
 	if err != nil {
 		return fmt.Errorf("during transaction: %w", err)
 	}
+	return nil
+}
+
+func (s *Service) Restore(ctx context.Context, id string) (domain.Image, error) {
+	var image domain.Image
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		image, err = s.imageRepo.Restore(ctx, id)
+		if err != nil {
+			return fmt.Errorf("restoring image: %w", err)
+		}
 
-	// Push S3 delete request to queue (async)
-	req := &imageerv1.ImageS3DeleteRequest{
-		ImageId:   id,
-		ProjectId: projectID,
-		S3Keys:    s3Keys,
+		// Images of deleted projects are restored along with the project
+		if image.Project.ID == "" {
+			return apperr.NewError(apperr.CodeConflict).
+				WithSummary("Project of image %s is deleted", id)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionImageRestore,
+			TargetType: audits.TargetTypeImage,
+			TargetID:   id,
+			After:      imageSnapshot(image),
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("during transaction: %w", err)
 	}
-	if err := s.imageS3DeleteRequestQueue.Push(ctx, req); err != nil {
-		// Log error but don't fail the delete operation
-		slog.ErrorContext(ctx, "Failed to push S3 delete request", "imageId", id, "error", err)
+
+	image, err = s.presignPrivateURLs(ctx, image)
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("presigning private URLs: %w", err)
 	}
 
-	return nil
+	return image, nil
 }
 
 func (s *Service) DeleteS3Objects(ctx context.Context, req *imageerv1.ImageS3DeleteRequest) error {
-	if err := s.objectStorage.DeleteObjects(ctx, req.S3Keys); err != nil {
+	if err := s.objectStorage.DeleteObjects(ctx, req.StorageProfile, req.S3Keys); err != nil {
 		return fmt.Errorf("deleting S3 objects: %w", err)
 	}
 	return nil
@@ -253,6 +515,12 @@ func (s *Service) CreateUploadURL(ctx context.Context, req domain.CreateUploadUR
 
 	var image domain.Image
 	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		project, err := s.projectRepo.FindByID(ctx, req.ProjectID)
+		if err != nil {
+			return fmt.Errorf("finding project by ID: %w", err)
+		}
+		projectRef := project.ToReference()
+
 		// Check presets exist
 		params := domain.ListPresetsParams{
 			SearchFilter: domain.PresetSearchFilter{
@@ -271,34 +539,48 @@ func (s *Service) CreateUploadURL(ctx context.Context, req domain.CreateUploadUR
 
 		// Create image record
 		imageID := uuid.NewString()
+		createdAt := time.Now()
+		path := imageObjectPath(projectRef, imageID, createdAt, req.Format)
 		image = domain.Image{
-			ID:       imageID,
-			FileName: req.FileName,
-			Format:   req.Format,
-			State:    images.StateUploadPending,
-			S3Key:    s.imageS3Key(req.ProjectID, imageID, req.Format),
-			URL:      s.imagePublicURL(req.ProjectID, imageID, req.Format),
-			Project:  domain.ProjectReference{ID: req.ProjectID},
+			ID:        imageID,
+			CreatedAt: createdAt,
+			FileName:  req.FileName,
+			Format:    req.Format,
+			State:     images.StateUploadPending,
+			S3Key:     s.objectS3Key(path),
+			URL:       s.objectPublicURL(projectRef, path),
+			Project:   projectRef,
+
+			OriginalState: images.OriginalStateStored,
+
+			ExternalID: req.ExternalID,
+			Tags:       lo.Uniq(req.Tags),
+			Metadata:   lo.CoalesceMapOrEmpty(req.Metadata),
 		}
 		image, err = s.imageRepo.Create(ctx, image)
 		if err != nil {
 			return fmt.Errorf("creating image: %w", err)
 		}
 
-		// Create image variant records
+		// Create image variant records, one for each descriptor of variant sets
 		for _, preset := range presets {
-			variantID := uuid.NewString()
-			variant := domain.ImageVariant{
-				ID:      variantID,
-				Format:  preset.Format,
-				State:   images.VariantStateUploadPending,
-				S3Key:   s.imageVariantS3Key(req.ProjectID, imageID, variantID, preset.Format),
-				URL:     s.imageVariantPublicURL(req.ProjectID, imageID, variantID, preset.Format),
-				ImageID: imageID,
-				Preset:  domain.PresetReference{ID: preset.ID},
-			}
-			if _, err = s.imageVarRepo.Create(ctx, variant); err != nil {
-				return fmt.Errorf("creating image variant for preset: %w", err)
+			for _, descriptor := range preset.VariantDescriptors() {
+				variantID := uuid.NewString()
+				path := imageVariantObjectPath(projectRef, imageID, createdAt, preset.Name, variantID,
+					preset.Format)
+				variant := domain.ImageVariant{
+					ID:         variantID,
+					Format:     preset.Format,
+					State:      images.VariantStateUploadPending,
+					S3Key:      s.objectS3Key(path),
+					URL:        s.objectPublicURL(projectRef, path),
+					Descriptor: descriptor,
+					ImageID:    imageID,
+					Preset:     domain.PresetReference{ID: preset.ID},
+				}
+				if _, err = s.imageVarRepo.Create(ctx, variant); err != nil {
+					return fmt.Errorf("creating image variant for preset: %w", err)
+				}
 			}
 		}
 
@@ -310,8 +592,9 @@ func (s *Service) CreateUploadURL(ctx context.Context, req domain.CreateUploadUR
 
 	// Presign image upload URL
 	presignReq := domain.PresignPutObjectRequest{
-		S3Key:       image.S3Key,
-		ContentType: req.Format.ContentType(),
+		StorageProfile: image.Project.StorageProfile,
+		S3Key:          image.S3Key,
+		ContentType:    req.Format.ContentType(),
 	}
 	presignResp, err := s.s3Presigner.PresignPutObject(ctx, presignReq)
 	if err != nil {
@@ -327,22 +610,103 @@ func (s *Service) CreateUploadURL(ctx context.Context, req domain.CreateUploadUR
 }
 
 func (s *Service) StartImageProcessingOnUpload(ctx context.Context, s3Key string) error {
-	_, imageID, ok := parseImageS3Key(s3Key)
-	if !ok {
-		return apperr.NewError(apperr.CodeBadRequest).
-			WithSummary("Unexpected s3 key of uploaded image: %s", s3Key)
+	image, err := s.imageRepo.FindByS3Key(ctx, s3Key)
+	if err != nil {
+		return fmt.Errorf("finding image by S3 key: %w", err)
+	}
+
+	if _, err := s.startImageProcessing(ctx, image.ID); err != nil {
+		return fmt.Errorf("starting image processing: %w", err)
+	}
+	return nil
+}
+
+// IsPublicObject reports whether the object of the S3 key is an original or a
+// variant of an image in a public project. Objects not known to belong to
+// images, such as watermarks, are not public.
+func (s *Service) IsPublicObject(ctx context.Context, s3Key string) (bool, error) {
+	image, err := s.imageRepo.FindByS3Key(ctx, s3Key)
+	if apperr.IsErrorCode(err, apperr.CodeNotFound) {
+		variant, err := s.imageVarRepo.FindByS3Key(ctx, s3Key)
+		switch {
+		case apperr.IsErrorCode(err, apperr.CodeNotFound):
+			return false, nil
+		case err != nil:
+			return false, fmt.Errorf("finding image variant by S3 key: %w", err)
+		}
+
+		image, err = s.imageRepo.FindByID(ctx, variant.ImageID)
+		if err != nil {
+			return false, fmt.Errorf("finding image by ID: %w", err)
+		}
+	} else if err != nil {
+		return false, fmt.Errorf("finding image by S3 key: %w", err)
+	}
+
+	return image.Project.Visibility == projects.VisibilityPublic, nil
+}
+
+// CompleteUpload starts image processing on behalf of clients in deployments
+// without upload events, and of the upload sweeper when events are lost, once
+// the uploaded object is found in the storage.
+func (s *Service) CompleteUpload(ctx context.Context, imageID string) (domain.Image, error) {
+	image, err := s.imageRepo.FindByID(ctx, imageID)
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("finding image by ID: %w", err)
+	}
+
+	switch image.State {
+	case images.StateUploadPending:
+		err := s.objectStorage.HeadObject(ctx, image.Project.StorageProfile, image.S3Key)
+		switch {
+		case apperr.IsErrorCode(err, apperr.CodeNotFound):
+			return domain.Image{}, apperr.NewError(apperr.CodeConflict).
+				WithCause(err).
+				WithSummary("Image is not uploaded yet")
+		case err != nil:
+			return domain.Image{}, fmt.Errorf("heading image object: %w", err)
+		}
+
+		image, err = s.startImageProcessing(ctx, image.ID)
+		if err != nil {
+			return domain.Image{}, fmt.Errorf("starting image processing: %w", err)
+		}
+
+	case images.StateUploadExpired:
+		return domain.Image{}, apperr.NewError(apperr.CodeConflict).
+			WithSummary("Image upload expired")
 	}
 
+	image, err = s.presignPrivateURLs(ctx, image)
+	if err != nil {
+		return domain.Image{}, fmt.Errorf("presigning private URLs: %w", err)
+	}
+	return image, nil
+}
+
+// startImageProcessing marks the uploaded image ready and requests processing
+// of its variants. Images already marked ready are returned as they are, as
+// uploads may be reported by several event sources and by clients.
+func (s *Service) startImageProcessing(ctx context.Context, imageID string,
+) (domain.Image, error) {
 	var (
 		image        domain.Image
 		procRequests []*imageerv1.ImageProcessRequest
+		started      bool
 	)
 	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
-		var err error
+		uploaded, err := s.imageRepo.FindByID(ctx, imageID)
+		if err != nil {
+			return fmt.Errorf("finding image by ID: %w", err)
+		}
+		if uploaded.State == images.StateReady {
+			image = uploaded
+			return nil
+		}
 
 		// Update image state to "ready"
 		image, err = s.imageRepo.Update(ctx, domain.UpdateImageRequest{
-			ID:    imageID,
+			ID:    uploaded.ID,
 			State: new(images.StateReady),
 		})
 		if err != nil {
@@ -351,46 +715,88 @@ func (s *Service) StartImageProcessingOnUpload(ctx context.Context, s3Key string
 
 		procRequests = make([]*imageerv1.ImageProcessRequest, 0, len(image.Variants))
 		for _, variant := range image.Variants {
-			// Update image variant state to "processing"
-			variant, err := s.imageVarRepo.Update(ctx, domain.UpdateImageVariantRequest{
-				ID:    variant.ID,
-				State: new(images.VariantStateProcessing),
-			})
-			if err != nil {
-				return fmt.Errorf("updating image variant: %w", err)
-			}
-
 			preset, err := s.presetRepo.FindByID(ctx, variant.Preset.ID)
 			if err != nil {
 				return fmt.Errorf("finding preset by ID: %w", err)
 			}
 
-			procRequests = append(procRequests, &imageerv1.ImageProcessRequest{
-				Image:   image.ToProto(),
-				Variant: variant.ToProto(),
-				Preset:  preset.ToProto(),
-			})
+			procReq, err := s.startVariantProcessing(ctx, image, variant, preset)
+			if err != nil {
+				return fmt.Errorf("starting variant processing: %w", err)
+			}
+			procRequests = append(procRequests, procReq)
 		}
 
+		started = true
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("during transaction: %w", err)
+		return domain.Image{}, fmt.Errorf("during transaction: %w", err)
 	}
 
-	for _, req := range procRequests {
-		if err := s.imageProcRequestQueue.Push(ctx, req); err != nil {
-			return fmt.Errorf("enqueuing image process request: %w", err)
-		}
+	if !started {
+		slog.InfoContext(ctx, "Skip image processing already started", "imageId", image.ID)
+		return image, nil
+	}
+
+	if err := s.pushProcessRequests(ctx, procRequests); err != nil {
+		return domain.Image{}, fmt.Errorf("pushing image process requests: %w", err)
 	}
 
 	if _, err := s.imageNotificationPublisher.PublishUploadDone(ctx, image.ID); err != nil {
-		return fmt.Errorf("publishing image upload done notification: %w", err)
+		return domain.Image{}, fmt.Errorf("publishing image upload done notification: %w", err)
 	}
 
 	slog.InfoContext(ctx, "Request image processing after client upload", "imageId", image.ID)
 
-	return nil
+	return image, nil
+}
+
+// presignPrivateURLs replaces URLs of the image and its variants with
+// presigned ones if the image belongs to a private project, as objects of
+// private projects are not readable through the CDN.
+func (s *Service) presignPrivateURLs(ctx context.Context, image domain.Image,
+) (domain.Image, error) {
+	if image.Project.Visibility != projects.VisibilityPrivate {
+		return image, nil
+	}
+
+	presign := func(s3Key string) (string, time.Time, error) {
+		resp, err := s.s3Presigner.PresignGetObject(ctx, domain.PresignGetObjectRequest{
+			StorageProfile: image.Project.StorageProfile,
+			S3Key:          s3Key,
+			Expiry:         s.cfg.PrivateURLTTL,
+		})
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("presigning get object: %w", err)
+		}
+		return resp.URL, resp.ExpireAt, nil
+	}
+
+	var (
+		expireAt time.Time
+		err      error
+	)
+	if image.HasOriginal() {
+		image.URL, expireAt, err = presign(image.S3Key)
+		if err != nil {
+			return domain.Image{}, err
+		}
+	}
+
+	image.Variants = slices.Clone(image.Variants)
+	for i, variant := range image.Variants {
+		image.Variants[i].URL, expireAt, err = presign(variant.S3Key)
+		if err != nil {
+			return domain.Image{}, err
+		}
+	}
+
+	if !expireAt.IsZero() {
+		image.URLExpireAt = &expireAt
+	}
+
+	return image, nil
 }
 
 func (s *Service) ReceiveImageProcessResult(ctx context.Context, res *imageerv1.ImageProcessResult,
@@ -405,7 +811,10 @@ func (s *Service) ReceiveImageProcessResult(ctx context.Context, res *imageerv1.
 
 	err = s.transactioner.WithTx(ctx, func(ctx context.Context) error {
 		variantState := images.VariantStateFailed
-		if procLog.IsSuccess {
+		switch {
+		case res.IsSkipped:
+			variantState = images.VariantStateSkipped
+		case procLog.IsSuccess:
 			variantState = images.VariantStateReady
 		}
 
@@ -0,0 +1,112 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// UploadSweeper completes uploads of images pending for a while, as upload
+// events may be lost and clients may not complete uploads themselves. Images
+// whose objects exist start processing as if their uploads were reported.
+type UploadSweeper struct {
+	imageRepo port.ImageRepository
+	imageSvc  port.ImageService
+	cfg       UploadSweeperConfig
+
+	ticker *time.Ticker
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func NewUploadSweeper(
+	cfg UploadSweeperConfig,
+	imageRepo port.ImageRepository,
+	imageSvc port.ImageService,
+) *UploadSweeper {
+	return &UploadSweeper{
+		imageRepo: imageRepo,
+		imageSvc:  imageSvc,
+		cfg:       cfg,
+	}
+}
+
+func (s *UploadSweeper) OnStartedLeading(ctx context.Context) {
+	s.ticker = time.NewTicker(s.cfg.CheckInterval)
+	s.stopCh = make(chan struct{})
+	s.doneCh = make(chan struct{})
+
+	go s.run(ctx)
+}
+
+func (s *UploadSweeper) OnStoppedLeading() {
+	if s.stopCh != nil {
+		close(s.stopCh)
+		<-s.doneCh
+	}
+}
+
+func (s *UploadSweeper) run(ctx context.Context) {
+	defer close(s.doneCh)
+	defer s.ticker.Stop()
+
+	for {
+		if err := s.sweepPendingUploads(); err != nil {
+			slog.ErrorContext(ctx, "Failed to sweep pending uploads", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-s.ticker.C:
+		}
+	}
+}
+
+func (s *UploadSweeper) sweepPendingUploads() error {
+	ctx, span := tracing.StartSpanNonSampled(context.Background(),
+		"image.UploadSweeper.sweepPendingUploads")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.CheckTimeout)
+	defer cancel()
+
+	threshold := time.Now().Add(-s.cfg.PendingFor)
+	result, err := s.imageRepo.List(ctx, domain.ListImagesParams{
+		Limit: new(-1),
+		SearchFilter: domain.ImageSearchFilter{
+			State:           new(images.StateUploadPending),
+			UpdatedAtBefore: &threshold,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing pending images: %w", err)
+	}
+
+	for _, img := range result.Items {
+		// Uploads are completed idempotently, so that images reported by events
+		// meanwhile are not processed twice.
+		_, err := s.imageSvc.CompleteUpload(ctx, img.ID)
+		switch {
+		case apperr.IsErrorCode(err, apperr.CodeConflict):
+			continue
+		case err != nil:
+			slog.ErrorContext(ctx, "Failed to complete pending upload", "imageId", img.ID,
+				"error", err)
+			continue
+		}
+
+		slog.InfoContext(ctx, "Completed upload found by sweep", "imageId", img.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package image
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+type fakePendingImageRepository struct {
+	port.ImageRepository
+	images []domain.Image
+	params domain.ListImagesParams
+}
+
+func (r *fakePendingImageRepository) List(_ context.Context, params domain.ListImagesParams,
+) (domain.Images, error) {
+	r.params = params
+	return domain.Images{Items: r.images, Total: int64(len(r.images))}, nil
+}
+
+type fakeUploadCompleter struct {
+	port.ImageService
+	uploadedIDs  []string
+	completedIDs []string
+}
+
+func (s *fakeUploadCompleter) CompleteUpload(_ context.Context, imageID string,
+) (domain.Image, error) {
+	for _, id := range s.uploadedIDs {
+		if id == imageID {
+			s.completedIDs = append(s.completedIDs, imageID)
+			return domain.Image{ID: imageID, State: images.StateReady}, nil
+		}
+	}
+	return domain.Image{}, apperr.NewError(apperr.CodeConflict).
+		WithSummary("Image is not uploaded yet")
+}
+
+func TestUploadSweeper_sweepPendingUploads(t *testing.T) {
+	imageRepo := &fakePendingImageRepository{
+		images: []domain.Image{
+			{ID: "image-uploaded", State: images.StateUploadPending},
+			{ID: "image-not-uploaded", State: images.StateUploadPending},
+		},
+	}
+	imageSvc := &fakeUploadCompleter{uploadedIDs: []string{"image-uploaded"}}
+	sweeper := NewUploadSweeper(UploadSweeperConfig{
+		CheckTimeout: time.Minute,
+		PendingFor:   time.Minute,
+	}, imageRepo, imageSvc)
+
+	err := sweeper.sweepPendingUploads()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"image-uploaded"}, imageSvc.completedIDs)
+	assert.Equal(t, images.StateUploadPending, *imageRepo.params.SearchFilter.State)
+	assert.WithinDuration(t, time.Now().Add(-time.Minute),
+		*imageRepo.params.SearchFilter.UpdatedAtBefore, time.Second)
+}
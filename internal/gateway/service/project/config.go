@@ -0,0 +1,11 @@
+package project
+
+import "time"
+
+type Config struct {
+	DefaultStorageProfile string
+	StorageProfiles       []string
+	// RestoreWindow is how long deleted projects can be restored before their
+	// deletion jobs start.
+	RestoreWindow time.Duration
+}
@@ -0,0 +1,212 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/audits"
+	"github.com/isutare412/imageer/pkg/projects"
+	"github.com/isutare412/imageer/pkg/validation"
+)
+
+func (s *Service) GetMember(ctx context.Context, projectID, userID string,
+) (domain.ProjectMember, error) {
+	member, err := s.projectMemberRepo.Find(ctx, projectID, userID)
+	if err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("finding project member: %w", err)
+	}
+	return member, nil
+}
+
+func (s *Service) ListMembers(ctx context.Context, projectID string,
+) ([]domain.ProjectMember, error) {
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("finding project: %w", err)
+	}
+
+	members, err := s.projectMemberRepo.List(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("listing project members: %w", err)
+	}
+	return members, nil
+}
+
+// AddMember adds a user who has signed in at least once to the project.
+func (s *Service) AddMember(ctx context.Context, req domain.AddProjectMemberRequest,
+) (domain.ProjectMember, error) {
+	if err := validation.Validate(req); err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("validating request: %w", err)
+	}
+
+	var member domain.ProjectMember
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := s.projectRepo.FindByID(ctx, req.ProjectID); err != nil {
+			return fmt.Errorf("finding project: %w", err)
+		}
+
+		user, err := s.userRepo.FindByEmail(ctx, req.Email)
+		if err != nil {
+			return fmt.Errorf("finding user: %w", err)
+		}
+
+		_, err = s.projectMemberRepo.Find(ctx, req.ProjectID, user.ID)
+		switch {
+		case err == nil:
+			return apperr.NewError(apperr.CodeConflict).
+				WithSummary("User %s is already a member of project %s", user.ID, req.ProjectID)
+		case !apperr.IsErrorCode(err, apperr.CodeNotFound):
+			return fmt.Errorf("finding project member: %w", err)
+		}
+
+		member, err = s.projectMemberRepo.Create(ctx, domain.ProjectMember{
+			ProjectID: req.ProjectID,
+			Role:      req.Role,
+			User:      user,
+		})
+		if err != nil {
+			return fmt.Errorf("creating project member: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionProjectMemberAdd,
+			TargetType: audits.TargetTypeProjectMember,
+			TargetID:   memberTargetID(member.ProjectID, member.User.ID),
+			After:      newMemberSnapshot(member),
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("during transaction: %w", err)
+	}
+	return member, nil
+}
+
+// UpdateMember changes the role of the member. The last owner of the project
+// cannot be demoted.
+func (s *Service) UpdateMember(ctx context.Context, req domain.UpdateProjectMemberRequest,
+) (domain.ProjectMember, error) {
+	if err := validation.Validate(req); err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("validating request: %w", err)
+	}
+
+	var member domain.ProjectMember
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		if req.Role != projects.MemberRoleOwner {
+			if err := s.checkNotLastOwner(ctx, req.ProjectID, req.UserID); err != nil {
+				return err
+			}
+		}
+
+		before, err := s.projectMemberRepo.Find(ctx, req.ProjectID, req.UserID)
+		if err != nil {
+			return fmt.Errorf("finding project member: %w", err)
+		}
+
+		member, err = s.projectMemberRepo.Update(ctx, req)
+		if err != nil {
+			return fmt.Errorf("updating project member: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionProjectMemberUpdate,
+			TargetType: audits.TargetTypeProjectMember,
+			TargetID:   memberTargetID(req.ProjectID, req.UserID),
+			Before:     newMemberSnapshot(before),
+			After:      newMemberSnapshot(member),
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.ProjectMember{}, fmt.Errorf("during transaction: %w", err)
+	}
+	return member, nil
+}
+
+// RemoveMember removes the member from the project. The last owner of the
+// project cannot be removed.
+func (s *Service) RemoveMember(ctx context.Context, projectID, userID string) error {
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.checkNotLastOwner(ctx, projectID, userID); err != nil {
+			return err
+		}
+
+		before, err := s.projectMemberRepo.Find(ctx, projectID, userID)
+		if err != nil {
+			return fmt.Errorf("finding project member: %w", err)
+		}
+
+		if err := s.projectMemberRepo.Delete(ctx, projectID, userID); err != nil {
+			return fmt.Errorf("deleting project member: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionProjectMemberRemove,
+			TargetType: audits.TargetTypeProjectMember,
+			TargetID:   memberTargetID(projectID, userID),
+			Before:     newMemberSnapshot(before),
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("during transaction: %w", err)
+	}
+	return nil
+}
+
+// checkNotLastOwner fails if the user is the only owner of the project, so
+// that every project with members keeps someone who manages them.
+func (s *Service) checkNotLastOwner(ctx context.Context, projectID, userID string) error {
+	members, err := s.projectMemberRepo.List(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("listing project members: %w", err)
+	}
+
+	var isOwner bool
+	var owners int
+	for _, m := range members {
+		if m.Role != projects.MemberRoleOwner {
+			continue
+		}
+		owners++
+		if m.User.ID == userID {
+			isOwner = true
+		}
+	}
+
+	if isOwner && owners == 1 {
+		return apperr.NewError(apperr.CodeConflict).
+			WithSummary("User %s is the last owner of project %s", userID, projectID)
+	}
+	return nil
+}
+
+// memberSnapshot is the audited view of a project member, leaving out details
+// of the user.
+type memberSnapshot struct {
+	ProjectID string
+	UserID    string
+	Email     string
+	Role      projects.MemberRole
+}
+
+func newMemberSnapshot(m domain.ProjectMember) memberSnapshot {
+	return memberSnapshot{
+		ProjectID: m.ProjectID,
+		UserID:    m.User.ID,
+		Email:     m.User.Email,
+		Role:      m.Role,
+	}
+}
+
+// memberTargetID identifies a project member in audit entries.
+func memberTargetID(projectID, userID string) string {
+	return projectID + "/" + userID
+}
@@ -3,19 +3,46 @@ package project
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/audits"
+	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
 	"github.com/isutare412/imageer/pkg/validation"
 )
 
 type Service struct {
-	projectRepo port.ProjectRepository
+	transactioner       port.Transactioner
+	projectRepo         port.ProjectRepository
+	projectDeletionRepo port.ProjectDeletionRepository
+	projectMemberRepo   port.ProjectMemberRepository
+	userRepo            port.UserRepository
+	watermarkRepo       port.WatermarkRepository
+	auditRecorder       port.AuditRecorder
+
+	cfg Config
 }
 
-func NewService(projectRepo port.ProjectRepository) *Service {
+func NewService(cfg Config, transactioner port.Transactioner, projectRepo port.ProjectRepository,
+	projectDeletionRepo port.ProjectDeletionRepository,
+	projectMemberRepo port.ProjectMemberRepository, userRepo port.UserRepository,
+	watermarkRepo port.WatermarkRepository, auditRecorder port.AuditRecorder,
+) *Service {
 	return &Service{
-		projectRepo: projectRepo,
+		transactioner:       transactioner,
+		projectRepo:         projectRepo,
+		projectDeletionRepo: projectDeletionRepo,
+		projectMemberRepo:   projectMemberRepo,
+		userRepo:            userRepo,
+		watermarkRepo:       watermarkRepo,
+		auditRecorder:       auditRecorder,
+		cfg:                 cfg,
 	}
 }
 
@@ -42,10 +69,41 @@ func (s *Service) Create(ctx context.Context, req domain.CreateProjectRequest) (
 		return domain.Project{}, fmt.Errorf("validating request: %w", err)
 	}
 
+	// Watermarks belong to existing projects, so a new project cannot reference
+	// any of them.
+	for _, preset := range req.Presets {
+		if preset.Watermark != nil {
+			return domain.Project{}, apperr.NewError(apperr.CodeBadRequest).
+				WithSummary("Watermark %s not found in project", preset.Watermark.WatermarkID)
+		}
+	}
+
 	project := req.ToProject()
-	project, err := s.projectRepo.Create(ctx, project)
+	project.StorageProfile = lo.FromPtrOr(req.StorageProfile, s.cfg.DefaultStorageProfile)
+	if !slices.Contains(s.cfg.StorageProfiles, project.StorageProfile) {
+		return domain.Project{}, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Storage profile %q not found", project.StorageProfile)
+	}
+
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		project, err = s.projectRepo.Create(ctx, project)
+		if err != nil {
+			return fmt.Errorf("creating project: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionProjectCreate,
+			TargetType: audits.TargetTypeProject,
+			TargetID:   project.ID,
+			After:      project,
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return domain.Project{}, fmt.Errorf("creating project: %w", err)
+		return domain.Project{}, fmt.Errorf("during transaction: %w", err)
 	}
 
 	return project, nil
@@ -58,17 +116,177 @@ func (s *Service) Update(
 		return domain.Project{}, fmt.Errorf("validating request: %w", err)
 	}
 
-	project, err := s.projectRepo.Update(ctx, req)
+	var project domain.Project
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.checkPresetWatermarks(ctx, req.ID, req.Presets); err != nil {
+			return fmt.Errorf("checking preset watermarks: %w", err)
+		}
+
+		before, err := s.projectRepo.FindByID(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("finding project: %w", err)
+		}
+
+		project, err = s.projectRepo.Update(ctx, req)
+		if err != nil {
+			return fmt.Errorf("updating project: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionProjectUpdate,
+			TargetType: audits.TargetTypeProject,
+			TargetID:   project.ID,
+			Before:     before,
+			After:      project,
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return domain.Project{}, fmt.Errorf("updating project: %w", err)
+		return domain.Project{}, fmt.Errorf("during transaction: %w", err)
 	}
 
 	return project, nil
 }
 
-func (s *Service) Delete(ctx context.Context, id string) error {
-	if err := s.projectRepo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("deleting project: %w", err)
+// Delete soft-deletes the project along with its images, which blocks new
+// uploads to the project. A deletion job purging them and their objects starts
+// after the restore window.
+func (s *Service) Delete(ctx context.Context, id string) (domain.ProjectDeletion, error) {
+	var deletion domain.ProjectDeletion
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		project, err := s.projectRepo.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("finding project: %w", err)
+		}
+
+		if err := s.projectRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("deleting project: %w", err)
+		}
+
+		deletion, err = s.projectDeletionRepo.Create(ctx, domain.ProjectDeletion{
+			ProjectID:      project.ID,
+			ProjectName:    project.Name,
+			StorageProfile: project.StorageProfile,
+			State:          projects.DeletionStatePending,
+			StartAfter:     time.Now().Add(s.cfg.RestoreWindow),
+		})
+		if err != nil {
+			return fmt.Errorf("creating project deletion: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionProjectDelete,
+			TargetType: audits.TargetTypeProject,
+			TargetID:   project.ID,
+			Before:     project,
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.ProjectDeletion{}, fmt.Errorf("during transaction: %w", err)
 	}
+	return deletion, nil
+}
+
+// Restore restores the project and cancels its pending deletion job. Projects
+// whose deletion jobs are already running cannot be restored.
+func (s *Service) Restore(ctx context.Context, id string) (domain.Project, error) {
+	var project domain.Project
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		deletion, err := s.projectDeletionRepo.FindLatestByProjectID(ctx, id)
+		switch {
+		case apperr.IsErrorCode(err, apperr.CodeNotFound):
+			// Projects deleted before deletion jobs were introduced
+		case err != nil:
+			return fmt.Errorf("finding project deletion: %w", err)
+		case deletion.State == projects.DeletionStateRunning:
+			return apperr.NewError(apperr.CodeConflict).
+				WithSummary("Project %s is being deleted", id)
+		case deletion.State == projects.DeletionStatePending:
+			if _, err := s.projectDeletionRepo.Update(ctx, domain.UpdateProjectDeletionRequest{
+				ID:         deletion.ID,
+				State:      new(projects.DeletionStateCanceled),
+				FinishedAt: new(time.Now()),
+			}); err != nil {
+				return fmt.Errorf("canceling project deletion: %w", err)
+			}
+		}
+
+		project, err = s.projectRepo.Restore(ctx, id)
+		if err != nil {
+			return fmt.Errorf("restoring project: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionProjectRestore,
+			TargetType: audits.TargetTypeProject,
+			TargetID:   project.ID,
+			After:      project,
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.Project{}, fmt.Errorf("during transaction: %w", err)
+	}
+	return project, nil
+}
+
+// GetDeletion returns the latest deletion job of the project.
+func (s *Service) GetDeletion(ctx context.Context, projectID string,
+) (domain.ProjectDeletion, error) {
+	deletion, err := s.projectDeletionRepo.FindLatestByProjectID(ctx, projectID)
+	if err != nil {
+		return domain.ProjectDeletion{}, fmt.Errorf("finding project deletion: %w", err)
+	}
+	return deletion, nil
+}
+
+func (s *Service) checkPresetWatermarks(ctx context.Context, projectID string,
+	presets []domain.UpsertPresetRequest,
+) error {
+	var watermarkIDs []string
+	for _, preset := range presets {
+		if preset.Watermark != nil {
+			watermarkIDs = append(watermarkIDs, preset.Watermark.WatermarkID)
+		}
+	}
+	watermarkIDs = lo.Uniq(watermarkIDs)
+	if len(watermarkIDs) == 0 {
+		return nil
+	}
+
+	watermarks, err := s.watermarkRepo.List(ctx, domain.ListWatermarksParams{
+		Limit: new(len(watermarkIDs)),
+		SearchFilter: domain.WatermarkSearchFilter{
+			ProjectID: &projectID,
+			IDs:       watermarkIDs,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing watermarks: %w", err)
+	}
+
+	existingIDs := lo.Map(watermarks.Items, func(w domain.Watermark, _ int) string {
+		return w.ID
+	})
+	if missing, _ := lo.Difference(watermarkIDs, existingIDs); len(missing) > 0 {
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Watermarks not found in project: %v", missing)
+	}
+
+	pendingIDs := lo.FilterMap(watermarks.Items, func(w domain.Watermark, _ int) (string, bool) {
+		return w.ID, w.State != images.WatermarkStateReady
+	})
+	if len(pendingIDs) > 0 {
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Watermarks not uploaded yet: %v", pendingIDs)
+	}
+
 	return nil
 }
@@ -0,0 +1,99 @@
+package project
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+type fakeWatermarkRepository struct {
+	port.WatermarkRepository
+	watermarks []domain.Watermark
+}
+
+func (r *fakeWatermarkRepository) List(_ context.Context, params domain.ListWatermarksParams,
+) (domain.Watermarks, error) {
+	var items []domain.Watermark
+	for _, w := range r.watermarks {
+		if w.Project.ID == *params.SearchFilter.ProjectID &&
+			slices.Contains(params.SearchFilter.IDs, w.ID) {
+			items = append(items, w)
+		}
+	}
+	return domain.Watermarks{Items: items, Total: int64(len(items))}, nil
+}
+
+func TestService_checkPresetWatermarks(t *testing.T) {
+	watermarks := []domain.Watermark{
+		{
+			ID:      "watermark-ready",
+			State:   images.WatermarkStateReady,
+			Project: domain.ProjectReference{ID: "project-1"},
+		},
+		{
+			ID:      "watermark-pending",
+			State:   images.WatermarkStateUploadPending,
+			Project: domain.ProjectReference{ID: "project-1"},
+		},
+		{
+			ID:      "watermark-other",
+			State:   images.WatermarkStateReady,
+			Project: domain.ProjectReference{ID: "project-2"},
+		},
+	}
+
+	tests := []struct {
+		name         string // description of this test case
+		watermarkIDs []string
+		wantErr      bool
+	}{
+		{
+			name:         "ready watermark",
+			watermarkIDs: []string{"watermark-ready"},
+		},
+		{
+			name: "no watermarks",
+		},
+		{
+			name:         "watermark not uploaded yet",
+			watermarkIDs: []string{"watermark-ready", "watermark-pending"},
+			wantErr:      true,
+		},
+		{
+			name:         "watermark of another project",
+			watermarkIDs: []string{"watermark-other"},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				watermarkRepo: &fakeWatermarkRepository{watermarks: watermarks},
+			}
+
+			presets := []domain.UpsertPresetRequest{{}}
+			for _, id := range tt.watermarkIDs {
+				presets = append(presets, domain.UpsertPresetRequest{
+					Watermark: &domain.PresetWatermark{WatermarkID: id},
+				})
+			}
+
+			err := svc.checkPresetWatermarks(t.Context(), "project-1", presets)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, apperr.IsErrorCode(err, apperr.CodeBadRequest))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ const (
 	randCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 	randLen     = 48
 	checksumLen = 16
+
+	displayPrefixLen = len(prefix) + 8
 )
 
 type APIKey string
@@ -58,6 +60,12 @@ func (k APIKey) Validate() error {
 	return nil
 }
 
+// Prefix returns the leading characters of the key, which identify the key
+// to humans without revealing it.
+func (k APIKey) Prefix() string {
+	return string(k[:displayPrefixLen])
+}
+
 func (k APIKey) Hash() string {
 	hashBytes := sha256.Sum256([]byte(k))
 	hash := base64.RawStdEncoding.EncodeToString(hashBytes[:])
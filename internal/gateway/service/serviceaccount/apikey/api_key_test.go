@@ -46,3 +46,8 @@ func TestAPIKey_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIKey_Prefix(t *testing.T) {
+	k := APIKey("ak_SOEXTZL3Ww7BXyqMWdD5QpPfyPF5nXTy14PkpV9X6vySOzmQdpjHYXK26vbhDgDj")
+	require.Equal(t, "ak_SOEXTZL3", k.Prefix())
+}
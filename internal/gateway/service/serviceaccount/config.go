@@ -0,0 +1,45 @@
+package serviceaccount
+
+import "time"
+
+type Config struct {
+	CertificateMappings []CertificateMapping
+	TokenMappings       []TokenMapping
+}
+
+// CertificateMapping maps client certificates to the service account, whose
+// subject distinguished name or any URI SAN equals Subject.
+type CertificateMapping struct {
+	Subject          string
+	ServiceAccountID string
+}
+
+// TokenMapping maps workload tokens to the service account, which are issued
+// by Issuer and whose claim named Claim is or contains ClaimValue.
+type TokenMapping struct {
+	Issuer           string
+	Claim            string
+	ClaimValue       string
+	ServiceAccountID string
+}
+
+type UsageTrackerConfig struct {
+	// FlushInterval is how often usages of API keys, batched in memory, are
+	// written to the database.
+	FlushInterval time.Duration
+	FlushTimeout  time.Duration
+}
+
+type StaleDisablerConfig struct {
+	Enabled       bool
+	CheckInterval time.Duration
+	CheckTimeout  time.Duration
+	// UnusedFor is how long service accounts may stay unused before they are
+	// disabled.
+	UnusedFor time.Duration
+	// WarnBefore is how long before being disabled service accounts are
+	// warned. Warnings are only recorded, not sent to anyone.
+	WarnBefore time.Duration
+	// BatchSize is the number of service accounts handled at once.
+	BatchSize int
+}
@@ -4,21 +4,46 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
+
+	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/port"
 	"github.com/isutare412/imageer/internal/gateway/service/serviceaccount/apikey"
 	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/audits"
 	"github.com/isutare412/imageer/pkg/validation"
 )
 
+// defaultAPIKeyName is the name of the API key minted along with a service
+// account.
+const defaultAPIKeyName = "default"
+
 type Service struct {
+	transactioner      port.Transactioner
 	serviceAccountRepo port.ServiceAccountRepository
+	apiKeyRepo         port.ServiceAccountAPIKeyRepository
+	auditRecorder      port.AuditRecorder
+	usageTracker       *UsageTracker
+	// workloadTokenVerifier is nil unless workload tokens are accepted.
+	workloadTokenVerifier port.WorkloadTokenVerifier
+	cfg                   Config
 }
 
-func NewService(serviceAccountRepo port.ServiceAccountRepository) *Service {
+func NewService(cfg Config, transactioner port.Transactioner,
+	serviceAccountRepo port.ServiceAccountRepository, apiKeyRepo port.ServiceAccountAPIKeyRepository,
+	auditRecorder port.AuditRecorder, usageTracker *UsageTracker,
+	workloadTokenVerifier port.WorkloadTokenVerifier,
+) *Service {
 	return &Service{
-		serviceAccountRepo: serviceAccountRepo,
+		transactioner:         transactioner,
+		serviceAccountRepo:    serviceAccountRepo,
+		apiKeyRepo:            apiKeyRepo,
+		auditRecorder:         auditRecorder,
+		usageTracker:          usageTracker,
+		workloadTokenVerifier: workloadTokenVerifier,
+		cfg:                   cfg,
 	}
 }
 
@@ -43,11 +68,41 @@ func (s *Service) GetByAPIKey(ctx context.Context, key string) (domain.ServiceAc
 			apperr.NewError(apperr.CodeBadRequest).WithSummary("API key is invalid")
 	case err != nil:
 		return domain.ServiceAccount{}, fmt.Errorf("finding service account: %w", err)
+	case account.IsExpired():
+		return domain.ServiceAccount{},
+			apperr.NewError(apperr.CodeBadRequest).WithSummary("Service account is expired")
+	case account.IsDisabled():
+		return domain.ServiceAccount{}, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Service account is disabled for reason %s", account.DisabledReason)
 	}
 
 	return account, nil
 }
 
+func (s *Service) TrackAPIKeyUsage(_ context.Context, serviceAccountID, key, remoteIP string,
+) error {
+	apiKey, err := apikey.ParseString(key)
+	if err != nil {
+		return fmt.Errorf("parsing API key: %w", err)
+	}
+
+	s.usageTracker.Track(domain.ServiceAccountUsage{
+		ServiceAccountID: serviceAccountID,
+		APIKeyHash:       apiKey.Hash(),
+		UsedAt:           time.Now(),
+		RemoteIP:         remoteIP,
+	})
+	return nil
+}
+
+func (s *Service) TrackUsage(_ context.Context, serviceAccountID, remoteIP string) {
+	s.usageTracker.Track(domain.ServiceAccountUsage{
+		ServiceAccountID: serviceAccountID,
+		UsedAt:           time.Now(),
+		RemoteIP:         remoteIP,
+	})
+}
+
 func (s *Service) List(
 	ctx context.Context, params domain.ListServiceAccountsParams,
 ) (domain.ServiceAccounts, error) {
@@ -70,17 +125,39 @@ func (s *Service) Create(
 		return domain.ServiceAccountWithAPIKey{}, fmt.Errorf("validating request: %w", err)
 	}
 
-	apiKey := apikey.New()
-	account := req.ToServiceAccount(apiKey.Hash())
-	account, err := s.serviceAccountRepo.Create(ctx, account)
+	var resp domain.ServiceAccountWithAPIKey
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		account, err := s.serviceAccountRepo.Create(ctx, req.ToServiceAccount())
+		if err != nil {
+			return fmt.Errorf("creating service account: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionServiceAccountCreate,
+			TargetType: audits.TargetTypeServiceAccount,
+			TargetID:   account.ID,
+			After:      account,
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+
+		key, apiKey, err := s.createAPIKey(ctx, account.ID, defaultAPIKeyName, nil)
+		if err != nil {
+			return fmt.Errorf("creating API key: %w", err)
+		}
+
+		resp = domain.ServiceAccountWithAPIKey{
+			ServiceAccount: account,
+			APIKeyID:       key.ID,
+			APIKey:         apiKey.String(),
+		}
+		return nil
+	})
 	if err != nil {
-		return domain.ServiceAccountWithAPIKey{}, fmt.Errorf("creating service account: %w", err)
+		return domain.ServiceAccountWithAPIKey{}, fmt.Errorf("during transaction: %w", err)
 	}
 
-	return domain.ServiceAccountWithAPIKey{
-		ServiceAccount: account,
-		APIKey:         apiKey.String(),
-	}, nil
+	return resp, nil
 }
 
 func (s *Service) Update(
@@ -90,17 +167,175 @@ func (s *Service) Update(
 		return domain.ServiceAccount{}, fmt.Errorf("validating request: %w", err)
 	}
 
-	account, err := s.serviceAccountRepo.Update(ctx, req)
+	var account domain.ServiceAccount
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		before, err := s.serviceAccountRepo.FindByID(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("finding service account: %w", err)
+		}
+
+		account, err = s.serviceAccountRepo.Update(ctx, req)
+		if err != nil {
+			return fmt.Errorf("updating service account: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionServiceAccountUpdate,
+			TargetType: audits.TargetTypeServiceAccount,
+			TargetID:   account.ID,
+			Before:     before,
+			After:      account,
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return domain.ServiceAccount{}, fmt.Errorf("updating service account: %w", err)
+		return domain.ServiceAccount{}, fmt.Errorf("during transaction: %w", err)
 	}
 
 	return account, nil
 }
 
 func (s *Service) Delete(ctx context.Context, id string) error {
-	if err := s.serviceAccountRepo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("deleting service account: %w", err)
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		before, err := s.serviceAccountRepo.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("finding service account: %w", err)
+		}
+
+		if err := s.serviceAccountRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("deleting service account: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionServiceAccountDelete,
+			TargetType: audits.TargetTypeServiceAccount,
+			TargetID:   id,
+			Before:     before,
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("during transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) ListAPIKeys(ctx context.Context, serviceAccountID string,
+) ([]domain.ServiceAccountAPIKey, error) {
+	if _, err := s.serviceAccountRepo.FindByID(ctx, serviceAccountID); err != nil {
+		return nil, fmt.Errorf("finding service account: %w", err)
+	}
+
+	keys, err := s.apiKeyRepo.List(ctx, serviceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("listing API keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// CreateAPIKey mints a new API key of the service account. Existing keys stay
+// valid until they expire or are revoked.
+func (s *Service) CreateAPIKey(
+	ctx context.Context, req domain.CreateServiceAccountAPIKeyRequest,
+) (domain.ServiceAccountWithAPIKey, error) {
+	if err := validation.Validate(req); err != nil {
+		return domain.ServiceAccountWithAPIKey{}, fmt.Errorf("validating request: %w", err)
+	}
+
+	var resp domain.ServiceAccountWithAPIKey
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		account, err := s.serviceAccountRepo.FindByID(ctx, req.ServiceAccountID)
+		if err != nil {
+			return fmt.Errorf("finding service account: %w", err)
+		}
+
+		key, apiKey, err := s.createAPIKey(ctx, account.ID, req.Name, req.ExpireAt)
+		if err != nil {
+			return fmt.Errorf("creating API key: %w", err)
+		}
+
+		resp = domain.ServiceAccountWithAPIKey{
+			ServiceAccount: account,
+			APIKeyID:       key.ID,
+			APIKey:         apiKey.String(),
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.ServiceAccountWithAPIKey{}, fmt.Errorf("during transaction: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) RevokeAPIKey(ctx context.Context, serviceAccountID, id string) error {
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		keys, err := s.apiKeyRepo.List(ctx, serviceAccountID)
+		if err != nil {
+			return fmt.Errorf("listing API keys: %w", err)
+		}
+
+		before, ok := lo.Find(keys, func(k domain.ServiceAccountAPIKey) bool {
+			return k.ID == id
+		})
+		if !ok {
+			return apperr.NewError(apperr.CodeNotFound).WithSummary("API key %s not found", id)
+		}
+
+		if err := s.apiKeyRepo.Delete(ctx, serviceAccountID, id); err != nil {
+			return fmt.Errorf("deleting API key: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionServiceAccountAPIKeyRevoke,
+			TargetType: audits.TargetTypeServiceAccountAPIKey,
+			TargetID:   id,
+			Before:     apiKeySnapshot(before),
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("during transaction: %w", err)
 	}
 	return nil
 }
+
+func (s *Service) createAPIKey(ctx context.Context, serviceAccountID, name string,
+	expireAt *time.Time,
+) (domain.ServiceAccountAPIKey, apikey.APIKey, error) {
+	apiKey := apikey.New()
+	key, err := s.apiKeyRepo.Create(ctx, domain.ServiceAccountAPIKey{
+		ServiceAccountID: serviceAccountID,
+		Name:             name,
+		Prefix:           apiKey.Prefix(),
+		Hash:             apiKey.Hash(),
+		ExpireAt:         expireAt,
+	})
+	if err != nil {
+		return domain.ServiceAccountAPIKey{}, "", fmt.Errorf("creating API key: %w", err)
+	}
+
+	if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+		Action:     audits.ActionServiceAccountAPIKeyCreate,
+		TargetType: audits.TargetTypeServiceAccountAPIKey,
+		TargetID:   key.ID,
+		After:      apiKeySnapshot(key),
+	}); err != nil {
+		return domain.ServiceAccountAPIKey{}, "", fmt.Errorf("recording audit entry: %w", err)
+	}
+
+	return key, apiKey, nil
+}
+
+// apiKeySnapshot is the audited view of the API key, which leaves out the hash.
+func apiKeySnapshot(key domain.ServiceAccountAPIKey) domain.ServiceAccountAPIKey {
+	key.Hash = ""
+	return key
+}
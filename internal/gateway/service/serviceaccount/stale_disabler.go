@@ -0,0 +1,197 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/audits"
+	"github.com/isutare412/imageer/pkg/serviceaccounts"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// StaleDisabler disables service accounts unused for a while. Service accounts
+// are warned ahead, and those used again after the warning are spared.
+// Disabled accounts are kept with the reason until enabled again by admins.
+//
+// Warnings are not delivered to anyone, as service accounts record no owners
+// to notify. They are shown as stale warnings of the accounts, and recorded in
+// audit entries and logs.
+type StaleDisabler struct {
+	transactioner      port.Transactioner
+	serviceAccountRepo port.ServiceAccountRepository
+	auditRecorder      port.AuditRecorder
+	cfg                StaleDisablerConfig
+
+	ticker *time.Ticker
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func NewStaleDisabler(cfg StaleDisablerConfig, transactioner port.Transactioner,
+	serviceAccountRepo port.ServiceAccountRepository, auditRecorder port.AuditRecorder,
+) *StaleDisabler {
+	return &StaleDisabler{
+		transactioner:      transactioner,
+		serviceAccountRepo: serviceAccountRepo,
+		auditRecorder:      auditRecorder,
+		cfg:                cfg,
+	}
+}
+
+func (d *StaleDisabler) OnStartedLeading(ctx context.Context) {
+	if !d.cfg.Enabled {
+		return
+	}
+
+	d.ticker = time.NewTicker(d.cfg.CheckInterval)
+	d.stopCh = make(chan struct{})
+	d.doneCh = make(chan struct{})
+
+	go d.run(ctx)
+}
+
+func (d *StaleDisabler) OnStoppedLeading() {
+	if d.stopCh != nil {
+		close(d.stopCh)
+		<-d.doneCh
+	}
+}
+
+func (d *StaleDisabler) run(ctx context.Context) {
+	defer close(d.doneCh)
+	defer d.ticker.Stop()
+
+	for {
+		if err := d.check(); err != nil {
+			slog.ErrorContext(ctx, "Failed to check stale service accounts", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.stopCh:
+			return
+		case <-d.ticker.C:
+		}
+	}
+}
+
+func (d *StaleDisabler) check() error {
+	ctx, span := tracing.StartSpanNonSampled(context.Background(), "serviceaccount.StaleDisabler.check")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.CheckTimeout)
+	defer cancel()
+
+	now := time.Now()
+	if err := d.warnStale(ctx, now); err != nil {
+		return fmt.Errorf("warning stale service accounts: %w", err)
+	}
+	if err := d.disableStale(ctx, now); err != nil {
+		return fmt.Errorf("disabling stale service accounts: %w", err)
+	}
+	return nil
+}
+
+// warnStale warns service accounts to be disabled within the warning period.
+func (d *StaleDisabler) warnStale(ctx context.Context, now time.Time) error {
+	accounts, err := d.serviceAccountRepo.List(ctx, domain.ListServiceAccountsParams{
+		Limit: &d.cfg.BatchSize,
+		SearchFilter: domain.ServiceAccountSearchFilter{
+			Active:       true,
+			UnusedBefore: new(now.Add(-(d.cfg.UnusedFor - d.cfg.WarnBefore))),
+			StaleWarned:  new(false),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing service accounts: %w", err)
+	}
+
+	for _, account := range accounts.Items {
+		err := d.transactioner.WithTx(ctx, func(ctx context.Context) error {
+			if err := d.serviceAccountRepo.MarkStaleWarned(ctx, account.ID, now); err != nil {
+				return fmt.Errorf("marking stale warning: %w", err)
+			}
+
+			if err := d.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+				Action:     audits.ActionServiceAccountStaleWarn,
+				TargetType: audits.TargetTypeServiceAccount,
+				TargetID:   account.ID,
+				After: staleWarning{
+					StaleWarnedAt: now,
+					DisableAt:     now.Add(d.cfg.WarnBefore),
+				},
+			}); err != nil {
+				return fmt.Errorf("recording audit entry: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("during transaction: %w", err)
+		}
+
+		slog.WarnContext(ctx, "Service account is unused and will be disabled",
+			"serviceAccountId", account.ID, "serviceAccountName", account.Name,
+			"lastUsedAt", account.LastUsedAt, "disableAt", now.Add(d.cfg.WarnBefore))
+	}
+	return nil
+}
+
+// disableStale disables service accounts still unused after the warning
+// period.
+func (d *StaleDisabler) disableStale(ctx context.Context, now time.Time) error {
+	accounts, err := d.serviceAccountRepo.List(ctx, domain.ListServiceAccountsParams{
+		Limit: &d.cfg.BatchSize,
+		SearchFilter: domain.ServiceAccountSearchFilter{
+			Active:            true,
+			UnusedBefore:      new(now.Add(-d.cfg.UnusedFor)),
+			StaleWarnedBefore: new(now.Add(-d.cfg.WarnBefore)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing service accounts: %w", err)
+	}
+
+	for _, account := range accounts.Items {
+		err := d.transactioner.WithTx(ctx, func(ctx context.Context) error {
+			if err := d.serviceAccountRepo.Disable(ctx, account.ID, now,
+				serviceaccounts.DisabledReasonUnused); err != nil {
+				return fmt.Errorf("disabling service account: %w", err)
+			}
+
+			disabled, err := d.serviceAccountRepo.FindByID(ctx, account.ID)
+			if err != nil {
+				return fmt.Errorf("finding service account: %w", err)
+			}
+
+			if err := d.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+				Action:     audits.ActionServiceAccountDisable,
+				TargetType: audits.TargetTypeServiceAccount,
+				TargetID:   account.ID,
+				Before:     account,
+				After:      disabled,
+			}); err != nil {
+				return fmt.Errorf("recording audit entry: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("during transaction: %w", err)
+		}
+
+		slog.WarnContext(ctx, "Disabled unused service account",
+			"serviceAccountId", account.ID, "serviceAccountName", account.Name,
+			"lastUsedAt", account.LastUsedAt)
+	}
+	return nil
+}
+
+// staleWarning is the audited change of warning a service account.
+type staleWarning struct {
+	StaleWarnedAt time.Time
+	DisableAt     time.Time
+}
@@ -0,0 +1,99 @@
+package serviceaccount
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/audits"
+	"github.com/isutare412/imageer/pkg/serviceaccounts"
+)
+
+type fakeStaleServiceAccountRepository struct {
+	port.ServiceAccountRepository
+	stale    []domain.ServiceAccount
+	warned   []domain.ServiceAccount
+	warnedAt map[string]time.Time
+	disabled map[string]serviceaccounts.DisabledReason
+}
+
+func (r *fakeStaleServiceAccountRepository) List(_ context.Context,
+	params domain.ListServiceAccountsParams,
+) (domain.ServiceAccounts, error) {
+	items := r.stale
+	if params.SearchFilter.StaleWarnedBefore != nil {
+		items = r.warned
+	}
+	return domain.ServiceAccounts{Items: items, Total: int64(len(items))}, nil
+}
+
+func (r *fakeStaleServiceAccountRepository) MarkStaleWarned(_ context.Context, id string,
+	warnedAt time.Time,
+) error {
+	r.warnedAt[id] = warnedAt
+	return nil
+}
+
+func (r *fakeStaleServiceAccountRepository) Disable(_ context.Context, id string,
+	_ time.Time, reason serviceaccounts.DisabledReason,
+) error {
+	r.disabled[id] = reason
+	return nil
+}
+
+func (r *fakeStaleServiceAccountRepository) FindByID(_ context.Context, id string,
+) (domain.ServiceAccount, error) {
+	return domain.ServiceAccount{
+		ID:             id,
+		DisabledAt:     new(time.Now()),
+		DisabledReason: r.disabled[id],
+	}, nil
+}
+
+type fakeAuditRecorder struct {
+	requests []domain.RecordAuditRequest
+}
+
+func (r *fakeAuditRecorder) Record(_ context.Context, req domain.RecordAuditRequest) error {
+	r.requests = append(r.requests, req)
+	return nil
+}
+
+func TestStaleDisabler_check(t *testing.T) {
+	accountRepo := &fakeStaleServiceAccountRepository{
+		stale:    []domain.ServiceAccount{{ID: "account-1"}},
+		warned:   []domain.ServiceAccount{{ID: "account-2"}},
+		warnedAt: make(map[string]time.Time),
+		disabled: make(map[string]serviceaccounts.DisabledReason),
+	}
+	auditRecorder := &fakeAuditRecorder{}
+	disabler := NewStaleDisabler(StaleDisablerConfig{
+		Enabled:      true,
+		CheckTimeout: time.Second,
+		UnusedFor:    90 * 24 * time.Hour,
+		WarnBefore:   7 * 24 * time.Hour,
+		BatchSize:    10,
+	}, fakeTransactioner{}, accountRepo, auditRecorder)
+
+	err := disabler.check()
+	require.NoError(t, err)
+
+	assert.Contains(t, accountRepo.warnedAt, "account-1")
+	assert.Equal(t, map[string]serviceaccounts.DisabledReason{
+		"account-2": serviceaccounts.DisabledReasonUnused,
+	}, accountRepo.disabled)
+
+	require.Len(t, auditRecorder.requests, 2)
+	assert.Equal(t, audits.ActionServiceAccountStaleWarn, auditRecorder.requests[0].Action)
+	assert.Equal(t, "account-1", auditRecorder.requests[0].TargetID)
+	assert.Equal(t, audits.ActionServiceAccountDisable, auditRecorder.requests[1].Action)
+	assert.Equal(t, "account-2", auditRecorder.requests[1].TargetID)
+	disabled, ok := auditRecorder.requests[1].After.(domain.ServiceAccount)
+	require.True(t, ok)
+	assert.True(t, disabled.IsDisabled())
+}
@@ -0,0 +1,131 @@
+package serviceaccount
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// UsageTracker batches usages of service accounts in memory and writes them
+// periodically, which keeps requests authenticated by service accounts off the
+// database.
+type UsageTracker struct {
+	transactioner      port.Transactioner
+	serviceAccountRepo port.ServiceAccountRepository
+	apiKeyRepo         port.ServiceAccountAPIKeyRepository
+
+	// usages are the latest usages of API keys keyed by their hashes, or of
+	// service accounts keyed by their IDs for other credentials.
+	usages   map[string]domain.ServiceAccountUsage
+	usagesMu sync.Mutex
+
+	workers        *sync.WaitGroup
+	lifetimeCtx    context.Context
+	lifetimeCancel context.CancelFunc
+
+	cfg UsageTrackerConfig
+}
+
+func NewUsageTracker(cfg UsageTrackerConfig, transactioner port.Transactioner,
+	serviceAccountRepo port.ServiceAccountRepository, apiKeyRepo port.ServiceAccountAPIKeyRepository,
+) *UsageTracker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &UsageTracker{
+		transactioner:      transactioner,
+		serviceAccountRepo: serviceAccountRepo,
+		apiKeyRepo:         apiKeyRepo,
+		usages:             make(map[string]domain.ServiceAccountUsage),
+		workers:            &sync.WaitGroup{},
+		lifetimeCtx:        ctx,
+		lifetimeCancel:     cancel,
+		cfg:                cfg,
+	}
+}
+
+func (t *UsageTracker) Run() {
+	t.workers.Go(func() {
+		ticker := time.NewTicker(t.cfg.FlushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-t.lifetimeCtx.Done():
+				// Flush remaining usages before termination
+				t.flush()
+				slog.Info("Service account usage tracker loop terminated")
+				return
+			case <-ticker.C:
+				t.flush()
+			}
+		}
+	})
+}
+
+func (t *UsageTracker) Shutdown() {
+	t.lifetimeCancel()
+	t.workers.Wait()
+}
+
+// Track records the usage to be written on the next flush. Only the latest
+// usage of each credential is kept.
+func (t *UsageTracker) Track(usage domain.ServiceAccountUsage) {
+	t.usagesMu.Lock()
+	defer t.usagesMu.Unlock()
+
+	key := cmp.Or(usage.APIKeyHash, usage.ServiceAccountID)
+	if prev, ok := t.usages[key]; ok && prev.UsedAt.After(usage.UsedAt) {
+		return
+	}
+	t.usages[key] = usage
+}
+
+func (t *UsageTracker) flush() {
+	t.usagesMu.Lock()
+	usages := t.usages
+	t.usages = make(map[string]domain.ServiceAccountUsage, len(usages))
+	t.usagesMu.Unlock()
+
+	if len(usages) == 0 {
+		return
+	}
+
+	ctx, span := tracing.StartSpanNonSampled(context.Background(), "serviceaccount.UsageTracker.flush")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, t.cfg.FlushTimeout)
+	defer cancel()
+
+	for usage := range maps.Values(usages) {
+		if err := t.markUsed(ctx, usage); err != nil {
+			slog.WarnContext(ctx, "Failed to mark usage of service account",
+				"serviceAccountId", usage.ServiceAccountID, "error", err)
+		}
+	}
+}
+
+func (t *UsageTracker) markUsed(ctx context.Context, usage domain.ServiceAccountUsage) error {
+	err := t.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		if usage.APIKeyHash != "" {
+			if err := t.apiKeyRepo.MarkUsed(ctx, usage.APIKeyHash, usage.UsedAt); err != nil {
+				return fmt.Errorf("marking usage of API key: %w", err)
+			}
+		}
+		if err := t.serviceAccountRepo.MarkUsed(ctx, usage); err != nil {
+			return fmt.Errorf("marking usage of service account: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("during transaction: %w", err)
+	}
+	return nil
+}
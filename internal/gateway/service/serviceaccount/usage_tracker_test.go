@@ -0,0 +1,80 @@
+package serviceaccount
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+)
+
+type fakeTransactioner struct {
+	port.Transactioner
+}
+
+func (fakeTransactioner) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeServiceAccountRepository struct {
+	port.ServiceAccountRepository
+	usages []domain.ServiceAccountUsage
+}
+
+func (r *fakeServiceAccountRepository) MarkUsed(_ context.Context, usage domain.ServiceAccountUsage,
+) error {
+	r.usages = append(r.usages, usage)
+	return nil
+}
+
+type fakeAPIKeyRepository struct {
+	port.ServiceAccountAPIKeyRepository
+	usedAts map[string]time.Time
+}
+
+func (r *fakeAPIKeyRepository) MarkUsed(_ context.Context, hash string, usedAt time.Time) error {
+	r.usedAts[hash] = usedAt
+	return nil
+}
+
+func TestUsageTracker_flush(t *testing.T) {
+	accountRepo := &fakeServiceAccountRepository{}
+	apiKeyRepo := &fakeAPIKeyRepository{usedAts: make(map[string]time.Time)}
+	tracker := NewUsageTracker(UsageTrackerConfig{FlushTimeout: time.Second},
+		fakeTransactioner{}, accountRepo, apiKeyRepo)
+
+	now := time.Now()
+	latest := domain.ServiceAccountUsage{
+		ServiceAccountID: "account-1",
+		APIKeyHash:       "hash-1",
+		UsedAt:           now,
+		RemoteIP:         "203.0.113.7",
+	}
+	tracker.Track(latest)
+	// Usages delivered out of order are dropped
+	tracker.Track(domain.ServiceAccountUsage{
+		ServiceAccountID: "account-1",
+		APIKeyHash:       "hash-1",
+		UsedAt:           now.Add(-time.Second),
+		RemoteIP:         "203.0.113.8",
+	})
+
+	// Usages by credentials other than API keys only mark service accounts
+	byCert := domain.ServiceAccountUsage{
+		ServiceAccountID: "account-2",
+		UsedAt:           now,
+		RemoteIP:         "203.0.113.9",
+	}
+	tracker.Track(byCert)
+
+	tracker.flush()
+	assert.ElementsMatch(t, []domain.ServiceAccountUsage{latest, byCert}, accountRepo.usages)
+	assert.Equal(t, map[string]time.Time{"hash-1": now}, apiKeyRepo.usedAts)
+
+	// Flushed usages are not written again
+	tracker.flush()
+	assert.Len(t, accountRepo.usages, 2)
+}
@@ -0,0 +1,85 @@
+package serviceaccount
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+func (s *Service) GetByClientCertificate(ctx context.Context, cert *x509.Certificate,
+) (domain.ServiceAccount, error) {
+	subjects := []string{cert.Subject.String()}
+	for _, uri := range cert.URIs {
+		subjects = append(subjects, uri.String())
+	}
+
+	idx := slices.IndexFunc(s.cfg.CertificateMappings, func(m CertificateMapping) bool {
+		return slices.Contains(subjects, m.Subject)
+	})
+	if idx < 0 {
+		return domain.ServiceAccount{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Client certificate is not mapped to any service account")
+	}
+
+	account, err := s.getActiveByID(ctx, s.cfg.CertificateMappings[idx].ServiceAccountID)
+	if err != nil {
+		return domain.ServiceAccount{}, fmt.Errorf("getting mapped service account: %w", err)
+	}
+	return account, nil
+}
+
+func (s *Service) IsWorkloadToken(token string) bool {
+	return s.workloadTokenVerifier != nil && s.workloadTokenVerifier.Trusts(token)
+}
+
+func (s *Service) GetByWorkloadToken(ctx context.Context, token string,
+) (domain.ServiceAccount, error) {
+	if s.workloadTokenVerifier == nil {
+		return domain.ServiceAccount{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Workload tokens are not accepted")
+	}
+
+	payload, err := s.workloadTokenVerifier.VerifyWorkloadToken(ctx, token)
+	if err != nil {
+		return domain.ServiceAccount{}, fmt.Errorf("verifying workload token: %w", err)
+	}
+
+	idx := slices.IndexFunc(s.cfg.TokenMappings, func(m TokenMapping) bool {
+		return m.Issuer == payload.Issuer && domain.ClaimContains(payload.Claims[m.Claim], m.ClaimValue)
+	})
+	if idx < 0 {
+		return domain.ServiceAccount{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Workload token is not mapped to any service account")
+	}
+
+	account, err := s.getActiveByID(ctx, s.cfg.TokenMappings[idx].ServiceAccountID)
+	if err != nil {
+		return domain.ServiceAccount{}, fmt.Errorf("getting mapped service account: %w", err)
+	}
+	return account, nil
+}
+
+// getActiveByID finds the service account mapped to workload credentials,
+// which are rejected once the account is gone or expired.
+func (s *Service) getActiveByID(ctx context.Context, id string) (domain.ServiceAccount, error) {
+	account, err := s.serviceAccountRepo.FindByID(ctx, id)
+	switch {
+	case apperr.IsErrorStatusCode(err, http.StatusNotFound):
+		return domain.ServiceAccount{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Mapped service account %s does not exist", id)
+	case err != nil:
+		return domain.ServiceAccount{}, fmt.Errorf("finding service account: %w", err)
+	case account.IsExpired():
+		return domain.ServiceAccount{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Mapped service account %s is expired", id)
+	case account.IsDisabled():
+		return domain.ServiceAccount{}, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("Mapped service account %s is disabled", id)
+	}
+	return account, nil
+}
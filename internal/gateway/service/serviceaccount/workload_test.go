@@ -0,0 +1,169 @@
+package serviceaccount
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/serviceaccounts"
+)
+
+type fakeWorkloadServiceAccountRepository struct {
+	port.ServiceAccountRepository
+	accounts map[string]domain.ServiceAccount
+}
+
+func (r *fakeWorkloadServiceAccountRepository) FindByID(_ context.Context, id string,
+) (domain.ServiceAccount, error) {
+	account, ok := r.accounts[id]
+	if !ok {
+		return domain.ServiceAccount{}, apperr.NewError(apperr.CodeNotFound)
+	}
+	return account, nil
+}
+
+type fakeWorkloadTokenVerifier struct {
+	payload domain.WorkloadTokenPayload
+}
+
+func (v fakeWorkloadTokenVerifier) Trusts(string) bool {
+	return true
+}
+
+func (v fakeWorkloadTokenVerifier) VerifyWorkloadToken(context.Context, string,
+) (domain.WorkloadTokenPayload, error) {
+	return v.payload, nil
+}
+
+func newWorkloadTestService(verifier port.WorkloadTokenVerifier) *Service {
+	repo := &fakeWorkloadServiceAccountRepository{
+		accounts: map[string]domain.ServiceAccount{
+			"account-1": {ID: "account-1"},
+			"account-2": {ID: "account-2", ExpireAt: new(time.Now().Add(-time.Hour))},
+			"account-3": {
+				ID:             "account-3",
+				DisabledAt:     new(time.Now().Add(-time.Hour)),
+				DisabledReason: serviceaccounts.DisabledReasonUnused,
+			},
+		},
+	}
+	cfg := Config{
+		CertificateMappings: []CertificateMapping{
+			{Subject: "CN=uploader,O=platform", ServiceAccountID: "account-1"},
+			{Subject: "spiffe://example.org/ns/ci/sa/expired", ServiceAccountID: "account-2"},
+			{Subject: "CN=stale", ServiceAccountID: "account-3"},
+		},
+		TokenMappings: []TokenMapping{
+			{
+				Issuer:           "https://token.workload.example.com",
+				Claim:            "groups",
+				ClaimValue:       "uploaders",
+				ServiceAccountID: "account-1",
+			},
+		},
+	}
+	return NewService(cfg, fakeTransactioner{}, repo, nil, nil, nil, verifier)
+}
+
+func TestService_GetByClientCertificate(t *testing.T) {
+	tests := []struct {
+		name    string // description of this test case
+		cert    *x509.Certificate
+		wantID  string
+		wantErr bool
+	}{
+		{
+			name: "mapped by subject",
+			cert: &x509.Certificate{
+				Subject: pkix.Name{CommonName: "uploader", Organization: []string{"platform"}},
+			},
+			wantID: "account-1",
+		},
+		{
+			name: "mapped to expired account by URI SAN",
+			cert: &x509.Certificate{
+				Subject: pkix.Name{CommonName: "expired"},
+				URIs:    []*url.URL{{Scheme: "spiffe", Host: "example.org", Path: "/ns/ci/sa/expired"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "mapped to disabled account",
+			cert:    &x509.Certificate{Subject: pkix.Name{CommonName: "stale"}},
+			wantErr: true,
+		},
+		{
+			name:    "not mapped",
+			cert:    &x509.Certificate{Subject: pkix.Name{CommonName: "stranger"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newWorkloadTestService(nil)
+
+			account, err := svc.GetByClientCertificate(t.Context(), tt.cert)
+			if tt.wantErr {
+				assert.True(t, apperr.IsErrorCode(err, apperr.CodeUnauthorized), "got error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, account.ID)
+		})
+	}
+}
+
+func TestService_GetByWorkloadToken(t *testing.T) {
+	tests := []struct {
+		name    string // description of this test case
+		payload domain.WorkloadTokenPayload
+		wantID  string
+		wantErr bool
+	}{
+		{
+			name: "mapped by claim in list",
+			payload: domain.WorkloadTokenPayload{
+				Issuer: "https://token.workload.example.com",
+				Claims: map[string]any{"groups": []any{"readers", "uploaders"}},
+			},
+			wantID: "account-1",
+		},
+		{
+			name: "claim from another issuer",
+			payload: domain.WorkloadTokenPayload{
+				Issuer: "https://token.other.example.com",
+				Claims: map[string]any{"groups": []any{"uploaders"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newWorkloadTestService(fakeWorkloadTokenVerifier{payload: tt.payload})
+
+			account, err := svc.GetByWorkloadToken(t.Context(), "token")
+			if tt.wantErr {
+				assert.True(t, apperr.IsErrorCode(err, apperr.CodeUnauthorized), "got error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, account.ID)
+		})
+	}
+}
+
+func TestService_IsWorkloadToken(t *testing.T) {
+	assert.False(t, newWorkloadTestService(nil).IsWorkloadToken("token"))
+	assert.True(t, newWorkloadTestService(fakeWorkloadTokenVerifier{}).IsWorkloadToken("token"))
+}
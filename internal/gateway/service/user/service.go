@@ -3,18 +3,29 @@ package user
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/audits"
+	"github.com/isutare412/imageer/pkg/validation"
 )
 
 type Service struct {
-	userRepo port.UserRepository
+	transactioner port.Transactioner
+	userRepo      port.UserRepository
+	sessionStore  port.SessionStore
+	auditRecorder port.AuditRecorder
 }
 
-func NewService(userRepo port.UserRepository) *Service {
+func NewService(transactioner port.Transactioner, userRepo port.UserRepository,
+	sessionStore port.SessionStore, auditRecorder port.AuditRecorder,
+) *Service {
 	return &Service{
-		userRepo: userRepo,
+		transactioner: transactioner,
+		userRepo:      userRepo,
+		sessionStore:  sessionStore,
+		auditRecorder: auditRecorder,
 	}
 }
 
@@ -25,3 +36,77 @@ func (s *Service) GetByID(ctx context.Context, id string) (domain.User, error) {
 	}
 	return user, nil
 }
+
+func (s *Service) List(ctx context.Context, params domain.ListUsersParams) (domain.Users, error) {
+	if err := validation.Validate(params); err != nil {
+		return domain.Users{}, fmt.Errorf("validating params: %w", err)
+	}
+
+	users, err := s.userRepo.List(ctx, params)
+	if err != nil {
+		return domain.Users{}, fmt.Errorf("listing users: %w", err)
+	}
+	return users, nil
+}
+
+// Update changes the role of the user, or suspends or unsuspends the user.
+// Changes apply to tokens issued before as well, as sessions of the user are
+// updated with the role, or revoked on suspension.
+func (s *Service) Update(ctx context.Context, req domain.UpdateUserRequest) (domain.User, error) {
+	if err := validation.Validate(req); err != nil {
+		return domain.User{}, fmt.Errorf("validating request: %w", err)
+	}
+
+	var user domain.User
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		before, err := s.userRepo.FindByID(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("finding user: %w", err)
+		}
+
+		user, err = s.userRepo.Update(ctx, req)
+		if err != nil {
+			return fmt.Errorf("updating user: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionUserUpdate,
+			TargetType: audits.TargetTypeUser,
+			TargetID:   user.ID,
+			Before:     before,
+			After:      user,
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+
+		if err := s.applyToSessions(ctx, before, user); err != nil {
+			return fmt.Errorf("applying user changes to sessions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.User{}, fmt.Errorf("during transaction: %w", err)
+	}
+	return user, nil
+}
+
+// applyToSessions revokes sessions of the user if suspended, or updates them
+// if the role changed. Sessions are kept in another store, so that they are
+// changed before the transaction commits to roll back user changes on failure.
+func (s *Service) applyToSessions(ctx context.Context, before, after domain.User) error {
+	switch {
+	case after.IsSuspended() && !before.IsSuspended():
+		count, err := s.sessionStore.DeleteAllOfUser(ctx, after.ID)
+		if err != nil {
+			return fmt.Errorf("deleting sessions of user: %w", err)
+		}
+		slog.InfoContext(ctx, "Revoked sessions of suspended user", "userId", after.ID,
+			"count", count)
+
+	case after.Role != before.Role:
+		if err := s.sessionStore.UpdateRoleOfUser(ctx, after.ID, after.Role); err != nil {
+			return fmt.Errorf("updating role of sessions: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package watermark
+
+type Config struct {
+	CDNDomain   string
+	S3KeyPrefix string
+
+	// StorageCDNBaseURLs are CDN base URLs of storage profiles, which override
+	// CDNDomain. The default profile is also keyed by the empty name.
+	StorageCDNBaseURLs map[string]string
+}
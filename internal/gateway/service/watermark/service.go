@@ -0,0 +1,173 @@
+package watermark
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/audits"
+	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/validation"
+)
+
+type Service struct {
+	s3Presigner   port.S3Presigner
+	objectStorage port.ObjectStorage
+	transactioner port.Transactioner
+	projectRepo   port.ProjectRepository
+	watermarkRepo port.WatermarkRepository
+	auditRecorder port.AuditRecorder
+
+	cfg Config
+}
+
+func NewService(cfg Config, s3Presigner port.S3Presigner, objectStorage port.ObjectStorage,
+	transactioner port.Transactioner, projectRepo port.ProjectRepository,
+	watermarkRepo port.WatermarkRepository, auditRecorder port.AuditRecorder,
+) *Service {
+	return &Service{
+		s3Presigner:   s3Presigner,
+		objectStorage: objectStorage,
+		transactioner: transactioner,
+		projectRepo:   projectRepo,
+		watermarkRepo: watermarkRepo,
+		auditRecorder: auditRecorder,
+		cfg:           cfg,
+	}
+}
+
+func (s *Service) Get(ctx context.Context, id string) (domain.Watermark, error) {
+	watermark, err := s.watermarkRepo.FindByID(ctx, id)
+	if err != nil {
+		return domain.Watermark{}, fmt.Errorf("finding watermark by ID: %w", err)
+	}
+	return watermark, nil
+}
+
+func (s *Service) List(ctx context.Context, params domain.ListWatermarksParams,
+) (domain.Watermarks, error) {
+	watermarks, err := s.watermarkRepo.List(ctx, params)
+	if err != nil {
+		return domain.Watermarks{}, fmt.Errorf("listing watermarks: %w", err)
+	}
+	return watermarks, nil
+}
+
+func (s *Service) CreateUploadURL(ctx context.Context, req domain.CreateWatermarkUploadURLRequest,
+) (domain.WatermarkUploadURL, error) {
+	if err := validation.Validate(req); err != nil {
+		return domain.WatermarkUploadURL{}, fmt.Errorf("validating request: %w", err)
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, req.ProjectID)
+	if err != nil {
+		return domain.WatermarkUploadURL{}, fmt.Errorf("finding project by ID: %w", err)
+	}
+
+	watermarkID := uuid.NewString()
+	watermark := domain.Watermark{
+		ID:      watermarkID,
+		Name:    req.Name,
+		Format:  req.Format,
+		S3Key:   s.watermarkS3Key(req.ProjectID, watermarkID, req.Format),
+		URL:     s.watermarkPublicURL(project.ToReference(), watermarkID, req.Format),
+		State:   images.WatermarkStateUploadPending,
+		Project: project.ToReference(),
+	}
+	watermark, err = s.watermarkRepo.Create(ctx, watermark)
+	if err != nil {
+		return domain.WatermarkUploadURL{}, fmt.Errorf("creating watermark: %w", err)
+	}
+
+	presignResp, err := s.s3Presigner.PresignPutObject(ctx, domain.PresignPutObjectRequest{
+		StorageProfile: project.StorageProfile,
+		S3Key:          watermark.S3Key,
+		ContentType:    watermark.Format.ContentType(),
+	})
+	if err != nil {
+		return domain.WatermarkUploadURL{}, fmt.Errorf("presigning put object: %w", err)
+	}
+
+	return domain.WatermarkUploadURL{
+		Watermark: watermark,
+		ExpiresAt: presignResp.ExpireAt,
+		URL:       presignResp.URL,
+		Header:    presignResp.Header,
+	}, nil
+}
+
+// CompleteUpload marks the watermark ready once its object is found in the
+// storage, after which presets can reference it. Calling it for a watermark
+// already marked ready is a no-op.
+func (s *Service) CompleteUpload(ctx context.Context, id string) (domain.Watermark, error) {
+	watermark, err := s.watermarkRepo.FindByID(ctx, id)
+	if err != nil {
+		return domain.Watermark{}, fmt.Errorf("finding watermark by ID: %w", err)
+	}
+	if watermark.State == images.WatermarkStateReady {
+		return watermark, nil
+	}
+
+	err = s.objectStorage.HeadObject(ctx, watermark.Project.StorageProfile, watermark.S3Key)
+	switch {
+	case apperr.IsErrorCode(err, apperr.CodeNotFound):
+		return domain.Watermark{}, apperr.NewError(apperr.CodeConflict).
+			WithCause(err).
+			WithSummary("Watermark is not uploaded yet")
+	case err != nil:
+		return domain.Watermark{}, fmt.Errorf("heading watermark object: %w", err)
+	}
+
+	watermark, err = s.watermarkRepo.Update(ctx, domain.UpdateWatermarkRequest{
+		ID:    watermark.ID,
+		State: new(images.WatermarkStateReady),
+	})
+	if err != nil {
+		return domain.Watermark{}, fmt.Errorf("updating watermark: %w", err)
+	}
+	return watermark, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id string) error {
+	var s3Key, storageProfile string
+	err := s.transactioner.WithTx(ctx, func(ctx context.Context) error {
+		watermark, err := s.watermarkRepo.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("finding watermark by ID: %w", err)
+		}
+		s3Key = watermark.S3Key
+		storageProfile = watermark.Project.StorageProfile
+
+		// NOTE: Presets referencing the watermark are detached by the foreign
+		// key constraint.
+		if err := s.watermarkRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("deleting watermark: %w", err)
+		}
+
+		if err := s.auditRecorder.Record(ctx, domain.RecordAuditRequest{
+			Action:     audits.ActionWatermarkDelete,
+			TargetType: audits.TargetTypeWatermark,
+			TargetID:   id,
+			Before:     watermark,
+		}); err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("during transaction: %w", err)
+	}
+
+	if err := s.objectStorage.DeleteObjects(ctx, storageProfile, []string{s3Key}); err != nil {
+		// Log error but don't fail the delete operation
+		slog.ErrorContext(ctx, "Failed to delete watermark S3 object", "watermarkId", id,
+			"error", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package watermark
+
+import (
+	"fmt"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+func watermarkPath(projectID, watermarkID string, format images.Format) string {
+	return fmt.Sprintf("projects/%s/watermarks/%s.%s", projectID, watermarkID, format.Extension())
+}
+
+func (s *Service) watermarkS3Key(projectID, watermarkID string, format images.Format) string {
+	return fmt.Sprintf("%s/%s", s.cfg.S3KeyPrefix, watermarkPath(projectID, watermarkID, format))
+}
+
+func (s *Service) watermarkPublicURL(project domain.ProjectReference, watermarkID string,
+	format images.Format,
+) string {
+	baseURL := s.cfg.CDNDomain
+	if profileURL, ok := s.cfg.StorageCDNBaseURLs[project.StorageProfile]; ok {
+		baseURL = profileURL
+	}
+	return fmt.Sprintf("%s/%s", baseURL, watermarkPath(project.ID, watermarkID, format))
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/isutare412/imageer/internal/gateway/port"
 	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/awshelpers"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
 
@@ -146,6 +147,9 @@ func (l *ImageUploadListener) handleEvent(ctx context.Context, msgBytes []byte)
 
 	var errs error
 	for _, record := range event.Records {
+		if !awshelpers.IsObjectCreatedEvent(record) {
+			continue
+		}
 		if err := l.handleRecord(ctx, record); err != nil {
 			errs = errors.Join(errs, fmt.Errorf("handling record: %w", err))
 		}
@@ -162,7 +166,8 @@ func (l *ImageUploadListener) handleRecord(ctx context.Context, record events.S3
 	ctx, cancel := context.WithTimeout(ctx, l.cfg.HandleTimeout)
 	defer cancel()
 
-	s3Key := record.S3.Object.Key
+	// Images are looked up by exact keys, which are URL-encoded in events
+	s3Key := record.S3.Object.URLDecodedKey
 	err := l.imageSvc.StartImageProcessingOnUpload(ctx, s3Key)
 	switch {
 	case apperr.IsErrorCode(err, apperr.CodeBadRequest):
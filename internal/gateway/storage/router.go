@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+type Presigner interface {
+	PresignPutObject(context.Context, domain.PresignPutObjectRequest) (domain.PresignPutObjectResponse, error)
+	PresignGetObject(context.Context, domain.PresignGetObjectRequest) (domain.PresignGetObjectResponse, error)
+}
+
+type ObjectStorage interface {
+	HeadObject(ctx context.Context, key string) error
+	ListObjects(ctx context.Context, prefix string) ([]domain.StoredObject, error)
+	MoveToInfrequentAccess(ctx context.Context, key string) error
+	DeleteObjects(ctx context.Context, keys []string) error
+}
+
+// Backend is the storage of a single storage profile.
+type Backend struct {
+	Presigner     Presigner
+	ObjectStorage ObjectStorage
+}
+
+// Router routes object storage requests to the backend of a storage profile.
+// An empty profile name is routed to the default profile.
+type Router struct {
+	defaultProfile string
+	backends       map[string]Backend
+}
+
+func NewRouter(defaultProfile string, backends map[string]Backend) (*Router, error) {
+	if _, ok := backends[defaultProfile]; !ok {
+		return nil, fmt.Errorf("default storage profile %q not found", defaultProfile)
+	}
+
+	return &Router{
+		defaultProfile: defaultProfile,
+		backends:       backends,
+	}, nil
+}
+
+func (r *Router) PresignPutObject(ctx context.Context, req domain.PresignPutObjectRequest,
+) (domain.PresignPutObjectResponse, error) {
+	backend, err := r.backend(req.StorageProfile)
+	if err != nil {
+		return domain.PresignPutObjectResponse{}, err
+	}
+	return backend.Presigner.PresignPutObject(ctx, req)
+}
+
+func (r *Router) PresignGetObject(ctx context.Context, req domain.PresignGetObjectRequest,
+) (domain.PresignGetObjectResponse, error) {
+	backend, err := r.backend(req.StorageProfile)
+	if err != nil {
+		return domain.PresignGetObjectResponse{}, err
+	}
+	return backend.Presigner.PresignGetObject(ctx, req)
+}
+
+func (r *Router) HeadObject(ctx context.Context, storageProfile, key string) error {
+	backend, err := r.backend(storageProfile)
+	if err != nil {
+		return err
+	}
+	return backend.ObjectStorage.HeadObject(ctx, key)
+}
+
+func (r *Router) ListObjects(ctx context.Context, storageProfile, prefix string,
+) ([]domain.StoredObject, error) {
+	backend, err := r.backend(storageProfile)
+	if err != nil {
+		return nil, err
+	}
+	return backend.ObjectStorage.ListObjects(ctx, prefix)
+}
+
+func (r *Router) MoveToInfrequentAccess(ctx context.Context, storageProfile, key string) error {
+	backend, err := r.backend(storageProfile)
+	if err != nil {
+		return err
+	}
+	return backend.ObjectStorage.MoveToInfrequentAccess(ctx, key)
+}
+
+func (r *Router) DeleteObjects(ctx context.Context, storageProfile string, keys []string) error {
+	backend, err := r.backend(storageProfile)
+	if err != nil {
+		return err
+	}
+	return backend.ObjectStorage.DeleteObjects(ctx, keys)
+}
+
+func (r *Router) backend(profile string) (Backend, error) {
+	if profile == "" {
+		profile = r.defaultProfile
+	}
+
+	backend, ok := r.backends[profile]
+	if !ok {
+		return Backend{}, apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Storage profile %q is not configured", profile)
+	}
+	return backend, nil
+}
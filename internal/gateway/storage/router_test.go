@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+type fakeBackend struct {
+	name        string
+	deletedKeys []string
+}
+
+func (b *fakeBackend) PresignPutObject(_ context.Context, req domain.PresignPutObjectRequest,
+) (domain.PresignPutObjectResponse, error) {
+	return domain.PresignPutObjectResponse{URL: b.name + "/" + req.S3Key}, nil
+}
+
+func (b *fakeBackend) PresignGetObject(_ context.Context, req domain.PresignGetObjectRequest,
+) (domain.PresignGetObjectResponse, error) {
+	return domain.PresignGetObjectResponse{URL: b.name + "/" + req.S3Key}, nil
+}
+
+func (b *fakeBackend) HeadObject(_ context.Context, key string) error {
+	return nil
+}
+
+func (b *fakeBackend) ListObjects(_ context.Context, prefix string,
+) ([]domain.StoredObject, error) {
+	return []domain.StoredObject{{Key: b.name + "/" + prefix}}, nil
+}
+
+func (b *fakeBackend) MoveToInfrequentAccess(_ context.Context, key string) error {
+	return nil
+}
+
+func (b *fakeBackend) DeleteObjects(_ context.Context, keys []string) error {
+	b.deletedKeys = append(b.deletedKeys, keys...)
+	return nil
+}
+
+func TestRouter(t *testing.T) {
+	primary := &fakeBackend{name: "primary"}
+	archive := &fakeBackend{name: "archive"}
+	router, err := NewRouter("primary", map[string]Backend{
+		"primary": {Presigner: primary, ObjectStorage: primary},
+		"archive": {Presigner: archive, ObjectStorage: archive},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		profile string
+		wantURL string
+		wantErr bool
+	}{
+		{
+			name:    "default profile",
+			profile: "",
+			wantURL: "primary/a.jpg",
+		},
+		{
+			name:    "named profile",
+			profile: "archive",
+			wantURL: "archive/a.jpg",
+		},
+		{
+			name:    "unknown profile",
+			profile: "unknown",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			putResp, err := router.PresignPutObject(t.Context(), domain.PresignPutObjectRequest{
+				StorageProfile: tt.profile,
+				S3Key:          "a.jpg",
+			})
+			getResp, getErr := router.PresignGetObject(t.Context(), domain.PresignGetObjectRequest{
+				StorageProfile: tt.profile,
+				S3Key:          "a.jpg",
+			})
+			if tt.wantErr {
+				assert.True(t, apperr.IsErrorCode(err, apperr.CodeInternalServerError))
+				assert.True(t, apperr.IsErrorCode(getErr, apperr.CodeInternalServerError))
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, getErr)
+			assert.Equal(t, tt.wantURL, putResp.URL)
+			assert.Equal(t, tt.wantURL, getResp.URL)
+		})
+	}
+
+	require.NoError(t, router.DeleteObjects(t.Context(), "archive", []string{"a.jpg"}))
+	assert.Equal(t, []string{"a.jpg"}, archive.deletedKeys)
+	assert.Empty(t, primary.deletedKeys)
+}
+
+func TestNewRouter_MissingDefaultProfile(t *testing.T) {
+	_, err := NewRouter("primary", map[string]Backend{})
+	require.Error(t, err)
+}
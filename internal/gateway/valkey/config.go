@@ -169,3 +169,8 @@ type ImageNotificationPublisherConfig struct {
 	UploadDoneChannelPrefix  string
 	ProcessDoneChannelPrefix string
 }
+
+type SessionStoreConfig struct {
+	KeyPrefix string
+	CacheTTL  time.Duration
+}
@@ -0,0 +1,223 @@
+package valkey
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/valkey-io/valkey-go"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+	"github.com/isutare412/imageer/pkg/users"
+)
+
+// SessionStore keeps each session in a key expiring with the session, and
+// indexes sessions of a user in a sorted set scored by expiry. Lookups are
+// cached in the client with server-assisted invalidation, so that revocations
+// apply to other instances as soon as the cache entries are invalidated.
+type SessionStore struct {
+	client valkey.Client
+	cfg    SessionStoreConfig
+}
+
+func NewSessionStore(cfg SessionStoreConfig, c *Client) *SessionStore {
+	return &SessionStore{
+		client: c.client,
+		cfg:    cfg,
+	}
+}
+
+type sessionRecord struct {
+	UserID   string     `json:"userId"`
+	Role     users.Role `json:"role,omitempty"`
+	ExpireAt time.Time  `json:"expireAt"`
+}
+
+func (s *SessionStore) Save(ctx context.Context, session domain.Session) error {
+	ctx, span := tracing.StartSpan(ctx, "valkey.SessionStore.Save",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceValkey))
+	defer span.End()
+
+	record, err := json.Marshal(sessionRecord{
+		UserID:   session.UserID,
+		Role:     session.Role,
+		ExpireAt: session.ExpireAt,
+	})
+	if err != nil {
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Failed to marshal session").
+			WithCause(err)
+	}
+
+	userKey := s.userSessionsKey(session.UserID)
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+
+	// Sessions of a user share the same TTL, so that the latest saved session
+	// expires last.
+	resps := s.client.DoMulti(ctx,
+		s.client.B().Set().
+			Key(s.sessionKey(session.ID)).
+			Value(valkey.BinaryString(record)).
+			Pxat(session.ExpireAt).
+			Build(),
+		s.client.B().Zadd().
+			Key(userKey).
+			ScoreMember().
+			ScoreMember(float64(session.ExpireAt.UnixMilli()), session.ID).
+			Build(),
+		s.client.B().Zremrangebyscore().
+			Key(userKey).
+			Min("-inf").
+			Max(now).
+			Build(),
+		s.client.B().Pexpireat().
+			Key(userKey).
+			MillisecondsTimestamp(session.ExpireAt.UnixMilli()).
+			Build())
+	for _, resp := range resps {
+		if err := resp.Error(); err != nil {
+			return dbhelpers.WrapValkeyError(err, "Failed to save session %s", session.ID)
+		}
+	}
+
+	return nil
+}
+
+func (s *SessionStore) Find(ctx context.Context, id string) (domain.Session, error) {
+	ctx, span := tracing.StartSpan(ctx, "valkey.SessionStore.Find",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceValkey))
+	defer span.End()
+
+	cmd := s.client.B().Get().Key(s.sessionKey(id)).Cache()
+	record, err := s.client.DoCache(ctx, cmd, s.cfg.CacheTTL).AsBytes()
+	if err != nil {
+		return domain.Session{}, dbhelpers.WrapValkeyError(err, "Failed to GET session %s", id)
+	}
+
+	var rec sessionRecord
+	if err := json.Unmarshal(record, &rec); err != nil {
+		return domain.Session{}, apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Failed to unmarshal session %s", id).
+			WithCause(err)
+	}
+
+	return domain.Session{
+		ID:       id,
+		UserID:   rec.UserID,
+		Role:     rec.Role,
+		ExpireAt: rec.ExpireAt,
+	}, nil
+}
+
+func (s *SessionStore) Delete(ctx context.Context, userID, id string) error {
+	ctx, span := tracing.StartSpan(ctx, "valkey.SessionStore.Delete",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceValkey))
+	defer span.End()
+
+	resps := s.client.DoMulti(ctx,
+		s.client.B().Del().Key(s.sessionKey(id)).Build(),
+		s.client.B().Zrem().Key(s.userSessionsKey(userID)).Member(id).Build())
+	for _, resp := range resps {
+		if err := resp.Error(); err != nil {
+			return dbhelpers.WrapValkeyError(err, "Failed to delete session %s", id)
+		}
+	}
+
+	return nil
+}
+
+func (s *SessionStore) DeleteAllOfUser(ctx context.Context, userID string,
+) (count int64, err error) {
+	ctx, span := tracing.StartSpan(ctx, "valkey.SessionStore.DeleteAllOfUser",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceValkey))
+	defer span.End()
+
+	userKey := s.userSessionsKey(userID)
+
+	ids, err := s.client.Do(ctx, s.client.B().Zrange().Key(userKey).Min("0").Max("-1").Build()).
+		AsStrSlice()
+	if err != nil {
+		return 0, dbhelpers.WrapValkeyError(err, "Failed to ZRANGE sessions of user %s", userID)
+	}
+
+	keys := append(lo.Map(ids, func(id string, _ int) string { return s.sessionKey(id) }), userKey)
+	count, err = s.client.Do(ctx, s.client.B().Del().Key(keys...).Build()).AsInt64()
+	if err != nil {
+		return 0, dbhelpers.WrapValkeyError(err, "Failed to delete sessions of user %s", userID)
+	}
+
+	// Excludes the user key, which exists as long as any session does
+	return max(count-1, 0), nil
+}
+
+// UpdateRoleOfUser rewrites sessions of the user with the role. Sessions are
+// only overwritten if they still exist, so that revoked ones are not revived.
+func (s *SessionStore) UpdateRoleOfUser(ctx context.Context, userID string, role users.Role,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "valkey.SessionStore.UpdateRoleOfUser",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceValkey))
+	defer span.End()
+
+	ids, err := s.client.Do(ctx,
+		s.client.B().Zrange().Key(s.userSessionsKey(userID)).Min("0").Max("-1").Build()).
+		AsStrSlice()
+	if err != nil {
+		return dbhelpers.WrapValkeyError(err, "Failed to ZRANGE sessions of user %s", userID)
+	}
+
+	for _, id := range ids {
+		record, err := s.client.Do(ctx, s.client.B().Get().Key(s.sessionKey(id)).Build()).AsBytes()
+		switch {
+		case valkey.IsValkeyNil(err):
+			continue
+		case err != nil:
+			return dbhelpers.WrapValkeyError(err, "Failed to GET session %s", id)
+		}
+
+		var rec sessionRecord
+		if err := json.Unmarshal(record, &rec); err != nil {
+			return apperr.NewError(apperr.CodeInternalServerError).
+				WithSummary("Failed to unmarshal session %s", id).
+				WithCause(err)
+		}
+
+		rec.Role = role
+		updated, err := json.Marshal(rec)
+		if err != nil {
+			return apperr.NewError(apperr.CodeInternalServerError).
+				WithSummary("Failed to marshal session").
+				WithCause(err)
+		}
+
+		err = s.client.Do(ctx, s.client.B().Set().
+			Key(s.sessionKey(id)).
+			Value(valkey.BinaryString(updated)).
+			Xx().
+			Pxat(rec.ExpireAt).
+			Build()).Error()
+		if err != nil && !valkey.IsValkeyNil(err) {
+			return dbhelpers.WrapValkeyError(err, "Failed to update session %s", id)
+		}
+	}
+
+	return nil
+}
+
+func (s *SessionStore) sessionKey(id string) string {
+	return s.cfg.KeyPrefix + "id:" + id
+}
+
+func (s *SessionStore) userSessionsKey(userID string) string {
+	return s.cfg.KeyPrefix + "user:" + userID
+}
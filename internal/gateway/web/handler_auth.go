@@ -7,20 +7,26 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/samber/lo"
 
+	"github.com/isutare412/imageer/internal/gateway/contextbag"
 	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
 )
 
 // Authentication handlers
 
+// googleProvider is the OIDC provider of the Google-specific sign-in routes.
+const googleProvider = "google"
+
 // StartGoogleSignIn starts Google Sign-In process
 func (h *handler) StartGoogleSignIn(ctx echo.Context, params StartGoogleSignInParams) error {
 	rctx := ctx.Request().Context()
 
-	req := domain.StartGoogleSignInRequest{
+	req := domain.StartSignInRequest{
 		HTTPReq:      ctx.Request(),
+		Provider:     googleProvider,
 		RedirectPath: lo.FromPtr(params.Redirect),
 	}
-	resp, err := h.authSvc.StartGoogleSignIn(rctx, req)
+	resp, err := h.authSvc.StartSignIn(rctx, req)
 	if err != nil {
 		return fmt.Errorf("start google sign-in: %w", err)
 	}
@@ -33,12 +39,13 @@ func (h *handler) StartGoogleSignIn(ctx echo.Context, params StartGoogleSignInPa
 func (h *handler) FinishGoogleSignIn(ctx echo.Context, params FinishGoogleSignInParams) error {
 	rctx := ctx.Request().Context()
 
-	req := domain.FinishGoogleSignInRequest{
+	req := domain.FinishSignInRequest{
 		HTTPReq:  ctx.Request(),
+		Provider: googleProvider,
 		AuthCode: params.Code,
 		State:    params.State,
 	}
-	resp, err := h.authSvc.FinishGoogleSignIn(rctx, req)
+	resp, err := h.authSvc.FinishSignIn(rctx, req)
 	if err != nil {
 		return fmt.Errorf("finish google sign-in: %w", err)
 	}
@@ -48,11 +55,31 @@ func (h *handler) FinishGoogleSignIn(ctx echo.Context, params FinishGoogleSignIn
 	return ctx.Redirect(http.StatusFound, resp.RedirectURL)
 }
 
-// SignOut signs out the current user by clearing the user cookie
+// SignOut signs out the current user by revoking the session and clearing the
+// user cookie
 func (h *handler) SignOut(ctx echo.Context) error {
 	rctx := ctx.Request().Context()
 
-	resp := h.authSvc.SignOut(rctx)
+	bag, ok := contextbag.BagFromContext(rctx)
+	if !ok || bag.Identity == nil {
+		return apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("No authentication provided")
+	}
+
+	identity, ok := bag.Identity.(domain.UserTokenIdentity)
+	if !ok {
+		return apperr.NewError(apperr.CodeForbidden).
+			WithSummary("Must be user token authentication")
+	}
+
+	resp, err := h.authSvc.SignOut(rctx, domain.SignOutRequest{
+		SessionID: identity.Payload.SessionID,
+		UserID:    identity.Payload.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("sign out: %w", err)
+	}
+
 	ctx.SetCookie(resp.UserCookie)
 	return ctx.NoContent(http.StatusOK)
 }
@@ -90,7 +90,7 @@ func (h *handler) UpdateProjectAdmin(ctx echo.Context, projectID ProjectIDPath)
 func (h *handler) DeleteProjectAdmin(ctx echo.Context, projectID ProjectIDPath) error {
 	rctx := ctx.Request().Context()
 
-	if err := h.projectSvc.Delete(rctx, projectID); err != nil {
+	if _, err := h.projectSvc.Delete(rctx, projectID); err != nil {
 		return fmt.Errorf("deleting project: %w", err)
 	}
 	return ctx.NoContent(http.StatusOK)
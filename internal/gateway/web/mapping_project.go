@@ -4,6 +4,7 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/pkg/projects"
 )
 
 func ProjectToWeb(p domain.Project) Project {
@@ -57,7 +58,8 @@ func ListProjectsAdminParamsToDomain(params ListProjectsAdminParams) domain.List
 
 func CreateProjectAdminRequestToDomain(req CreateProjectAdminRequest) domain.CreateProjectRequest {
 	return domain.CreateProjectRequest{
-		Name: req.Name,
+		Name:       req.Name,
+		Visibility: projects.VisibilityPublic,
 		Presets: lo.Map(req.Presets,
 			func(t CreatePresetRequest, _ int) domain.CreatePresetRequest {
 				return CreatePresetRequestToDomain(t)
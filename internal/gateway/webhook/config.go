@@ -0,0 +1,9 @@
+package webhook
+
+import "time"
+
+type UploadEventHandlerConfig struct {
+	// AuthToken is the bearer token storages send along with notifications.
+	AuthToken     string
+	HandleTimeout time.Duration
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/awshelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+const maxEventBodySize = 1 << 20
+
+// UploadEventHandler receives bucket notifications of S3-compatible storages
+// in MinIO's webhook format, whose records follow the AWS S3 event structure.
+// Failures are responded with 5xx so that storages retry the notifications.
+type UploadEventHandler struct {
+	imageSvc port.ImageService
+	cfg      UploadEventHandlerConfig
+}
+
+func NewUploadEventHandler(cfg UploadEventHandlerConfig, imageSvc port.ImageService,
+) *UploadEventHandler {
+	return &UploadEventHandler{
+		imageSvc: imageSvc,
+		cfg:      cfg,
+	}
+}
+
+func (h *UploadEventHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "" && r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.cfg.AuthToken)) != 1 {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var event events.S3Event
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxEventBodySize)).
+		Decode(&event); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.handleEvent(r.Context(), event); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to handle upload event webhook", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError),
+			http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *UploadEventHandler) handleEvent(ctx context.Context, event events.S3Event) error {
+	var errs error
+	for _, record := range event.Records {
+		if !awshelpers.IsObjectCreatedEvent(record) {
+			continue
+		}
+		if err := h.handleRecord(ctx, record); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("handling record: %w", err))
+		}
+	}
+	return errs
+}
+
+func (h *UploadEventHandler) handleRecord(ctx context.Context, record events.S3EventRecord,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "webhook.UploadEventHandler.handleRecord",
+		trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, h.cfg.HandleTimeout)
+	defer cancel()
+
+	s3Key := record.S3.Object.URLDecodedKey
+	err := h.imageSvc.StartImageProcessingOnUpload(ctx, s3Key)
+	switch {
+	case apperr.IsErrorCode(err, apperr.CodeBadRequest):
+		fallthrough
+	case apperr.IsErrorCode(err, apperr.CodeNotFound):
+		slog.WarnContext(ctx, "Skipping image processing for invalid upload",
+			"error", err, "s3Key", s3Key)
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("starting image processing: %w", err)
+	}
+
+	return nil
+}
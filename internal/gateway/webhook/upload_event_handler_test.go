@@ -0,0 +1,143 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+type fakeImageService struct {
+	port.ImageService
+	uploadedKeys []string
+	err          error
+}
+
+func (s *fakeImageService) StartImageProcessingOnUpload(_ context.Context, s3Key string) error {
+	s.uploadedKeys = append(s.uploadedKeys, s3Key)
+	return s.err
+}
+
+const testEventBody = `{
+  "EventName": "s3:ObjectCreated:Put",
+  "Key": "images/test%20image.jpg",
+  "Records": [
+    {
+      "eventName": "s3:ObjectCreated:Put",
+      "s3": {"object": {"key": "images%2Ftest+image.jpg"}}
+    },
+    {
+      "eventName": "s3:ObjectRemoved:Delete",
+      "s3": {"object": {"key": "images%2Fremoved.jpg"}}
+    }
+  ]
+}`
+
+func TestUploadEventHandler_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		authorization  string
+		body           string
+		svcErr         error
+		wantStatusCode int
+		wantKeys       []string
+	}{
+		{
+			name:           "created event",
+			method:         http.MethodPost,
+			path:           "/",
+			authorization:  "Bearer test-token",
+			body:           testEventBody,
+			wantStatusCode: http.StatusNoContent,
+			wantKeys:       []string{"images/test image.jpg"},
+		},
+		{
+			name:           "unknown image",
+			method:         http.MethodPost,
+			path:           "/",
+			authorization:  "Bearer test-token",
+			body:           testEventBody,
+			svcErr:         apperr.NewError(apperr.CodeNotFound),
+			wantStatusCode: http.StatusNoContent,
+			wantKeys:       []string{"images/test image.jpg"},
+		},
+		{
+			name:           "processing failure",
+			method:         http.MethodPost,
+			path:           "/",
+			authorization:  "Bearer test-token",
+			body:           testEventBody,
+			svcErr:         errors.New("database unavailable"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantKeys:       []string{"images/test image.jpg"},
+		},
+		{
+			name:           "wrong token",
+			method:         http.MethodPost,
+			path:           "/",
+			authorization:  "Bearer other-token",
+			body:           testEventBody,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing token",
+			method:         http.MethodPost,
+			path:           "/",
+			body:           testEventBody,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid body",
+			method:         http.MethodPost,
+			path:           "/",
+			authorization:  "Bearer test-token",
+			body:           "not-json",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "wrong method",
+			method:         http.MethodGet,
+			path:           "/",
+			authorization:  "Bearer test-token",
+			wantStatusCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "unknown path",
+			method:         http.MethodPost,
+			path:           "/other",
+			authorization:  "Bearer test-token",
+			body:           testEventBody,
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imageSvc := &fakeImageService{err: tt.svcErr}
+			handler := NewUploadEventHandler(UploadEventHandlerConfig{
+				AuthToken:     "test-token",
+				HandleTimeout: time.Second,
+			}, imageSvc)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatusCode, rec.Code)
+			assert.Equal(t, tt.wantKeys, imageSvc.uploadedKeys)
+		})
+	}
+}
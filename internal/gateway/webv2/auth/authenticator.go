@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -52,6 +53,15 @@ func (a *Authenticator) Authenticate(next http.Handler) http.Handler {
 			return
 		}
 
+		if ok, err := a.authenticateByClientCertificate(ctx, r.TLS); err != nil {
+			gen.RespondError(w, r, fmt.Errorf("authenticate by client certificate: %w", err))
+			return
+		} else if ok {
+			// Identity issued by client certificate
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		if ok, err := a.authenticateByCookie(ctx, w, r.Cookies()); err != nil {
 			gen.RespondError(w, r, fmt.Errorf("authenticate by cookie: %w", err))
 			return
@@ -93,6 +103,16 @@ func (a *Authenticator) identityFromBearerToken(ctx context.Context, header http
 		return false, nil
 	}
 
+	if a.serviceAccountSvc.IsWorkloadToken(token) {
+		account, err := a.serviceAccountSvc.GetByWorkloadToken(ctx, token)
+		if err != nil {
+			return false, fmt.Errorf("getting service account by workload token: %w", err)
+		}
+
+		a.registerServiceAccount(ctx, account)
+		return true, nil
+	}
+
 	payload, err := a.authSvc.VerifyUserToken(ctx, token)
 	if err != nil {
 		return false, fmt.Errorf("verifying user token: %w", err)
@@ -114,11 +134,36 @@ func (a *Authenticator) identityFromAPIKey(ctx context.Context, header http.Head
 		return false, fmt.Errorf("getting service account by API key: %w", err)
 	}
 
+	if err := a.serviceAccountSvc.TrackAPIKeyUsage(ctx, account.ID, apiKey,
+		remoteIP(ctx)); err != nil {
+		slog.WarnContext(ctx, "Failed to track usage of API key", "serviceAccountId", account.ID,
+			"error", err)
+	}
+
 	identity := domain.NewServiceAccountIdentity(account)
 	a.registerIdentity(ctx, identity)
 	return true, nil
 }
 
+// authenticateByClientCertificate maps the client certificate verified during
+// the TLS handshake to a service account. Client certificates are only
+// requested if mTLS is enabled.
+func (a *Authenticator) authenticateByClientCertificate(ctx context.Context,
+	state *tls.ConnectionState,
+) (bool, error) {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return false, nil
+	}
+
+	account, err := a.serviceAccountSvc.GetByClientCertificate(ctx, state.VerifiedChains[0][0])
+	if err != nil {
+		return false, fmt.Errorf("getting service account by client certificate: %w", err)
+	}
+
+	a.registerServiceAccount(ctx, account)
+	return true, nil
+}
+
 func (a *Authenticator) authenticateByCookie(ctx context.Context, w http.ResponseWriter,
 	cookies []*http.Cookie,
 ) (bool, error) {
@@ -128,13 +173,21 @@ func (a *Authenticator) authenticateByCookie(ctx context.Context, w http.Respons
 	}
 
 	payload, err := a.authSvc.VerifyUserToken(ctx, cookie.Value)
-	if err != nil {
+	switch {
+	case apperr.IsErrorCode(err, apperr.CodeUnauthorized):
+		// Drop cookies of revoked sessions, so that the client can sign in again
+		slog.InfoContext(ctx, "Dropped unauthorized user cookie", "error", err)
+		http.SetCookie(w, a.expiredUserCookie())
+		return false, nil
+	case err != nil:
 		return false, fmt.Errorf("verifying user token: %w", err)
 	}
 
 	// Refresh token if near expiration
 	if time.Until(payload.ExpireAt) < a.tokenRefreshThreshold {
-		a.refreshUserToken(ctx, w, payload.UserID)
+		if err := a.refreshUserToken(ctx, w, payload.SessionID, payload.UserID); err != nil {
+			return false, fmt.Errorf("refreshing user token: %w", err)
+		}
 	}
 
 	identity := domain.NewUserTokenIdentity(payload)
@@ -148,16 +201,47 @@ func (a *Authenticator) registerIdentity(ctx context.Context, id domain.Identity
 	}
 }
 
-func (a *Authenticator) refreshUserToken(ctx context.Context, w http.ResponseWriter, userID string,
-) {
-	resp, err := a.authSvc.RefreshUserToken(ctx, userID)
-	if err != nil {
+// registerServiceAccount registers the service account authenticated by
+// credentials other than API keys.
+func (a *Authenticator) registerServiceAccount(ctx context.Context, account domain.ServiceAccount) {
+	a.serviceAccountSvc.TrackUsage(ctx, account.ID, remoteIP(ctx))
+	a.registerIdentity(ctx, domain.NewServiceAccountIdentity(account))
+}
+
+func remoteIP(ctx context.Context) string {
+	if bag, ok := contextbag.BagFromContext(ctx); ok {
+		return bag.RemoteIP
+	}
+	return ""
+}
+
+// refreshUserToken fails only if the user is suspended, while the request goes
+// on with the current token on other failures.
+func (a *Authenticator) refreshUserToken(ctx context.Context, w http.ResponseWriter,
+	sessionID, userID string,
+) error {
+	resp, err := a.authSvc.RefreshUserToken(ctx, sessionID, userID)
+	switch {
+	case apperr.IsErrorCode(err, apperr.CodeForbidden):
+		return err
+	case err != nil:
 		a.logRefreshError(ctx, err)
-		return
+		return nil
 	}
 
 	slog.InfoContext(ctx, "Refreshed user token", "userId", userID)
 	http.SetCookie(w, resp.UserCookie)
+	return nil
+}
+
+func (a *Authenticator) expiredUserCookie() *http.Cookie {
+	return &http.Cookie{
+		Name:     a.userCookieName,
+		Path:     "/",
+		MaxAge:   -1, // Delete cookie immediately
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
 }
 
 func (a *Authenticator) logRefreshError(ctx context.Context, err error) {
@@ -17,14 +17,16 @@ type Authorizer struct {
 }
 
 func NewAuthorizer(serviceAccountSvc port.ServiceAccountService, projectSvc port.ProjectService,
-	imageSvc port.ImageService,
+	imageSvc port.ImageService, watermarkSvc port.WatermarkService,
 ) *Authorizer {
 	return &Authorizer{
 		permissionInspectors: []permissionInspector{
 			newAdminPermissionInspector(),
-			newProjectPermissionInspector(),
+			newProjectPermissionInspector(projectSvc),
+			newServicePermissionInspector(),
 		},
-		resourceInspectors: newResourceInspector(serviceAccountSvc, projectSvc, imageSvc),
+		resourceInspectors: newResourceInspector(serviceAccountSvc, projectSvc, imageSvc,
+			watermarkSvc),
 	}
 }
 
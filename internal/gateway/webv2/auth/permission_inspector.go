@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/port"
+	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
 	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/projects"
 	"github.com/isutare412/imageer/pkg/serviceaccounts"
 )
 
@@ -60,13 +64,22 @@ func (i *adminPermissionInspector) inspect(r *http.Request, identity domain.Iden
 	return nil
 }
 
+// projectPermissionInspector checks access to project routes. Users reach
+// routes of projects they are members of according to their roles, while
+// service accounts reach routes of projects in their access scope. Listing
+// projects needs authentication only, as the handler lists accessible ones.
 type projectPermissionInspector struct {
-	pathPattern *regexp.Regexp
+	pathPattern       *regexp.Regexp
+	memberPathPattern *regexp.Regexp
+
+	projectSvc port.ProjectService
 }
 
-func newProjectPermissionInspector() *projectPermissionInspector {
+func newProjectPermissionInspector(projectSvc port.ProjectService) *projectPermissionInspector {
 	return &projectPermissionInspector{
-		pathPattern: regexp.MustCompile(`^/api/v1/projects/([^/]+).*`),
+		pathPattern:       regexp.MustCompile(`^/api/v1/projects(/.*)?$`),
+		memberPathPattern: regexp.MustCompile(`^/api/v1/projects/[^/]+/members(/.*)?$`),
+		projectSvc:        projectSvc,
 	}
 }
 
@@ -84,10 +97,30 @@ func (i *projectPermissionInspector) inspect(r *http.Request, identity domain.Id
 		return apperr.NewError(apperr.CodeUnauthorized).WithSummary("Need authentication")
 	}
 
+	projID := mux.Vars(r)["projectId"]
+	if projID == "" {
+		// Lists projects accessible to the identity.
+		return nil
+	}
+
 	switch id := identity.(type) {
 	case domain.UserTokenIdentity:
-		if !id.Payload.IsAdmin() {
-			return apperr.NewError(apperr.CodeForbidden).WithSummary("Admin role required")
+		if id.Payload.IsAdmin() {
+			return nil
+		}
+
+		member, err := i.projectSvc.GetMember(r.Context(), projID, id.Payload.UserID)
+		switch {
+		case apperr.IsErrorCode(err, apperr.CodeNotFound):
+			return apperr.NewError(apperr.CodeForbidden).WithSummary("Not a member of the project")
+		case err != nil:
+			return fmt.Errorf("getting project member: %w", err)
+		}
+
+		required := i.requiredMemberRole(r)
+		if !member.Role.Includes(required) {
+			return apperr.NewError(apperr.CodeForbidden).
+				WithSummary("Project role %s required", required)
 		}
 
 	case domain.ServiceAccountIdentity:
@@ -97,11 +130,6 @@ func (i *projectPermissionInspector) inspect(r *http.Request, identity domain.Id
 			return nil
 
 		case serviceaccounts.AccessScopeProject:
-			projID := mux.Vars(r)["projectId"]
-			if projID == "" {
-				return apperr.NewError(apperr.CodeBadRequest).WithSummary("Project ID required")
-			}
-
 			_, projFound := lo.Find(id.ServiceAccount.Projects,
 				func(p domain.ProjectReference) bool {
 					return p.ID == projID
@@ -120,3 +148,153 @@ func (i *projectPermissionInspector) inspect(r *http.Request, identity domain.Id
 
 	return nil
 }
+
+// requiredMemberRole returns the least role for the request. Viewers read
+// anything, editors change anything but members, and owners manage members.
+func (i *projectPermissionInspector) requiredMemberRole(r *http.Request) projects.MemberRole {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return projects.MemberRoleViewer
+	}
+
+	path, err := mux.CurrentRoute(r).GetPathTemplate()
+	if err != nil {
+		panic(fmt.Errorf("getting path template of current request: %w", err))
+	}
+	if i.memberPathPattern.MatchString(path) {
+		return projects.MemberRoleOwner
+	}
+	return projects.MemberRoleEditor
+}
+
+// operationPermissions maps operation IDs to permissions required for service
+// accounts to call them.
+var operationPermissions = map[string]serviceaccounts.Permission{
+	// Projects
+	"listProjects":            serviceaccounts.PermissionProjectsRead,
+	"getProject":              serviceaccounts.PermissionProjectsRead,
+	"listProjectsAdmin":       serviceaccounts.PermissionProjectsRead,
+	"getProjectAdmin":         serviceaccounts.PermissionProjectsRead,
+	"getProjectDeletionAdmin": serviceaccounts.PermissionProjectsRead,
+	"createProjectAdmin":      serviceaccounts.PermissionProjectsWrite,
+	"updateProjectAdmin":      serviceaccounts.PermissionProjectsWrite,
+	"deleteProjectAdmin":      serviceaccounts.PermissionProjectsWrite,
+	"restoreProjectAdmin":     serviceaccounts.PermissionProjectsWrite,
+
+	// Project members
+	"listProjectMembers":  serviceaccounts.PermissionMembersRead,
+	"addProjectMember":    serviceaccounts.PermissionMembersWrite,
+	"updateProjectMember": serviceaccounts.PermissionMembersWrite,
+	"removeProjectMember": serviceaccounts.PermissionMembersWrite,
+
+	// Images
+	"listImages":           serviceaccounts.PermissionImagesRead,
+	"getImage":             serviceaccounts.PermissionImagesRead,
+	"listImagesAdmin":      serviceaccounts.PermissionImagesRead,
+	"createUploadUrl":      serviceaccounts.PermissionImagesWrite,
+	"completeUpload":       serviceaccounts.PermissionImagesWrite,
+	"updateImage":          serviceaccounts.PermissionImagesWrite,
+	"updateImageFocus":     serviceaccounts.PermissionImagesWrite,
+	"restoreImage":         serviceaccounts.PermissionImagesWrite,
+	"restoreImageAdmin":    serviceaccounts.PermissionImagesWrite,
+	"reprocessImagesAdmin": serviceaccounts.PermissionImagesWrite,
+	"deleteImage":          serviceaccounts.PermissionImagesDelete,
+	"deleteImageAdmin":     serviceaccounts.PermissionImagesDelete,
+
+	// Watermarks
+	"listWatermarks":           serviceaccounts.PermissionWatermarksRead,
+	"createWatermarkUploadUrl": serviceaccounts.PermissionWatermarksWrite,
+	"completeWatermarkUpload":  serviceaccounts.PermissionWatermarksWrite,
+	"deleteWatermark":          serviceaccounts.PermissionWatermarksWrite,
+
+	// Service accounts
+	"listServiceAccountsAdmin":        serviceaccounts.PermissionServiceAccountsRead,
+	"getServiceAccountAdmin":          serviceaccounts.PermissionServiceAccountsRead,
+	"listServiceAccountApiKeysAdmin":  serviceaccounts.PermissionServiceAccountsRead,
+	"createServiceAccountAdmin":       serviceaccounts.PermissionServiceAccountsWrite,
+	"updateServiceAccountAdmin":       serviceaccounts.PermissionServiceAccountsWrite,
+	"deleteServiceAccountAdmin":       serviceaccounts.PermissionServiceAccountsWrite,
+	"createServiceAccountApiKeyAdmin": serviceaccounts.PermissionServiceAccountsWrite,
+	"revokeServiceAccountApiKeyAdmin": serviceaccounts.PermissionServiceAccountsWrite,
+
+	// Users
+	"listUsersAdmin":          serviceaccounts.PermissionUsersRead,
+	"getUserAdmin":            serviceaccounts.PermissionUsersRead,
+	"updateUserAdmin":         serviceaccounts.PermissionUsersWrite,
+	"suspendUserAdmin":        serviceaccounts.PermissionUsersWrite,
+	"unsuspendUserAdmin":      serviceaccounts.PermissionUsersWrite,
+	"revokeUserSessionsAdmin": serviceaccounts.PermissionUsersWrite,
+
+	// Audit logs
+	"listAuditLogsAdmin": serviceaccounts.PermissionAuditLogsRead,
+}
+
+// servicePermissionInspector checks permissions of service accounts by the
+// operation ID of the route. Service accounts call operations their
+// permissions allow, in addition to the checks of their access scope by other
+// inspectors. Operations not requiring permissions are not targeted.
+type servicePermissionInspector struct {
+	// routePermissions maps method and path template of routes to permissions
+	// required for their operations.
+	routePermissions map[string]serviceaccounts.Permission
+}
+
+func newServicePermissionInspector() *servicePermissionInspector {
+	swagger, err := gen.GetSwagger()
+	if err != nil {
+		panic(fmt.Errorf("getting swagger spec: %w", err))
+	}
+
+	// The embedded spec carries operation IDs in Go naming, such as
+	// CreateUploadURL for createUploadUrl, so they are compared in lower case.
+	permissions := make(map[string]serviceaccounts.Permission, len(operationPermissions))
+	for operationID, permission := range operationPermissions {
+		permissions[strings.ToLower(operationID)] = permission
+	}
+
+	routePermissions := make(map[string]serviceaccounts.Permission)
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			if permission, ok := permissions[strings.ToLower(op.OperationID)]; ok {
+				routePermissions[routeKey(method, path)] = permission
+			}
+		}
+	}
+
+	return &servicePermissionInspector{
+		routePermissions: routePermissions,
+	}
+}
+
+func (i *servicePermissionInspector) isTarget(r *http.Request) bool {
+	_, ok := i.requiredPermission(r)
+	return ok
+}
+
+func (i *servicePermissionInspector) inspect(r *http.Request, identity domain.Identity) error {
+	id, ok := identity.(domain.ServiceAccountIdentity)
+	if !ok {
+		return nil
+	}
+
+	required, _ := i.requiredPermission(r)
+	if !id.ServiceAccount.HasPermission(required) {
+		return apperr.NewError(apperr.CodeForbidden).
+			WithSummary("Service account permission %s required", required)
+	}
+	return nil
+}
+
+func (i *servicePermissionInspector) requiredPermission(r *http.Request,
+) (serviceaccounts.Permission, bool) {
+	path, err := mux.CurrentRoute(r).GetPathTemplate()
+	if err != nil {
+		panic(fmt.Errorf("getting path template of current request: %w", err))
+	}
+
+	permission, ok := i.routePermissions[routeKey(r.Method, path)]
+	return permission, ok
+}
+
+func routeKey(method, path string) string {
+	return method + " " + path
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/serviceaccounts"
+)
+
+func TestOperationPermissions(t *testing.T) {
+	swagger, err := gen.GetSwagger()
+	require.NoError(t, err)
+
+	permissions := make(map[string]serviceaccounts.Permission)
+	for operationID, permission := range operationPermissions {
+		permissions[strings.ToLower(operationID)] = permission
+		assert.NoError(t, permission.Validate())
+	}
+
+	operationIDs := make(map[string]bool)
+	for path, item := range swagger.Paths.Map() {
+		for _, op := range item.Operations() {
+			operationID := strings.ToLower(op.OperationID)
+			operationIDs[operationID] = true
+
+			// Service accounts reach project and admin operations
+			if strings.HasPrefix(path, "/api/v1/projects") || strings.HasPrefix(path, "/api/v1/admin") {
+				assert.Contains(t, permissions, operationID,
+					"operation %s requires no permission", op.OperationID)
+			}
+		}
+	}
+
+	for operationID := range operationPermissions {
+		assert.True(t, operationIDs[strings.ToLower(operationID)], "unknown operation %s",
+			operationID)
+	}
+}
+
+func TestServicePermissionInspector(t *testing.T) {
+	inspector := newServicePermissionInspector()
+
+	reader := domain.ServiceAccountIdentity{
+		ServiceAccount: domain.ServiceAccount{
+			AccessScope: serviceaccounts.AccessScopeProject,
+			Permissions: []serviceaccounts.Permission{
+				serviceaccounts.PermissionProjectsRead,
+				serviceaccounts.PermissionImagesRead,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		identity domain.Identity
+		target   bool
+		wantErr  bool
+	}{
+		{
+			name:     "permitted operation",
+			method:   http.MethodGet,
+			path:     "/api/v1/projects/project-1/images/image-1",
+			identity: reader,
+			target:   true,
+		},
+		{
+			name:     "operation without permission",
+			method:   http.MethodDelete,
+			path:     "/api/v1/projects/project-1/images/image-1",
+			identity: reader,
+			target:   true,
+			wantErr:  true,
+		},
+		{
+			name:     "user identity",
+			method:   http.MethodDelete,
+			path:     "/api/v1/projects/project-1/images/image-1",
+			identity: domain.UserTokenIdentity{},
+			target:   true,
+		},
+		{
+			name:     "operation not requiring permission",
+			method:   http.MethodGet,
+			path:     "/api/v1/users/me",
+			identity: reader,
+			target:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				target bool
+				err    error
+			)
+			router := mux.NewRouter()
+			gen.HandlerWithOptions(nil, gen.GorillaServerOptions{
+				BaseRouter: router,
+				Middlewares: []gen.MiddlewareFunc{
+					func(http.Handler) http.Handler {
+						return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							target = inspector.isTarget(r)
+							if target {
+								err = inspector.inspect(r, tt.identity)
+							}
+						})
+					},
+				},
+			})
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.target, target)
+			if tt.wantErr {
+				assert.True(t, apperr.IsErrorCode(err, apperr.CodeForbidden))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
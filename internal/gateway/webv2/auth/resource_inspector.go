@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 
 	"github.com/gorilla/mux"
 
@@ -13,24 +14,41 @@ import (
 )
 
 type resourceInspector struct {
+	// skipPathPattern matches routes whose handlers check the consistency of
+	// requested resources themselves, such as public delivery routes on the
+	// hot path.
+	skipPathPattern *regexp.Regexp
+
 	serviceAccountSvc port.ServiceAccountService
 	projectSvc        port.ProjectService
 	imageSvc          port.ImageService
+	watermarkSvc      port.WatermarkService
 }
 
 func newResourceInspector(serviceAccountSvc port.ServiceAccountService,
 	projectSvc port.ProjectService, imageSvc port.ImageService,
+	watermarkSvc port.WatermarkService,
 ) *resourceInspector {
 	return &resourceInspector{
+		skipPathPattern:   regexp.MustCompile(`^/i/.*`),
 		serviceAccountSvc: serviceAccountSvc,
 		projectSvc:        projectSvc,
 		imageSvc:          imageSvc,
+		watermarkSvc:      watermarkSvc,
 	}
 }
 
 func (i *resourceInspector) inspect(r *http.Request) error {
 	ctx := r.Context()
 
+	path, err := mux.CurrentRoute(r).GetPathTemplate()
+	if err != nil {
+		return fmt.Errorf("getting path template of current request: %w", err)
+	}
+	if i.skipPathPattern.MatchString(path) {
+		return nil
+	}
+
 	if _, _, err := i.fetchServiceAccount(ctx, r); err != nil {
 		return fmt.Errorf("fetching requested service account: %w", err)
 	}
@@ -52,6 +70,18 @@ func (i *resourceInspector) inspect(r *http.Request) error {
 		}
 	}
 
+	watermark, watermarkExists, err := i.fetchWatermark(ctx, r)
+	if err != nil {
+		return fmt.Errorf("fetching requested watermark: %w", err)
+	}
+
+	if watermarkExists && projectExists {
+		if watermark.Project.ID != project.ID {
+			return apperr.NewError(apperr.CodeNotFound).
+				WithSummary("Watermark not found in the specified project")
+		}
+	}
+
 	return nil
 }
 
@@ -96,3 +126,17 @@ func (i *resourceInspector) fetchImage(ctx context.Context, r *http.Request,
 	}
 	return image, true, nil
 }
+
+func (i *resourceInspector) fetchWatermark(ctx context.Context, r *http.Request,
+) (domain.Watermark, bool, error) {
+	id := mux.Vars(r)["watermarkId"]
+	if id == "" {
+		return domain.Watermark{}, false, nil
+	}
+
+	watermark, err := i.watermarkSvc.Get(ctx, id)
+	if err != nil {
+		return domain.Watermark{}, false, fmt.Errorf("getting watermark by id: %w", err)
+	}
+	return watermark, true, nil
+}
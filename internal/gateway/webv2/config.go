@@ -1,6 +1,10 @@
 package webv2
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/gorilla/handlers"
@@ -16,6 +20,38 @@ type Config struct {
 	ReadTimeout           time.Duration
 	ReadHeaderTimeout     time.Duration
 	CORS                  CORSConfig
+	TLS                   TLSConfig
+}
+
+type TLSConfig struct {
+	Enabled  bool
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is the CA bundle verifying client certificates, which are
+	// requested only if it is set.
+	ClientCAFile string
+}
+
+func (c TLSConfig) buildTLSConfig() (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.ClientCAFile == "" {
+		return tlsCfg, nil
+	}
+
+	caBytes, err := os.ReadFile(c.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading client CA file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caBytes) {
+		return nil, fmt.Errorf("no certificate found in client CA file %s", c.ClientCAFile)
+	}
+
+	// Requests without client certificates fall back to other credentials
+	tlsCfg.ClientAuth = tls.VerifyClientCertIfGiven
+	tlsCfg.ClientCAs = pool
+	return tlsCfg, nil
 }
 
 type CORSConfig struct {
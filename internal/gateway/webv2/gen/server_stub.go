@@ -8,6 +8,7 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -17,11 +18,14 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gorilla/mux"
+	"github.com/isutare412/imageer/pkg/audits"
 	"github.com/isutare412/imageer/pkg/dbhelpers"
 	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
 	"github.com/isutare412/imageer/pkg/serviceaccounts"
 	"github.com/isutare412/imageer/pkg/users"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -48,6 +52,17 @@ const (
 	UpdatedAt ListImagesParamsSortBy = "updatedAt"
 )
 
+// AddProjectMemberRequest defines model for AddProjectMemberRequest.
+type AddProjectMemberRequest struct {
+	// Email The email address of the user to add.
+	Email openapi_types.Email `json:"email"`
+
+	// Role The role of the user in the project. Viewers read resources of the
+	// project, editors manage images and watermarks as well, and owners
+	// manage members as well.
+	Role ProjectMemberRole `json:"role"`
+}
+
 // AppError defines model for AppError.
 type AppError struct {
 	// CodeID Error code ID for programmatic handling
@@ -60,6 +75,93 @@ type AppError struct {
 	Message string `json:"message"`
 }
 
+// AuditAction The audited change.
+type AuditAction = audits.Action
+
+// AuditActorType The kind of identity making the change. SYSTEM is for changes without
+// an authenticated identity.
+type AuditActorType = audits.ActorType
+
+// AuditChange defines model for AuditChange.
+type AuditChange struct {
+	// After The JSON value after the change, absent if it is unset.
+	After json.RawMessage `json:"after,omitempty"`
+
+	// Before The JSON value before the change, absent if it was unset.
+	Before json.RawMessage `json:"before,omitempty"`
+
+	// Field The name of the changed field of the target.
+	Field string `json:"field"`
+}
+
+// AuditEntries defines model for AuditEntries.
+type AuditEntries struct {
+	Items []AuditEntry `json:"items"`
+
+	// Total The total number of audit entries.
+	Total int64 `json:"total"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action The audited change.
+	Action AuditAction `json:"action"`
+
+	// ActorID The ID of the user or service account, absent for the system.
+	ActorID string `json:"actorId,omitempty"`
+
+	// ActorName The email of the user or the name of the service account.
+	ActorName string `json:"actorName,omitempty"`
+
+	// ActorType The kind of identity making the change. SYSTEM is for changes without
+	// an authenticated identity.
+	ActorType AuditActorType `json:"actorType"`
+
+	// Changes Fields of the target changed by the action.
+	Changes []AuditChange `json:"changes"`
+
+	// CreatedAt The time when the change was made.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ID The unique identifier of the audit entry.
+	ID string `json:"id"`
+
+	// RemoteIP The IP address of the client making the change.
+	RemoteIP string `json:"remoteIp,omitempty"`
+
+	// RequestID The ID of the request making the change.
+	RequestID string `json:"requestId,omitempty"`
+
+	// TargetID The ID of the changed resource. Project members are identified by
+	// the project ID and the user ID joined with a slash.
+	TargetID string `json:"targetId"`
+
+	// TargetType The kind of resource changed.
+	TargetType AuditTargetType `json:"targetType"`
+}
+
+// AuditTargetType The kind of resource changed.
+type AuditTargetType = audits.TargetType
+
+// AuthProvider defines model for AuthProvider.
+type AuthProvider struct {
+	// DisplayName The human-readable name of the OIDC provider.
+	DisplayName string `json:"displayName"`
+
+	// Name The name of the OIDC provider used in sign-in paths.
+	Name string `json:"name"`
+}
+
+// AuthProviders defines model for AuthProviders.
+type AuthProviders struct {
+	Items []AuthProvider `json:"items"`
+}
+
+// ChromaSubsampling The chroma subsampling of encoded images:
+//   - 420: Halve the color resolution. Smaller files, with color bleeding on sharp edges.
+//   - 444: Keep the full color resolution.
+type ChromaSubsampling = images.ChromaSubsampling
+
 // CreatePresetRequest defines model for CreatePresetRequest.
 type CreatePresetRequest struct {
 	// Anchor The anchor position for image cropping.
@@ -68,13 +170,22 @@ type CreatePresetRequest struct {
 	// Default Indicates if this preset is the default one.
 	Default bool `json:"default"`
 
+	// Densities Pixel density multipliers of a variant set. A variant is rendered
+	// for each density, scaling width and height. Requires width or
+	// height, and cannot be used with widths.
+	Densities *[]float64 `json:"densities,omitempty"`
+
+	// Encoder Encoder options of AVIF, HEIC and JXL outputs. Ignored by other formats.
+	Encoder *PresetEncoder `json:"encoder,omitempty"`
+
 	// Fit The fit mode for image conversion:
 	//   - COVER: Scale the image to cover the target dimensions. Some parts may be cropped.
 	//   - CONTAIN: Scale the image to fit within the target dimensions. No cropping occurs. Empty areas may be filled with background color.
 	//   - FILL: Scale the image to fill the target dimensions. The image may be distorted.
 	Fit *ImageFit `json:"fit,omitempty"`
 
-	// Format The content type of the image.
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format *ImageFormat `json:"format,omitempty"`
 
 	// Height The height of the image in pixels.
@@ -86,15 +197,51 @@ type CreatePresetRequest struct {
 	// Quality The quality of the image (1-100). Default is 80.
 	Quality *int64 `json:"quality,omitempty"`
 
+	// Watermark Watermark composited onto the image after resizing. The watermark is placed at the center of the image unless anchor is provided.
+	Watermark *PresetWatermark `json:"watermark,omitempty"`
+
 	// Width The width of the image in pixels.
 	Width *int64 `json:"width,omitempty"`
+
+	// Widths Widths in pixels of a variant set. A variant is rendered for each
+	// width, scaling height to keep the aspect ratio of the preset.
+	Widths *[]int64 `json:"widths,omitempty"`
+
+	// WithoutEnlargement If true, variants are never rendered larger than the original
+	// image. Target dimensions are scaled down to fit the original while
+	// keeping their aspect ratio.
+	WithoutEnlargement bool `json:"withoutEnlargement,omitempty"`
 }
 
 // CreateProjectAdminRequest defines model for CreateProjectAdminRequest.
 type CreateProjectAdminRequest struct {
+	// CdnBaseURL Base URL of the CDN serving images of the project, without a
+	// trailing slash. The default CDN is used if absent.
+	CdnBaseURL *string `json:"cdnBaseUrl,omitempty"`
+
+	// KeyTemplate Template of object paths of images of the project. Supported
+	// placeholders are {project}, {image}, {preset}, {variant}, {ext},
+	// {yyyy}, {mm} and {dd}; {image}, {variant} and {ext} are required.
+	// Objects are stored in the default layout if absent.
+	KeyTemplate *string `json:"keyTemplate,omitempty"`
+
 	// Name The name of the project.
 	Name    string                `json:"name"`
 	Presets []CreatePresetRequest `json:"presets,omitempty"`
+
+	// Retention Decides how long objects of images of a project are kept. Images and
+	// their originals are kept forever unless specified.
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// StorageProfile Name of the storage profile to store objects of the project in. It
+	// cannot be changed after creation. The default profile is used if
+	// absent.
+	StorageProfile *string `json:"storageProfile,omitempty"`
+
+	// Visibility The visibility of the project. Images of public projects are served
+	// through the CDN, while images of private projects are served through
+	// short-lived presigned URLs only. Projects are public unless specified.
+	Visibility *ProjectVisibility `json:"visibility,omitempty"`
 }
 
 // CreateServiceAccountAdminRequest defines model for CreateServiceAccountAdminRequest.
@@ -108,20 +255,77 @@ type CreateServiceAccountAdminRequest struct {
 	// Name The name of the service account.
 	Name string `json:"name"`
 
+	// Permissions Permissions granted on resources in the access scope.
+	Permissions []ServiceAccountPermission `json:"permissions"`
+
 	// ProjectIDs List of project IDs to associate with the service account.
 	ProjectIDs []string `json:"projectIds,omitempty"`
 }
 
+// CreateServiceAccountAPIKeyAdminRequest defines model for CreateServiceAccountApiKeyAdminRequest.
+type CreateServiceAccountAPIKeyAdminRequest struct {
+	// ExpireAt The expiration time of the API key.
+	ExpireAt *time.Time `json:"expireAt,omitempty"`
+
+	// Name The name of the API key.
+	Name string `json:"name"`
+}
+
 // CreateUploadURLRequest defines model for CreateUploadUrlRequest.
 type CreateUploadURLRequest struct {
+	// ExternalID ID of the image in the client system.
+	ExternalID *string `json:"externalId,omitempty"`
+
 	// FileName The name of the file to be uploaded.
 	FileName string `json:"fileName"`
 
-	// Format The content type of the image.
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format ImageFormat `json:"format"`
 
+	// Metadata Arbitrary key/value metadata of the image. Keys are at most 64 and
+	// values at most 512 characters long.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
 	// PresetNames List of preset names to apply to the image. If not provided, default presets will be applied.
 	PresetNames []string `json:"presetNames,omitempty"`
+
+	// Tags Tags of the image. Duplicates are removed.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// CreateWatermarkUploadURLRequest defines model for CreateWatermarkUploadUrlRequest.
+type CreateWatermarkUploadURLRequest struct {
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
+	Format ImageFormat `json:"format"`
+
+	// Name The name of the watermark.
+	Name string `json:"name"`
+}
+
+// CropBox Rectangle to keep as fractions of the image width and height.
+type CropBox struct {
+	// Height Height of the rectangle.
+	Height float64 `json:"height"`
+
+	// Width Width of the rectangle.
+	Width float64 `json:"width"`
+
+	// X Left edge of the rectangle.
+	X float64 `json:"x"`
+
+	// Y Top edge of the rectangle.
+	Y float64 `json:"y"`
+}
+
+// FocalPoint Point of interest as fractions of the image width and height.
+type FocalPoint struct {
+	// X Horizontal position from the left edge.
+	X float64 `json:"x"`
+
+	// Y Vertical position from the top edge.
+	Y float64 `json:"y"`
 }
 
 // Image defines model for Image.
@@ -129,20 +333,51 @@ type Image struct {
 	// CreatedAt The creation time of the image.
 	CreatedAt time.Time `json:"createdAt"`
 
-	// Format The content type of the image.
+	// DeletedAt The deletion time of the image. Absent unless deleted.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// ExternalID ID of the image in the client system. Absent if not set.
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// Focus Region of interest of an image. At most one of focalPoint and cropBox
+	// can be set.
+	Focus *ImageFocus `json:"focus,omitempty"`
+
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format ImageFormat `json:"format"`
 
 	// ID The unique identifier of the image.
 	ID string `json:"id"`
 
+	// Metadata Arbitrary key/value metadata of the image.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// OriginalState The state of the original image, which is changed by the retention
+	// policy of the project.
+	OriginalState ImageOriginalState `json:"originalState"`
+
+	// Srcsets Ready-made srcset attribute values of ready variants, keyed by
+	// preset name.
+	Srcsets map[string]string `json:"srcsets,omitempty"`
+
 	// State The current state of the image.
 	State ImageState `json:"state"`
 
+	// Tags Tags of the image.
+	Tags []string `json:"tags,omitempty"`
+
 	// UpdatedAt The last update time of the image.
 	UpdatedAt time.Time `json:"updatedAt"`
 
-	// URL The URL of the original image.
-	URL string `json:"url"`
+	// URL The URL of the original image. URLs of the image and its variants
+	// are presigned if the image belongs to a private project. Absent if
+	// the original is deleted by the retention policy of the project.
+	URL *string `json:"url,omitempty"`
+
+	// URLExpireAt The expiry time of presigned URLs. Absent if the image belongs to a
+	// public project.
+	URLExpireAt *time.Time `json:"urlExpireAt,omitempty"`
 
 	// Variants List of image variants with applied presets.
 	Variants []ImageVariant `json:"variants,omitempty"`
@@ -157,9 +392,24 @@ type ImageAnchor = images.Anchor
 //   - FILL: Scale the image to fill the target dimensions. The image may be distorted.
 type ImageFit = images.Fit
 
-// ImageFormat The content type of the image.
+// ImageFocus Region of interest of an image. At most one of focalPoint and cropBox
+// can be set.
+type ImageFocus struct {
+	// CropBox Rectangle to keep as fractions of the image width and height.
+	CropBox *CropBox `json:"cropBox,omitempty"`
+
+	// FocalPoint Point of interest as fractions of the image width and height.
+	FocalPoint *FocalPoint `json:"focalPoint,omitempty"`
+}
+
+// ImageFormat The content type of the image. JXL (JPEG XL) is only available as the
+// output format of presets.
 type ImageFormat = images.Format
 
+// ImageOriginalState The state of the original image, which is changed by the retention
+// policy of the project.
+type ImageOriginalState = images.OriginalState
+
 // ImageState The current state of the image.
 type ImageState = images.State
 
@@ -168,7 +418,13 @@ type ImageVariant struct {
 	// CreatedAt The creation time of the image variant.
 	CreatedAt time.Time `json:"createdAt"`
 
-	// Format The content type of the image.
+	// Descriptor The srcset descriptor of the variant within the variant set of its
+	// preset, such as 2x or 640w. Omitted if the preset has no variant
+	// set.
+	Descriptor *string `json:"descriptor,omitempty"`
+
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format ImageFormat `json:"format"`
 
 	// ID The unique identifier of the image variant.
@@ -180,7 +436,8 @@ type ImageVariant struct {
 	// PresetName The name of the preset.
 	PresetName string `json:"presetName"`
 
-	// State The current state of the image variant.
+	// State The current state of the image variant. SKIPPED variants of a variant
+	// set are not rendered as they would upscale the original image.
 	State ImageVariantState `json:"state"`
 
 	// UpdatedAt The time when the image variant was updated.
@@ -190,7 +447,8 @@ type ImageVariant struct {
 	URL string `json:"url"`
 }
 
-// ImageVariantState The current state of the image variant.
+// ImageVariantState The current state of the image variant. SKIPPED variants of a variant
+// set are not rendered as they would upscale the original image.
 type ImageVariantState = images.VariantState
 
 // Images defines model for Images.
@@ -201,6 +459,11 @@ type Images struct {
 	Total int64 `json:"total"`
 }
 
+// OriginalRetention What happens to original images once all of their variants are ready.
+// Originals are either kept as they are, deleted, or moved to the
+// infrequent access storage class of the storage.
+type OriginalRetention = projects.OriginalRetention
+
 // Preset defines model for Preset.
 type Preset struct {
 	// Anchor The anchor position for image cropping.
@@ -212,13 +475,22 @@ type Preset struct {
 	// Default Indicates if this preset is the default one.
 	Default bool `json:"default"`
 
+	// Densities Pixel density multipliers of a variant set. A variant is rendered
+	// for each density, scaling width and height. Requires width or
+	// height, and cannot be used with widths.
+	Densities *[]float64 `json:"densities,omitempty"`
+
+	// Encoder Encoder options of AVIF, HEIC and JXL outputs. Ignored by other formats.
+	Encoder *PresetEncoder `json:"encoder,omitempty"`
+
 	// Fit The fit mode for image conversion:
 	//   - COVER: Scale the image to cover the target dimensions. Some parts may be cropped.
 	//   - CONTAIN: Scale the image to fit within the target dimensions. No cropping occurs. Empty areas may be filled with background color.
 	//   - FILL: Scale the image to fill the target dimensions. The image may be distorted.
 	Fit *ImageFit `json:"fit,omitempty"`
 
-	// Format The content type of the image.
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format ImageFormat `json:"format"`
 
 	// Height The height of the image in pixels.
@@ -236,29 +508,165 @@ type Preset struct {
 	// UpdatedAt The last update time of the preset.
 	UpdatedAt time.Time `json:"updatedAt"`
 
+	// Watermark Watermark composited onto the image after resizing. The watermark is placed at the center of the image unless anchor is provided.
+	Watermark *PresetWatermark `json:"watermark,omitempty"`
+
 	// Width The width of the image in pixels.
 	Width *int64 `json:"width,omitempty"`
+
+	// Widths Widths in pixels of a variant set. A variant is rendered for each
+	// width, scaling height to keep the aspect ratio of the preset.
+	Widths *[]int64 `json:"widths,omitempty"`
+
+	// WithoutEnlargement If true, variants are never rendered larger than the original
+	// image. Target dimensions are scaled down to fit the original while
+	// keeping their aspect ratio.
+	WithoutEnlargement bool `json:"withoutEnlargement"`
+}
+
+// PresetEncoder Encoder options of AVIF, HEIC and JXL outputs. Ignored by other formats.
+type PresetEncoder struct {
+	// ChromaSubsampling The chroma subsampling of encoded images:
+	//   - 420: Halve the color resolution. Smaller files, with color bleeding on sharp edges.
+	//   - 444: Keep the full color resolution.
+	ChromaSubsampling *ChromaSubsampling `json:"chromaSubsampling,omitempty"`
+
+	// Effort CPU effort of the encoder (0-9). Higher values produce smaller files but take longer. Default depends on the format.
+	Effort *int64 `json:"effort,omitempty"`
+
+	// Lossless Encodes images losslessly, ignoring quality.
+	Lossless bool `json:"lossless,omitempty"`
+}
+
+// PresetWatermark Watermark composited onto the image after resizing. The watermark is placed at the center of the image unless anchor is provided.
+type PresetWatermark struct {
+	// Anchor The anchor position for image cropping.
+	Anchor *ImageAnchor `json:"anchor,omitempty"`
+
+	// Margin The margin from the anchored edges in pixels. Default is 0.
+	Margin *int64 `json:"margin,omitempty"`
+
+	// Opacity The opacity of the watermark (0-1]. Default is 1.
+	Opacity *float64 `json:"opacity,omitempty"`
+
+	// Scale The width of the watermark relative to the width of the image (0-1]. Default is 0.2.
+	Scale *float64 `json:"scale,omitempty"`
+
+	// WatermarkID The unique identifier of the watermark.
+	WatermarkID string `json:"watermarkId"`
 }
 
 // Project defines model for Project.
 type Project struct {
+	// CdnBaseURL Base URL of the CDN serving images of the project, without a
+	// trailing slash. The default CDN is used if absent.
+	CdnBaseURL *string `json:"cdnBaseUrl,omitempty"`
+
 	// CreatedAt The creation time of the project.
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DeletedAt The deletion time of the project. Absent unless deleted.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
 	// ID The unique identifier of the project.
 	ID string `json:"id"`
 
 	// ImageCount The total number of images in the project.
 	ImageCount int64 `json:"imageCount"`
 
+	// KeyTemplate Template of object paths of images of the project. Supported
+	// placeholders are {project}, {image}, {preset}, {variant}, {ext},
+	// {yyyy}, {mm} and {dd}; {image}, {variant} and {ext} are required.
+	// Objects are stored in the default layout if absent.
+	KeyTemplate *string `json:"keyTemplate,omitempty"`
+
 	// Name The name of the project.
 	Name string `json:"name"`
 
 	// Presets List of presets to apply to images of the project.
 	Presets []Preset `json:"presets"`
 
+	// Retention Decides how long objects of images of a project are kept. Images and
+	// their originals are kept forever unless specified.
+	Retention RetentionPolicy `json:"retention"`
+
+	// StorageProfile Name of the storage profile objects of the project are stored in.
+	// Absent for projects stored in the default profile before storage
+	// profiles were introduced.
+	StorageProfile *string `json:"storageProfile,omitempty"`
+
 	// UpdatedAt The last update time of the project.
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Visibility The visibility of the project. Images of public projects are served
+	// through the CDN, while images of private projects are served through
+	// short-lived presigned URLs only. Projects are public unless specified.
+	Visibility ProjectVisibility `json:"visibility"`
+}
+
+// ProjectDeletion defines model for ProjectDeletion.
+type ProjectDeletion struct {
+	// CreatedAt The creation time of the deletion job.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FinishedAt The time when the job succeeded or was canceled.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// ID The unique identifier of the deletion job.
+	ID string `json:"id"`
+
+	// LastError The error of the last run, which is retried later. Absent unless
+	// the last run failed.
+	LastError *string `json:"lastError,omitempty"`
+
+	// ProjectID The ID of the deleted project.
+	ProjectID string `json:"projectId"`
+
+	// ProjectName The name of the deleted project.
+	ProjectName string `json:"projectName"`
+
+	// PurgedImages The number of images purged so far.
+	PurgedImages int64 `json:"purgedImages"`
+
+	// StartAfter The time after which the job starts, unless canceled.
+	StartAfter time.Time `json:"startAfter"`
+
+	// State The current state of the project deletion job.
+	State ProjectDeletionState `json:"state"`
+
+	// TotalImages The number of images to purge, counted when the job starts.
+	TotalImages int64 `json:"totalImages"`
+
+	// UpdatedAt The last update time of the deletion job.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ProjectDeletionState The current state of the project deletion job.
+type ProjectDeletionState = projects.DeletionState
+
+// ProjectMember defines model for ProjectMember.
+type ProjectMember struct {
+	// CreatedAt The time the user joined the project.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Role The role of the user in the project. Viewers read resources of the
+	// project, editors manage images and watermarks as well, and owners
+	// manage members as well.
+	Role ProjectMemberRole `json:"role"`
+
+	// UpdatedAt The last update time of the membership.
+	UpdatedAt time.Time `json:"updatedAt"`
+	User      User      `json:"user"`
+}
+
+// ProjectMemberRole The role of the user in the project. Viewers read resources of the
+// project, editors manage images and watermarks as well, and owners
+// manage members as well.
+type ProjectMemberRole = projects.MemberRole
+
+// ProjectMembers defines model for ProjectMembers.
+type ProjectMembers struct {
+	Items []ProjectMember `json:"items"`
 }
 
 // ProjectReference defines model for ProjectReference.
@@ -270,6 +678,11 @@ type ProjectReference struct {
 	Name string `json:"name"`
 }
 
+// ProjectVisibility The visibility of the project. Images of public projects are served
+// through the CDN, while images of private projects are served through
+// short-lived presigned URLs only. Projects are public unless specified.
+type ProjectVisibility = projects.Visibility
+
 // Projects defines model for Projects.
 type Projects struct {
 	Items []Project `json:"items"`
@@ -287,6 +700,23 @@ type ReprocessImagesAdminRequest struct {
 	ReprocessAll bool `json:"reprocessAll,omitempty"`
 }
 
+// RetentionPolicy Decides how long objects of images of a project are kept. Images and
+// their originals are kept forever unless specified.
+type RetentionPolicy struct {
+	// ImageExpireDays The number of days after creation when images are deleted entirely.
+	// Images never expire if zero.
+	ImageExpireDays int `json:"imageExpireDays"`
+
+	// Original What happens to original images once all of their variants are ready.
+	// Originals are either kept as they are, deleted, or moved to the
+	// infrequent access storage class of the storage.
+	Original OriginalRetention `json:"original"`
+
+	// OriginalDays The number of days after all variants of an image are ready when
+	// the original retention is applied.
+	OriginalDays int `json:"originalDays"`
+}
+
 // ServiceAccount defines model for ServiceAccount.
 type ServiceAccount struct {
 	// AccessScope The access scope of the service account.
@@ -295,18 +725,40 @@ type ServiceAccount struct {
 	// CreatedAt The creation time of the service account.
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DisabledAt The time the service account was disabled. Disabled service accounts
+	// fail to authenticate until enabled again.
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
+
+	// DisabledReason The reason the service account was disabled. UNUSED means the service
+	// account was not used for a while.
+	DisabledReason *ServiceAccountDisabledReason `json:"disabledReason,omitempty"`
+
 	// ExpireAt The expiration time of the service account token.
 	ExpireAt *time.Time `json:"expireAt,omitempty"`
 
 	// ID The unique identifier of the service account.
 	ID string `json:"id"`
 
+	// LastUsedAt The last authentication time by any credential of the service
+	// account, which is recorded with a delay of up to a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// LastUsedIP The remote IP address of the last authentication.
+	LastUsedIP *string `json:"lastUsedIp,omitempty"`
+
 	// Name The name of the service account.
 	Name string `json:"name"`
 
+	// Permissions Permissions granted on resources in the access scope.
+	Permissions []ServiceAccountPermission `json:"permissions"`
+
 	// Projects List of projects associated with the service account.
 	Projects []ProjectReference `json:"projects"`
 
+	// StaleWarnedAt The time the service account was warned to be disabled for not
+	// being used. It is cleared once the service account is used again.
+	StaleWarnedAt *time.Time `json:"staleWarnedAt,omitempty"`
+
 	// UpdatedAt The last update time of the service account.
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -314,29 +766,90 @@ type ServiceAccount struct {
 // ServiceAccountAccessScope The access scope of the service account.
 type ServiceAccountAccessScope = serviceaccounts.AccessScope
 
+// ServiceAccountAPIKey defines model for ServiceAccountApiKey.
+type ServiceAccountAPIKey struct {
+	// CreatedAt The creation time of the API key.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpireAt The expiration time of the API key.
+	ExpireAt *time.Time `json:"expireAt,omitempty"`
+
+	// ID The unique identifier of the API key.
+	ID string `json:"id"`
+
+	// LastUsedAt The last time the API key was used, recorded at a resolution of
+	// minutes. Omitted if the API key was never used.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// Name The name of the API key.
+	Name string `json:"name"`
+
+	// Prefix The leading characters of the API key identifying it.
+	Prefix string `json:"prefix"`
+}
+
+// ServiceAccountAPIKeys defines model for ServiceAccountApiKeys.
+type ServiceAccountAPIKeys struct {
+	Items []ServiceAccountAPIKey `json:"items"`
+}
+
+// ServiceAccountDisabledReason The reason the service account was disabled. UNUSED means the service
+// account was not used for a while.
+type ServiceAccountDisabledReason = serviceaccounts.DisabledReason
+
+// ServiceAccountPermission A permission of the service account. Each permission allows a group of
+// operations on resources in the access scope of the service account.
+type ServiceAccountPermission = serviceaccounts.Permission
+
 // ServiceAccountWithAPIKey defines model for ServiceAccountWithApiKey.
 type ServiceAccountWithAPIKey struct {
 	// AccessScope The access scope of the service account.
 	AccessScope ServiceAccountAccessScope `json:"accessScope"`
 
-	// APIKey The API key for the service account.
+	// APIKey The API key for the service account, which is not retrievable
+	// afterwards.
 	APIKey string `json:"apiKey"`
 
+	// APIKeyID The unique identifier of the API key.
+	APIKeyID string `json:"apiKeyId"`
+
 	// CreatedAt The creation time of the service account.
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DisabledAt The time the service account was disabled. Disabled service accounts
+	// fail to authenticate until enabled again.
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
+
+	// DisabledReason The reason the service account was disabled. UNUSED means the service
+	// account was not used for a while.
+	DisabledReason *ServiceAccountDisabledReason `json:"disabledReason,omitempty"`
+
 	// ExpireAt The expiration time of the service account token.
 	ExpireAt *time.Time `json:"expireAt,omitempty"`
 
 	// ID The unique identifier of the service account.
 	ID string `json:"id"`
 
+	// LastUsedAt The last authentication time by any credential of the service
+	// account, which is recorded with a delay of up to a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// LastUsedIP The remote IP address of the last authentication.
+	LastUsedIP *string `json:"lastUsedIp,omitempty"`
+
 	// Name The name of the service account.
 	Name string `json:"name"`
 
+	// Permissions Permissions granted on resources in the access scope.
+	Permissions []ServiceAccountPermission `json:"permissions"`
+
 	// Projects List of projects associated with the service account.
 	Projects []ProjectReference `json:"projects"`
 
+	// StaleWarnedAt The time the service account was warned to be disabled for not
+	// being used. It is cleared once the service account is used again.
+	StaleWarnedAt *time.Time `json:"staleWarnedAt,omitempty"`
+
 	// UpdatedAt The last update time of the service account.
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -352,11 +865,50 @@ type ServiceAccounts struct {
 // SortDirection The sort direction for list operations.
 type SortDirection = dbhelpers.SortDirection
 
+// UpdateImageRequest Absent fields are left unchanged. An empty externalId clears the
+// external ID, and empty tags or metadata clear them.
+type UpdateImageRequest struct {
+	// ExternalID ID of the image in the client system.
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// Metadata Metadata replacing the one of the image.
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// Tags Tags replacing the ones of the image.
+	Tags *[]string `json:"tags,omitempty"`
+}
+
 // UpdateProjectAdminRequest defines model for UpdateProjectAdminRequest.
 type UpdateProjectAdminRequest struct {
+	// CdnBaseURL Base URL of the CDN serving images of the project. Set to an empty
+	// string to restore the default CDN.
+	CdnBaseURL *string `json:"cdnBaseUrl,omitempty"`
+
+	// KeyTemplate Template of object paths of images of the project. Set to an empty
+	// string to restore the default layout. Objects of existing images
+	// are not moved.
+	KeyTemplate *string `json:"keyTemplate,omitempty"`
+
 	// Name The name of the project.
 	Name    *string               `json:"name,omitempty"`
 	Presets []UpsertPresetRequest `json:"presets,omitempty"`
+
+	// Retention Decides how long objects of images of a project are kept. Images and
+	// their originals are kept forever unless specified.
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// Visibility The visibility of the project. Images of public projects are served
+	// through the CDN, while images of private projects are served through
+	// short-lived presigned URLs only. Projects are public unless specified.
+	Visibility *ProjectVisibility `json:"visibility,omitempty"`
+}
+
+// UpdateProjectMemberRequest defines model for UpdateProjectMemberRequest.
+type UpdateProjectMemberRequest struct {
+	// Role The role of the user in the project. Viewers read resources of the
+	// project, editors manage images and watermarks as well, and owners
+	// manage members as well.
+	Role ProjectMemberRole `json:"role"`
 }
 
 // UpdateServiceAccountAdminRequest defines model for UpdateServiceAccountAdminRequest.
@@ -364,16 +916,30 @@ type UpdateServiceAccountAdminRequest struct {
 	// AccessScope The access scope of the service account.
 	AccessScope *ServiceAccountAccessScope `json:"accessScope,omitempty"`
 
+	// Enable Whether to enable the service account again if disabled. Stale
+	// warnings are cleared as well.
+	Enable bool `json:"enable,omitempty"`
+
 	// ExpireAt The expiration time of the service account token.
 	ExpireAt *time.Time `json:"expireAt,omitempty"`
 
 	// Name The name of the service account.
 	Name *string `json:"name,omitempty"`
 
+	// Permissions Permissions granted on resources in the access scope. Permissions
+	// are unchanged if omitted.
+	Permissions []ServiceAccountPermission `json:"permissions,omitempty"`
+
 	// ProjectIDs List of project IDs to associate with the service account.
 	ProjectIDs []string `json:"projectIds,omitempty"`
 }
 
+// UpdateUserAdminRequest defines model for UpdateUserAdminRequest.
+type UpdateUserAdminRequest struct {
+	// Role The role of the user.
+	Role UserRole `json:"role"`
+}
+
 // UploadURL defines model for UploadUrl.
 type UploadURL struct {
 	// ExpiresAt The expiration time of the presigned URL.
@@ -399,13 +965,22 @@ type UpsertPresetRequest struct {
 	// Default Indicates if this preset is the default one.
 	Default *bool `json:"default,omitempty"`
 
+	// Densities Pixel density multipliers of a variant set. A variant is rendered
+	// for each density, scaling width and height. Requires width or
+	// height, and cannot be used with widths.
+	Densities *[]float64 `json:"densities,omitempty"`
+
+	// Encoder Encoder options of AVIF, HEIC and JXL outputs. Ignored by other formats.
+	Encoder *PresetEncoder `json:"encoder,omitempty"`
+
 	// Fit The fit mode for image conversion:
 	//   - COVER: Scale the image to cover the target dimensions. Some parts may be cropped.
 	//   - CONTAIN: Scale the image to fit within the target dimensions. No cropping occurs. Empty areas may be filled with background color.
 	//   - FILL: Scale the image to fill the target dimensions. The image may be distorted.
 	Fit *ImageFit `json:"fit,omitempty"`
 
-	// Format The content type of the image.
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format *ImageFormat `json:"format,omitempty"`
 
 	// Height The height of the image in pixels.
@@ -420,8 +995,24 @@ type UpsertPresetRequest struct {
 	// Quality The quality of the image (1-100). Default is 80.
 	Quality *int64 `json:"quality,omitempty"`
 
+	// RemoveWatermark If true, the preset stops applying its watermark. Cannot be used
+	// with watermark.
+	RemoveWatermark *bool `json:"removeWatermark,omitempty"`
+
+	// Watermark Watermark composited onto the image after resizing. The watermark is placed at the center of the image unless anchor is provided.
+	Watermark *PresetWatermark `json:"watermark,omitempty"`
+
 	// Width The width of the image in pixels.
 	Width *int64 `json:"width,omitempty"`
+
+	// Widths Widths in pixels of a variant set. A variant is rendered for each
+	// width, scaling height to keep the aspect ratio of the preset.
+	Widths *[]int64 `json:"widths,omitempty"`
+
+	// WithoutEnlargement If true, variants are never rendered larger than the original
+	// image. Target dimensions are scaled down to fit the original while
+	// keeping their aspect ratio.
+	WithoutEnlargement *bool `json:"withoutEnlargement,omitempty"`
 }
 
 // User defines model for User.
@@ -444,6 +1035,9 @@ type User struct {
 	// Role The role of the user.
 	Role UserRole `json:"role"`
 
+	// SuspendedAt The time the user was suspended. Absent unless suspended.
+	SuspendedAt *time.Time `json:"suspendedAt,omitempty"`
+
 	// UpdatedAt The last update time of the user.
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -451,12 +1045,111 @@ type User struct {
 // UserRole The role of the user.
 type UserRole = users.Role
 
+// Users defines model for Users.
+type Users struct {
+	Items []User `json:"items"`
+
+	// Total The total number of users.
+	Total int64 `json:"total"`
+}
+
+// Watermark defines model for Watermark.
+type Watermark struct {
+	// CreatedAt The creation time of the watermark.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
+	Format ImageFormat `json:"format"`
+
+	// ID The unique identifier of the watermark.
+	ID string `json:"id"`
+
+	// Name The name of the watermark.
+	Name string `json:"name"`
+
+	// State The upload state of the watermark. Presets can reference only ready
+	// watermarks.
+	State WatermarkState `json:"state"`
+
+	// UpdatedAt The last update time of the watermark.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// URL The URL of the watermark.
+	URL string `json:"url"`
+}
+
+// WatermarkState The upload state of the watermark. Presets can reference only ready
+// watermarks.
+type WatermarkState = images.WatermarkState
+
+// WatermarkUploadURL defines model for WatermarkUploadUrl.
+type WatermarkUploadURL struct {
+	// ExpiresAt The expiration time of the presigned URL.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Header Additional headers required for the upload request.
+	Header map[string]string `json:"header"`
+
+	// URL The presigned URL for uploading the watermark. It must be called with PUT method.
+	URL       string    `json:"url"`
+	Watermark Watermark `json:"watermark"`
+}
+
+// Watermarks defines model for Watermarks.
+type Watermarks struct {
+	Items []Watermark `json:"items"`
+
+	// Total The total number of watermarks.
+	Total int64 `json:"total"`
+}
+
+// ActorIDQuery defines model for ActorIdQuery.
+type ActorIDQuery = string
+
+// APIKeyIDPath defines model for ApiKeyIdPath.
+type APIKeyIDPath = string
+
+// AuditActionQuery The audited change.
+type AuditActionQuery = AuditAction
+
+// AuditTargetTypeQuery The kind of resource changed.
+type AuditTargetTypeQuery = AuditTargetType
+
+// CreatedAfterQuery defines model for CreatedAfterQuery.
+type CreatedAfterQuery = time.Time
+
+// CreatedBeforeQuery defines model for CreatedBeforeQuery.
+type CreatedBeforeQuery = time.Time
+
+// DeletedQuery defines model for DeletedQuery.
+type DeletedQuery = bool
+
+// ExternalIDQuery defines model for ExternalIdQuery.
+type ExternalIDQuery = string
+
+// FileNamePrefixQuery defines model for FileNamePrefixQuery.
+type FileNamePrefixQuery = string
+
+// FormatQuery The content type of the image. JXL (JPEG XL) is only available as the
+// output format of presets.
+type FormatQuery = ImageFormat
+
 // ImageIDPath defines model for ImageIdPath.
 type ImageIDPath = string
 
+// KeywordQuery defines model for KeywordQuery.
+type KeywordQuery = string
+
 // LimitQuery defines model for LimitQuery.
 type LimitQuery = int64
 
+// MetadataQuery defines model for MetadataQuery.
+type MetadataQuery map[string]string
+
+// OIDCProviderPath defines model for OIDCProviderPath.
+type OIDCProviderPath = string
+
 // OffsetQuery defines model for OffsetQuery.
 type OffsetQuery = int64
 
@@ -475,12 +1168,57 @@ type SortByQuery string
 // SortOrderQuery The sort direction for list operations.
 type SortOrderQuery = SortDirection
 
+// SuspendedQuery defines model for SuspendedQuery.
+type SuspendedQuery = bool
+
+// TagQuery defines model for TagQuery.
+type TagQuery = []string
+
+// TargetIDQuery defines model for TargetIdQuery.
+type TargetIDQuery = string
+
+// UserIDPath defines model for UserIdPath.
+type UserIDPath = string
+
+// UserRoleQuery The role of the user.
+type UserRoleQuery = UserRole
+
 // WaitUntilProcessedQuery defines model for WaitUntilProcessedQuery.
 type WaitUntilProcessedQuery = bool
 
+// WatermarkIDPath defines model for WatermarkIdPath.
+type WatermarkIDPath = string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse = AppError
 
+// ListAuditLogsAdminParams defines parameters for ListAuditLogsAdmin.
+type ListAuditLogsAdminParams struct {
+	// Offset Offset for pagination
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// ActorID List only audit entries of the user or service account
+	ActorID *ActorIDQuery `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// Action List only audit entries of the action
+	Action *AuditActionQuery `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetType List only audit entries of the target type
+	TargetType *AuditTargetTypeQuery `form:"targetType,omitempty" json:"targetType,omitempty"`
+
+	// TargetID List only audit entries of the target
+	TargetID *TargetIDQuery `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// CreatedAfter List only resources created at or after the time
+	CreatedAfter *CreatedAfterQuery `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore List only resources created before the time
+	CreatedBefore *CreatedBeforeQuery `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+}
+
 // ListProjectsAdminParams defines parameters for ListProjectsAdmin.
 type ListProjectsAdminParams struct {
 	// Offset Offset for pagination
@@ -488,6 +1226,9 @@ type ListProjectsAdminParams struct {
 
 	// Limit Limit for pagination
 	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Deleted List only soft-deleted resources waiting for purge
+	Deleted *DeletedQuery `form:"deleted,omitempty" json:"deleted,omitempty"`
 }
 
 // ListImagesAdminParams defines parameters for ListImagesAdmin.
@@ -503,6 +1244,31 @@ type ListImagesAdminParams struct {
 
 	// SortOrder Sort direction
 	SortOrder *SortOrderQuery `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	// Deleted List only soft-deleted resources waiting for purge
+	Deleted *DeletedQuery `form:"deleted,omitempty" json:"deleted,omitempty"`
+
+	// ExternalID List only images with the external ID
+	ExternalID *ExternalIDQuery `form:"externalId,omitempty" json:"externalId,omitempty"`
+
+	// Tag List only images having all of the tags
+	Tag *TagQuery `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Metadata List only images having all of the metadata entries, given as
+	// metadata[key]=value
+	Metadata *MetadataQuery `json:"metadata,omitempty"`
+
+	// FileNamePrefix List only images whose file names start with the prefix
+	FileNamePrefix *FileNamePrefixQuery `form:"fileNamePrefix,omitempty" json:"fileNamePrefix,omitempty"`
+
+	// Format List only images of the format
+	Format *FormatQuery `form:"format,omitempty" json:"format,omitempty"`
+
+	// CreatedAfter List only resources created at or after the time
+	CreatedAfter *CreatedAfterQuery `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore List only resources created before the time
+	CreatedBefore *CreatedBeforeQuery `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
 }
 
 // ListImagesAdminParamsSortBy defines parameters for ListImagesAdmin.
@@ -517,21 +1283,54 @@ type ListServiceAccountsAdminParams struct {
 	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// StartGoogleSignInParams defines parameters for StartGoogleSignIn.
-type StartGoogleSignInParams struct {
+// ListUsersAdminParams defines parameters for ListUsersAdmin.
+type ListUsersAdminParams struct {
+	// Offset Offset for pagination
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Keyword Match users whose nicknames or emails contain the keyword, ignoring case
+	Keyword *KeywordQuery `form:"keyword,omitempty" json:"keyword,omitempty"`
+
+	// Role Match users of the role
+	Role *UserRoleQuery `form:"role,omitempty" json:"role,omitempty"`
+
+	// Suspended Match suspended users if true, and active users if false
+	Suspended *SuspendedQuery `form:"suspended,omitempty" json:"suspended,omitempty"`
+}
+
+// SignOutParams defines parameters for SignOut.
+type SignOutParams struct {
+	// All Whether to revoke all sessions of the user, signing out everywhere.
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
+}
+
+// StartSignInParams defines parameters for StartSignIn.
+type StartSignInParams struct {
 	// Redirect The path to redirect to after successful sign-in. Defaults to root path if not provided.
 	Redirect *RedirectQuery `form:"redirect,omitempty" json:"redirect,omitempty"`
 }
 
-// FinishGoogleSignInParams defines parameters for FinishGoogleSignIn.
-type FinishGoogleSignInParams struct {
-	// Code The authorization code returned by Google after sign-in.
+// FinishSignInParams defines parameters for FinishSignIn.
+type FinishSignInParams struct {
+	// Code The authorization code returned by the OIDC provider after sign-in.
 	Code string `form:"code" json:"code"`
 
 	// State The state parameter to prevent CSRF attacks.
 	State string `form:"state" json:"state"`
 }
 
+// ListProjectsParams defines parameters for ListProjects.
+type ListProjectsParams struct {
+	// Offset Offset for pagination
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListImagesParams defines parameters for ListImages.
 type ListImagesParams struct {
 	// Offset Offset for pagination
@@ -545,6 +1344,28 @@ type ListImagesParams struct {
 
 	// SortOrder Sort direction
 	SortOrder *SortOrderQuery `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	// ExternalID List only images with the external ID
+	ExternalID *ExternalIDQuery `form:"externalId,omitempty" json:"externalId,omitempty"`
+
+	// Tag List only images having all of the tags
+	Tag *TagQuery `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Metadata List only images having all of the metadata entries, given as
+	// metadata[key]=value
+	Metadata *MetadataQuery `json:"metadata,omitempty"`
+
+	// FileNamePrefix List only images whose file names start with the prefix
+	FileNamePrefix *FileNamePrefixQuery `form:"fileNamePrefix,omitempty" json:"fileNamePrefix,omitempty"`
+
+	// Format List only images of the format
+	Format *FormatQuery `form:"format,omitempty" json:"format,omitempty"`
+
+	// CreatedAfter List only resources created at or after the time
+	CreatedAfter *CreatedAfterQuery `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore List only resources created before the time
+	CreatedBefore *CreatedBeforeQuery `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
 }
 
 // ListImagesParamsSortBy defines parameters for ListImages.
@@ -556,6 +1377,36 @@ type GetImageParams struct {
 	WaitUntilProcessed *WaitUntilProcessedQuery `form:"waitUntilProcessed,omitempty" json:"waitUntilProcessed,omitempty"`
 }
 
+// ListWatermarksParams defines parameters for ListWatermarks.
+type ListWatermarksParams struct {
+	// Offset Offset for pagination
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeliverImageParams defines parameters for DeliverImage.
+type DeliverImageParams struct {
+	// Accept Media types the client can display.
+	Accept *string `json:"Accept,omitempty"`
+
+	// DPR Device pixel ratio of the client.
+	DPR *float64 `json:"DPR,omitempty"`
+
+	// Width Intended display width of the image in physical pixels.
+	Width *int64 `json:"Width,omitempty"`
+
+	// SecCHDPR Device pixel ratio of the client. Precedes DPR.
+	SecCHDPR *float64 `json:"Sec-CH-DPR,omitempty"`
+
+	// SecCHWidth Intended display width of the image in physical pixels. Precedes Width.
+	SecCHWidth *int64 `json:"Sec-CH-Width,omitempty"`
+
+	// IfNoneMatch Entity tag of the image cached by the client.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
 // CreateProjectAdminJSONRequestBody defines body for CreateProjectAdmin for application/json ContentType.
 type CreateProjectAdminJSONRequestBody = CreateProjectAdminRequest
 
@@ -571,11 +1422,35 @@ type CreateServiceAccountAdminJSONRequestBody = CreateServiceAccountAdminRequest
 // UpdateServiceAccountAdminJSONRequestBody defines body for UpdateServiceAccountAdmin for application/json ContentType.
 type UpdateServiceAccountAdminJSONRequestBody = UpdateServiceAccountAdminRequest
 
+// CreateServiceAccountAPIKeyAdminJSONRequestBody defines body for CreateServiceAccountAPIKeyAdmin for application/json ContentType.
+type CreateServiceAccountAPIKeyAdminJSONRequestBody = CreateServiceAccountAPIKeyAdminRequest
+
+// UpdateUserAdminJSONRequestBody defines body for UpdateUserAdmin for application/json ContentType.
+type UpdateUserAdminJSONRequestBody = UpdateUserAdminRequest
+
 // CreateUploadURLJSONRequestBody defines body for CreateUploadURL for application/json ContentType.
 type CreateUploadURLJSONRequestBody = CreateUploadURLRequest
 
+// UpdateImageJSONRequestBody defines body for UpdateImage for application/json ContentType.
+type UpdateImageJSONRequestBody = UpdateImageRequest
+
+// UpdateImageFocusJSONRequestBody defines body for UpdateImageFocus for application/json ContentType.
+type UpdateImageFocusJSONRequestBody = ImageFocus
+
+// AddProjectMemberJSONRequestBody defines body for AddProjectMember for application/json ContentType.
+type AddProjectMemberJSONRequestBody = AddProjectMemberRequest
+
+// UpdateProjectMemberJSONRequestBody defines body for UpdateProjectMember for application/json ContentType.
+type UpdateProjectMemberJSONRequestBody = UpdateProjectMemberRequest
+
+// CreateWatermarkUploadURLJSONRequestBody defines body for CreateWatermarkUploadURL for application/json ContentType.
+type CreateWatermarkUploadURLJSONRequestBody = CreateWatermarkUploadURLRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List audit log entries
+	// (GET /api/v1/admin/audit-logs)
+	ListAuditLogsAdmin(w http.ResponseWriter, r *http.Request, params ListAuditLogsAdminParams)
 	// List all projects
 	// (GET /api/v1/admin/projects)
 	ListProjectsAdmin(w http.ResponseWriter, r *http.Request, params ListProjectsAdminParams)
@@ -591,6 +1466,9 @@ type ServerInterface interface {
 	// Update project details
 	// (PUT /api/v1/admin/projects/{projectId})
 	UpdateProjectAdmin(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath)
+	// Get the latest deletion job of a project
+	// (GET /api/v1/admin/projects/{projectId}/deletion)
+	GetProjectDeletionAdmin(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath)
 	// List images in a project
 	// (GET /api/v1/admin/projects/{projectId}/images)
 	ListImagesAdmin(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, params ListImagesAdminParams)
@@ -600,6 +1478,12 @@ type ServerInterface interface {
 	// Delete an image
 	// (DELETE /api/v1/admin/projects/{projectId}/images/{imageId})
 	DeleteImageAdmin(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, imageID ImageIDPath)
+	// Restore a deleted image
+	// (POST /api/v1/admin/projects/{projectId}/images/{imageId}/restore)
+	RestoreImageAdmin(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, imageID ImageIDPath)
+	// Restore a deleted project
+	// (POST /api/v1/admin/projects/{projectId}/restore)
+	RestoreProjectAdmin(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath)
 	// List service accounts
 	// (GET /api/v1/admin/service-accounts)
 	ListServiceAccountsAdmin(w http.ResponseWriter, r *http.Request, params ListServiceAccountsAdminParams)
@@ -615,15 +1499,48 @@ type ServerInterface interface {
 	// Update a service account
 	// (PUT /api/v1/admin/service-accounts/{serviceAccountId})
 	UpdateServiceAccountAdmin(w http.ResponseWriter, r *http.Request, serviceAccountID ServiceAccountIDPath)
-	// Start Google Sign-In
-	// (GET /api/v1/auth/google/sign-in)
-	StartGoogleSignIn(w http.ResponseWriter, r *http.Request, params StartGoogleSignInParams)
-	// Finish Google Sign-In
-	// (GET /api/v1/auth/google/sign-in/callback)
-	FinishGoogleSignIn(w http.ResponseWriter, r *http.Request, params FinishGoogleSignInParams)
+	// List API keys of a service account
+	// (GET /api/v1/admin/service-accounts/{serviceAccountId}/api-keys)
+	ListServiceAccountAPIKeysAdmin(w http.ResponseWriter, r *http.Request, serviceAccountID ServiceAccountIDPath)
+	// Mint a new API key of a service account
+	// (POST /api/v1/admin/service-accounts/{serviceAccountId}/api-keys)
+	CreateServiceAccountAPIKeyAdmin(w http.ResponseWriter, r *http.Request, serviceAccountID ServiceAccountIDPath)
+	// Revoke an API key of a service account
+	// (DELETE /api/v1/admin/service-accounts/{serviceAccountId}/api-keys/{apiKeyId})
+	RevokeServiceAccountAPIKeyAdmin(w http.ResponseWriter, r *http.Request, serviceAccountID ServiceAccountIDPath, apiKeyID APIKeyIDPath)
+	// List and search users
+	// (GET /api/v1/admin/users)
+	ListUsersAdmin(w http.ResponseWriter, r *http.Request, params ListUsersAdminParams)
+	// Get user details
+	// (GET /api/v1/admin/users/{userId})
+	GetUserAdmin(w http.ResponseWriter, r *http.Request, userID UserIDPath)
+	// Change the role of a user
+	// (PUT /api/v1/admin/users/{userId})
+	UpdateUserAdmin(w http.ResponseWriter, r *http.Request, userID UserIDPath)
+	// Revoke all sessions of a user
+	// (POST /api/v1/admin/users/{userId}/revoke-sessions)
+	RevokeUserSessionsAdmin(w http.ResponseWriter, r *http.Request, userID UserIDPath)
+	// Suspend a user
+	// (POST /api/v1/admin/users/{userId}/suspend)
+	SuspendUserAdmin(w http.ResponseWriter, r *http.Request, userID UserIDPath)
+	// Unsuspend a user
+	// (POST /api/v1/admin/users/{userId}/unsuspend)
+	UnsuspendUserAdmin(w http.ResponseWriter, r *http.Request, userID UserIDPath)
+	// List OIDC providers available for sign-in
+	// (GET /api/v1/auth/providers)
+	ListAuthProviders(w http.ResponseWriter, r *http.Request)
 	// Sign out the current user
 	// (POST /api/v1/auth/sign-out)
-	SignOut(w http.ResponseWriter, r *http.Request)
+	SignOut(w http.ResponseWriter, r *http.Request, params SignOutParams)
+	// Start sign-in with an OIDC provider
+	// (GET /api/v1/auth/{provider}/sign-in)
+	StartSignIn(w http.ResponseWriter, r *http.Request, provider OIDCProviderPath, params StartSignInParams)
+	// Finish sign-in with an OIDC provider
+	// (GET /api/v1/auth/{provider}/sign-in/callback)
+	FinishSignIn(w http.ResponseWriter, r *http.Request, provider OIDCProviderPath, params FinishSignInParams)
+	// List accessible projects
+	// (GET /api/v1/projects)
+	ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams)
 	// Get project details
 	// (GET /api/v1/projects/{projectId})
 	GetProject(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath)
@@ -639,9 +1556,48 @@ type ServerInterface interface {
 	// Get image details
 	// (GET /api/v1/projects/{projectId}/images/{imageId})
 	GetImage(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, imageID ImageIDPath, params GetImageParams)
+	// Update the external ID, tags or metadata of an image
+	// (PATCH /api/v1/projects/{projectId}/images/{imageId})
+	UpdateImage(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, imageID ImageIDPath)
+	// Start processing an uploaded image
+	// (POST /api/v1/projects/{projectId}/images/{imageId}/complete-upload)
+	CompleteUpload(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, imageID ImageIDPath)
+	// Set the focal point or crop box of an image
+	// (PUT /api/v1/projects/{projectId}/images/{imageId}/focus)
+	UpdateImageFocus(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, imageID ImageIDPath)
+	// Restore a deleted image
+	// (POST /api/v1/projects/{projectId}/images/{imageId}/restore)
+	RestoreImage(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, imageID ImageIDPath)
+	// List members of a project
+	// (GET /api/v1/projects/{projectId}/members)
+	ListProjectMembers(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath)
+	// Add a member to a project
+	// (POST /api/v1/projects/{projectId}/members)
+	AddProjectMember(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath)
+	// Remove a member from a project
+	// (DELETE /api/v1/projects/{projectId}/members/{userId})
+	RemoveProjectMember(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, userID UserIDPath)
+	// Change the role of a project member
+	// (PUT /api/v1/projects/{projectId}/members/{userId})
+	UpdateProjectMember(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, userID UserIDPath)
+	// List watermarks in a project
+	// (GET /api/v1/projects/{projectId}/watermarks)
+	ListWatermarks(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, params ListWatermarksParams)
+	// Issue a presigned URL for uploading a watermark
+	// (POST /api/v1/projects/{projectId}/watermarks/upload-url)
+	CreateWatermarkUploadURL(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath)
+	// Delete a watermark
+	// (DELETE /api/v1/projects/{projectId}/watermarks/{watermarkId})
+	DeleteWatermark(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, watermarkID WatermarkIDPath)
+	// Mark an uploaded watermark ready
+	// (POST /api/v1/projects/{projectId}/watermarks/{watermarkId}/complete-upload)
+	CompleteWatermarkUpload(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, watermarkID WatermarkIDPath)
 	// Get current user details
 	// (GET /api/v1/users/me)
 	GetCurrentUser(w http.ResponseWriter, r *http.Request)
+	// Deliver an image variant negotiated for the client
+	// (GET /i/{projectId}/{imageId}/{presetName})
+	DeliverImage(w http.ResponseWriter, r *http.Request, projectID ProjectIDPath, imageID ImageIDPath, presetName string, params DeliverImageParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -653,6 +1609,99 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ListAuditLogsAdmin operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditLogsAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditLogsAdminParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actorId", r.URL.Query(), &params.ActorID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actorId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "targetType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetType", r.URL.Query(), &params.TargetType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "targetId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetId", r.URL.Query(), &params.TargetID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBefore", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditLogsAdmin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListProjectsAdmin operation middleware
 func (siw *ServerInterfaceWrapper) ListProjectsAdmin(w http.ResponseWriter, r *http.Request) {
 
@@ -687,6 +1736,14 @@ func (siw *ServerInterfaceWrapper) ListProjectsAdmin(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deleted", r.URL.Query(), &params.Deleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deleted", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListProjectsAdmin(w, r, params)
 	}))
@@ -827,6 +1884,41 @@ func (siw *ServerInterfaceWrapper) UpdateProjectAdmin(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
+// GetProjectDeletionAdmin operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectDeletionAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectDeletionAdmin(w, r, projectID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListImagesAdmin operation middleware
 func (siw *ServerInterfaceWrapper) ListImagesAdmin(w http.ResponseWriter, r *http.Request) {
 
@@ -886,6 +1978,70 @@ func (siw *ServerInterfaceWrapper) ListImagesAdmin(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Optional query parameter "deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deleted", r.URL.Query(), &params.Deleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deleted", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "externalId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "externalId", r.URL.Query(), &params.ExternalID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "externalId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "metadata", r.URL.Query(), &params.Metadata)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metadata", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fileNamePrefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fileNamePrefix", r.URL.Query(), &params.FileNamePrefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fileNamePrefix", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBefore", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListImagesAdmin(w, r, projectID, params)
 	}))
@@ -976,42 +2132,41 @@ func (siw *ServerInterfaceWrapper) DeleteImageAdmin(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListServiceAccountsAdmin operation middleware
-func (siw *ServerInterfaceWrapper) ListServiceAccountsAdmin(w http.ResponseWriter, r *http.Request) {
+// RestoreImageAdmin operation middleware
+func (siw *ServerInterfaceWrapper) RestoreImageAdmin(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
-
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListServiceAccountsAdminParams
-
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "imageId" -------------
+	var imageID ImageIDPath
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListServiceAccountsAdmin(w, r, params)
+		siw.Handler.RestoreImageAdmin(w, r, projectID, imageID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1021,8 +2176,19 @@ func (siw *ServerInterfaceWrapper) ListServiceAccountsAdmin(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// CreateServiceAccountAdmin operation middleware
-func (siw *ServerInterfaceWrapper) CreateServiceAccountAdmin(w http.ResponseWriter, r *http.Request) {
+// RestoreProjectAdmin operation middleware
+func (siw *ServerInterfaceWrapper) RestoreProjectAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1035,7 +2201,7 @@ func (siw *ServerInterfaceWrapper) CreateServiceAccountAdmin(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateServiceAccountAdmin(w, r)
+		siw.Handler.RestoreProjectAdmin(w, r, projectID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1045,13 +2211,257 @@ func (siw *ServerInterfaceWrapper) CreateServiceAccountAdmin(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteServiceAccountAdmin operation middleware
-func (siw *ServerInterfaceWrapper) DeleteServiceAccountAdmin(w http.ResponseWriter, r *http.Request) {
+// ListServiceAccountsAdmin operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceAccountsAdmin(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "serviceAccountId" -------------
-	var serviceAccountID ServiceAccountIDPath
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServiceAccountsAdminParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListServiceAccountsAdmin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateServiceAccountAdmin operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceAccountAdmin(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceAccountAdmin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteServiceAccountAdmin operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceAccountAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serviceAccountId" -------------
+	var serviceAccountID ServiceAccountIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceAccountId", mux.Vars(r)["serviceAccountId"], &serviceAccountID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceAccountId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServiceAccountAdmin(w, r, serviceAccountID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServiceAccountAdmin operation middleware
+func (siw *ServerInterfaceWrapper) GetServiceAccountAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serviceAccountId" -------------
+	var serviceAccountID ServiceAccountIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceAccountId", mux.Vars(r)["serviceAccountId"], &serviceAccountID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceAccountId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServiceAccountAdmin(w, r, serviceAccountID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateServiceAccountAdmin operation middleware
+func (siw *ServerInterfaceWrapper) UpdateServiceAccountAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serviceAccountId" -------------
+	var serviceAccountID ServiceAccountIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceAccountId", mux.Vars(r)["serviceAccountId"], &serviceAccountID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceAccountId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateServiceAccountAdmin(w, r, serviceAccountID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListServiceAccountAPIKeysAdmin operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceAccountAPIKeysAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serviceAccountId" -------------
+	var serviceAccountID ServiceAccountIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceAccountId", mux.Vars(r)["serviceAccountId"], &serviceAccountID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceAccountId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListServiceAccountAPIKeysAdmin(w, r, serviceAccountID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateServiceAccountAPIKeyAdmin operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceAccountAPIKeyAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serviceAccountId" -------------
+	var serviceAccountID ServiceAccountIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceAccountId", mux.Vars(r)["serviceAccountId"], &serviceAccountID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceAccountId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceAccountAPIKeyAdmin(w, r, serviceAccountID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeServiceAccountAPIKeyAdmin operation middleware
+func (siw *ServerInterfaceWrapper) RevokeServiceAccountAPIKeyAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serviceAccountId" -------------
+	var serviceAccountID ServiceAccountIDPath
 
 	err = runtime.BindStyledParameterWithOptions("simple", "serviceAccountId", mux.Vars(r)["serviceAccountId"], &serviceAccountID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1059,6 +2469,876 @@ func (siw *ServerInterfaceWrapper) DeleteServiceAccountAdmin(w http.ResponseWrit
 		return
 	}
 
+	// ------------- Path parameter "apiKeyId" -------------
+	var apiKeyID APIKeyIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyId", mux.Vars(r)["apiKeyId"], &apiKeyID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiKeyId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeServiceAccountAPIKeyAdmin(w, r, serviceAccountID, apiKeyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUsersAdmin operation middleware
+func (siw *ServerInterfaceWrapper) ListUsersAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersAdminParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "keyword" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keyword", r.URL.Query(), &params.Keyword)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyword", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "suspended" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "suspended", r.URL.Query(), &params.Suspended)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "suspended", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsersAdmin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserAdmin operation middleware
+func (siw *ServerInterfaceWrapper) GetUserAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userID UserIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserAdmin(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateUserAdmin operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userID UserIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateUserAdmin(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeUserSessionsAdmin operation middleware
+func (siw *ServerInterfaceWrapper) RevokeUserSessionsAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userID UserIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeUserSessionsAdmin(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SuspendUserAdmin operation middleware
+func (siw *ServerInterfaceWrapper) SuspendUserAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userID UserIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SuspendUserAdmin(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnsuspendUserAdmin operation middleware
+func (siw *ServerInterfaceWrapper) UnsuspendUserAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userID UserIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnsuspendUserAdmin(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAuthProviders operation middleware
+func (siw *ServerInterfaceWrapper) ListAuthProviders(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuthProviders(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SignOut operation middleware
+func (siw *ServerInterfaceWrapper) SignOut(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SignOutParams
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SignOut(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartSignIn operation middleware
+func (siw *ServerInterfaceWrapper) StartSignIn(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider OIDCProviderPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", mux.Vars(r)["provider"], &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartSignInParams
+
+	// ------------- Optional query parameter "redirect" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "redirect", r.URL.Query(), &params.Redirect)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "redirect", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartSignIn(w, r, provider, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FinishSignIn operation middleware
+func (siw *ServerInterfaceWrapper) FinishSignIn(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider OIDCProviderPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", mux.Vars(r)["provider"], &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FinishSignInParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FinishSignIn(w, r, provider, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjects operation middleware
+func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectsParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjects(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProject operation middleware
+func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProject(w, r, projectID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListImages operation middleware
+func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListImagesParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "externalId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "externalId", r.URL.Query(), &params.ExternalID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "externalId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "metadata", r.URL.Query(), &params.Metadata)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metadata", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fileNamePrefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fileNamePrefix", r.URL.Query(), &params.FileNamePrefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fileNamePrefix", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBefore", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListImages(w, r, projectID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUploadURL operation middleware
+func (siw *ServerInterfaceWrapper) CreateUploadURL(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUploadURL(w, r, projectID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteImage operation middleware
+func (siw *ServerInterfaceWrapper) DeleteImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageID ImageIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteImage(w, r, projectID, imageID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetImage operation middleware
+func (siw *ServerInterfaceWrapper) GetImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageID ImageIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetImageParams
+
+	// ------------- Optional query parameter "waitUntilProcessed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "waitUntilProcessed", r.URL.Query(), &params.WaitUntilProcessed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "waitUntilProcessed", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetImage(w, r, projectID, imageID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateImage operation middleware
+func (siw *ServerInterfaceWrapper) UpdateImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageID ImageIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateImage(w, r, projectID, imageID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CompleteUpload operation middleware
+func (siw *ServerInterfaceWrapper) CompleteUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageID ImageIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompleteUpload(w, r, projectID, imageID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateImageFocus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateImageFocus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageID ImageIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1070,7 +3350,7 @@ func (siw *ServerInterfaceWrapper) DeleteServiceAccountAdmin(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteServiceAccountAdmin(w, r, serviceAccountID)
+		siw.Handler.UpdateImageFocus(w, r, projectID, imageID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1080,17 +3360,26 @@ func (siw *ServerInterfaceWrapper) DeleteServiceAccountAdmin(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetServiceAccountAdmin operation middleware
-func (siw *ServerInterfaceWrapper) GetServiceAccountAdmin(w http.ResponseWriter, r *http.Request) {
+// RestoreImage operation middleware
+func (siw *ServerInterfaceWrapper) RestoreImage(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "serviceAccountId" -------------
-	var serviceAccountID ServiceAccountIDPath
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "serviceAccountId", mux.Vars(r)["serviceAccountId"], &serviceAccountID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceAccountId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageID ImageIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
 		return
 	}
 
@@ -1105,7 +3394,7 @@ func (siw *ServerInterfaceWrapper) GetServiceAccountAdmin(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServiceAccountAdmin(w, r, serviceAccountID)
+		siw.Handler.RestoreImage(w, r, projectID, imageID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1115,17 +3404,17 @@ func (siw *ServerInterfaceWrapper) GetServiceAccountAdmin(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateServiceAccountAdmin operation middleware
-func (siw *ServerInterfaceWrapper) UpdateServiceAccountAdmin(w http.ResponseWriter, r *http.Request) {
+// ListProjectMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectMembers(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "serviceAccountId" -------------
-	var serviceAccountID ServiceAccountIDPath
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "serviceAccountId", mux.Vars(r)["serviceAccountId"], &serviceAccountID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceAccountId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
@@ -1140,7 +3429,7 @@ func (siw *ServerInterfaceWrapper) UpdateServiceAccountAdmin(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateServiceAccountAdmin(w, r, serviceAccountID)
+		siw.Handler.ListProjectMembers(w, r, projectID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1150,24 +3439,32 @@ func (siw *ServerInterfaceWrapper) UpdateServiceAccountAdmin(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// StartGoogleSignIn operation middleware
-func (siw *ServerInterfaceWrapper) StartGoogleSignIn(w http.ResponseWriter, r *http.Request) {
+// AddProjectMember operation middleware
+func (siw *ServerInterfaceWrapper) AddProjectMember(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params StartGoogleSignInParams
-
-	// ------------- Optional query parameter "redirect" -------------
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
 
-	err = runtime.BindQueryParameter("form", true, false, "redirect", r.URL.Query(), &params.Redirect)
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "redirect", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StartGoogleSignIn(w, r, params)
+		siw.Handler.AddProjectMember(w, r, projectID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1177,58 +3474,29 @@ func (siw *ServerInterfaceWrapper) StartGoogleSignIn(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// FinishGoogleSignIn operation middleware
-func (siw *ServerInterfaceWrapper) FinishGoogleSignIn(w http.ResponseWriter, r *http.Request) {
+// RemoveProjectMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveProjectMember(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params FinishGoogleSignInParams
-
-	// ------------- Required query parameter "code" -------------
-
-	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
-		return
-	}
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
 
-	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "state" -------------
-
-	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
-		return
-	}
+	// ------------- Path parameter "userId" -------------
+	var userID UserIDPath
 
-	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FinishGoogleSignIn(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// SignOut operation middleware
-func (siw *ServerInterfaceWrapper) SignOut(w http.ResponseWriter, r *http.Request) {
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1240,7 +3508,7 @@ func (siw *ServerInterfaceWrapper) SignOut(w http.ResponseWriter, r *http.Reques
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SignOut(w, r)
+		siw.Handler.RemoveProjectMember(w, r, projectID, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1250,8 +3518,8 @@ func (siw *ServerInterfaceWrapper) SignOut(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// GetProject operation middleware
-func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+// UpdateProjectMember operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectMember(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1264,6 +3532,15 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Path parameter "userId" -------------
+	var userID UserIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1275,7 +3552,7 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProject(w, r, projectID)
+		siw.Handler.UpdateProjectMember(w, r, projectID, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1285,8 +3562,8 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListImages operation middleware
-func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Request) {
+// ListWatermarks operation middleware
+func (siw *ServerInterfaceWrapper) ListWatermarks(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1310,7 +3587,7 @@ func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListImagesParams
+	var params ListWatermarksParams
 
 	// ------------- Optional query parameter "offset" -------------
 
@@ -1328,24 +3605,8 @@ func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Optional query parameter "sortBy" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "sortOrder" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListImages(w, r, projectID, params)
+		siw.Handler.ListWatermarks(w, r, projectID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1355,8 +3616,8 @@ func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateUploadURL operation middleware
-func (siw *ServerInterfaceWrapper) CreateUploadURL(w http.ResponseWriter, r *http.Request) {
+// CreateWatermarkUploadURL operation middleware
+func (siw *ServerInterfaceWrapper) CreateWatermarkUploadURL(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1380,7 +3641,7 @@ func (siw *ServerInterfaceWrapper) CreateUploadURL(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateUploadURL(w, r, projectID)
+		siw.Handler.CreateWatermarkUploadURL(w, r, projectID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1390,8 +3651,8 @@ func (siw *ServerInterfaceWrapper) CreateUploadURL(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteImage operation middleware
-func (siw *ServerInterfaceWrapper) DeleteImage(w http.ResponseWriter, r *http.Request) {
+// DeleteWatermark operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWatermark(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1404,12 +3665,12 @@ func (siw *ServerInterfaceWrapper) DeleteImage(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Path parameter "imageId" -------------
-	var imageID ImageIDPath
+	// ------------- Path parameter "watermarkId" -------------
+	var watermarkID WatermarkIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "watermarkId", mux.Vars(r)["watermarkId"], &watermarkID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watermarkId", Err: err})
 		return
 	}
 
@@ -1424,7 +3685,7 @@ func (siw *ServerInterfaceWrapper) DeleteImage(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteImage(w, r, projectID, imageID)
+		siw.Handler.DeleteWatermark(w, r, projectID, watermarkID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1434,8 +3695,8 @@ func (siw *ServerInterfaceWrapper) DeleteImage(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetImage operation middleware
-func (siw *ServerInterfaceWrapper) GetImage(w http.ResponseWriter, r *http.Request) {
+// CompleteWatermarkUpload operation middleware
+func (siw *ServerInterfaceWrapper) CompleteWatermarkUpload(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1448,12 +3709,12 @@ func (siw *ServerInterfaceWrapper) GetImage(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// ------------- Path parameter "imageId" -------------
-	var imageID ImageIDPath
+	// ------------- Path parameter "watermarkId" -------------
+	var watermarkID WatermarkIDPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "watermarkId", mux.Vars(r)["watermarkId"], &watermarkID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watermarkId", Err: err})
 		return
 	}
 
@@ -1467,19 +3728,8 @@ func (siw *ServerInterfaceWrapper) GetImage(w http.ResponseWriter, r *http.Reque
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetImageParams
-
-	// ------------- Optional query parameter "waitUntilProcessed" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "waitUntilProcessed", r.URL.Query(), &params.WaitUntilProcessed)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "waitUntilProcessed", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetImage(w, r, projectID, imageID, params)
+		siw.Handler.CompleteWatermarkUpload(w, r, projectID, watermarkID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1513,6 +3763,168 @@ func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// DeliverImage operation middleware
+func (siw *ServerInterfaceWrapper) DeliverImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectID ProjectIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", mux.Vars(r)["projectId"], &projectID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageID ImageIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", mux.Vars(r)["imageId"], &imageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "presetName" -------------
+	var presetName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "presetName", mux.Vars(r)["presetName"], &presetName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "presetName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeliverImageParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Accept" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept")]; found {
+		var Accept string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept", valueList[0], &Accept, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept", Err: err})
+			return
+		}
+
+		params.Accept = &Accept
+
+	}
+
+	// ------------- Optional header parameter "DPR" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("DPR")]; found {
+		var DPR float64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "DPR", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "DPR", valueList[0], &DPR, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "DPR", Err: err})
+			return
+		}
+
+		params.DPR = &DPR
+
+	}
+
+	// ------------- Optional header parameter "Width" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Width")]; found {
+		var Width int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Width", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Width", valueList[0], &Width, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Width", Err: err})
+			return
+		}
+
+		params.Width = &Width
+
+	}
+
+	// ------------- Optional header parameter "Sec-CH-DPR" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Sec-CH-DPR")]; found {
+		var SecCHDPR float64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Sec-CH-DPR", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Sec-CH-DPR", valueList[0], &SecCHDPR, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Sec-CH-DPR", Err: err})
+			return
+		}
+
+		params.SecCHDPR = &SecCHDPR
+
+	}
+
+	// ------------- Optional header parameter "Sec-CH-Width" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Sec-CH-Width")]; found {
+		var SecCHWidth int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Sec-CH-Width", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Sec-CH-Width", valueList[0], &SecCHWidth, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Sec-CH-Width", Err: err})
+			return
+		}
+
+		params.SecCHWidth = &SecCHWidth
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeliverImage(w, r, projectID, imageID, presetName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -1626,6 +4038,8 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/audit-logs", wrapper.ListAuditLogsAdmin).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v1/admin/projects", wrapper.ListProjectsAdmin).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v1/admin/projects", wrapper.CreateProjectAdmin).Methods("POST")
@@ -1636,12 +4050,18 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v1/admin/projects/{projectId}", wrapper.UpdateProjectAdmin).Methods("PUT")
 
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/projects/{projectId}/deletion", wrapper.GetProjectDeletionAdmin).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v1/admin/projects/{projectId}/images", wrapper.ListImagesAdmin).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v1/admin/projects/{projectId}/images/reprocess", wrapper.ReprocessImagesAdmin).Methods("POST")
 
 	r.HandleFunc(options.BaseURL+"/api/v1/admin/projects/{projectId}/images/{imageId}", wrapper.DeleteImageAdmin).Methods("DELETE")
 
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/projects/{projectId}/images/{imageId}/restore", wrapper.RestoreImageAdmin).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/projects/{projectId}/restore", wrapper.RestoreProjectAdmin).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/api/v1/admin/service-accounts", wrapper.ListServiceAccountsAdmin).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v1/admin/service-accounts", wrapper.CreateServiceAccountAdmin).Methods("POST")
@@ -1652,12 +4072,34 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v1/admin/service-accounts/{serviceAccountId}", wrapper.UpdateServiceAccountAdmin).Methods("PUT")
 
-	r.HandleFunc(options.BaseURL+"/api/v1/auth/google/sign-in", wrapper.StartGoogleSignIn).Methods("GET")
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/service-accounts/{serviceAccountId}/api-keys", wrapper.ListServiceAccountAPIKeysAdmin).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/service-accounts/{serviceAccountId}/api-keys", wrapper.CreateServiceAccountAPIKeyAdmin).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/service-accounts/{serviceAccountId}/api-keys/{apiKeyId}", wrapper.RevokeServiceAccountAPIKeyAdmin).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/users", wrapper.ListUsersAdmin).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/users/{userId}", wrapper.GetUserAdmin).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/users/{userId}", wrapper.UpdateUserAdmin).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/users/{userId}/revoke-sessions", wrapper.RevokeUserSessionsAdmin).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/users/{userId}/suspend", wrapper.SuspendUserAdmin).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/admin/users/{userId}/unsuspend", wrapper.UnsuspendUserAdmin).Methods("POST")
 
-	r.HandleFunc(options.BaseURL+"/api/v1/auth/google/sign-in/callback", wrapper.FinishGoogleSignIn).Methods("GET")
+	r.HandleFunc(options.BaseURL+"/api/v1/auth/providers", wrapper.ListAuthProviders).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v1/auth/sign-out", wrapper.SignOut).Methods("POST")
 
+	r.HandleFunc(options.BaseURL+"/api/v1/auth/{provider}/sign-in", wrapper.StartSignIn).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/auth/{provider}/sign-in/callback", wrapper.FinishSignIn).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects", wrapper.ListProjects).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}", wrapper.GetProject).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/images", wrapper.ListImages).Methods("GET")
@@ -1668,84 +4110,245 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/images/{imageId}", wrapper.GetImage).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/images/{imageId}", wrapper.UpdateImage).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/images/{imageId}/complete-upload", wrapper.CompleteUpload).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/images/{imageId}/focus", wrapper.UpdateImageFocus).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/images/{imageId}/restore", wrapper.RestoreImage).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/members", wrapper.ListProjectMembers).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/members", wrapper.AddProjectMember).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/members/{userId}", wrapper.RemoveProjectMember).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/members/{userId}", wrapper.UpdateProjectMember).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/watermarks", wrapper.ListWatermarks).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/watermarks/upload-url", wrapper.CreateWatermarkUploadURL).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/watermarks/{watermarkId}", wrapper.DeleteWatermark).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/projects/{projectId}/watermarks/{watermarkId}/complete-upload", wrapper.CompleteWatermarkUpload).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/api/v1/users/me", wrapper.GetCurrentUser).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/i/{projectId}/{imageId}/{presetName}", wrapper.DeliverImage).Methods("GET")
+
 	return r
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9aXPbOJZ/BYXdD7tV1GU77kRbW7uKpaTV7bbVktXJTMbVBZGQhJgiGAC0o07pv2/h",
-	"4CWCEnU5mR1/k4nr4V14F+Bv0KWLkAY4EBy2v8EQMbTAAjP1V3+BZrjvDZCYyz89zF1GQkFoANvwbo5B",
-	"vwvoFIg5BkR2rUMHEtkWyhEODNACwzYkehroQIa/RIRhD7YFi7ADuTvHCyTnxl/RIvRl74uzS3x5fjGt",
-	"vWp6Xu2ihZq1169bk5r75k3r4qI1OXe9V9CBYhnK3lwwEszgauXAa7Ig4vcIs2URWNUGppSBEM1IgNRn",
-	"A+wXNSSB1pddoRW2VtOBU8oWSMhdBeLyIgWEBALPMFOQ3E6nHJeBohurwUJVXzswFWEZMPoZu6IaFUPd",
-	"GQgKnubEnaekBRPs02DGS0gcxqucmshD7BGG3TLkyu1IyOQOmOkqf6OpwAzwyHUx59PIB5zMghoJ6qCL",
-	"pyjyBVcjKBV6OJmCQP5m9JF42KuX0Cdewk4h2DBo4datjDB7JC7uuC6NgooE4noMQHpQCTX42synJsqI",
-	"MvF2WUKSdwT7nsQup0yAybIElVzNkUOkp0kD29BlGAnsdSSicRAtYPtT7lsUeub3fRl8t8zDrARE2Q40",
-	"JctlkceT5GD8d4ansA3/rZHq0YZu5Q05bTeZVQLyARExDgTxB4xKTsReCUSyI4hkz4wIhnoQCWaAcCAX",
-	"9LHAXgm8T4W17MidIp9jJ2UD87fB4oRSHyMJ/UoyEQ9pwLE6HHqMUTY0X+QHlwYCB0L+RGHoE1dptsZn",
-	"Lnf0rSLOOmGoJtYL5pGiGgB13Ygx7AEvkgRW+JHcjblQODYzyYWSyeTJxmiImSAaeJd68kQq4F0vIVul",
-	"0CkdzeiMocUCCeKCOQo8X3KVkz0UmlVUsaPWvFG02bCqJF61deHbTvfPYe/3cW90V5RKBy4w52hWulrc",
-	"nJ1xRBfY8NVXgNe6FcUq1SmfYNrPoDaz31Qm6USqQwndlZLeAcMci6GhXoFKKHDnmnqbWEZZKR3dVfGM",
-	"Yez1ffcDT/Ik5lK7iznhIFTLS2GSTGQGAhooQ2abQDhwSkQl2N4RteWYRaqM0F1XDpxjMpsL+7Gg23IW",
-	"GCABCMlX7PPcFl5XZNHAyp5yLcWXiZUg0ZZbAD5dNpvz182mjRO/RMgnouS0No35XfxHq9ZqNv8zOZ0l",
-	"iV43cyu+qbajJ+KVnaqqqQr2LpsVba2sQChUpty4SQaUjdDxFiQolYSqhFFT5SkjMBc102KjjqamWoUI",
-	"vODbGNQmuatkXsQYkofQ19qM1uS3Gn8gYY0qkJFfC6lEGNNmiBVn5ZjKG0ybEYaUpTdyaYi3HtP5aTMD",
-	"VxKPIWG4UyKBqlUdc0CQlA5rVhoQ9AEHeaqcNc/Oa61mrdm6a521m812s/l3mOEyac/U5Jw2klXjBout",
-	"uMYVpkfN9LBzhzHsuc2p4kr7xF5Dv6usaMQ5dQkSUsLEvNRsjXntUPvTznrGCIq9ny4/iCedHD+Vc+g4",
-	"9Cnyxswv5csp8UuMgHXyyZ4SnRMMIjWtdkNSdGmX+3M4s+Fkr8NGqwIJ3kZqq0NTgqrJHYb+Uv5IwwCg",
-	"n3efnORsNcoGPBHflztThqLxr4oMselQOYa+SYiR4MtGWoUkiwmZ+B9WUqrmdcWQREmOpAj2ojLx7BBH",
-	"AfkSYUA8HAgyJZhtgHpfUeUCCVwJ3pHqucr6dlaofcQF0H1OiuqI+XYAxsPreE3KyIwEyLctPhci5O1G",
-	"w3ypu3TRiPs3dP8nPAltSz8iRpAJ0NllUlsvcT+td41oxSKXE7Gt2P9DT3UsQSPKH7A67DFPaAxvF8RO",
-	"4hMUSaH9BRBSTpToST9Ko8ZlNAxJMKtnAgij3zpD6Tld9W7uekPowJvb4d3P0IG9jvKoRrdj9ecH6WDd",
-	"5/wkMzJPKYMbsggp05pfBXTgjIh5NFEEJzwSiOGL1pkmOWaN8GGmf3O5xQS7sX7n9dSzSZwJ6+anRICF",
-	"dCEzu6bBI2ac0KD9jwCAGri6/aM3bIORi+TZkpi9ggKXPmKmPgnEZlgAjyxwIIfyOlB+YYiY4GCBllJv",
-	"K3xirx5Pe3PX6d9YJ5ZgSX4kQdnsNzQhj3bueR30FqFYAsQwSpacEt/HnubtCXIfZoxGgQdc6lNm4HjX",
-	"v74uAcL3y5a/SzqahTzCBWVC7S7DLgp3kl30ZqED5XJ5xkjbnoU1jGOZ1e/2s0hHZoAcXdSPZn+/DHrv",
-	"oQMHN+8Vz78dQAd2/ui/gw78ude/ym/UtD/PLpOTK3Mw2PcZMSb3qTRK6UbHg+vbTvfPQe+m21ebNR96",
-	"Hwf9Ya8LHTjsdbp/kwTu9K973fzO47Zn2XpyCOaU8vFMkfjI+Oc0SezQ72ua6HOyvysktmDIYSDcnCQI",
-	"U93yMmxW1QBTTPU0x0GRMOAJcWObec9simk4Egc0bwxtt8yM0dSIMbrRQtvF2Em4LEftXY2gHIl2VIU5",
-	"qamsEgfD26veaKRbfxj9uM6qfd25oCATw7e6BVwwfeXfVKAS1lNNIIgWE60aDIBriYLdw4ga4HhpG0Po",
-	"SNyRguf7nCQ2mTpIvl8i+PtH8Mn3PL9+tPTBHvmCPaMNR5eB75a32Hh6rSU1ktlTItk1lE4+HMVuteY4",
-	"DkL1HiJjAWFfmVEEvFLh9x0OFmDcaRsoF2dHzfUdllLaFEPOh4/NxorrVjqzzSloObT3lujj8tkeYhaj",
-	"McckGwRsiKeY4cC1BKu/L4+fjNVsSC1NIg7iYqwDzcNYnR1mIMalYc9hIg6xKR3S9vHmzKmp1twabTbZ",
-	"PhbPXcj6ABR4aXPH95WZxiLsAOT7dkWWJIaScWue46eqTNg6O8cXry5/quHXbya11pl3XkMXry5rF2eX",
-	"l62L1k8XzWZTIut58pC6knaHLKQkcYo5W8HWmmk8NahNhpUjuQ7u0ANWxrOLPakwgIr+xpSvYDvvkAco",
-	"cGM+33661P0+1sXGnPlBVsYPWUiw87GwET+nPR6OWc6wvZiBp2UMXrU6hgpHRnpAH89OORXH7mGvZAU3",
-	"g+r7rTqgk5d4S2JPdQBc9ti0cRPOeje+vtYxq196V2u5u/hjSYQq/qgnN3Pzeie3tVT37RHQWpvaUgr+",
-	"gYh5JyS/YuXzIt+/ncL2p100IVw5Ba2aTFhEb2fQBw94qTKHW3kKPfw5uu19vPv79fmHp5/eflx++e2D",
-	"1331eziYLgfvXgUf75ati8FD+Mebj5ePy9HtX4vfvfDzz3/7+OvZ5eNk3p11P2/lNgNskXPuC8g62Jwr",
-	"YO4Qq24Nc89i3eXLzK1g8lyBu6Kzr/RdiPWpw7Pi0xldQQd2e6O1pJ/6slluvMkc+yFmvJ6H6kCZSaZV",
-	"6Bkr1fPPVjc5Djlm4mh1kwU20Fh5qZF8qZF8lhpJC/+Z2scio2kG4TtyiBQwMguwB8bD6yNyxhwjD6vE",
-	"CPI8ovc3yMFryUhkYe4kw4Cei4NYYydHqC7ZjC+m5KD/Bn+mUhzhlNI6P6+jBfqLBuiJSw0IbaiNL1B+",
-	"t7K90oxrjkZq83rj8cWcuB5UgEXEhaocQmkZz2B8BxZYzKlXB1dz7D6AOBfrUZfXJUo0ctTZ0FE/R+cN",
-	"H0nJbEQcs1lEPKyCfwqKMfM1G94q3NXnYuErqBaUYeBhgchajDzJ/hr8NzT8//OAl/+NJm7r7Hy7aZxc",
-	"b9XJW8NfTobt763yUjwPihmvKSAeUImuuJQ2TTckwRJji/8XoGKO2RPh2AEIBPjJdPxHEPc0FnwddHwf",
-	"4K+EC0mpOBwbUAFI4PqRh704ZhApMJW5kE7jYXXzTBdIvdzZecn4vVwY2ivxVlQKHLPj5Mmkdjxm+GqB",
-	"SMkZoJoA8jwmffTS5eWX/83U2BwlHlVcZm9OJu7DBm42reXrfqbzwKNW3IVzKui49AiVrdnSpeLc1gol",
-	"OYyrQzAuS0ooGTFig4NRf6uhLxlwKPvtH5E6KudZMzwxqcyWYu7MYNrZej08L3NDg5riLuUKhZ3FjnL3",
-	"N1Xs+35cKBF/b72Wm3eW5XS8PtRbOMg/VjPpK9McuxEjYjmSBM2GfjqR1mvqrnhioxgf4GOtM+jXfu1l",
-	"KrdMDGblwAlGDLN4vP4rLjeGv3y4i++Vq6NWtaazSNbVl5/pA8E5GPSnFIbxSNVXry0v90SCKbXYBhoB",
-	"4D0S+AktVRRL2XooQDN1SV6paIY5jZiri7EEET4ujoUONIXysA2b9ZaEmIY4QCGBbXheb9alApfkUAht",
-	"oJA0HlsNJN3rRjamPNOVWElcRRrtygeMk4/KI1dzpQ+tlIT20i6N7IMiK2dr98xLKKv7tWv7Z83m0S7r",
-	"JxlVy2X9UfLWhr8EDAtG8KMqwIyH5Kw+2yoJ2I38UwOKy6PFArFl7GAj3wfZtzbQjCv5VMi+lyqYcgth",
-	"ihdwzUsZmIu31FseDVHlN31XxYcVTkChrQQy2jJG4tGoozeeOCNJMG2NQCunRKYa35LgykprAOl4FCnZ",
-	"Vd/XKLmbjOUfyimTmw04NE7R0XGo9wbQBvw5dsXzHotnQMmzMmpBk8Re/dHQ/R6LwtxWlRJZMF4MTR8F",
-	"6cfXSOUx9B9EIxmb7WRk1gioQOlKuikuU99kAmSKbw5lCueUFsP23tn3nip2zzy/dFI9Ym4AVFcj6f2C",
-	"45kjadUNOvTQM4zVSMp6lF9gtWZsVV4/qPbZVJB2Yv1TkUEEI7MZZtgDEyTceeJLpG9wHY1lEmSAReQL",
-	"Evr4FAz0zYSpK5hROjj7PHoq+8TkATYXia/sHNXiCsy8FVC/libcfBKsVTD8v/IJ16szqmvi9dqJ4+rk",
-	"wuy7uomWzPpJvcUNmfwTq8jSaqSqXuQark/jTa4vsoeQNr6tv5RZQTva+WA32bU+/XmA+jsVwhPXczuy",
-	"y13QZ0fYCYRgfzV2Ev+0bI0d/dQTU+ZUXuuPohkr+7CnEk/jw6IddWEk5o0ZpTMfN8wzyKXWykggJt6r",
-	"viMyC/q780f+weaCyJ43z4o6Lh6jqkgo0OsDCUBNQWCqbuTAa6oJWf4gQO4laJPEUW/CUFacOWWD9TzU",
-	"6kCimbQMbH+6z5JQIbgIR0K+SMxxIAy3bqVjw0W+P0HuQylB35GA8PlmilrqryMxp4z8pdPM6qFahkXE",
-	"AukfLWPwzSPb5mXtkmeJ5eCN71EXEoDWglb1rEECt6RnyPAjDgS4Gg3fASQEch94GRDxawvVoajEtznh",
-	"N3VRJNC1ThpHx2LeFNUq0fVdWFez0gG8qziF6uPJbnnLSW8jAXc2jAzy5eTHUrYSFjmhokP8vIakR8Ut",
-	"l6U0tkTuX4L2hwftYzi30qN6GPclgvsvHMHVD8RU5SZTUlozRbSbYgymhnp4/YPGbUteuT2x1Z1Wlm9j",
-	"CsJ5hL1i9fjRmKMvF1A8UV73bAkb7sgwu8ZqX8K0JfguD0p8D7xt7172/0FOr3B31bcnOcHzM28UH1Xx",
-	"1tDlmmU0vtJG2ljbaKdTTxyzHdCXNR1PgkXrApts1LyN/y1X7/fpXrJttoJQf8nW8326l/zJMXu0e5BX",
-	"8Vt0qoe5J9GGDcXWBqz4qt46eHKt/D2l7Kf4pbZ0tAp3rO5X/xcAAP//SFJOjtlsAAA=",
+	"H4sIAAAAAAAC/+x9+3Pbtpbwv4Lh9/2wu0PJjzhpm52dbxVbadU6tq5lJ92tMr0QCUmoKYAFQDu6mfzv",
+	"3+BFgiQoUS/HtzcznWks4nFwcHBwcJ6fg4guUkoQETx4/TlIIYMLJBBTf/UiQdkg/luG2FL+HSMeMZwK",
+	"TEnwOrjEXABKkiWAWYwFQEQwjDigUyDmCGQcMUAZ4Ig94AgBGEU0IyIIAyx7/6kGDQMCFyh4HUA9VRAG",
+	"PJqjBZTToU9wkSby69npK/Tqxdm08/I4jjtnJ/C48/33J5NO9MMPJ2dnJ5MXUfwyCAOxTGVrLhgms+DL",
+	"lzDopfgXtBzEQyjm9RXczhEYXFiAe8MBuEfLroUwlX0KAM1IQRgw9GeGGYqD14JlaN8QS1z2IgnhdniH",
+	"qm8zmvXHAub/y9A0eB38n6OCEo70V37kAFMAdwvZDInbZYq2A1Co/kAt3Q+lyGfYDNICMgXtOUNQoLg3",
+	"FYitBZUhTjMWIQ4i3Q1AIckXyt4abrxoAjhyZmog4dPj0xedk+PO8cnt8fFr9d//BmEwpWwBRfA6iKFA",
+	"HTNFnSrMUt6gKWVoi7VMVMeWy9CzrFzHyZbruEAJEmg9R+F0Kjqxbuws5xFigckMTCkDacZmTQsxPUtL",
+	"iNEUZokIXk9hwlFYLMn8bYCdUJogqMm9/0kgRmDSggXiBZxJALGYKywj0xUMLhpgRPngDZiOFrwDmcBR",
+	"gjpnp150vsUJuoILNGRoij+1BnJOOQJTnCAgYeGAC8hEAXuqRmsAe1qasgH0CSQEsY4fZkUpbWE1PMOQ",
+	"VwNI9mM7VjGQI2soFEDq73ZXhAKq4YLAephD3w+/oOUjZU0E+Q6KaK6uXrvNBEf3epcpA2gBccJBRImA",
+	"mKgl3evxQoBnhMpZQAR507kyjRt2/Q86J0EYLOCnS0RmEpunL1/51nCJF7iZAhZY6AMOZ5jAFZdZIpv6",
+	"YTk5dlgSJuLVWYFMTASaIaYgeYcEjKGAbclxDh8kimCSWJJYmBHsLReCGX5ABEA+Jvbbb/do+fG/HmCS",
+	"obFcDPqUJjRGlj58a7NdS8uDcYwlZDAZMpoiJjBScloFww5v+xxo1qnZifxk2tLJHygS8gculonmmSi9",
+	"zn+9HlycDxl9wDFizSdDAmsRIXuA1HRpOCP2c8tDMqN0lvgvkuvplKMmGtIf2xERVW39VNSSiIaMSrS1",
+	"YyGpbgwEBY9zHM0LvgImKKFkxptxp2c5NIe5QTFmKGpCrlyOhEyugJmm8t9aUOJZFCHOp1kCOJ6RDiZd",
+	"cKFvXq56UCp0dzwFRP5bk0TcbdgfO0UDzzkyaOHepYz026Onnx7tNqjyXmnYDV4Z+dCbMqJMvFk2bMlb",
+	"jJJYYpdTJsBk2YBKrsbwC0W5CCsRjUi2CF7/VvotS2Pz749N8F2zuFHQlt+B3snms8jtIK3vcjnsRT6q",
+	"AiTjKSIxWn1DctvK3JV4CtSmAUhi9YJ6QMUXJSA2gWwH8tOnGtQnWt7C2fZ3joAz3u4aEXDmB+w3I6PJ",
+	"r9ligZjcVizQwn+hmB8gY3DpXhqSPQZqOfLlta2qQL/3Vj4G968auOOIteMIkhAa2ECmBjn04Zeg3tAE",
+	"tRD6DMiMJk0Uaz61O192ZgXGB4jFHRFYSh+SyTeeMdkQZLKlc7ulupMkZSxF0EVq32g+KB9rc+36mPsA",
+	"BWILyO7bbfqjbd6w84/FcIfd/i9ydJ5SwrWw12eMshvzi/xBSvOICPlPmKYJjpS4c/QHl6v63FZ/kqZq",
+	"YD1hGTHqA6BRlDGGYhBnEjJNZujPDHH9jDIjKb1hHBuZ6B1aTBC7Mc2kgrEkuKr3iH8n1CcA45ghXtYp",
+	"SlkjVvJCgVv54b/Nn92ILlyNhJ4krDM1dRLW4KW8DnsSiu3+LR9ejfbRI2DnqK0tP6KxfDHW1q8RLr9K",
+	"glRiLKMzBhcLKHAE5pDEiVxD6D54jttIq6Ga80qR8IpZlVzfat7gTe/i95v+3+76o1sfjheIczhrnM1+",
+	"dkcc0QUy/OETQJVmdebo7kXRzqDWWa93axz9ppcK1XWFYhDNIdEvfysbDW+uf+6f3/5+ftPv3faDMP/h",
+	"bnhR/uGif9kv/XDTH91e37i/vOu/e9O/+b13cVH/sTae+f2m/+76vfx91L95Pzjv/947P7++u3Igqn7I",
+	"R6p+yCGsfhjd9i77v3/o3Vz5eg1GvTeXvm694eD3X/r/0wyJbXDTf3/9i2wweNf7sV/Aof8s8PShd9u/",
+	"ede7+aVocjdycaP+GvVHo8H11cgO+9GlqtpulCkpDD51ZrRjflTbzrs9K6zm3zp4kVKmOZm6RIIZFvNs",
+	"InnOEeaZgAydnZweqSsPsaP0fnakBysp9ylTSmovyd1jEkt+h2NEBBZLsID3lt8aMgSj/xnd9t/Je1Qe",
+	"Uv2rVj7STIwJJABmYi77R0r9a8fqjolDwhJp9c2Rv6jhy/gzjdthzaxvz4g7V+uss1H18vQj8+fR9RVQ",
+	"ihdHka/xFQI44YgIKeFjIXGZEY5E14VaKrU4Jd0b+PguZy3Fmvg9Tjs01SqZTkoxUYBIEeBLGGid+1q4",
+	"HNW8F7BHuHfIpvK9uF6jo8GJgWpeltXLt6/ir+t4s560kQv39ZOgvrv5oyT/x1o7kBxr6Xu2CCpgg7yh",
+	"PgGSyXterrX0UOlWrtp2eiF39Rp4C8FKLCw9FJ5fUa2tdWFuUm3xuvHYaXMqlBxGtuNLLtCivPFbibTt",
+	"6VStwC+sFCJiZRmiQsQedU5ZY10RG3eC1XL1NrtkWkuRTPPvBr1O1W5qT+Vk6Zh75bLanw/DSD0HpND4",
+	"+A8JXiDwOEfE4Q+KQS1gjMqodeydJ6eb2QnDADdQbUbwnxky19kU65Mq5sg5rcs9UKg6uwsq0CBtOD3D",
+	"6sskSrA8LPW7uoKUF93j7snJi+53O5CaeXWtP9qm4TqozibTH06nL15+993kxVkMX8EXEfrh9If4GB2j",
+	"s+9evNoB1Fx5swZSS9TW1NsF5uEFFurlxQFkzr5L6h8TV50+uFCqu5wTDC7AHxQTFGuzJgQ8gXxu5J+d",
+	"qcNxUNjCL6F0L8SBe+pcPhIW3holhwhHIWY5R+N9clsCtFnYtHi3G+F56dReIH7R0S/pW5neleWN2O4V",
+	"09tJmrcuWvYnaoq5tXzVb+IY8zSBy+ZLaZ4tIOkwBGM4SdZYyIpl/9hg6bLqpo2Mb/IExAATa4FR9pay",
+	"DLPKtOaSJ9GSnbtqP60VONuDCFcMVr+jvHKVD6bzOaMLOMomXK5ZLs6LxUg1A7xoJ3GKiNQbxEYF/3pM",
+	"AOiAs9Pj1+AnmDwYaZ0mlKmTk2TqDgajBUwSxJRrBQ8159GtJglCsRqbAD6HLAUoniHeNQOfnb0GvyCU",
+	"qnGnWZLUBy+93M5Oj4MwODs7Kx8e+UPDwdniTOi1B1/MCGZY/Wu3jt3cS2nIEEeiUecHSTSnbB0RKKeM",
+	"nm76JSz0vdUtHJBYvXG1CWeOOUjV9ABzhUzTEVBSvvQa9MRyJsJxftpLcw3xJ5QA3WAJFlkicJpgo3WH",
+	"4AEyDIkA8qkGevmfmAOGSIwYisdECtMIRnM7Sgh4BBXRPeJY3lMkBnOEZ3PRBTeazLn5RNmY6E/aShVB",
+	"QqgAE6SPuyI21ZJXbrnfTsLT8IVr3imEMJpNXC6gnz8+wVAfCLZeXSqR3zeN1VNTtPPHwerQWsg28OAJ",
+	"A42VBnasvpX8dyRnTOVWllni9y0VqO0YsqbC0gTB46vj4/n3x8c+Nv9nBhMsGszt5mN5Ff920jk5Pv73",
+	"3LwuCe3749KMP7RbUW7KaLe7uSFF9ZUU54fakO16zL9qiXlN3R5zk/q9GLztcQT2NI6JGro4jIZqBAX3",
+	"litDnqJIAAYFppVNrhy3F6fH4auz4/Dk9Ptj76lrXmH11BmNXp8kUtJZGCtP1fhV4YjWlG1WrEVngh4Q",
+	"K1auxpOPZaifcpRh6SeTjIl2cQNatAIxXkhGRYkeReJHWoDoI5HImWJR6i09WhI0JhJp5sGBWQlzFVx5",
+	"zeNtXxcNoopBjlckMPeTejL04gUmjbdUFJM3kKM75lEXyQ/g7ubSksH5xZVWM0jTZslt0bxOQquaBXBM",
+	"BINYEZl+kYBb55aSI2GuGTqeGg1MBWfBXIiUvz4y93N3lfriSyhd9m7RIk2g8HEt80XCq9GkZUX5t3cl",
+	"XTDKUilJyMssTWCE5jSJ7fvss2n1JQSfVXf5D31I5L8MQcp/ok/iSzgmn5fL5VL+vVh8UZfa5zj+8p9O",
+	"Z9tFf5S91ER237tjol3WDHkKyrTk6179CVxK1DfiM4f6SINzJKE5MjAcWfg7OSxdBcf2srpFZQkIgbjo",
+	"mC++oTUU7YVonyhWZTCbaBwEIm1UkDe24ZAmODKuIpTBmTx1Uiyuo+fKVdbptiDVjZVLk9xTQ5xVagTS",
+	"u2wgxqSQhawiQWv71cNaiebuKbOjFydtTPykYXqUnVpfnXn25wFzPMH2Cm9hUX5fdPBysmb+VXZqW83G",
+	"oPLGG0V0vaaiMqzTUTmUppihJrWg+qoQrTWEftUrEPQekT1qCdsdt5UKYHXsTItOEaFUP36ILTBXF6Hn",
+	"XVB8BDMGiUCxfOcVYQuGIenNAFwitbXCtrwtxVQSqgUmAz3GSV12yP1FeZM/1tTRninnTMg5jTAUqIgF",
+	"8GAvB3sPejQPO9K7mjvVXvAdBQL3CJQ3svURU6Ffqw/aVgfEiTh70iPhnZdRoSDsnB6fvuqcHLfTDTXj",
+	"8C5NKIzvWLICZ3kETP1df1F7OThqdp8tqhYusyYOIcxDWdYjzF5G8rGtloUq/kdaav4jnfk2ZatHbR4A",
+	"sInff3kVPTbBgkG2lJt9pK3NdthyRAv4BS21CAUFWFAuwKszKXKNierF859fnpzKK5bBSCDGgfRXr9yZ",
+	"9YiDBfzkgv3itEoy7eUQLQXJPVvJ1GQjE9okuVqaJkv5D2e9g7LzeejIBrKz1Lkkidxu2RmjuIHvrXrR",
+	"bylqKd/eOkHCGa9s2UWmff0QNyLxgj40Apo7+66TYxbwk7lSXpxuuYaa3d+csvwgNPOMXLWwnnlsdaja",
+	"sceS66fDYOgihWTZSeiMtlWcr1wxTd/QT3VwblAkIJlpjqP0D5CDKdPGoDIV1LWGQVjBU5Ny7KeSYozZ",
+	"OUtLPu6+DD3KwgX8hBdSEX2iRBD972OPErFBOfTBVQwdZmYPWi/RVCit+7qZT3aa2afCo2mriU93mLhC",
+	"fp8CCYndgVxF6qPDtzSCyVCeYI9kK3+WYKvzjbjYiRQ9m/ITZfgflAiYgJRydcmBKaMLNW5id2yvpOHZ",
+	"oPcSxsgLg6CpD4QX+94q384o5uVRTa320bBv3pKkmQeu7knONAHWTTCoz34YQE+7FWUkQZwDM9AeQdtZ",
+	"qrQQmgC1mhp/XVy2BDTKeMurSbbcVkbc2E3GQwbbPt2eVD4NNghpbS9qWbX1SBjl6FrUX5d6SOUWi6xa",
+	"bksk3CAYLzsLGCOgBwNQCIYnmUDACN/KPwPGy1yjH0qEGScYR9qtSeJini0mjsbYURUfwe4jmqTg5FMI",
+	"fJ8n+vPpp10QzFsjNkdoW/F3jZi7L2m8iHr0HrQEcgF0m4Oy24w1eK06VojcCKMnl58qN7S8m7HgORmN",
+	"CWTaiIVnRFscasHI6gEFUoYf5BpzK0DOIsekPHfO0a1/Yq46BqlSCVe14A3GDZcW7eja4qEIswFJ/fVa",
+	"mGW+UcXCJapctu/Hwpik2STBURPo5R0+ebnRDts9aX7WaoBsO+PWpl+n9tXaWqmoztx7PdTWp2OdJ1tx",
+	"diwnqLLcle8j1wPEu53akcSR2SgzSIoYTaUB0vViG73r3dwGYXDev7pVvmtX1ze3PwVh0O+pyKXR9Z36",
+	"84MMZCp51dieT+JXU7i85G4R3sVPsQALGiN31ZQ8ICZVm8Zf6fz6ff/mNRhJo61D04KCiD7YpEZVO28X",
+	"qPirFDLBwQIuwcTgU1nb9LBXt73BlXdgCZakTEyaRr+i+fbokELeBf1FKpYAMgTzKac4SaxXywRG9zNG",
+	"MxJrtygDx9vB5WUDEEnSNP1t3tBMFGNpYRJqdQ65KNxJctGLDcJATlcmjOLbk5CGcZFx5EaP6mAmT4L7",
+	"YJO+ECSXvY0ajxLFAaf50097FGmNhDKnSdTkvg3V90euuFhtgdTNtDzsPDFXdXIeo1++NDGFt7ms7Hn9",
+	"6GBYlV2s8vD4+ddL8G8/D/s/gl8v/13eVDog/QHiRLlqQuUxNiY0E2kmTMqhQqHIywQiBwrCYHj1o2Ia",
+	"b4ZBGPTeD94GYfBTf3AehMHPv1boxbR6GmLJHwkewdWLOcWi/cJEaFKVYF6NPshv9zFZdb1bHizD+WSI",
+	"4+DqrYoZlQ7C5+f90SgIAx2Yd1HhvbbHkyCtJt47sqmf2jLG1MvRxV0h/Nk4u+Hlde/i92H/6mKgyMX8",
+	"0P91ONCru+n3LqSP9Nve4LKKAvvtSTBQXrmVEPangrDyy15VEXr6JjHBvKqKZhYgA4p7Xzk+Y7IVljKy",
+	"Pv2hzGwzlzzi9BOgDLw6O37sgusFFqKQnHVTMIccEGoHG5O6j1hw+mlvhqLtlAD+jdhWGaAXPtgUEp+T",
+	"5G4gXB3EObP9O9acmPwYrXk/luOpShuj4z519yd+SVp9rnEBKD8yumufa7odP7IYXfls2+QRkVNZabeL",
+	"x4Vc2/onRWmLNuTq+akBo18Gw2H/oniPuT6n6shrt0sqCqdLLWAswSPNkhhkKc9F18rbvRylvfb2GN5c",
+	"yztUf61cJWFgIP2Kl0r1UAx04x3DRNQoOwb5GgCfILrXihY3riddxSw2h/LuSFNElMKhTBRSXI2QkwUK",
+	"s7KHr1IRSodI003/irCYIwbuUSpy+oMMhVZNE8rLTFmOjYV8TDCZyrXJE2A9loxbXpTAIuLR/Fgm1l/6",
+	"/WEuy3kFvRIZtkrGYDO8des43DHqy45ssvghjvYVr7KNgOTjrztKRt+CZr4FzTyLoBn8NUXD5xaxs0WI",
+	"zpZ2iL2zlG+hQv9soUJ/qdCgjZ4LlYCgsMgTbo+uF2s+6a3Moeu5zPQHoA0liqikKjAEUhOo7h2pe9R6",
+	"Rd4FA5lpW+vQqJLONGC87jET+WKYVypdfWG5aDo1klEZ7PPhHdDfLJWaCwv823Hnh3/vgp/wTIJnzNAp",
+	"o3EWIcDdKGcwyQQQ8B4pX1DEinDEGKWIxFJsdTK4lw7zWaujnFDOE8T5+sg3vQ3cysu2Y7J0kpubrW8h",
+	"1mxgBmuglw8us6xmyDSfVKI7yrGOGXCdVE0IC0Mc/0PaspTpIue/kjmp+CtVp0J2ipAEqsxEjZONsZRh",
+	"Xsq2vAcxdwHZDDekztPfCk8qPQGKdeS7w9/dANZy/OrJq1YUQlMYNd7E5mPNt1PS+MnH0uQnbfzMakKf",
+	"YnwtrrViZoYSqDIMm+323H514I67p23cBes+mE6e0s1EsAY32F1yPpnYjiIV60WNpbvw+lmxjpH760Zs",
+	"bvd29MQUPrmHX9U95GA+fls8Jzz42fY9obbxXIWKbaBlss6GPlDOTlsxum+xvH+1WN5VESzl4BX/FrZ1",
+	"+DHKLc+74WuF9jZE9Ja2vjsmvSIJoWnCG0jDDmzyaZr5xsT8zsEjYghgIrQYGzfH+taNJtu+vvfNlPcZ",
+	"Zdzm7eTM51JKQcIlZrjiur4w18V+TMn55fMHnewRu1NMMJ+3tdb9QSe62gmKpezOlMEugiRCSeNd972C",
+	"6uVh77pm7Gx74UkCz1OY1yHRObrN7LItYBlxfEYYEgwrzYKQD8SScDAmbicwhTipH8236lfJBVXRN80L",
+	"/ZzV1shZk2/QOqnuVS4wg7WzQa+EYO0NItEQF3Y0z1RV6UN3AZyCKSwnnzttp1JTReJ6zamW1enQb9ai",
+	"sJE6JLIjD6002OqIbHZwW9nnK5yo8DiX0tpGqLR0GAIVHo7iClNQ691GvtvynjkIO9zMMF+UpnIPQWGa",
+	"d4injPEKLbe4QjY13BuA6liy6S1zw/rN3dWV/tfo7vy8379Q9vPz3tV5v+aVVfTalwm9MEY2mD9rlFuq",
+	"TbHxzaooKM+XapKlHkZs2bLQxtYHwuSMneN0j4uQaGpTLqd2dFTH0NbdWVdTq0b3Dka8SJDjlpJgV96Z",
+	"4D1Gj4hx5SDgJCXRXcbEtAsBirGgjIMFJNBeslw91nKdDAdQytFJoq2p9JEgJusc6h55ql7dpuwZcP3h",
+	"SjlU9y8Gt9fyH+8H/Q/V7K/5x1b+AA5m9usI4OB9ZzeV0mg7pDM149ygKWKIRJ640K+rFDnYe9l3ETWm",
+	"Hqk/e7wgFe+aKmBgkL+zy9E+RiuB2INUl4g5o9lsbpWJoTZvOY/0SsSU2xuYzmPC55SJToIfUFyJRlK+",
+	"6XkSbN3bwGPEKGk5k7tZiVsY3r25VN7nw5vBe1kbpXxr2a+tztd79/W39/O1r5O1owtYvtoncAK7QaYI",
+	"miay1RmFTD3htRFhJncTs2PXkpsoVp1/7iUqSs9UO0wSv3Yyz3+S96sI7L+1ZRQnpy/Q2ctX33XQ9z9M",
+	"Oien8YsOPHv5qnN2+urVydnJd2fHx8el2oMHzSqlaz1vkFMqDHIM9JJkg+ybebdmJEtT+r0yrKIIxZKp",
+	"AxWXZXf+wEbKqkKvRmcXKMIx4mBOH5V119XZFZwOlnR30tEwZ6Iqg5C29NOSV6JsJXV6yvPAz9A8h0FH",
+	"d17A5dqHWgyXvJICUL/RrFDDige4xAFDiXSdNGBrhwid0QvgKfgHYlXvhGMn28OLVy+Pj70ZH1wbpVn/",
+	"OqZW93F0Om+4dEl4Jf9kYi3L1l1UIaUSwlsE7GKepz8qr/7FhsuvMMocF5WVhbVt9jHRcm62w6U83EYx",
+	"uTLX4G7mOMxlkNr611wFBKWVtJ274ML8q9qMj4lUvSmLg1M5zBTxRER3gjOIyeqw5y2XdYMgp2Sz/boo",
+	"932uWSo3FstXEtEuKtw7vuYp7Wx9jqXJEkCylOSuAIVJBcwxyQtGOQrfiLK4qL0SowQqSTtLFYWBBSaZ",
+	"QHulJLvAppI9uqCPp3KPZ+Xti/Z8y0LamHh0fdpRXiQcjdtlHG3xHCgeyN6y1TBBHyAjWzLSR9XVZIK0",
+	"vEsZJwkVYzJBmMyUL4hMS6zCZRMEmdqIyD+q9R3ZP2vdUnd2qCtsC+tjc+ZWh8jWiwi9skBQR4V7Ilah",
+	"wTyx395dXhbFkLYpX2QGt3dvt1da6E4v7crQuiS+J5vtfgyx+09g+0zy6G58aXtBOOhlnTMqM7UOGOUo",
+	"Dov7FwoAnSJCgE7HRN+9vBa87A6jX0CKke2TJe2QnXiFd0bK0BR/asAUgqrskpOztjyL3cqlbIUr7A7e",
+	"/z667v96+7+XLzZnaIaJGehasCl1LnfWivkG3UHtvFLkbhC05LcWb5G7q7tR/wIsECTcK1FqUqRC35Dy",
+	"loVaz1oJkFXjVKoV6982Y8OV1R2cEztiVA2TPVDcd013EujLoDinHUwS+sgBBDJbTqpOuyQkaKIS1giD",
+	"TdOUkG3v3ddSf+Dcw68fGRYoCANjBLLf7Z/2symoZr6avyoftWYmcHyX8w7OL7ZTRW7Om9Z+tx2kkSxv",
+	"pf+wn1QZQJng13z/WMvxnXfchLCcjT44UX3AYl5c8TBJrqfB69824RnBl7CmU8kHrJ93y0jzOsHVOsL5",
+	"s1AHwwuG0YM8Z2Oi1FSPkMXV6EuX8354/O7Nr8s/332IL17+LR1Ol8O3L8mvt8uTs+F9+v6HX189LEfX",
+	"/1j8LU7/+Ol/fv3l9NXDZH4xu/jDd1XodQy+4uVeYbw5QBY2DxP+WNvjPV8SO1pQqqqkp7CkjCgTF5ih",
+	"yB9KL8HklAkQ2zaKPBP1/sw5oivU90bnKm59dF6NUB+ts5fFkzlKUsR4twzVjkc9H1ah5049j5SC2rEY",
+	"Ve4M4yurq0ZDZjIoZ8QWdQU9ApDKtVYkydVPVJP6yv4MBhfawq9bC5V+kxXJWVUf2WXhU9Z/7boOe0hN",
+	"+86ulCHpGW+rJ5u8aW0T066tfLA64Wlt7s1yoO6Q6t9rL9Ik+PTV07pghFSALTTUOyZ6EUCZPXWBKFGO",
+	"yXlmNdM2g18HOXTBdWFuQ58wFwWGdOZWeZ/qog//ChXN7lKOmHgOFc129cJffbKMV1PT0drSl65yw6pR",
+	"PjaC8lVKjCkjky8tDlJB0oIaO5T3aan0pwBPnfflSEApZEqdLSYzfSFafWzZRW0v5Si/FUnbv3kCOL00",
+	"z8uFGbnXVGuw9C4esJraJgWK/gWLrjUwEekMu5p1tOFld3xzFmZqBzVVaeMbHtGSa94ej+YcQZNOYtsC",
+	"Cnk3oMfieTRl/hzX9crU74iLisD6E5XbEkwp7fIXXbiA/6AEPnIlF/k21ngmfb2qE43pC0t7pBavF26l",
+	"Z1v3S4BFxnWlUFhkmB7e3YIFEnMad8H5HEX3eU2EmEa8K1GikaMebD31z9GLIykGcnEkdUizDMfoaGih",
+	"uGOJJkMtw3XnYpEoqBZUuR4JiCuZbXIR1eD/SMP//+7R8r/gJDo5baGCNrtjMyEa+godsvefl7pg5Usd",
+	"g2M3g0ToZjy13oLGiPefOq/JI+YoBBAQ9GgajoltaTTlXSD9EXPp1ga+SskWkyjJ4iLOM1Ngqjd8MYwN",
+	"Lfe8QrdKaPEtZdq3lGnfUqY9v5RpbiaU74+3yaCm6yOuSMmTe+4WKwNc0FQ7QRr7IHeyooDz0skYE300",
+	"8u9j0ooRfEup9i2l2ldOqVYXCfgWAYVeDxEpG+3TPWQBcYMEqD5Vnfvq08tf/nuN/m9j1lufZmvGi6P7",
+	"FczXfG2e9w86JzH14i6dU0HvGgVo+dXVy9bH9ib7lt24EoFthu98JzOGt40GLV59YcAznsqT1DKSVToN",
+	"5F2qqYiKD1/d0W6vJ8MbJGdJKY891afHoYRNwlHzLWkVhVqyrV28U1Vyfryr1VbSP622r8nheHcfYZ5q",
+	"JG1O43sI7dTRvjvZTvXansBgWhJ8dmfrDbnhdku/8iTVL/aZ1e6wFaVb5rXId7Zt0YkmhnSIPW1TbqJh",
+	"Xt9tkzflRy7uuimZ7cXjOM8QqxUoGv8rj9OKbBRG81ZKRlGsFQyNriOCBDDrtK5rUqnYrDHJG/M1FSF0",
+	"NYevV+ChToL1Qu7flLH7VsZurQt1iHC9PnRXHeUGD1znaduUFHRT3WY+5M6XfendvcON7xzqg1/78gpB",
+	"UcawWI7kMlxnvl6mNQdYAppj09iBfu30hoPOL32Hg8Dck3iCIEPM9td/2ZJ8wc8fpDhXRoOUk7RdkwPM",
+	"eVaUj5tBgR7hUhUheaTsXp2OekuWcZWkMndPUt+Y0sWa2ETNIdVmqdetgqqAXhKuhD2i9B6j0tr1T8Xa",
+	"70b9m/qyJS4xmVKPBkBzSfCjXotyFlSafpkUJXfZKAydctsFFgmq9w3CwFTwDF4Hx90TndwYEZji4HUg",
+	"o9AkUShvEwnHEUzx0cPJEZRGtqPCd1V+myHRYIFERDBsQrdlR8wF0+mIpbY3RlywLFJ/a0srL1I4L6jU",
+	"vTMUIeV9bAdKYle5UcQP66QVbGZ3J/e8G8QGmJ6E+ZLOdCoEtTYGF0go4bzBabVocnQ9nXIk/pYhtlRO",
+	"q2uaX+IFbt+6FwnKBnH79nItPeX6t1kfrfy5XaaodT/dZQPgzo3cMxWIbdrpjUqoaXp9DAOGeEoJ18zk",
+	"9PhY/s+U3dTsJU1MHOXRHyZKQDPRdSxWYcNQlT5xZfIdZco3YJolSkZSjsQoBorwQUJnlrQrhhzflPka",
+	"jlRewxvzp2aZ2WIB2dKel/rw1mnvt0CT7UfZq3wY3RhIcxTr1G8ToTxD4r/QdrUn2PRhkRqm7Ya7mdL2",
+	"uM9JUoxc3+IwSCn3bKQ+JK5XZKAvacTFGxov94ao+kS5F9wXLRgcdofWbpB5W1kk7m139MJzO3LuUNj2",
+	"DB59zr1yvug7UdJ2k+isWual8p18IZxORSevmqaDGiYJje65AkyLwjKfKa3keJmjJTAVlY23Z2zyKpjr",
+	"MlNBxW5+wjHRSSRNIg8xz7uCR0xi+qiGlS31BcsLK4/O2KZsAWNiE7ZgAiZQRHPElXmrnOoHC46Sqe+K",
+	"1lygQtubcamhRf1QvjQ9nOR033SapxpeR6+yX5wlBcXmW7A30tUIBHAF2Yb+++FHJA6L96fnDzUGbv1g",
+	"9obuH5Goje3l5JkH43X39r0gff8XQbMf/jO5CIxi7WDbrBHQYqdbXQlHsZObfM1ZtLzln+VMtuaFvrO5",
+	"Z14oD6fOtyIQL6fjLaUR23ofcZ7DuVHidvLu7bp74dcV0GWo2ZvlRs2vWbzB46/8Aljfvp8HfW3wip21",
+	"bmsDslp3eIsTJJNQD1X8fftuSpH113pXD4zuvjUPKJT9+3teFRkQ93XSj/IUixI8/+vMl3HzmV7rq5KD",
+	"Hvhib0kgguHZTDk0qcdErtg0cNerDm9PMjkyrPsrOgQBfTYe05Vnoe8RpP2En+biGGioVkoJK7bJJrbE",
+	"tkD6Xp8ypKi+sRfUH5mH7aozrBo8tx3Y38lrwZltyaO9bqlBLIAVktl2az076TGV5PXgAFRJZfOoJzeN",
+	"bL5iG6hnUsqOie1syjy5HbmAOfVrBUmKiLJ6VoTNMXG74aImyX/aHzl4nFNeLq1hyuxnRIYTjknhF2+B",
+	"9alQDI7/em95sz/7VvbVaXIDXl9N+bLyLVBJqfHkSvhDbmNlbRuIftVkHvsVAmujb6pn9wQmH1TdviIQ",
+	"+sAyWWNWn7Zq+AquD6OOr06yxSE9+sxLS20ljvnpYLOzO6pMu6u8dSiE50rk9chuViY/OcIOcAi2Z2MH",
+	"0TQ3zbGhxvnAO3Mo/fNz4YyttdGHOp4aHQAeiBfKDp17kxiypTDTGw5kKsl/zlNu82C2P+wmPdueZRU7",
+	"qlZOt+G9/kfPO6yiwtR1acZ0cuLxuYzuUl6/Ks5ZBchpoLqgb+Oie8OBjPNacv3GeYAJjkPApaUZCg2l",
+	"eptQoUh9sgQLTFRPPa/Mz6eKcTD0QO/zjFJJrDJamUIZKgUXB/wRS+WOMWOb3r6njVdCUpT3T8DEvMCn",
+	"2AL/3IU8ub0F5e+N8CWtlkm1LfXvxNqOPts8iCtFvxtJvAcnuBZeewbYbQVGdQgPsHsaPwCSnTcvs+FR",
+	"jReOCqB6ho5rv6DlI2XtbVA2qq29Jc1GED7BM/7OxKu1vQhNfNteveKIlJwgi+Zm9LbUc/RZ/s+c6KZX",
+	"SZ4iaGMiulODH142MRU9N9mBgzw2SgOveGE0RGYqBOjSxRX3eq1GtWrW2iVfSeW0+z4d6j1SyzZ14Ku7",
+	"FWHYt0emGu9LAaPc8oGwe6uYvJph47N5pK+iDkdF2ja/FDvCM5OlXXYFNBMAPSC2fJwjJhMYaKIyMVr2",
+	"grPDAsiQlD2lHldZGRYoxlDoomselbnsLfE7Mv0PxiJaXNI5avZ9SydJgZ9dttCEtDdvXbE3efS75uXG",
+	"0qJ3RSf7qbYwRg4ZTjYmmBi/1Yzk7XwbaK7IvwhzL6Nkb2RgkLT9vueb0Gw5vbNN/iJb4dDdfjcjR1S7",
+	"7cjE/MjkQVsjJstIs2He8qCxM+5E7QWW68HFOSjWsiNCTbBh8Pq3jzVJsjwTgA8QJyqdqwyWkwymg4mL",
+	"91JFNs8GqC40Ey75l5esOa2tLVIqpRFljCGbbbXQpAgVsHmPiE5UpDnjmKAHpHLLYgHmkIMJQgRENMUo",
+	"BijhSN+BPkaIZ+Q6E/VD15jhlvkvB3vvhgpRUn9TuYCDUAcz/qkeJXksI0ySIHQoqFq7tpYLaPN70kQa",
+	"y43YG2vEM6IW6O5U9ViuI4/PltS+HFniajqoIwGZkJMOtnjMDi7O7blrqz+4QbosQcML8sXxaR3nto9J",
+	"Gy4xUzpQeUi0GuKSGrw05l5QtKaHLKQ6QSuHsaCcam6FL4fhFGorLACmfCSprXQHIjiSYe4TGN03UsNb",
+	"TDCf75Mc6jsggaMM/0PNCiIaO3FAJjS6tGajojVraDrtcpzAjRDXCb+aN9ELm85Xka9JEkXKJAcU4Hx0",
+	"8xZAIWB0z5uAUN03gqIV/ft4jqWQgx2GAuvy2H2dE6HJcS9HwhOUWo8P54ULlJibrBBMxa/pzNxdoLRS",
+	"gCNUarnUjzxT7wnQaajz0+kgc24USWX3D70auaeltN9jwpG+A+0ETfHjwyJe8y/ht/N8gmHVXFiKZ56Y",
+	"WAOmn7qq4ZZrQmq+RbbtHtnWej/ax8h8C4/5Fu7yLdzlUOEuCsTWx9WkWeqYhE+r/CNNuq2by2ca5FKC",
+	"kiVPpau3860lCmMVqSUa2xtxDOQEAJZHr+To8sRYbEgwDYEtdenXJIbmlewGJu+7J2UBVv7yOqvPmDge",
+	"/FhwYNMNNGYtaMwxMDDr/RZZ49v1ZrfOr4G39c0/QCzuJLkMdRDXkxjL24bSlLj+QQS18si+7UyhiOZN",
+	"DqFf7SwcyjRbqlr5FKGMre2y+z3Idyanqkpe6RTSrJXPpNNVp31THq8ATZBAHX2FNCvEz42Ln3zUa19D",
+	"zanL6SHz/PKCKq5euqrG5NHmj+GCMkXoFOmKLYTKQupuYj+5Uj041wFZ5vcxiWSVHV5o3U0lRVUJxigL",
+	"dAacIrhUFlxIEl2HQd2YkJgE+gAmKmUqmCBMZrYLUvVqICC0Q9Mu0EKAhtRiTGrbtHpfqB2yPpCQyTG5",
+	"VIdmRDaSNzZimMY4AvwRodTrEGlG1TP9y0UpFkjVm2KocW9WAaUaLshBHiE9hSdwceuzNKVRpvDn9ac5",
+	"pw+I5VWKIkZTt+6DohVJzGqQau0NLhCMbfAhltQbzSnrgvd2AGWgj+b58No638mrSAiqHDPvbi5ldCIi",
+	"Y1LMZVI1FpasCEZzeSxVIQqpd7OhkugB00zDLguTSpMnGRNd59ccQkGLAr96fB+1O7z9rULaX+LGctbz",
+	"/G4qvRn7O1EmI8uURjABqqyfYoKSrCf0076vqbbhwXRajf3koB5sCygxFU/oI5FFrPI6umOSR/bqQ7ci",
+	"LvcZvTv+FeLJ29KOsSK0yZ75zjR9/mpkC+nm2mSLjr1qqApLjVdFVeiUmwJbbq2lTGUQn8MH5BjmoAAJ",
+	"glwASiJUdyvtxXEJK89UXVUF82kTrRnUrKMXGMc1WtkbqfTiGEAzqDKJriSVlue65Bu+Sj2lSlXQR6JT",
+	"p+dzl64DXQHdw+Dlh70SWXhwl1O1lkNtpcZIsZsqrffao5+JbbcmRgsqfFvjKXf+FbbmwIkZnzHDqOZn",
+	"3DOdef3TK3NtwzweS7UcGuUCp+TDc7ZhHlLgcFDQXthw0LtXOaMYt8katg0JbGATq9aieebGsXrpnCdi",
+	"IvWJ/0nMZcAtD7MTUX3O/71GOLHFmzJeK6qjisa6NWO7DTavDw7UB+ZTH4pl7Wr7enTr4Ow3v8lhtnHf",
+	"6vFio3368e6YOHpusF7NrTljaWAZKgXjZWim17kCUm+5MCzqWvFa+TBXDb5CbV05/8+HKvfL3TbQYxcY",
+	"3LMu+50c09VfFzOpzVt3AHQo0AI1CkI/InGuHefvtN/8cwmQdd35D2J89U6wxj8WlxhJobH8rA+d9Kv6",
+	"0ug0az3zufXL16YoW/G6VKwaTOECJ0vND3iGtevsmGi7E5ggrgtG2HYRJVxxCjMKgQsU27Egsf/k+sOY",
+	"aIaBhXETBgl9RCyCXAXZLNSc0yn+FGpDA+Tg72KeLSYE4qQDH/D07zpLiPOrrOn79y7QfmLaJpEq9sO0",
+	"nkcbDGP9Cuy9H7wNwYf+m2E4Jj//ehmCn/qD8xD8POz/qMAdXv0I4IJaQyMxwTm9KEKpMGXzpOMvfeSh",
+	"BsWpI25mx9F94SV/MbxRA6si5MZ8B+aYCN4F7saMScmyaWwyU0CocnVNhYpEquyZ2QTMzZYukQjHhDKT",
+	"oSU2/stnx68A1j3KC8kNo2pFeki9DXTqBQhhMUeswUsGPyD2ldw9Vhc3rZA2BbEGNo8QUEUm8wCB4kyt",
+	"jBIoig7mxOgr8VnLtoNiDIFsZvzXNUnIWzPGPE3gMgerWvROb17gB0Jt0JE8I6H+pzwY4X8c/UcboC6Q",
+	"cntX9fHLNew1eI0gXQxv/PCcumUtaTZJnJqWutagD46B5PfyujGoAKrwftVQCdL5kmNlEpIA80bo1KHz",
+	"wycr8LcpZ7gxpsCQoQjFiMuj3wjZCEWd8586zwN9BcgKYeuAPgBW+0RgsZR+KGVglZE456VraHEw7VxR",
+	"gjrvlO/SzgE9noA2gmZUYOgajpwwnnMJbOecEsGop9am/CzHSmmCo6Vdpw3rkW1WgRwG/Vs4C16vx5wH",
+	"yFXDrg8+2m7c90riqSNVaQryArTuwJgSEKMUyXuLrg9lenF8tspl1Ec6yo9U4CTRCcoOFExsrsLcNJ3f",
+	"2g4Gbb1dDZcj/pnOSyn4lef4XKpr+ttHeYrcSqn6F7d+6G8fJaUr3wpvWK8RwLX3BTOVa18HR+qdYwD6",
+	"nF8+Zbn0S5h/yYNQip+Mlbf4IV+W85sOXP/y8cv/HwAhHc5u5DMBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -9,6 +9,8 @@ type Handler struct {
 	projectSvc        port.ProjectService
 	userSvc           port.UserService
 	imageSvc          port.ImageService
+	watermarkSvc      port.WatermarkService
+	auditSvc          port.AuditService
 	healthCheckers    []port.HealthChecker
 }
 
@@ -20,6 +22,8 @@ func NewHandler(
 	projectSvc port.ProjectService,
 	userSvc port.UserService,
 	imageSvc port.ImageService,
+	watermarkSvc port.WatermarkService,
+	auditSvc port.AuditService,
 ) *Handler {
 	return &Handler{
 		authSvc:           authSvc,
@@ -27,6 +31,8 @@ func NewHandler(
 		projectSvc:        projectSvc,
 		userSvc:           userSvc,
 		imageSvc:          imageSvc,
+		watermarkSvc:      watermarkSvc,
+		auditSvc:          auditSvc,
 		healthCheckers:    healthCheckers,
 	}
 }
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// Audit handlers
+
+// ListAuditLogsAdmin lists audit entries (admin endpoint)
+func (h *Handler) ListAuditLogsAdmin(w http.ResponseWriter, r *http.Request,
+	params gen.ListAuditLogsAdminParams,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.ListAuditLogsAdmin")
+	defer span.End()
+
+	entries, err := h.auditSvc.List(ctx, ListAuditLogsAdminParamsToDomain(params))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("listing audit entries: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, AuditEntriesToWeb(entries))
+}
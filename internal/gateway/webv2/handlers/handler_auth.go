@@ -6,27 +6,39 @@ import (
 
 	"github.com/samber/lo"
 
+	"github.com/isutare412/imageer/internal/gateway/contextbag"
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
+	"github.com/isutare412/imageer/pkg/apperr"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
 
 // Authentication handlers
 
-// StartGoogleSignIn starts Google Sign-In process
-func (h *Handler) StartGoogleSignIn(w http.ResponseWriter, r *http.Request,
-	params gen.StartGoogleSignInParams,
+// ListAuthProviders lists OIDC providers available for sign-in
+func (h *Handler) ListAuthProviders(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.ListAuthProviders")
+	defer span.End()
+
+	providers := h.authSvc.ListSignInProviders(ctx)
+	gen.RespondJSON(w, http.StatusOK, AuthProvidersToWeb(providers))
+}
+
+// StartSignIn starts sign-in process with an OIDC provider
+func (h *Handler) StartSignIn(w http.ResponseWriter, r *http.Request,
+	provider gen.OIDCProviderPath, params gen.StartSignInParams,
 ) {
-	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.StartGoogleSignIn")
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.StartSignIn")
 	defer span.End()
 
-	req := domain.StartGoogleSignInRequest{
+	req := domain.StartSignInRequest{
 		HTTPReq:      r,
+		Provider:     provider,
 		RedirectPath: lo.FromPtr(params.Redirect),
 	}
-	resp, err := h.authSvc.StartGoogleSignIn(ctx, req)
+	resp, err := h.authSvc.StartSignIn(ctx, req)
 	if err != nil {
-		gen.RespondError(w, r, fmt.Errorf("start google sign-in: %w", err))
+		gen.RespondError(w, r, fmt.Errorf("start sign-in: %w", err))
 		return
 	}
 
@@ -34,21 +46,22 @@ func (h *Handler) StartGoogleSignIn(w http.ResponseWriter, r *http.Request,
 	http.Redirect(w, r, resp.RedirectURL, http.StatusFound)
 }
 
-// FinishGoogleSignIn finishes Google Sign-In process
-func (h *Handler) FinishGoogleSignIn(w http.ResponseWriter, r *http.Request,
-	params gen.FinishGoogleSignInParams,
+// FinishSignIn finishes sign-in process with an OIDC provider
+func (h *Handler) FinishSignIn(w http.ResponseWriter, r *http.Request,
+	provider gen.OIDCProviderPath, params gen.FinishSignInParams,
 ) {
-	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.FinishGoogleSignIn")
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.FinishSignIn")
 	defer span.End()
 
-	req := domain.FinishGoogleSignInRequest{
+	req := domain.FinishSignInRequest{
 		HTTPReq:  r,
+		Provider: provider,
 		AuthCode: params.Code,
 		State:    params.State,
 	}
-	resp, err := h.authSvc.FinishGoogleSignIn(ctx, req)
+	resp, err := h.authSvc.FinishSignIn(ctx, req)
 	if err != nil {
-		gen.RespondError(w, r, fmt.Errorf("finish google sign-in: %w", err))
+		gen.RespondError(w, r, fmt.Errorf("finish sign-in: %w", err))
 		return
 	}
 
@@ -57,12 +70,35 @@ func (h *Handler) FinishGoogleSignIn(w http.ResponseWriter, r *http.Request,
 	http.Redirect(w, r, resp.RedirectURL, http.StatusFound)
 }
 
-// SignOut signs out the current user by clearing the user cookie
-func (h *Handler) SignOut(w http.ResponseWriter, r *http.Request) {
+// SignOut signs out the current user by revoking the session and clearing the
+// user cookie
+func (h *Handler) SignOut(w http.ResponseWriter, r *http.Request, params gen.SignOutParams) {
 	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.SignOut")
 	defer span.End()
 
-	resp := h.authSvc.SignOut(ctx)
+	bag, ok := contextbag.BagFromContext(ctx)
+	if !ok || bag.Identity == nil {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("No authentication provided"))
+		return
+	}
+
+	identity, ok := bag.Identity.(domain.UserTokenIdentity)
+	if !ok {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeForbidden).
+			WithSummary("Must be user token authentication"))
+		return
+	}
+
+	resp, err := h.authSvc.SignOut(ctx, domain.SignOutRequest{
+		SessionID:   identity.Payload.SessionID,
+		UserID:      identity.Payload.UserID,
+		AllSessions: lo.FromPtr(params.All),
+	})
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("sign out: %w", err))
+		return
+	}
 
 	http.SetCookie(w, resp.UserCookie)
 	gen.RespondNoContent(w, http.StatusOK)
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+
+	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
@@ -67,6 +70,70 @@ func (h *Handler) GetImage(
 	gen.RespondJSON(w, http.StatusOK, ImageToWeb(image))
 }
 
+// UpdateImageFocus sets the focal point or crop box of an image
+func (h *Handler) UpdateImageFocus(
+	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath, imageID gen.ImageIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.UpdateImageFocus")
+	defer span.End()
+
+	var req gen.ImageFocus
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeBadRequest).
+			WithCause(err).
+			WithSummary("Failed to parse request body"))
+		return
+	}
+
+	image, err := h.imageSvc.UpdateFocus(ctx, UpdateImageFocusRequestToDomain(imageID, req))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("updating image focus: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ImageToWeb(image))
+}
+
+// UpdateImage updates the external ID, tags or metadata of an image
+func (h *Handler) UpdateImage(
+	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath, imageID gen.ImageIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.UpdateImage")
+	defer span.End()
+
+	var req gen.UpdateImageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeBadRequest).
+			WithCause(err).
+			WithSummary("Failed to parse request body"))
+		return
+	}
+
+	image, err := h.imageSvc.UpdateDetails(ctx, UpdateImageRequestToDomain(imageID, req))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("updating image: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ImageToWeb(image))
+}
+
+// CompleteUpload starts processing of an image uploaded by the client
+func (h *Handler) CompleteUpload(
+	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath, imageID gen.ImageIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.CompleteUpload")
+	defer span.End()
+
+	image, err := h.imageSvc.CompleteUpload(ctx, string(imageID))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("completing image upload: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ImageToWeb(image))
+}
+
 // ReprocessImagesAdmin reprocesses multiple images in a project (admin endpoint)
 func (h *Handler) ReprocessImagesAdmin(w http.ResponseWriter, r *http.Request,
 	projectID gen.ProjectIDPath,
@@ -107,6 +174,22 @@ func (h *Handler) DeleteImageAdmin(
 	gen.RespondNoContent(w, http.StatusOK)
 }
 
+// RestoreImageAdmin restores a deleted image (admin endpoint)
+func (h *Handler) RestoreImageAdmin(
+	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath, imageID gen.ImageIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.RestoreImageAdmin")
+	defer span.End()
+
+	image, err := h.imageSvc.Restore(ctx, imageID)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("restoring image: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ImageToWeb(image))
+}
+
 // ListImages lists all images in a project
 func (h *Handler) ListImages(
 	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath,
@@ -138,3 +221,64 @@ func (h *Handler) DeleteImage(
 
 	gen.RespondNoContent(w, http.StatusOK)
 }
+
+// RestoreImage restores a deleted image
+func (h *Handler) RestoreImage(
+	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath, imageID gen.ImageIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.RestoreImage")
+	defer span.End()
+
+	image, err := h.imageSvc.Restore(ctx, imageID)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("restoring image: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ImageToWeb(image))
+}
+
+// DeliverImage redirects to the image variant negotiated for the client
+func (h *Handler) DeliverImage(
+	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath, imageID gen.ImageIDPath,
+	presetName string, params gen.DeliverImageParams,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.DeliverImage")
+	defer span.End()
+
+	req := domain.DeliverImageRequest{
+		ProjectID:  projectID,
+		ImageID:    imageID,
+		PresetName: presetName,
+		Accept:     lo.FromPtr(params.Accept),
+		DPR:        lo.CoalesceOrEmpty(params.SecCHDPR, params.DPR),
+		Width:      lo.CoalesceOrEmpty(params.SecCHWidth, params.Width),
+	}
+	delivery, err := h.imageSvc.Deliver(ctx, req)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("delivering image: %w", err))
+		return
+	}
+
+	w.Header().Set("Vary", "Accept, DPR, Width, Sec-CH-DPR, Sec-CH-Width")
+	w.Header().Set("Accept-CH", "DPR, Width, Sec-CH-DPR, Sec-CH-Width")
+	w.Header().Set("ETag", delivery.ETag)
+	w.Header().Set("Cache-Control", delivery.CacheControl)
+
+	if etagMatches(lo.FromPtr(params.IfNoneMatch), delivery.ETag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	http.Redirect(w, r, delivery.URL, http.StatusFound)
+}
+
+func etagMatches(ifNoneMatch, etag string) bool {
+	for tag := range strings.SplitSeq(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
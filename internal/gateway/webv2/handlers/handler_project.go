@@ -5,13 +5,55 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/internal/gateway/contextbag"
+	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
 	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/serviceaccounts"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
 
 // Project handlers
 
+// ListProjects lists projects accessible to the caller
+func (h *Handler) ListProjects(w http.ResponseWriter, r *http.Request, params gen.ListProjectsParams) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.ListProjects")
+	defer span.End()
+
+	bag, ok := contextbag.BagFromContext(ctx)
+	if !ok || bag.Identity == nil {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeUnauthorized).
+			WithSummary("No authentication provided"))
+		return
+	}
+
+	listParams := ListProjectsParamsToDomain(params)
+	switch id := bag.Identity.(type) {
+	case domain.UserTokenIdentity:
+		if !id.Payload.IsAdmin() {
+			listParams.SearchFilter.MemberUserID = &id.Payload.UserID
+		}
+
+	case domain.ServiceAccountIdentity:
+		if id.ServiceAccount.AccessScope != serviceaccounts.AccessScopeFull {
+			listParams.SearchFilter.IDs = lo.Map(id.ServiceAccount.Projects,
+				func(p domain.ProjectReference, _ int) string {
+					return p.ID
+				})
+		}
+	}
+
+	projects, err := h.projectSvc.List(ctx, listParams)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("listing projects: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ProjectsToWeb(projects))
+}
+
 // GetProject gets project details
 func (h *Handler) GetProject(w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath) {
 	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.GetProject")
@@ -105,10 +147,117 @@ func (h *Handler) DeleteProjectAdmin(w http.ResponseWriter, r *http.Request, pro
 	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.DeleteProjectAdmin")
 	defer span.End()
 
-	if err := h.projectSvc.Delete(ctx, projectID); err != nil {
+	deletion, err := h.projectSvc.Delete(ctx, projectID)
+	if err != nil {
 		gen.RespondError(w, r, fmt.Errorf("deleting project: %w", err))
 		return
 	}
 
+	gen.RespondJSON(w, http.StatusAccepted, ProjectDeletionToWeb(deletion))
+}
+
+// GetProjectDeletionAdmin gets the latest deletion job of a project (admin endpoint)
+func (h *Handler) GetProjectDeletionAdmin(w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.GetProjectDeletionAdmin")
+	defer span.End()
+
+	deletion, err := h.projectSvc.GetDeletion(ctx, projectID)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("getting project deletion: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ProjectDeletionToWeb(deletion))
+}
+
+// RestoreProjectAdmin restores a deleted project (admin endpoint)
+func (h *Handler) RestoreProjectAdmin(w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.RestoreProjectAdmin")
+	defer span.End()
+
+	project, err := h.projectSvc.Restore(ctx, projectID)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("restoring project: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ProjectToWeb(project))
+}
+
+// Project member handlers
+
+// ListProjectMembers lists members of a project
+func (h *Handler) ListProjectMembers(w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.ListProjectMembers")
+	defer span.End()
+
+	members, err := h.projectSvc.ListMembers(ctx, projectID)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("listing project members: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ProjectMembersToWeb(members))
+}
+
+// AddProjectMember adds a member to a project
+func (h *Handler) AddProjectMember(w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.AddProjectMember")
+	defer span.End()
+
+	var req gen.AddProjectMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Failed to parse request body").
+			WithCause(err))
+		return
+	}
+
+	member, err := h.projectSvc.AddMember(ctx, AddProjectMemberRequestToDomain(projectID, req))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("adding project member: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ProjectMemberToWeb(member))
+}
+
+// UpdateProjectMember changes the role of a project member
+func (h *Handler) UpdateProjectMember(w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath,
+	userID gen.UserIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.UpdateProjectMember")
+	defer span.End()
+
+	var req gen.UpdateProjectMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Failed to parse request body").
+			WithCause(err))
+		return
+	}
+
+	member, err := h.projectSvc.UpdateMember(ctx,
+		UpdateProjectMemberRequestToDomain(projectID, userID, req))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("updating project member: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ProjectMemberToWeb(member))
+}
+
+// RemoveProjectMember removes a member from a project
+func (h *Handler) RemoveProjectMember(w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath,
+	userID gen.UserIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.RemoveProjectMember")
+	defer span.End()
+
+	if err := h.projectSvc.RemoveMember(ctx, projectID, userID); err != nil {
+		gen.RespondError(w, r, fmt.Errorf("removing project member: %w", err))
+		return
+	}
+
 	gen.RespondNoContent(w, http.StatusOK)
 }
@@ -105,3 +105,60 @@ func (h *Handler) DeleteServiceAccountAdmin(
 
 	gen.RespondNoContent(w, http.StatusOK)
 }
+
+// ListServiceAccountAPIKeysAdmin lists API keys of a service account (admin endpoint)
+func (h *Handler) ListServiceAccountAPIKeysAdmin(
+	w http.ResponseWriter, r *http.Request, serviceAccountID gen.ServiceAccountIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.ListServiceAccountAPIKeysAdmin")
+	defer span.End()
+
+	keys, err := h.serviceAccountSvc.ListAPIKeys(ctx, serviceAccountID)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("listing API keys: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ServiceAccountAPIKeysToWeb(keys))
+}
+
+// CreateServiceAccountAPIKeyAdmin mints a new API key of a service account (admin endpoint)
+func (h *Handler) CreateServiceAccountAPIKeyAdmin(
+	w http.ResponseWriter, r *http.Request, serviceAccountID gen.ServiceAccountIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.CreateServiceAccountAPIKeyAdmin")
+	defer span.End()
+
+	var req gen.CreateServiceAccountAPIKeyAdminRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Failed to parse request body").
+			WithCause(err))
+		return
+	}
+
+	account, err := h.serviceAccountSvc.CreateAPIKey(ctx,
+		CreateServiceAccountAPIKeyAdminRequestToDomain(serviceAccountID, req))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("creating API key: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, ServiceAccountWithAPIKeyToWeb(account))
+}
+
+// RevokeServiceAccountAPIKeyAdmin revokes an API key of a service account (admin endpoint)
+func (h *Handler) RevokeServiceAccountAPIKeyAdmin(
+	w http.ResponseWriter, r *http.Request, serviceAccountID gen.ServiceAccountIDPath,
+	apiKeyID gen.APIKeyIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.RevokeServiceAccountAPIKeyAdmin")
+	defer span.End()
+
+	if err := h.serviceAccountSvc.RevokeAPIKey(ctx, serviceAccountID, apiKeyID); err != nil {
+		gen.RespondError(w, r, fmt.Errorf("revoking API key: %w", err))
+		return
+	}
+
+	gen.RespondNoContent(w, http.StatusOK)
+}
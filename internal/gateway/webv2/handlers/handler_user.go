@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -40,3 +41,104 @@ func (h *Handler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 
 	gen.RespondJSON(w, http.StatusOK, UserToWeb(user))
 }
+
+// Admin User handlers
+
+// ListUsersAdmin lists and searches users (admin endpoint)
+func (h *Handler) ListUsersAdmin(w http.ResponseWriter, r *http.Request, params gen.ListUsersAdminParams) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.ListUsersAdmin")
+	defer span.End()
+
+	users, err := h.userSvc.List(ctx, ListUsersAdminParamsToDomain(params))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("listing users: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, UsersToWeb(users))
+}
+
+// GetUserAdmin gets user details (admin endpoint)
+func (h *Handler) GetUserAdmin(w http.ResponseWriter, r *http.Request, userID gen.UserIDPath) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.GetUserAdmin")
+	defer span.End()
+
+	user, err := h.userSvc.GetByID(ctx, userID)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("getting user by id: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, UserToWeb(user))
+}
+
+// UpdateUserAdmin changes the role of a user (admin endpoint)
+func (h *Handler) UpdateUserAdmin(w http.ResponseWriter, r *http.Request, userID gen.UserIDPath) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.UpdateUserAdmin")
+	defer span.End()
+
+	var req gen.UpdateUserAdminRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Failed to parse request body").
+			WithCause(err))
+		return
+	}
+
+	user, err := h.userSvc.Update(ctx, UpdateUserAdminRequestToDomain(userID, req))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("updating user: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, UserToWeb(user))
+}
+
+// SuspendUserAdmin suspends a user (admin endpoint)
+func (h *Handler) SuspendUserAdmin(w http.ResponseWriter, r *http.Request, userID gen.UserIDPath) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.SuspendUserAdmin")
+	defer span.End()
+
+	user, err := h.userSvc.Update(ctx, domain.UpdateUserRequest{
+		ID:        userID,
+		Suspended: new(true),
+	})
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("suspending user: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, UserToWeb(user))
+}
+
+// UnsuspendUserAdmin unsuspends a user (admin endpoint)
+func (h *Handler) UnsuspendUserAdmin(w http.ResponseWriter, r *http.Request, userID gen.UserIDPath) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.UnsuspendUserAdmin")
+	defer span.End()
+
+	user, err := h.userSvc.Update(ctx, domain.UpdateUserRequest{
+		ID:        userID,
+		Suspended: new(false),
+	})
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("unsuspending user: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, UserToWeb(user))
+}
+
+// RevokeUserSessionsAdmin signs a user out everywhere (admin endpoint)
+func (h *Handler) RevokeUserSessionsAdmin(w http.ResponseWriter, r *http.Request,
+	userID gen.UserIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.RevokeUserSessionsAdmin")
+	defer span.End()
+
+	if err := h.authSvc.RevokeSessions(ctx, userID); err != nil {
+		gen.RespondError(w, r, fmt.Errorf("revoking user sessions: %w", err))
+		return
+	}
+
+	gen.RespondNoContent(w, http.StatusOK)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+// Watermark handlers
+
+// CreateWatermarkUploadURL issues a presigned URL for uploading a watermark
+func (h *Handler) CreateWatermarkUploadURL(w http.ResponseWriter, r *http.Request,
+	projectID gen.ProjectIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.CreateWatermarkUploadURL")
+	defer span.End()
+
+	var req gen.CreateWatermarkUploadURLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		gen.RespondError(w, r, apperr.NewError(apperr.CodeBadRequest).
+			WithCause(err).
+			WithSummary("Failed to parse request body"))
+		return
+	}
+
+	uploadURL, err := h.watermarkSvc.CreateUploadURL(ctx,
+		CreateWatermarkUploadURLRequestToDomain(projectID, req))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("creating watermark upload url: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, WatermarkUploadURLToWeb(uploadURL))
+}
+
+// ListWatermarks lists all watermarks in a project
+func (h *Handler) ListWatermarks(
+	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath,
+	params gen.ListWatermarksParams,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.ListWatermarks")
+	defer span.End()
+
+	watermarks, err := h.watermarkSvc.List(ctx, ListWatermarksParamsToDomain(projectID, params))
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("listing watermarks: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, WatermarksToWeb(watermarks))
+}
+
+// CompleteWatermarkUpload marks a watermark uploaded by the client ready
+func (h *Handler) CompleteWatermarkUpload(
+	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath,
+	watermarkID gen.WatermarkIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.CompleteWatermarkUpload")
+	defer span.End()
+
+	watermark, err := h.watermarkSvc.CompleteUpload(ctx, watermarkID)
+	if err != nil {
+		gen.RespondError(w, r, fmt.Errorf("completing watermark upload: %w", err))
+		return
+	}
+
+	gen.RespondJSON(w, http.StatusOK, WatermarkToWeb(watermark))
+}
+
+// DeleteWatermark deletes a watermark
+func (h *Handler) DeleteWatermark(
+	w http.ResponseWriter, r *http.Request, projectID gen.ProjectIDPath,
+	watermarkID gen.WatermarkIDPath,
+) {
+	ctx, span := tracing.StartSpan(r.Context(), "web.handlers.DeleteWatermark")
+	defer span.End()
+
+	if err := h.watermarkSvc.Delete(ctx, watermarkID); err != nil {
+		gen.RespondError(w, r, fmt.Errorf("deleting watermark: %w", err))
+		return
+	}
+
+	gen.RespondNoContent(w, http.StatusOK)
+}
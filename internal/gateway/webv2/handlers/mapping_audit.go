@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
+)
+
+func AuditEntryToWeb(e domain.AuditEntry) gen.AuditEntry {
+	return gen.AuditEntry{
+		ID:         e.ID,
+		CreatedAt:  e.CreatedAt,
+		ActorType:  e.ActorType,
+		ActorID:    e.ActorID,
+		ActorName:  e.ActorName,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		RequestID:  e.RequestID,
+		RemoteIP:   e.RemoteIP,
+		Changes: lo.Map(e.Changes, func(c domain.AuditChange, _ int) gen.AuditChange {
+			return gen.AuditChange{
+				Field:  c.Field,
+				Before: c.Before,
+				After:  c.After,
+			}
+		}),
+	}
+}
+
+func AuditEntriesToWeb(entries domain.AuditEntries) gen.AuditEntries {
+	return gen.AuditEntries{
+		Items: lo.Map(entries.Items, func(e domain.AuditEntry, _ int) gen.AuditEntry {
+			return AuditEntryToWeb(e)
+		}),
+		Total: entries.Total,
+	}
+}
+
+func ListAuditLogsAdminParamsToDomain(params gen.ListAuditLogsAdminParams,
+) domain.ListAuditEntriesParams {
+	var offset *int
+	if params.Offset != nil {
+		v := int(*params.Offset)
+		offset = &v
+	}
+
+	var limit *int
+	if params.Limit != nil {
+		v := int(*params.Limit)
+		limit = &v
+	}
+
+	return domain.ListAuditEntriesParams{
+		Offset: offset,
+		Limit:  limit,
+		SearchFilter: domain.AuditEntrySearchFilter{
+			ActorID:         params.ActorID,
+			Action:          params.Action,
+			TargetType:      params.TargetType,
+			TargetID:        params.TargetID,
+			CreatedAtAfter:  params.CreatedAfter,
+			CreatedAtBefore: params.CreatedBefore,
+		},
+	}
+}
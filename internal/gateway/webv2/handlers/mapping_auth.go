@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
+)
+
+func AuthProvidersToWeb(providers []domain.OIDCProvider) gen.AuthProviders {
+	return gen.AuthProviders{
+		Items: lo.Map(providers, func(p domain.OIDCProvider, _ int) gen.AuthProvider {
+			return gen.AuthProvider{
+				Name:        p.Name,
+				DisplayName: p.DisplayName,
+			}
+		}),
+	}
+}
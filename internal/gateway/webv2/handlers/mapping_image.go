@@ -6,22 +6,78 @@ import (
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
 	"github.com/isutare412/imageer/pkg/dbhelpers"
+	"github.com/isutare412/imageer/pkg/images"
 )
 
 func ImageToWeb(img domain.Image) gen.Image {
 	return gen.Image{
-		ID:        img.ID,
-		CreatedAt: img.CreatedAt,
-		UpdatedAt: img.UpdatedAt,
-		Format:    img.Format,
-		State:     img.State,
-		URL:       img.URL,
+		ID:            img.ID,
+		CreatedAt:     img.CreatedAt,
+		UpdatedAt:     img.UpdatedAt,
+		DeletedAt:     img.DeletedAt,
+		Format:        img.Format,
+		State:         img.State,
+		URL:           lo.EmptyableToPtr(img.URL),
+		URLExpireAt:   img.URLExpireAt,
+		OriginalState: img.OriginalState,
+		Focus:         ImageFocusToWeb(img.Focus),
+		ExternalID:    lo.EmptyableToPtr(img.ExternalID),
+		Tags:          lo.CoalesceSliceOrEmpty(img.Tags),
+		Metadata:      lo.CoalesceMapOrEmpty(img.Metadata),
+		Srcsets:       img.Srcsets(),
 		Variants: lo.Map(img.Variants, func(iv domain.ImageVariant, _ int) gen.ImageVariant {
 			return ImageVariantToWeb(iv)
 		}),
 	}
 }
 
+func ImageFocusToWeb(f domain.ImageFocus) *gen.ImageFocus {
+	if f.IsZero() {
+		return nil
+	}
+
+	var focus gen.ImageFocus
+	if p := f.FocalPoint; p != nil {
+		focus.FocalPoint = &gen.FocalPoint{
+			X: p.X,
+			Y: p.Y,
+		}
+	}
+	if b := f.CropBox; b != nil {
+		focus.CropBox = &gen.CropBox{
+			X:      b.X,
+			Y:      b.Y,
+			Width:  b.Width,
+			Height: b.Height,
+		}
+	}
+	return &focus
+}
+
+func UpdateImageFocusRequestToDomain(imageID string, req gen.ImageFocus,
+) domain.UpdateImageFocusRequest {
+	var focus domain.ImageFocus
+	if p := req.FocalPoint; p != nil {
+		focus.FocalPoint = &images.FocalPoint{
+			X: p.X,
+			Y: p.Y,
+		}
+	}
+	if b := req.CropBox; b != nil {
+		focus.CropBox = &images.CropBox{
+			X:      b.X,
+			Y:      b.Y,
+			Width:  b.Width,
+			Height: b.Height,
+		}
+	}
+
+	return domain.UpdateImageFocusRequest{
+		ImageID: imageID,
+		Focus:   focus,
+	}
+}
+
 func ImageVariantToWeb(iv domain.ImageVariant) gen.ImageVariant {
 	return gen.ImageVariant{
 		ID:         iv.ID,
@@ -32,6 +88,7 @@ func ImageVariantToWeb(iv domain.ImageVariant) gen.ImageVariant {
 		URL:        iv.URL,
 		PresetID:   iv.Preset.ID,
 		PresetName: iv.Preset.Name,
+		Descriptor: lo.EmptyableToPtr(string(iv.Descriptor)),
 	}
 }
 
@@ -42,6 +99,19 @@ func CreateUploadURLRequestToDomain(projID string, req gen.CreateUploadURLReques
 		FileName:    req.FileName,
 		Format:      req.Format,
 		PresetNames: req.PresetNames,
+		ExternalID:  lo.FromPtr(req.ExternalID),
+		Tags:        req.Tags,
+		Metadata:    req.Metadata,
+	}
+}
+
+func UpdateImageRequestToDomain(imageID string, req gen.UpdateImageRequest,
+) domain.UpdateImageDetailsRequest {
+	return domain.UpdateImageDetailsRequest{
+		ImageID:    imageID,
+		ExternalID: req.ExternalID,
+		Tags:       lo.FromPtr(req.Tags),
+		Metadata:   lo.FromPtr(req.Metadata),
 	}
 }
 
@@ -100,7 +170,15 @@ func ListImagesAdminParamsToDomain(projectID string, params gen.ListImagesAdminP
 		Offset: offset,
 		Limit:  limit,
 		SearchFilter: domain.ImageSearchFilter{
-			ProjectID: &projectID,
+			ProjectID:       &projectID,
+			Deleted:         lo.FromPtr(params.Deleted),
+			ExternalID:      params.ExternalID,
+			FileNamePrefix:  params.FileNamePrefix,
+			Format:          params.Format,
+			Tags:            lo.FromPtr(params.Tag),
+			Metadata:        lo.FromPtr(params.Metadata),
+			CreatedAtAfter:  params.CreatedAfter,
+			CreatedAtBefore: params.CreatedBefore,
 		},
 		SortFilter: sortFilter,
 	}
@@ -141,7 +219,14 @@ func ListImagesParamsToDomain(projectID string, params gen.ListImagesParams,
 		Offset: offset,
 		Limit:  limit,
 		SearchFilter: domain.ImageSearchFilter{
-			ProjectID: &projectID,
+			ProjectID:       &projectID,
+			ExternalID:      params.ExternalID,
+			FileNamePrefix:  params.FileNamePrefix,
+			Format:          params.Format,
+			Tags:            lo.FromPtr(params.Tag),
+			Metadata:        lo.FromPtr(params.Metadata),
+			CreatedAtAfter:  params.CreatedAfter,
+			CreatedAtBefore: params.CreatedBefore,
 		},
 		SortFilter: sortFilter,
 	}
@@ -1,25 +1,37 @@
 package handlers
 
 import (
+	"github.com/samber/lo"
+
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
 	"github.com/isutare412/imageer/pkg/images"
 )
 
 func PresetToWeb(t domain.Preset) gen.Preset {
-	return gen.Preset{
-		ID:        t.ID,
-		CreatedAt: t.CreatedAt,
-		UpdatedAt: t.UpdatedAt,
-		Name:      t.Name,
-		Default:   t.Default,
-		Format:    t.Format,
-		Quality:   int64(t.Quality),
-		Fit:       t.Fit,
-		Anchor:    t.Anchor,
-		Width:     t.Width,
-		Height:    t.Height,
+	preset := gen.Preset{
+		ID:                 t.ID,
+		CreatedAt:          t.CreatedAt,
+		UpdatedAt:          t.UpdatedAt,
+		Name:               t.Name,
+		Default:            t.Default,
+		Format:             t.Format,
+		Quality:            int64(t.Quality),
+		Fit:                t.Fit,
+		Anchor:             t.Anchor,
+		Width:              t.Width,
+		Height:             t.Height,
+		WithoutEnlargement: t.WithoutEnlargement,
+		Encoder:            PresetEncoderToWeb(t.Encoder),
+		Watermark:          PresetWatermarkToWeb(t.Watermark),
+	}
+	if len(t.Densities) > 0 {
+		preset.Densities = &t.Densities
+	}
+	if len(t.Widths) > 0 {
+		preset.Widths = &t.Widths
 	}
+	return preset
 }
 
 func CreatePresetRequestToDomain(req gen.CreatePresetRequest) domain.CreatePresetRequest {
@@ -38,6 +50,14 @@ func CreatePresetRequestToDomain(req gen.CreatePresetRequest) domain.CreatePrese
 		Anchor:  req.Anchor,
 		Width:   req.Width,
 		Height:  req.Height,
+
+		WithoutEnlargement: req.WithoutEnlargement,
+
+		Densities: lo.FromPtr(req.Densities),
+		Widths:    lo.FromPtr(req.Widths),
+
+		Encoder:   PresetEncoderToDomain(req.Encoder),
+		Watermark: PresetWatermarkToDomain(req.Watermark),
 	}
 }
 
@@ -58,5 +78,38 @@ func UpsertPresetRequestToDomain(req gen.UpsertPresetRequest) domain.UpsertPrese
 		Anchor:  req.Anchor,
 		Width:   req.Width,
 		Height:  req.Height,
+
+		WithoutEnlargement: req.WithoutEnlargement,
+
+		Densities: lo.FromPtr(req.Densities),
+		Widths:    lo.FromPtr(req.Widths),
+
+		Encoder:         PresetEncoderToDomain(req.Encoder),
+		Watermark:       PresetWatermarkToDomain(req.Watermark),
+		RemoveWatermark: lo.FromPtr(req.RemoveWatermark),
+	}
+}
+
+func PresetEncoderToWeb(e *domain.PresetEncoder) *gen.PresetEncoder {
+	if e == nil {
+		return nil
+	}
+
+	return &gen.PresetEncoder{
+		Effort:            e.Effort,
+		Lossless:          e.Lossless,
+		ChromaSubsampling: e.ChromaSubsampling,
+	}
+}
+
+func PresetEncoderToDomain(e *gen.PresetEncoder) *domain.PresetEncoder {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.PresetEncoder{
+		Effort:            e.Effort,
+		Lossless:          e.Lossless,
+		ChromaSubsampling: e.ChromaSubsampling,
 	}
 }
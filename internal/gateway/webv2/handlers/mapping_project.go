@@ -5,14 +5,21 @@ import (
 
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
+	"github.com/isutare412/imageer/pkg/projects"
 )
 
 func ProjectToWeb(p domain.Project) gen.Project {
 	return gen.Project{
-		ID:        p.ID,
-		CreatedAt: p.CreatedAt,
-		UpdatedAt: p.UpdatedAt,
-		Name:      p.Name,
+		ID:             p.ID,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+		DeletedAt:      p.DeletedAt,
+		Name:           p.Name,
+		Visibility:     p.Visibility,
+		KeyTemplate:    (*string)(p.KeyTemplate),
+		CdnBaseURL:     p.CDNBaseURL,
+		StorageProfile: lo.EmptyableToPtr(p.StorageProfile),
+		Retention:      RetentionPolicyToWeb(p.Retention),
 		Presets: lo.Map(p.Presets,
 			func(t domain.Preset, _ int) gen.Preset {
 				return PresetToWeb(t)
@@ -21,6 +28,41 @@ func ProjectToWeb(p domain.Project) gen.Project {
 	}
 }
 
+func ProjectDeletionToWeb(d domain.ProjectDeletion) gen.ProjectDeletion {
+	return gen.ProjectDeletion{
+		ID:           d.ID,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+		ProjectID:    d.ProjectID,
+		ProjectName:  d.ProjectName,
+		State:        d.State,
+		StartAfter:   d.StartAfter,
+		TotalImages:  d.TotalImages,
+		PurgedImages: d.PurgedImages,
+		LastError:    lo.EmptyableToPtr(d.LastError),
+		FinishedAt:   d.FinishedAt,
+	}
+}
+
+func RetentionPolicyToWeb(p domain.RetentionPolicy) gen.RetentionPolicy {
+	return gen.RetentionPolicy{
+		Original:        p.Original,
+		OriginalDays:    p.OriginalDays,
+		ImageExpireDays: p.ImageExpireDays,
+	}
+}
+
+func RetentionPolicyToDomain(p *gen.RetentionPolicy) *domain.RetentionPolicy {
+	if p == nil {
+		return nil
+	}
+	return &domain.RetentionPolicy{
+		Original:        p.Original,
+		OriginalDays:    p.OriginalDays,
+		ImageExpireDays: p.ImageExpireDays,
+	}
+}
+
 func ProjectsToWeb(projs domain.Projects) gen.Projects {
 	return gen.Projects{
 		Items: lo.Map(projs.Items, func(p domain.Project, _ int) gen.Project {
@@ -37,6 +79,25 @@ func ProjectReferenceToWeb(r domain.ProjectReference) gen.ProjectReference {
 	}
 }
 
+func ListProjectsParamsToDomain(params gen.ListProjectsParams) domain.ListProjectsParams {
+	var offset *int
+	if params.Offset != nil {
+		v := int(*params.Offset)
+		offset = &v
+	}
+
+	var limit *int
+	if params.Limit != nil {
+		v := int(*params.Limit)
+		limit = &v
+	}
+
+	return domain.ListProjectsParams{
+		Offset: offset,
+		Limit:  limit,
+	}
+}
+
 func ListProjectsAdminParamsToDomain(params gen.ListProjectsAdminParams) domain.ListProjectsParams {
 	var offset *int
 	if params.Offset != nil {
@@ -53,13 +114,21 @@ func ListProjectsAdminParamsToDomain(params gen.ListProjectsAdminParams) domain.
 	return domain.ListProjectsParams{
 		Offset: offset,
 		Limit:  limit,
+		SearchFilter: domain.ProjectSearchFilter{
+			Deleted: lo.FromPtr(params.Deleted),
+		},
 	}
 }
 
 func CreateProjectAdminRequestToDomain(req gen.CreateProjectAdminRequest,
 ) domain.CreateProjectRequest {
 	return domain.CreateProjectRequest{
-		Name: req.Name,
+		Name:           req.Name,
+		Visibility:     lo.FromPtrOr(req.Visibility, projects.VisibilityPublic),
+		KeyTemplate:    (*projects.KeyTemplate)(req.KeyTemplate),
+		CDNBaseURL:     req.CdnBaseURL,
+		StorageProfile: req.StorageProfile,
+		Retention:      RetentionPolicyToDomain(req.Retention),
 		Presets: lo.Map(req.Presets,
 			func(t gen.CreatePresetRequest, _ int) domain.CreatePresetRequest {
 				return CreatePresetRequestToDomain(t)
@@ -70,11 +139,51 @@ func CreateProjectAdminRequestToDomain(req gen.CreateProjectAdminRequest,
 func UpdateProjectAdminRequestToDomain(projectID string, req gen.UpdateProjectAdminRequest,
 ) domain.UpdateProjectRequest {
 	return domain.UpdateProjectRequest{
-		ID:   projectID,
-		Name: req.Name,
+		ID:          projectID,
+		Name:        req.Name,
+		Visibility:  req.Visibility,
+		KeyTemplate: (*projects.KeyTemplate)(req.KeyTemplate),
+		CDNBaseURL:  req.CdnBaseURL,
+		Retention:   RetentionPolicyToDomain(req.Retention),
 		Presets: lo.Map(req.Presets,
 			func(t gen.UpsertPresetRequest, _ int) domain.UpsertPresetRequest {
 				return UpsertPresetRequestToDomain(t)
 			}),
 	}
 }
+
+func ProjectMemberToWeb(m domain.ProjectMember) gen.ProjectMember {
+	return gen.ProjectMember{
+		User:      UserToWeb(m.User),
+		Role:      m.Role,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func ProjectMembersToWeb(members []domain.ProjectMember) gen.ProjectMembers {
+	return gen.ProjectMembers{
+		Items: lo.Map(members, func(m domain.ProjectMember, _ int) gen.ProjectMember {
+			return ProjectMemberToWeb(m)
+		}),
+	}
+}
+
+func AddProjectMemberRequestToDomain(projectID string, req gen.AddProjectMemberRequest,
+) domain.AddProjectMemberRequest {
+	return domain.AddProjectMemberRequest{
+		ProjectID: projectID,
+		Email:     string(req.Email),
+		Role:      req.Role,
+	}
+}
+
+func UpdateProjectMemberRequestToDomain(projectID, userID string,
+	req gen.UpdateProjectMemberRequest,
+) domain.UpdateProjectMemberRequest {
+	return domain.UpdateProjectMemberRequest{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      req.Role,
+	}
+}
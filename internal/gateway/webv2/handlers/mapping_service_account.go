@@ -15,9 +15,15 @@ func ServiceAccountToWeb(sa domain.ServiceAccount) gen.ServiceAccount {
 		ExpireAt:    sa.ExpireAt,
 		Name:        sa.Name,
 		AccessScope: sa.AccessScope,
+		Permissions: sa.Permissions,
 		Projects: lo.Map(sa.Projects, func(r domain.ProjectReference, _ int) gen.ProjectReference {
 			return ProjectReferenceToWeb(r)
 		}),
+		LastUsedAt:     sa.LastUsedAt,
+		LastUsedIP:     lo.EmptyableToPtr(sa.LastUsedIP),
+		StaleWarnedAt:  sa.StaleWarnedAt,
+		DisabledAt:     sa.DisabledAt,
+		DisabledReason: lo.EmptyableToPtr(sa.DisabledReason),
 	}
 }
 
@@ -30,10 +36,36 @@ func ServiceAccountWithAPIKeyToWeb(sa domain.ServiceAccountWithAPIKey,
 		ExpireAt:    sa.ExpireAt,
 		Name:        sa.Name,
 		AccessScope: sa.AccessScope,
+		Permissions: sa.Permissions,
 		Projects: lo.Map(sa.Projects, func(r domain.ProjectReference, _ int) gen.ProjectReference {
 			return ProjectReferenceToWeb(r)
 		}),
-		APIKey: sa.APIKey,
+		LastUsedAt:     sa.LastUsedAt,
+		LastUsedIP:     lo.EmptyableToPtr(sa.LastUsedIP),
+		StaleWarnedAt:  sa.StaleWarnedAt,
+		DisabledAt:     sa.DisabledAt,
+		DisabledReason: lo.EmptyableToPtr(sa.DisabledReason),
+		APIKeyID:       sa.APIKeyID,
+		APIKey:         sa.APIKey,
+	}
+}
+
+func ServiceAccountAPIKeyToWeb(key domain.ServiceAccountAPIKey) gen.ServiceAccountAPIKey {
+	return gen.ServiceAccountAPIKey{
+		ID:         key.ID,
+		CreatedAt:  key.CreatedAt,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		LastUsedAt: key.LastUsedAt,
+		ExpireAt:   key.ExpireAt,
+	}
+}
+
+func ServiceAccountAPIKeysToWeb(keys []domain.ServiceAccountAPIKey) gen.ServiceAccountAPIKeys {
+	return gen.ServiceAccountAPIKeys{
+		Items: lo.Map(keys, func(k domain.ServiceAccountAPIKey, _ int) gen.ServiceAccountAPIKey {
+			return ServiceAccountAPIKeyToWeb(k)
+		}),
 	}
 }
 
@@ -73,6 +105,7 @@ func CreateServiceAccountAdminRequestToDomain(
 	return domain.CreateServiceAccountRequest{
 		Name:        req.Name,
 		AccessScope: req.AccessScope,
+		Permissions: req.Permissions,
 		ProjectIDs:  req.ProjectIDs,
 		ExpireAt:    req.ExpireAt,
 	}
@@ -86,7 +119,20 @@ func UpdateServiceAccountAdminRequestToDomain(
 		ID:          serviceAccountID,
 		Name:        req.Name,
 		AccessScope: req.AccessScope,
+		Permissions: req.Permissions,
 		ProjectIDs:  req.ProjectIDs,
 		ExpireAt:    req.ExpireAt,
+		Enable:      req.Enable,
+	}
+}
+
+func CreateServiceAccountAPIKeyAdminRequestToDomain(
+	serviceAccountID string,
+	req gen.CreateServiceAccountAPIKeyAdminRequest,
+) domain.CreateServiceAccountAPIKeyRequest {
+	return domain.CreateServiceAccountAPIKeyRequest{
+		ServiceAccountID: serviceAccountID,
+		Name:             req.Name,
+		ExpireAt:         req.ExpireAt,
 	}
 }
@@ -1,18 +1,62 @@
 package handlers
 
 import (
+	"github.com/samber/lo"
+
 	"github.com/isutare412/imageer/internal/gateway/domain"
 	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
 )
 
 func UserToWeb(p domain.User) gen.User {
 	return gen.User{
-		ID:        p.ID,
-		CreatedAt: p.CreatedAt,
-		UpdatedAt: p.UpdatedAt,
-		Role:      p.Role,
-		Nickname:  p.Nickname,
-		Email:     p.Email,
-		PhotoURL:  p.PhotoURL,
+		ID:          p.ID,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		Role:        p.Role,
+		Nickname:    p.Nickname,
+		Email:       p.Email,
+		PhotoURL:    p.PhotoURL,
+		SuspendedAt: p.SuspendedAt,
+	}
+}
+
+func UsersToWeb(users domain.Users) gen.Users {
+	return gen.Users{
+		Items: lo.Map(users.Items, func(u domain.User, _ int) gen.User {
+			return UserToWeb(u)
+		}),
+		Total: users.Total,
+	}
+}
+
+func ListUsersAdminParamsToDomain(params gen.ListUsersAdminParams) domain.ListUsersParams {
+	var offset *int
+	if params.Offset != nil {
+		v := int(*params.Offset)
+		offset = &v
+	}
+
+	var limit *int
+	if params.Limit != nil {
+		v := int(*params.Limit)
+		limit = &v
+	}
+
+	return domain.ListUsersParams{
+		Offset: offset,
+		Limit:  limit,
+		SearchFilter: domain.UserSearchFilter{
+			Keyword:   params.Keyword,
+			Role:      params.Role,
+			Suspended: params.Suspended,
+		},
+	}
+}
+
+func UpdateUserAdminRequestToDomain(userID string, req gen.UpdateUserAdminRequest,
+) domain.UpdateUserRequest {
+	return domain.UpdateUserRequest{
+		ID:   userID,
+		Role: &req.Role,
 	}
 }
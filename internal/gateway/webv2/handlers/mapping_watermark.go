@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/internal/gateway/domain"
+	"github.com/isutare412/imageer/internal/gateway/webv2/gen"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+func WatermarkToWeb(w domain.Watermark) gen.Watermark {
+	return gen.Watermark{
+		ID:        w.ID,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+		Name:      w.Name,
+		Format:    w.Format,
+		URL:       w.URL,
+		State:     w.State,
+	}
+}
+
+func WatermarksToWeb(ws domain.Watermarks) gen.Watermarks {
+	return gen.Watermarks{
+		Items: lo.Map(ws.Items, func(w domain.Watermark, _ int) gen.Watermark {
+			return WatermarkToWeb(w)
+		}),
+		Total: ws.Total,
+	}
+}
+
+func WatermarkUploadURLToWeb(u domain.WatermarkUploadURL) gen.WatermarkUploadURL {
+	return gen.WatermarkUploadURL{
+		Watermark: WatermarkToWeb(u.Watermark),
+		ExpiresAt: u.ExpiresAt,
+		URL:       u.URL,
+		Header: lo.MapEntries(u.Header, func(k string, v []string) (string, string) {
+			return k, v[0]
+		}),
+	}
+}
+
+func CreateWatermarkUploadURLRequestToDomain(projID string,
+	req gen.CreateWatermarkUploadURLRequest,
+) domain.CreateWatermarkUploadURLRequest {
+	return domain.CreateWatermarkUploadURLRequest{
+		ProjectID: projID,
+		Name:      req.Name,
+		Format:    req.Format,
+	}
+}
+
+func ListWatermarksParamsToDomain(projectID string, params gen.ListWatermarksParams,
+) domain.ListWatermarksParams {
+	var offset *int
+	if params.Offset != nil {
+		v := int(*params.Offset)
+		offset = &v
+	}
+
+	var limit *int
+	if params.Limit != nil {
+		v := int(*params.Limit)
+		limit = &v
+	}
+
+	return domain.ListWatermarksParams{
+		Offset: offset,
+		Limit:  limit,
+		SearchFilter: domain.WatermarkSearchFilter{
+			ProjectID: &projectID,
+		},
+		SortFilter: domain.WatermarkSortFilter{
+			CreatedAt: true,
+		},
+	}
+}
+
+func PresetWatermarkToWeb(w *domain.PresetWatermark) *gen.PresetWatermark {
+	if w == nil {
+		return nil
+	}
+
+	return &gen.PresetWatermark{
+		WatermarkID: w.WatermarkID,
+		Anchor:      &w.Anchor,
+		Opacity:     &w.Opacity,
+		Margin:      &w.Margin,
+		Scale:       &w.Scale,
+	}
+}
+
+func PresetWatermarkToDomain(w *gen.PresetWatermark) *domain.PresetWatermark {
+	if w == nil {
+		return nil
+	}
+
+	return &domain.PresetWatermark{
+		WatermarkID: w.WatermarkID,
+		Anchor:      lo.FromPtrOr(w.Anchor, images.AnchorCenter),
+		Opacity:     lo.FromPtrOr(w.Opacity, 1),
+		Margin:      lo.FromPtr(w.Margin),
+		Scale:       lo.FromPtrOr(w.Scale, 0.2),
+	}
+}
@@ -10,6 +10,9 @@ func WithContextBag(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		ctx = contextbag.WithBag(ctx)
+		if bag, ok := contextbag.BagFromContext(ctx); ok {
+			bag.RemoteIP = RemoteIP(r)
+		}
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"net"
 	"net/http"
 	"regexp"
 	"strings"
@@ -62,6 +63,17 @@ func ProxyHeaders(h http.Handler) http.Handler {
 	return http.HandlerFunc(fn)
 }
 
+// RemoteIP returns the IP address of the client without the port. Apply
+// ProxyHeaders beforehand to respect the client IP passed by reverse proxies.
+func RemoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		// Addresses from proxy headers have no ports
+		return r.RemoteAddr
+	}
+	return host
+}
+
 // getIP retrieves the IP from the X-Forwarded-For, X-Real-IP and RFC7239
 // Forwarded headers (in that order).
 func getIP(r *http.Request) string {
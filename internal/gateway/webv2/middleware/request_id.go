@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/isutare412/imageer/internal/gateway/contextbag"
 	"github.com/isutare412/imageer/pkg/log"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
@@ -25,6 +26,9 @@ func WithRequestID(next http.Handler) http.Handler {
 			id = tid.String()
 		}
 		log.AddAttrs(ctx, slog.String("requestId", id))
+		if bag, ok := contextbag.BagFromContext(ctx); ok {
+			bag.RequestID = id
+		}
 
 		w.Header().Set(requestIDHeader, id)
 		next.ServeHTTP(w, r)
@@ -28,6 +28,14 @@ type Server struct {
 	server *http.Server
 }
 
+// RawRoute mounts a handler outside of the OpenAPI spec, such as storage
+// callbacks. Requests are passed with the path prefix stripped, and handlers
+// authenticate requests themselves.
+type RawRoute struct {
+	PathPrefix string
+	Handler    http.Handler
+}
+
 func NewServer(
 	cfg Config,
 	healthCheckers []port.HealthChecker,
@@ -36,14 +44,17 @@ func NewServer(
 	projectSvc port.ProjectService,
 	userSvc port.UserService,
 	imageSvc port.ImageService,
+	watermarkSvc port.WatermarkService,
+	auditSvc port.AuditService,
+	rawRoutes []RawRoute,
 ) (*Server, error) {
 	handler := handlers.NewHandler(healthCheckers, authSvc, serviceAccountSvc, projectSvc, userSvc,
-		imageSvc)
+		imageSvc, watermarkSvc, auditSvc)
 
 	authenticator := auth.NewAuthenticator(cfg.APIKeyHeader, cfg.UserCookieName,
 		cfg.TokenRefreshThreshold, authSvc, serviceAccountSvc)
 
-	authorizer := auth.NewAuthorizer(serviceAccountSvc, projectSvc, imageSvc)
+	authorizer := auth.NewAuthorizer(serviceAccountSvc, projectSvc, imageSvc, watermarkSvc)
 
 	baseMiddlewares := []mux.MiddlewareFunc{
 		middleware.ProxyHeaders,
@@ -84,6 +95,13 @@ func NewServer(
 			Methods("GET")
 	}
 
+	// Raw routes - base middleware only
+	for _, route := range rawRoutes {
+		rawRouter := r.PathPrefix(route.PathPrefix).Subrouter()
+		rawRouter.Use(baseMiddlewares...)
+		rawRouter.NewRoute().Handler(http.StripPrefix(route.PathPrefix, route.Handler))
+	}
+
 	// API routes - ALL middleware
 	apiRouter := r.PathPrefix("/").Subrouter()
 	apiRouter.Use(apiMiddlewares...)
@@ -96,15 +114,28 @@ func NewServer(
 		return nil, fmt.Errorf("logging registerred routes: %w", err)
 	}
 
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Port),
+		Handler:           muxhandlers.CORS(cfg.CORS.buildCORSOptions()...)(r),
+		WriteTimeout:      cfg.WriteTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+	}
+
+	switch {
+	case cfg.TLS.Enabled:
+		tlsCfg, err := cfg.TLS.buildTLSConfig()
+		if err != nil {
+			return nil, fmt.Errorf("building TLS config: %w", err)
+		}
+		server.TLSConfig = tlsCfg
+	case cfg.TLS.ClientCAFile != "":
+		return nil, fmt.Errorf("client certificates require TLS to be enabled")
+	}
+
 	return &Server{
-		cfg: cfg,
-		server: &http.Server{
-			Addr:              fmt.Sprintf(":%d", cfg.Port),
-			Handler:           muxhandlers.CORS(cfg.CORS.buildCORSOptions()...)(r),
-			WriteTimeout:      cfg.WriteTimeout,
-			ReadTimeout:       cfg.ReadTimeout,
-			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
-		},
+		cfg:    cfg,
+		server: server,
 	}, nil
 }
 
@@ -113,8 +144,15 @@ func (s *Server) Run() <-chan error {
 	go func() {
 		defer close(errs)
 
-		slog.Info("Starting web server", "port", s.cfg.Port)
-		if err := s.server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		slog.Info("Starting web server", "port", s.cfg.Port, "tls", s.cfg.TLS.Enabled)
+
+		var err error
+		if s.cfg.TLS.Enabled {
+			err = s.server.ListenAndServeTLS(s.cfg.TLS.CertFile, s.cfg.TLS.KeyFile)
+		} else {
+			err = s.server.ListenAndServe()
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
 			errs <- fmt.Errorf("failed to start server: %w", err)
 			return
 		}
@@ -0,0 +1,7 @@
+package azblob
+
+import "github.com/isutare412/imageer/pkg/azurehelpers"
+
+type ObjectStorageConfig struct {
+	Client azurehelpers.ContainerClientConfig
+}
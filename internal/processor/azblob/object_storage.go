@@ -0,0 +1,72 @@
+package azblob
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/azurehelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+type ObjectStorage struct {
+	client *container.Client
+}
+
+func NewObjectStorage(cfg ObjectStorageConfig) (*ObjectStorage, error) {
+	client, err := azurehelpers.NewContainerClient(cfg.Client)
+	if err != nil {
+		return nil, fmt.Errorf("creating azure container client: %w", err)
+	}
+
+	return &ObjectStorage{
+		client: client,
+	}, nil
+}
+
+func (s *ObjectStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	ctx, span := tracing.StartSpan(ctx, "azblob.ObjectStorage.Get",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAzureBlob))
+	defer span.End()
+
+	resp, err := s.client.NewBlobClient(key).DownloadStream(ctx, nil)
+	if err != nil {
+		return nil, azurehelpers.WrapBlobError(err, "Failed to get object %s", key)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, apperr.NewError(apperr.CodeInternalServerError).WithCause(err)
+	}
+
+	return data, nil
+}
+
+func (s *ObjectStorage) Put(ctx context.Context, key string, data []byte,
+	contentType string,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "azblob.ObjectStorage.Put",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceAzureBlob))
+	defer span.End()
+
+	_, err := s.client.NewBlockBlobClient(key).UploadBuffer(ctx, data, &blockblob.UploadBufferOptions{
+		HTTPHeaders: &blob.HTTPHeaders{
+			BlobContentType: lo.EmptyableToPtr(contentType),
+		},
+	})
+	if err != nil {
+		return azurehelpers.WrapBlobError(err, "Failed to put object %s", key)
+	}
+
+	return nil
+}
@@ -8,11 +8,12 @@ import (
 )
 
 type Config struct {
-	Log   LogConfig   `koanf:"log"`
-	Trace TraceConfig `koanf:"trace"`
-	Web   WebConfig   `koanf:"web"`
-	Kafka KafkaConfig `koanf:"kafka"`
-	AWS   AWSConfig   `koanf:"aws"`
+	Log     LogConfig     `koanf:"log"`
+	Trace   TraceConfig   `koanf:"trace"`
+	Web     WebConfig     `koanf:"web"`
+	Kafka   KafkaConfig   `koanf:"kafka"`
+	Storage StorageConfig `koanf:"storage"`
+	Service ServiceConfig `koanf:"service"`
 }
 
 type LogConfig struct {
@@ -57,10 +58,45 @@ type KafkaConfig struct {
 	} `koanf:"topics"`
 }
 
-type AWSConfig struct {
-	S3 S3Config `koanf:"s3"`
+type StorageConfig struct {
+	DefaultProfile string                          `koanf:"default-profile" validate:"required"`
+	Profiles       map[string]StorageProfileConfig `koanf:"profiles" validate:"required,dive"`
 }
 
-type S3Config struct {
-	Bucket string `koanf:"bucket" validate:"required"`
+type StorageType string
+
+const (
+	StorageTypeS3    StorageType = "s3"
+	StorageTypeGCS   StorageType = "gcs"
+	StorageTypeAzure StorageType = "azure"
+	StorageTypeLocal StorageType = "local"
+)
+
+// StorageProfileConfig configures a storage backend. Profiles must match the
+// ones of the gateway. Fields irrelevant to the type are ignored.
+type StorageProfileConfig struct {
+	Type StorageType `koanf:"type" validate:"oneof=s3 gcs azure local"`
+	// Bucket is the bucket of S3 and GCS, or the container of Azure Blob.
+	Bucket string `koanf:"bucket" validate:"required_unless=Type local"`
+	// Endpoint overrides the endpoint of the storage service, e.g. to use
+	// S3-compatible storages or emulators.
+	Endpoint        string `koanf:"endpoint"`
+	Region          string `koanf:"region"`
+	UsePathStyle    bool   `koanf:"use-path-style"`
+	AccessKeyID     string `koanf:"access-key-id"`
+	SecretAccessKey string `koanf:"secret-access-key"`
+	AccountName     string `koanf:"account-name" validate:"required_if=Type azure"`
+	AccountKey      string `koanf:"account-key" validate:"required_if=Type azure"`
+	CredentialsFile string `koanf:"credentials-file"`
+	Root            string `koanf:"root" validate:"required_if=Type local"`
+}
+
+type ServiceConfig struct {
+	Image struct {
+		MaxInputPixels int64 `koanf:"max-input-pixels" validate:"required,gt=0"`
+		Watermark      struct {
+			CacheSize int           `koanf:"cache-size" validate:"required,gt=0"`
+			CacheTTL  time.Duration `koanf:"cache-ttl" validate:"required,gt=0"`
+		} `koanf:"watermark"`
+	} `koanf:"image"`
 }
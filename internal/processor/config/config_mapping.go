@@ -5,9 +5,17 @@ import (
 
 	"github.com/samber/lo"
 
+	"github.com/isutare412/imageer/internal/processor/azblob"
+	"github.com/isutare412/imageer/internal/processor/gcs"
+	"github.com/isutare412/imageer/internal/processor/image"
 	"github.com/isutare412/imageer/internal/processor/kafka"
+	"github.com/isutare412/imageer/internal/processor/localfs"
 	"github.com/isutare412/imageer/internal/processor/s3"
+	imagesvc "github.com/isutare412/imageer/internal/processor/service/image"
 	"github.com/isutare412/imageer/internal/processor/web"
+	"github.com/isutare412/imageer/pkg/awshelpers"
+	"github.com/isutare412/imageer/pkg/azurehelpers"
+	"github.com/isutare412/imageer/pkg/gcphelpers"
 	"github.com/isutare412/imageer/pkg/log"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
@@ -25,9 +33,46 @@ func (c *Config) ToTracingConfig() tracing.Config {
 	return tracing.Config(c.Trace)
 }
 
-func (c *Config) ToS3ObjectStorageConfig() s3.ObjectStorageConfig {
+func (c *Config) ToS3ObjectStorageConfig(profile string) s3.ObjectStorageConfig {
+	p := c.Storage.Profiles[profile]
 	return s3.ObjectStorageConfig{
-		Bucket: c.AWS.S3.Bucket,
+		Bucket: p.Bucket,
+		Client: awshelpers.S3ClientConfig{
+			Region:          p.Region,
+			Endpoint:        p.Endpoint,
+			AccessKeyID:     p.AccessKeyID,
+			SecretAccessKey: p.SecretAccessKey,
+			UsePathStyle:    p.UsePathStyle,
+		},
+	}
+}
+
+func (c *Config) ToGCSObjectStorageConfig(profile string) gcs.ObjectStorageConfig {
+	p := c.Storage.Profiles[profile]
+	return gcs.ObjectStorageConfig{
+		Bucket: p.Bucket,
+		Client: gcphelpers.StorageClientConfig{
+			Endpoint:        p.Endpoint,
+			CredentialsFile: p.CredentialsFile,
+		},
+	}
+}
+
+func (c *Config) ToAzblobObjectStorageConfig(profile string) azblob.ObjectStorageConfig {
+	p := c.Storage.Profiles[profile]
+	return azblob.ObjectStorageConfig{
+		Client: azurehelpers.ContainerClientConfig{
+			ServiceURL:  p.Endpoint,
+			AccountName: p.AccountName,
+			AccountKey:  p.AccountKey,
+			Container:   p.Bucket,
+		},
+	}
+}
+
+func (c *Config) ToLocalFSObjectStorageConfig(profile string) localfs.ObjectStorageConfig {
+	return localfs.ObjectStorageConfig{
+		Root: c.Storage.Profiles[profile].Root,
 	}
 }
 
@@ -60,6 +105,19 @@ func (c *Config) ToKafkaImageProcessResultQueueConfig() kafka.ImageProcessResult
 	}
 }
 
+func (c *Config) ToImageProcessorConfig() image.Config {
+	return image.Config{
+		MaxInputPixels: c.Service.Image.MaxInputPixels,
+	}
+}
+
+func (c *Config) ToImageServiceConfig() imagesvc.Config {
+	return imagesvc.Config{
+		WatermarkCacheSize: c.Service.Image.Watermark.CacheSize,
+		WatermarkCacheTTL:  c.Service.Image.Watermark.CacheTTL,
+	}
+}
+
 func (c *Config) ToWebServerConfig() web.Config {
 	return web.Config{
 		Port: c.Web.Port,
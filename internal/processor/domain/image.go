@@ -1,10 +1,21 @@
 package domain
 
 import (
+	"errors"
+
 	"github.com/isutare412/imageer/pkg/images"
 )
 
+// ErrUpscaleSkipped is returned by image processors when a preset with
+// SkipUpscale would render an image larger than the original.
+var ErrUpscaleSkipped = errors.New("skipped to avoid upscaling")
+
 type RawImage struct {
 	Data   []byte
 	Format images.Format
+
+	// FocalPoint and CropBox are the region of interest of an input image set by
+	// editors. At most one of them is set.
+	FocalPoint *images.FocalPoint
+	CropBox    *images.CropBox
 }
@@ -18,9 +18,57 @@ type Preset struct {
 	Anchor  *images.Anchor
 	Width   *int32
 	Height  *int32
+
+	// WithoutEnlargement keeps the output from being larger than the input.
+	WithoutEnlargement bool
+
+	Encoder   *PresetEncoder
+	Watermark *PresetWatermark
+
+	// SkipUpscale tells processors to skip the variant instead of rendering it
+	// larger than the original image.
+	SkipUpscale bool
+}
+
+// PresetEncoder tunes the encoder of AVIF, HEIC and JPEG XL outputs.
+type PresetEncoder struct {
+	Effort            *int32
+	Lossless          bool
+	ChromaSubsampling images.ChromaSubsampling
+}
+
+type PresetWatermark struct {
+	S3Key   string
+	Anchor  images.Anchor
+	Opacity float32
+	Margin  int32
+	Scale   float32
+
+	// Image is the watermark image loaded from S3Key.
+	Image RawImage
 }
 
 func NewPreset(p *imageerv1.Preset) Preset {
+	var encoder *PresetEncoder
+	if e := p.Encoder; e != nil {
+		encoder = &PresetEncoder{
+			Effort:            e.Effort,
+			Lossless:          e.Lossless,
+			ChromaSubsampling: images.NewChromaSubsamplingFromProto(e.ChromaSubsampling),
+		}
+	}
+
+	var watermark *PresetWatermark
+	if w := p.Watermark; w != nil {
+		watermark = &PresetWatermark{
+			S3Key:   w.S3Key,
+			Anchor:  images.NewAnchorFromProto(w.Anchor),
+			Opacity: w.Opacity,
+			Margin:  w.Margin,
+			Scale:   w.Scale,
+		}
+	}
+
 	return Preset{
 		ID:      p.Id,
 		Name:    p.Name,
@@ -31,5 +79,12 @@ func NewPreset(p *imageerv1.Preset) Preset {
 		Anchor:  lo.EmptyableToPtr(images.NewAnchorFromProto(p.Anchor)),
 		Width:   p.Width,
 		Height:  p.Height,
+
+		WithoutEnlargement: p.WithoutEnlargement,
+
+		Encoder:   encoder,
+		Watermark: watermark,
+
+		SkipUpscale: p.SkipUpscale,
 	}
 }
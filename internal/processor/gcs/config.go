@@ -0,0 +1,8 @@
+package gcs
+
+import "github.com/isutare412/imageer/pkg/gcphelpers"
+
+type ObjectStorageConfig struct {
+	Bucket string
+	Client gcphelpers.StorageClientConfig
+}
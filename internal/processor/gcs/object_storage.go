@@ -0,0 +1,71 @@
+package gcs
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/storage"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/gcphelpers"
+	"github.com/isutare412/imageer/pkg/tracing"
+)
+
+type ObjectStorage struct {
+	bucket *storage.BucketHandle
+}
+
+func NewObjectStorage(cfg ObjectStorageConfig) (*ObjectStorage, error) {
+	client, err := gcphelpers.NewStorageClient(context.Background(), cfg.Client)
+	if err != nil {
+		return nil, fmt.Errorf("creating gcs client: %w", err)
+	}
+
+	return &ObjectStorage{
+		bucket: client.Bucket(cfg.Bucket),
+	}, nil
+}
+
+func (s *ObjectStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	ctx, span := tracing.StartSpan(ctx, "gcs.ObjectStorage.Get",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceGCS))
+	defer span.End()
+
+	reader, err := s.bucket.Object(key).NewReader(ctx)
+	if err != nil {
+		return nil, gcphelpers.WrapStorageError(err, "Failed to get object %s", key)
+	}
+	defer func() { _ = reader.Close() }()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, apperr.NewError(apperr.CodeInternalServerError).WithCause(err)
+	}
+
+	return data, nil
+}
+
+func (s *ObjectStorage) Put(ctx context.Context, key string, data []byte,
+	contentType string,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "gcs.ObjectStorage.Put",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.PeerServiceGCS))
+	defer span.End()
+
+	writer := s.bucket.Object(key).NewWriter(ctx)
+	writer.ContentType = contentType
+
+	if _, err := writer.Write(data); err != nil {
+		_ = writer.Close()
+		return gcphelpers.WrapStorageError(err, "Failed to put object %s", key)
+	}
+	if err := writer.Close(); err != nil {
+		return gcphelpers.WrapStorageError(err, "Failed to put object %s", key)
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package image
+
+type Config struct {
+	// MaxInputPixels is the largest width * height of input images. Larger
+	// images are rejected before being decoded.
+	MaxInputPixels int64
+}
@@ -0,0 +1,178 @@
+package image
+
+/*
+#cgo pkg-config: vips
+#include <stdlib.h>
+#include <vips/vips.h>
+
+// The effort and subsample_mode options of heifsave need libvips 8.13.
+#if VIPS_MAJOR_VERSION < 8 || (VIPS_MAJOR_VERSION == 8 && VIPS_MINOR_VERSION < 13)
+#error "libvips 8.13 or later is required"
+#endif
+
+static int
+imageer_has_operation(const char *nickname)
+{
+	return vips_type_find("VipsOperation", nickname) != 0;
+}
+
+static int
+imageer_heifsave(void *in_buf, size_t in_len, void **buf, size_t *len,
+	int av1, int quality, int lossless, int effort, int subsample_mode)
+{
+	VipsImage *in = vips_image_new_from_buffer(in_buf, in_len, "", NULL);
+	if (in == NULL) {
+		return -1;
+	}
+
+	int err = vips_heifsave_buffer(in, buf, len,
+		"compression", av1 ? VIPS_FOREIGN_HEIF_COMPRESSION_AV1 : VIPS_FOREIGN_HEIF_COMPRESSION_HEVC,
+		"Q", quality,
+		"lossless", lossless,
+		"effort", effort,
+		"subsample_mode", subsample_mode,
+		NULL);
+	g_object_unref(in);
+	return err;
+}
+
+static int
+imageer_jxlsave(void *in_buf, size_t in_len, void **buf, size_t *len,
+	int quality, int lossless, int effort)
+{
+	VipsImage *in = vips_image_new_from_buffer(in_buf, in_len, "", NULL);
+	if (in == NULL) {
+		return -1;
+	}
+
+	int err = vips_jxlsave_buffer(in, buf, len,
+		"Q", quality,
+		"lossless", lossless,
+		"effort", effort,
+		NULL);
+	g_object_unref(in);
+	return err;
+}
+*/
+import "C"
+
+import (
+	"bytes"
+	"errors"
+	goimage "image"
+	"image/png"
+	"strings"
+	"unsafe"
+
+	"github.com/samber/lo"
+
+	"github.com/isutare412/imageer/internal/processor/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+// Default efforts of libvips encoders.
+const (
+	defaultHEIFEffort = 4
+	defaultJXLEffort  = 7
+)
+
+// encodesSeparately reports whether images of the format are encoded by
+// encode rather than bimg, which exposes few options of their encoders.
+func encodesSeparately(f images.Format) bool {
+	switch f {
+	case images.FormatAVIF, images.FormatHEIC, images.FormatJXL:
+		return true
+	default:
+		return false
+	}
+}
+
+// encoders maps formats encoded by encode to the libvips savers they need.
+var encoders = map[images.Format]string{
+	images.FormatAVIF: "heifsave_buffer",
+	images.FormatHEIC: "heifsave_buffer",
+	images.FormatJXL:  "jxlsave_buffer",
+}
+
+// probeEncoders returns formats encoded by encode which libvips can encode.
+// Savers are optional in libvips builds, and heifsave needs encoder plugins of
+// libheif for each compression, so a small image is encoded to check them.
+func probeEncoders() (map[images.Format]bool, error) {
+	// Encoded as an opaque RGB image, as some encoders reject monochrome ones
+	probe := goimage.NewYCbCr(goimage.Rect(0, 0, 16, 16), goimage.YCbCrSubsampleRatio444)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, probe); err != nil {
+		return nil, apperr.NewError(apperr.CodeInternalServerError).
+			WithCause(err).
+			WithSummary("Failed to encode probe image")
+	}
+
+	supported := make(map[images.Format]bool, len(encoders))
+	for format, saver := range encoders {
+		name := C.CString(saver)
+		hasSaver := C.imageer_has_operation(name) != 0
+		C.free(unsafe.Pointer(name))
+		if !hasSaver {
+			continue
+		}
+
+		_, err := encode(buf.Bytes(), format, images.Quality(50), domain.PresetEncoder{})
+		supported[format] = err == nil
+	}
+	return supported, nil
+}
+
+// encode encodes an image decodable by libvips into the given format.
+func encode(data []byte, format images.Format, quality images.Quality, enc domain.PresetEncoder,
+) ([]byte, error) {
+	in := C.CBytes(data)
+	defer C.free(in)
+
+	var (
+		out    unsafe.Pointer
+		length C.size_t
+		ret    C.int
+	)
+	switch format {
+	case images.FormatAVIF, images.FormatHEIC:
+		ret = C.imageer_heifsave(in, C.size_t(len(data)), &out, &length,
+			C.int(lo.Ternary(format == images.FormatAVIF, 1, 0)),
+			C.int(quality),
+			C.int(lo.Ternary(enc.Lossless, 1, 0)),
+			C.int(lo.FromPtrOr(enc.Effort, defaultHEIFEffort)),
+			subsampleMode(enc.ChromaSubsampling))
+	case images.FormatJXL:
+		ret = C.imageer_jxlsave(in, C.size_t(len(data)), &out, &length,
+			C.int(quality),
+			C.int(lo.Ternary(enc.Lossless, 1, 0)),
+			// JPEG XL encoders do not accept zero effort
+			C.int(max(lo.FromPtrOr(enc.Effort, defaultJXLEffort), 1)))
+	default:
+		return nil, apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Unexpected image format %s to encode", format)
+	}
+	if ret != 0 {
+		return nil, wrapBimgError(vipsError(), "Failed to encode %s image", format)
+	}
+	defer C.g_free(C.gpointer(out))
+
+	return C.GoBytes(out, C.int(length)), nil
+}
+
+func subsampleMode(c images.ChromaSubsampling) C.int {
+	switch c {
+	case images.ChromaSubsampling420:
+		return C.VIPS_FOREIGN_SUBSAMPLE_ON
+	case images.ChromaSubsampling444:
+		return C.VIPS_FOREIGN_SUBSAMPLE_OFF
+	default:
+		return C.VIPS_FOREIGN_SUBSAMPLE_AUTO
+	}
+}
+
+func vipsError() error {
+	msg := C.GoString(C.vips_error_buffer())
+	C.vips_error_clear()
+	return errors.New(strings.TrimSpace(msg))
+}
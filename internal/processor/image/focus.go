@@ -0,0 +1,87 @@
+package image
+
+import (
+	"math"
+
+	"github.com/h2non/bimg"
+
+	"github.com/isutare412/imageer/internal/processor/domain"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+// cropsAroundFocus reports whether the preset crops the input, and the input
+// has a region of interest to crop around.
+func cropsAroundFocus(input domain.RawImage, preset domain.Preset) bool {
+	if input.FocalPoint == nil && input.CropBox == nil {
+		return false
+	}
+	return preset.Fit != nil && *preset.Fit == images.FitCover &&
+		preset.Width != nil && preset.Height != nil
+}
+
+// extractFocus crops img in place to the region of interest of the input,
+// leaving the final resize to the preset. The region is encoded losslessly so
+// that the final pass does not compound compression artifacts.
+func extractFocus(img *bimg.Image, input domain.RawImage, preset domain.Preset) error {
+	// Apply EXIF orientation first so that the area matches what editors see
+	if _, err := img.Process(bimg.Options{Type: bimg.PNG}); err != nil {
+		return wrapBimgError(err, "Failed to normalize image orientation")
+	}
+
+	size, err := img.Size()
+	if err != nil {
+		return wrapBimgError(err, "Failed to get image size")
+	}
+
+	var area rect
+	if input.CropBox != nil {
+		area = cropBoxArea(*input.CropBox, size.Width, size.Height)
+	} else {
+		area = focalPointArea(*input.FocalPoint, size.Width, size.Height,
+			float64(*preset.Width)/float64(*preset.Height))
+	}
+
+	if _, err := img.Process(bimg.Options{
+		Top:        area.top,
+		Left:       area.left,
+		AreaWidth:  area.width,
+		AreaHeight: area.height,
+		Type:       bimg.PNG,
+	}); err != nil {
+		return wrapBimgError(err, "Failed to extract focus area")
+	}
+	return nil
+}
+
+type rect struct {
+	left, top, width, height int
+}
+
+func cropBoxArea(b images.CropBox, width, height int) rect {
+	area := rect{
+		left:   int(math.Round(b.X * float64(width))),
+		top:    int(math.Round(b.Y * float64(height))),
+		width:  max(int(math.Round(b.Width*float64(width))), 1),
+		height: max(int(math.Round(b.Height*float64(height))), 1),
+	}
+	area.left = min(area.left, width-area.width)
+	area.top = min(area.top, height-area.height)
+	return area
+}
+
+// focalPointArea returns the largest area of the given aspect ratio that is
+// centered on the focal point as much as the image bounds allow.
+func focalPointArea(p images.FocalPoint, width, height int, aspectRatio float64) rect {
+	area := rect{width: width, height: height}
+	if float64(width)/float64(height) > aspectRatio {
+		area.width = max(int(math.Round(float64(height)*aspectRatio)), 1)
+	} else {
+		area.height = max(int(math.Round(float64(width)/aspectRatio)), 1)
+	}
+
+	centerX := int(math.Round(p.X * float64(width)))
+	centerY := int(math.Round(p.Y * float64(height)))
+	area.left = min(max(centerX-area.width/2, 0), width-area.width)
+	area.top = min(max(centerY-area.height/2, 0), height-area.height)
+	return area
+}
@@ -1,6 +1,8 @@
 package image
 
 import (
+	"math"
+
 	"github.com/h2non/bimg"
 
 	"github.com/isutare412/imageer/internal/processor/domain"
@@ -38,8 +40,9 @@ func applyPreset(o *bimg.Options, t domain.Preset) {
 		typ = bimg.PNG
 	case images.FormatWebp:
 		typ = bimg.WEBP
-	case images.FormatAVIF:
-		typ = bimg.AVIF
+	case images.FormatAVIF, images.FormatHEIC, images.FormatJXL:
+		// Resized losslessly, and then encoded separately with encoder options
+		typ = bimg.PNG
 	default:
 		typ = bimg.WEBP
 	}
@@ -82,3 +85,42 @@ func applyPreset(o *bimg.Options, t domain.Preset) {
 		o.Gravity = anchor
 	}
 }
+
+// finalizeOptions keeps only the encoding options of o, for processing an image
+// that has already been resized.
+func finalizeOptions(o bimg.Options) bimg.Options {
+	return bimg.Options{
+		StripMetadata: o.StripMetadata,
+		Quality:       o.Quality,
+		Type:          o.Type,
+	}
+}
+
+// upscales reports whether the preset renders an image larger than the given
+// size in any dimension.
+func upscales(p domain.Preset, size bimg.ImageSize) bool {
+	return (p.Width != nil && int(*p.Width) > size.Width) ||
+		(p.Height != nil && int(*p.Height) > size.Height)
+}
+
+// limitEnlargement scales the target dimensions of o down, keeping their aspect
+// ratio, so that the output is not larger than the given input size.
+func limitEnlargement(o *bimg.Options, size bimg.ImageSize) {
+	factor := 1.0
+	if o.Width > size.Width {
+		factor = min(factor, float64(size.Width)/float64(o.Width))
+	}
+	if o.Height > size.Height {
+		factor = min(factor, float64(size.Height)/float64(o.Height))
+	}
+	if factor == 1 {
+		return
+	}
+
+	if o.Width > 0 {
+		o.Width = max(int(math.Round(float64(o.Width)*factor)), 1)
+	}
+	if o.Height > 0 {
+		o.Height = max(int(math.Round(float64(o.Height)*factor)), 1)
+	}
+}
@@ -3,17 +3,46 @@ package image
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/h2non/bimg"
+	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/internal/processor/domain"
+	"github.com/isutare412/imageer/pkg/apperr"
+	"github.com/isutare412/imageer/pkg/images"
 	"github.com/isutare412/imageer/pkg/tracing"
 )
 
-type Processor struct{}
+type Processor struct {
+	maxInputPixels int64
+	// encodable holds formats encoded separately from bimg which libvips can
+	// encode.
+	encodable map[images.Format]bool
+}
 
-func NewProcessor() *Processor {
-	return &Processor{}
+func NewProcessor(cfg Config) (*Processor, error) {
+	encodable, err := probeEncoders()
+	if err != nil {
+		return nil, fmt.Errorf("probing encoders: %w", err)
+	}
+	for format := range encoders {
+		if !encodable[format] {
+			slog.Warn("Libvips cannot encode the format, so presets of it fail to process",
+				"format", format)
+		}
+	}
+
+	return &Processor{
+		maxInputPixels: cfg.MaxInputPixels,
+		encodable:      encodable,
+	}, nil
+}
+
+// Encodable reports whether images can be processed into the format, which
+// depends on savers and plugins included in the libvips build.
+func (c *Processor) Encodable(format images.Format) bool {
+	return !encodesSeparately(format) || c.encodable[format]
 }
 
 func (c *Processor) Process(ctx context.Context, input domain.RawImage, preset domain.Preset,
@@ -21,13 +50,82 @@ func (c *Processor) Process(ctx context.Context, input domain.RawImage, preset d
 	_, span := tracing.StartSpan(ctx, "image.Processor.Process")
 	defer span.End()
 
+	if !c.Encodable(preset.Format) {
+		return domain.RawImage{}, apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unsupported image output format").
+			WithDetail("Libvips of the processor cannot encode %s images", preset.Format)
+	}
+
 	var opt bimg.Options
 	applyPreset(&opt, preset)
 
 	img := bimg.NewImage(input.Data)
-	outBytes, err := img.Process(opt)
+
+	// Only the header is read to get the size, so oversized images are rejected
+	// before being decoded.
+	size, err := img.Size()
 	if err != nil {
-		return domain.RawImage{}, wrapBimgError(err, "Failed to process image")
+		return domain.RawImage{}, wrapBimgError(err, "Failed to get image size")
+	}
+	if pixels := int64(size.Width) * int64(size.Height); pixels > c.maxInputPixels {
+		return domain.RawImage{}, apperr.NewError(apperr.CodeImageTooLarge).
+			WithSummary("Image is too large to process").
+			WithDetail("Image of %dx%d pixels exceeds the limit of %d pixels",
+				size.Width, size.Height, c.maxInputPixels)
+	}
+
+	if cropsAroundFocus(input, preset) {
+		if err := extractFocus(img, input, preset); err != nil {
+			return domain.RawImage{}, fmt.Errorf("extracting focus: %w", err)
+		}
+		// The focus area is already what editors want to keep
+		opt.Gravity = bimg.GravityCentre
+
+		if size, err = img.Size(); err != nil {
+			return domain.RawImage{}, wrapBimgError(err, "Failed to get focus area size")
+		}
+	}
+
+	// Checked against the focus area as it is what gets resized
+	if preset.SkipUpscale && upscales(preset, size) {
+		return domain.RawImage{}, domain.ErrUpscaleSkipped
+	}
+
+	if preset.WithoutEnlargement {
+		limitEnlargement(&opt, size)
+	}
+
+	var outBytes []byte
+	if preset.Watermark == nil {
+		outBytes, err = img.Process(opt)
+		if err != nil {
+			return domain.RawImage{}, wrapBimgError(err, "Failed to process image")
+		}
+	} else {
+		// Resize into a lossless intermediate first so that the watermark can be
+		// scaled and placed against the final dimensions.
+		resizeOpt := opt
+		resizeOpt.Type = bimg.PNG
+		if _, err := img.Process(resizeOpt); err != nil {
+			return domain.RawImage{}, wrapBimgError(err, "Failed to process image")
+		}
+
+		outBytes, err = applyWatermark(img, finalizeOptions(opt), *preset.Watermark)
+		if err != nil {
+			return domain.RawImage{}, fmt.Errorf("applying watermark: %w", err)
+		}
+	}
+
+	if encodesSeparately(preset.Format) {
+		outBytes, err = encode(outBytes, preset.Format, preset.Quality, lo.FromPtr(preset.Encoder))
+		if err != nil {
+			return domain.RawImage{}, fmt.Errorf("encoding image: %w", err)
+		}
+
+		return domain.RawImage{
+			Data:   outBytes,
+			Format: preset.Format,
+		}, nil
 	}
 
 	meta, err := img.Metadata()
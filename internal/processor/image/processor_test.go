@@ -12,6 +12,7 @@ import (
 
 	"github.com/isutare412/imageer/internal/processor/domain"
 	"github.com/isutare412/imageer/internal/processor/image"
+	"github.com/isutare412/imageer/pkg/apperr"
 	"github.com/isutare412/imageer/pkg/images"
 )
 
@@ -28,6 +29,9 @@ func TestProcessor_Process(t *testing.T) {
 		preset       domain.Preset
 	}
 
+	watermarkBuf, err := testFS.ReadFile("testdata/png-mistletoe-1920x1920.png")
+	require.NoError(t, err)
+
 	tests := []testSet{
 		{
 			name:     "jpeg-astronaut-cover",
@@ -120,6 +124,47 @@ func TestProcessor_Process(t *testing.T) {
 				Height:  new(int32(400)),
 			},
 		},
+		{
+			name:     "jpeg-astronaut-cover-focal-point",
+			fileName: "testdata/jpeg-astronaut-2000x1360.jpg",
+			prepareInput: func(t *testing.T, tt testSet) domain.RawImage {
+				buf, err := testFS.ReadFile(tt.fileName)
+				require.NoError(t, err)
+				return domain.RawImage{
+					Data:       buf,
+					Format:     images.FormatJPEG,
+					FocalPoint: &images.FocalPoint{X: 0.2, Y: 0.5},
+				}
+			},
+			preset: domain.Preset{
+				Format:  images.FormatWebp,
+				Quality: images.Quality(90),
+				Fit:     new(images.FitCover),
+				Anchor:  new(images.AnchorSmart),
+				Width:   new(int32(400)),
+				Height:  new(int32(400)),
+			},
+		},
+		{
+			name:     "jpeg-astronaut-cover-crop-box",
+			fileName: "testdata/jpeg-astronaut-2000x1360.jpg",
+			prepareInput: func(t *testing.T, tt testSet) domain.RawImage {
+				buf, err := testFS.ReadFile(tt.fileName)
+				require.NoError(t, err)
+				return domain.RawImage{
+					Data:    buf,
+					Format:  images.FormatJPEG,
+					CropBox: &images.CropBox{X: 0.5, Y: 0.1, Width: 0.4, Height: 0.6},
+				}
+			},
+			preset: domain.Preset{
+				Format:  images.FormatWebp,
+				Quality: images.Quality(90),
+				Fit:     new(images.FitCover),
+				Width:   new(int32(400)),
+				Height:  new(int32(400)),
+			},
+		},
 		{
 			name:     "jpeg-mountain-cover",
 			fileName: "testdata/jpeg-mountain-2000x1332.jpg",
@@ -198,6 +243,57 @@ func TestProcessor_Process(t *testing.T) {
 				Height:  new(int32(800)),
 			},
 		},
+		{
+			name:     "jpeg-sprout-without-enlargement",
+			fileName: "testdata/jpeg-sprout-620x427.jpg",
+			prepareInput: func(t *testing.T, tt testSet) domain.RawImage {
+				buf, err := testFS.ReadFile(tt.fileName)
+				require.NoError(t, err)
+				return domain.RawImage{
+					Data:   buf,
+					Format: images.FormatJPEG,
+				}
+			},
+			preset: domain.Preset{
+				Format:             images.FormatWebp,
+				Quality:            images.Quality(90),
+				Fit:                new(images.FitCover),
+				Anchor:             new(images.AnchorSmart),
+				Width:              new(int32(1200)),
+				Height:             new(int32(800)),
+				WithoutEnlargement: true,
+			},
+		},
+		{
+			name:     "jpeg-astronaut-cover-watermark",
+			fileName: "testdata/jpeg-astronaut-2000x1360.jpg",
+			prepareInput: func(t *testing.T, tt testSet) domain.RawImage {
+				buf, err := testFS.ReadFile(tt.fileName)
+				require.NoError(t, err)
+				return domain.RawImage{
+					Data:   buf,
+					Format: images.FormatJPEG,
+				}
+			},
+			preset: domain.Preset{
+				Format:  images.FormatWebp,
+				Quality: images.Quality(90),
+				Fit:     new(images.FitCover),
+				Anchor:  new(images.AnchorSmart),
+				Width:   new(int32(800)),
+				Height:  new(int32(600)),
+				Watermark: &domain.PresetWatermark{
+					Anchor:  images.AnchorSouth,
+					Opacity: 0.5,
+					Margin:  16,
+					Scale:   0.2,
+					Image: domain.RawImage{
+						Data:   watermarkBuf,
+						Format: images.FormatPNG,
+					},
+				},
+			},
+		},
 		{
 			name:     "png-mistletoe-cover",
 			fileName: "testdata/png-mistletoe-1920x1920.png",
@@ -256,6 +352,70 @@ func TestProcessor_Process(t *testing.T) {
 				Height:  new(int32(300)),
 			},
 		},
+		{
+			name:     "jpeg-astronaut-to-avif",
+			fileName: "testdata/jpeg-astronaut-2000x1360.jpg",
+			prepareInput: func(t *testing.T, tt testSet) domain.RawImage {
+				buf, err := testFS.ReadFile(tt.fileName)
+				require.NoError(t, err)
+				return domain.RawImage{
+					Data:   buf,
+					Format: images.FormatJPEG,
+				}
+			},
+			preset: domain.Preset{
+				Format:  images.FormatAVIF,
+				Quality: images.Quality(80),
+				Fit:     new(images.FitCover),
+				Width:   new(int32(300)),
+				Height:  new(int32(300)),
+				Encoder: &domain.PresetEncoder{
+					Effort:            new(int32(2)),
+					ChromaSubsampling: images.ChromaSubsampling444,
+				},
+			},
+		},
+		{
+			name:     "jpeg-astronaut-to-heic",
+			fileName: "testdata/jpeg-astronaut-2000x1360.jpg",
+			prepareInput: func(t *testing.T, tt testSet) domain.RawImage {
+				buf, err := testFS.ReadFile(tt.fileName)
+				require.NoError(t, err)
+				return domain.RawImage{
+					Data:   buf,
+					Format: images.FormatJPEG,
+				}
+			},
+			preset: domain.Preset{
+				Format:  images.FormatHEIC,
+				Quality: images.Quality(80),
+				Fit:     new(images.FitCover),
+				Width:   new(int32(300)),
+				Height:  new(int32(300)),
+			},
+		},
+		{
+			name:     "jpeg-astronaut-to-jxl-lossless",
+			fileName: "testdata/jpeg-astronaut-2000x1360.jpg",
+			prepareInput: func(t *testing.T, tt testSet) domain.RawImage {
+				buf, err := testFS.ReadFile(tt.fileName)
+				require.NoError(t, err)
+				return domain.RawImage{
+					Data:   buf,
+					Format: images.FormatJPEG,
+				}
+			},
+			preset: domain.Preset{
+				Format:  images.FormatJXL,
+				Quality: images.Quality(80),
+				Fit:     new(images.FitCover),
+				Width:   new(int32(300)),
+				Height:  new(int32(300)),
+				Encoder: &domain.PresetEncoder{
+					Lossless: true,
+				},
+			},
+		},
 		{
 			name:     "heic-noodle-cover",
 			fileName: "testdata/heic-noodle-4032x3024.heic",
@@ -304,7 +464,13 @@ func TestProcessor_Process(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			c := image.NewProcessor()
+			c, err := image.NewProcessor(image.Config{MaxInputPixels: 100_000_000})
+			require.NoError(t, err)
+			// AVIF and HEIC need the AOM and x265 plugins of libheif, and JPEG XL
+			// needs libjxl in the libvips build.
+			require.Truef(t, c.Encodable(tt.preset.Format),
+				"libvips cannot encode %s images", tt.preset.Format)
+
 			input := tt.prepareInput(t, tt)
 			out, err := c.Process(t.Context(), input, tt.preset)
 			require.NoError(t, err)
@@ -316,6 +482,64 @@ func TestProcessor_Process(t *testing.T) {
 	}
 }
 
+func TestProcessor_Process_MaxInputPixels(t *testing.T) {
+	buf, err := testFS.ReadFile("testdata/jpeg-sprout-620x427.jpg")
+	require.NoError(t, err)
+
+	c, err := image.NewProcessor(image.Config{MaxInputPixels: 620*427 - 1})
+	require.NoError(t, err)
+	_, err = c.Process(t.Context(), domain.RawImage{Data: buf, Format: images.FormatJPEG},
+		domain.Preset{Format: images.FormatWebp, Quality: images.Quality(90)})
+	require.True(t, apperr.IsErrorCode(err, apperr.CodeImageTooLarge))
+}
+
+func TestProcessor_Process_SkipUpscale(t *testing.T) {
+	buf, err := testFS.ReadFile("testdata/jpeg-astronaut-2000x1360.jpg")
+	require.NoError(t, err)
+
+	preset := domain.Preset{
+		Format:      images.FormatWebp,
+		Quality:     images.Quality(90),
+		Fit:         new(images.FitCover),
+		Width:       new(int32(800)),
+		Height:      new(int32(800)),
+		SkipUpscale: true,
+	}
+
+	tests := []struct {
+		name    string // description of this test case
+		cropBox *images.CropBox
+		wantErr error
+	}{
+		{
+			name:    "whole image covers preset",
+			wantErr: nil,
+		},
+		{
+			name:    "crop box smaller than preset",
+			cropBox: &images.CropBox{X: 0.5, Y: 0.1, Width: 0.2, Height: 0.4},
+			wantErr: domain.ErrUpscaleSkipped,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := image.NewProcessor(image.Config{MaxInputPixels: 100_000_000})
+			require.NoError(t, err)
+
+			_, err = c.Process(t.Context(), domain.RawImage{
+				Data:    buf,
+				Format:  images.FormatJPEG,
+				CropBox: tt.cropBox,
+			}, preset)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func cleanUpTestOutputs(t *testing.T) {
 	files, err := filepath.Glob("testdata/*.out.*")
 	require.NoError(t, err)
@@ -0,0 +1,93 @@
+package image
+
+import (
+	"math"
+
+	"github.com/h2non/bimg"
+
+	"github.com/isutare412/imageer/internal/processor/domain"
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+// applyWatermark composites the watermark onto img, which must already be
+// resized to its final dimensions. The watermark is scaled relative to the
+// width of img and placed by its anchor and margin.
+func applyWatermark(img *bimg.Image, opt bimg.Options, w domain.PresetWatermark,
+) ([]byte, error) {
+	size, err := img.Size()
+	if err != nil {
+		return nil, wrapBimgError(err, "Failed to get image size")
+	}
+
+	mark := bimg.NewImage(w.Image.Data)
+	markSize, err := mark.Size()
+	if err != nil {
+		return nil, wrapBimgError(err, "Failed to get watermark size")
+	}
+
+	margin := int(w.Margin)
+	width, height, ok := watermarkSize(size.Width, size.Height, markSize.Width, markSize.Height,
+		margin, w.Scale)
+	if !ok {
+		// Not enough room to place the watermark; keep the image as is.
+		return img.Process(opt)
+	}
+
+	markBytes, err := mark.Process(bimg.Options{
+		Width:  width,
+		Height: height,
+		Force:  true,
+		Type:   bimg.PNG,
+	})
+	if err != nil {
+		return nil, wrapBimgError(err, "Failed to resize watermark")
+	}
+
+	left, top := watermarkPosition(w.Anchor, size.Width, size.Height, width, height, margin)
+	opt.WatermarkImage = bimg.WatermarkImage{
+		Left:    left,
+		Top:     top,
+		Buf:     markBytes,
+		Opacity: w.Opacity,
+	}
+
+	return img.Process(opt)
+}
+
+// watermarkSize scales the watermark to the scale of the image width, keeping
+// its aspect ratio and the margins. Reports false if there is no room for the
+// watermark.
+func watermarkSize(width, height, markWidth, markHeight, margin int, scale float32,
+) (int, int, bool) {
+	maxWidth := width - 2*margin
+	maxHeight := height - 2*margin
+	if maxWidth <= 0 || maxHeight <= 0 || markWidth <= 0 || markHeight <= 0 {
+		return 0, 0, false
+	}
+
+	w := min(int(math.Round(float64(width)*float64(scale))), maxWidth)
+	h := int(math.Round(float64(w) * float64(markHeight) / float64(markWidth)))
+	if h > maxHeight {
+		h = maxHeight
+		w = int(math.Round(float64(h) * float64(markWidth) / float64(markHeight)))
+	}
+	return max(w, 1), max(h, 1), true
+}
+
+func watermarkPosition(anchor images.Anchor, width, height, markWidth, markHeight, margin int,
+) (left, top int) {
+	left = (width - markWidth) / 2
+	top = (height - markHeight) / 2
+
+	switch anchor {
+	case images.AnchorNorth:
+		top = margin
+	case images.AnchorSouth:
+		top = height - markHeight - margin
+	case images.AnchorWest:
+		left = margin
+	case images.AnchorEast:
+		left = width - markWidth - margin
+	}
+	return left, top
+}
@@ -0,0 +1,112 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/isutare412/imageer/pkg/images"
+)
+
+func TestWatermarkSize(t *testing.T) {
+	tests := []struct {
+		name       string // description of this test case
+		width      int
+		height     int
+		markWidth  int
+		markHeight int
+		margin     int
+		scale      float32
+		wantWidth  int
+		wantHeight int
+		wantOK     bool
+	}{
+		{
+			name:       "scaled by image width",
+			width:      1000,
+			height:     800,
+			markWidth:  400,
+			markHeight: 100,
+			scale:      0.2,
+			wantWidth:  200,
+			wantHeight: 50,
+			wantOK:     true,
+		},
+		{
+			name:       "clamped by width with margin",
+			width:      1000,
+			height:     800,
+			markWidth:  400,
+			markHeight: 100,
+			margin:     100,
+			scale:      1,
+			wantWidth:  800,
+			wantHeight: 200,
+			wantOK:     true,
+		},
+		{
+			name:       "clamped by height with margin",
+			width:      1000,
+			height:     300,
+			markWidth:  100,
+			markHeight: 400,
+			margin:     50,
+			scale:      0.5,
+			wantWidth:  50,
+			wantHeight: 200,
+			wantOK:     true,
+		},
+		{
+			name:       "at least one pixel",
+			width:      100,
+			height:     100,
+			markWidth:  1000,
+			markHeight: 10,
+			scale:      0.01,
+			wantWidth:  1,
+			wantHeight: 1,
+			wantOK:     true,
+		},
+		{
+			name:       "margin leaves no room",
+			width:      100,
+			height:     100,
+			markWidth:  10,
+			markHeight: 10,
+			margin:     50,
+			scale:      0.2,
+			wantOK:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h, ok := watermarkSize(tt.width, tt.height, tt.markWidth, tt.markHeight, tt.margin,
+				tt.scale)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.wantWidth, w)
+			require.Equal(t, tt.wantHeight, h)
+		})
+	}
+}
+
+func TestWatermarkPosition(t *testing.T) {
+	tests := []struct {
+		name     string // description of this test case
+		anchor   images.Anchor
+		wantLeft int
+		wantTop  int
+	}{
+		{name: "center", anchor: images.AnchorCenter, wantLeft: 400, wantTop: 275},
+		{name: "north", anchor: images.AnchorNorth, wantLeft: 400, wantTop: 10},
+		{name: "south", anchor: images.AnchorSouth, wantLeft: 400, wantTop: 540},
+		{name: "west", anchor: images.AnchorWest, wantLeft: 10, wantTop: 275},
+		{name: "east", anchor: images.AnchorEast, wantLeft: 790, wantTop: 275},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, top := watermarkPosition(tt.anchor, 1000, 600, 200, 50, 10)
+			require.Equal(t, tt.wantLeft, left)
+			require.Equal(t, tt.wantTop, top)
+		})
+	}
+}
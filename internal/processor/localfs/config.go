@@ -0,0 +1,7 @@
+package localfs
+
+type ObjectStorageConfig struct {
+	// Root is the directory where objects are stored. It must be the root of
+	// the gateway storage of the same profile.
+	Root string
+}
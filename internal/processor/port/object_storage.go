@@ -4,7 +4,9 @@ import "context"
 
 //go:generate sh -c "go tool mockgen -package $GOPACKAGE -source=$GOFILE -destination=$(basename $GOFILE .go)_mock.go"
 
+// ObjectStorage routes objects to the storage of storageProfile, or of the
+// default profile if empty.
 type ObjectStorage interface {
-	Get(ctx context.Context, key string) ([]byte, error)
-	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, storageProfile, key string) ([]byte, error)
+	Put(ctx context.Context, storageProfile, key string, data []byte, contentType string) error
 }
@@ -41,30 +41,30 @@ func (m *MockObjectStorage) EXPECT() *MockObjectStorageMockRecorder {
 }
 
 // Get mocks base method.
-func (m *MockObjectStorage) Get(ctx context.Context, key string) ([]byte, error) {
+func (m *MockObjectStorage) Get(ctx context.Context, storageProfile, key string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret := m.ctrl.Call(m, "Get", ctx, storageProfile, key)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockObjectStorageMockRecorder) Get(ctx, key any) *gomock.Call {
+func (mr *MockObjectStorageMockRecorder) Get(ctx, storageProfile, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockObjectStorage)(nil).Get), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockObjectStorage)(nil).Get), ctx, storageProfile, key)
 }
 
 // Put mocks base method.
-func (m *MockObjectStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+func (m *MockObjectStorage) Put(ctx context.Context, storageProfile, key string, data []byte, contentType string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", ctx, key, data, contentType)
+	ret := m.ctrl.Call(m, "Put", ctx, storageProfile, key, data, contentType)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Put indicates an expected call of Put.
-func (mr *MockObjectStorageMockRecorder) Put(ctx, key, data, contentType any) *gomock.Call {
+func (mr *MockObjectStorageMockRecorder) Put(ctx, storageProfile, key, data, contentType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockObjectStorage)(nil).Put), ctx, key, data, contentType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockObjectStorage)(nil).Put), ctx, storageProfile, key, data, contentType)
 }
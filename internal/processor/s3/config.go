@@ -1,5 +1,8 @@
 package s3
 
+import "github.com/isutare412/imageer/pkg/awshelpers"
+
 type ObjectStorageConfig struct {
 	Bucket string
+	Client awshelpers.S3ClientConfig
 }
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
@@ -22,13 +21,11 @@ type ObjectStorage struct {
 }
 
 func NewObjectStorage(cfg ObjectStorageConfig) (*ObjectStorage, error) {
-	awsCfg, err := config.LoadDefaultConfig(context.Background())
+	client, err := awshelpers.NewS3Client(context.Background(), cfg.Client)
 	if err != nil {
-		return nil, fmt.Errorf("loading default aws config: %w", err)
+		return nil, fmt.Errorf("creating s3 client: %w", err)
 	}
 
-	client := s3.NewFromConfig(awsCfg)
-
 	return &ObjectStorage{
 		client: client,
 		cfg:    cfg,
@@ -0,0 +1,8 @@
+package image
+
+import "time"
+
+type Config struct {
+	WatermarkCacheSize int
+	WatermarkCacheTTL  time.Duration
+}
@@ -2,6 +2,7 @@ package image
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -17,15 +18,17 @@ import (
 )
 
 type Service struct {
+	watermarkCache       *watermarkCache
 	imageProcessor       port.ImageProcessor
 	objectStorage        port.ObjectStorage
 	imageProcResultQueue port.ImageProcessResultQueue
 }
 
-func NewService(imageProcessor port.ImageProcessor, objectStorage port.ObjectStorage,
+func NewService(cfg Config, imageProcessor port.ImageProcessor, objectStorage port.ObjectStorage,
 	imageProcResultQueue port.ImageProcessResultQueue,
 ) *Service {
 	return &Service{
+		watermarkCache:       newWatermarkCache(cfg.WatermarkCacheSize, cfg.WatermarkCacheTTL),
 		imageProcessor:       imageProcessor,
 		objectStorage:        objectStorage,
 		imageProcResultQueue: imageProcResultQueue,
@@ -42,7 +45,10 @@ func (s *Service) Process(ctx context.Context, req *imageerv1.ImageProcessReques
 	}
 
 	err := s.processImage(ctx, req)
-	if aerr, ok := apperr.AsError(err); ok {
+	if errors.Is(err, domain.ErrUpscaleSkipped) {
+		result.IsSuccess = true
+		result.IsSkipped = true
+	} else if aerr, ok := apperr.AsError(err); ok {
 		result.IsSuccess = false
 		result.ErrorCode = int32(aerr.Code.ID())
 		result.ErrorMessage = err.Error()
@@ -67,29 +73,41 @@ func (s *Service) Process(ctx context.Context, req *imageerv1.ImageProcessReques
 
 	slog.InfoContext(ctx, "Send image process result", "imageId", result.ImageId,
 		"variantId", result.ImageVariantId, "presetId", result.PresetId,
-		"isSuccess", result.IsSuccess, "processingTime", result.ProcessingTime.AsDuration())
+		"isSuccess", result.IsSuccess, "isSkipped", result.IsSkipped,
+		"processingTime", result.ProcessingTime.AsDuration())
 
 	return nil
 }
 
 func (s *Service) processImage(ctx context.Context, req *imageerv1.ImageProcessRequest) error {
-	imageBytes, err := s.objectStorage.Get(ctx, req.Image.S3Key)
+	imageBytes, err := s.objectStorage.Get(ctx, req.Image.StorageProfile, req.Image.S3Key)
 	if err != nil {
 		return fmt.Errorf("getting original image: %w", err)
 	}
 
 	image := domain.RawImage{
-		Data:   imageBytes,
-		Format: images.NewFormatFromProto(req.Image.Format),
+		Data:       imageBytes,
+		Format:     images.NewFormatFromProto(req.Image.Format),
+		FocalPoint: images.NewFocalPointFromProto(req.Image.FocalPoint),
+		CropBox:    images.NewCropBoxFromProto(req.Image.CropBox),
 	}
 	preset := domain.NewPreset(req.Preset)
 
+	if preset.Watermark != nil {
+		watermarkBytes, err := s.getWatermarkImage(ctx, req.Image.StorageProfile,
+			preset.Watermark.S3Key)
+		if err != nil {
+			return fmt.Errorf("loading watermark: %w", err)
+		}
+		preset.Watermark.Image = domain.RawImage{Data: watermarkBytes}
+	}
+
 	variant, err := s.imageProcessor.Process(ctx, image, preset)
 	if err != nil {
 		return fmt.Errorf("processing image: %w", err)
 	}
 
-	if err := s.objectStorage.Put(ctx, req.Variant.S3Key, variant.Data,
+	if err := s.objectStorage.Put(ctx, req.Image.StorageProfile, req.Variant.S3Key, variant.Data,
 		variant.Format.ContentType()); err != nil {
 		return fmt.Errorf("putting image variant: %w", err)
 	}
@@ -0,0 +1,97 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type watermarkCacheEntry struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// watermarkCache keeps recently used watermark images in memory so that a
+// burst of variants sharing the same watermark does not hit object storage for
+// every request.
+type watermarkCache struct {
+	mu      sync.Mutex
+	entries map[string]watermarkCacheEntry
+	size    int
+	ttl     time.Duration
+}
+
+func newWatermarkCache(size int, ttl time.Duration) *watermarkCache {
+	return &watermarkCache{
+		entries: make(map[string]watermarkCacheEntry, size),
+		size:    size,
+		ttl:     ttl,
+	}
+}
+
+func (c *watermarkCache) get(key string, now time.Time) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if now.After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.data, true
+}
+
+func (c *watermarkCache) put(key string, data []byte, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		c.evict(now)
+	}
+	c.entries[key] = watermarkCacheEntry{
+		data:      data,
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+// evict removes expired entries, or the entry closest to expiration if none
+// has expired yet. Must be called with mu held.
+func (c *watermarkCache) evict(now time.Time) {
+	var (
+		oldestKey string
+		oldestAt  time.Time
+	)
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.expiresAt.Before(oldestAt) {
+			oldestKey, oldestAt = key, entry.expiresAt
+		}
+	}
+	if len(c.entries) >= c.size && oldestKey != "" {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// getWatermarkImage gets the watermark from the storage of the image, as
+// watermarks are stored along with images of the project.
+func (s *Service) getWatermarkImage(ctx context.Context, storageProfile, s3Key string,
+) ([]byte, error) {
+	if data, ok := s.watermarkCache.get(s3Key, time.Now()); ok {
+		return data, nil
+	}
+
+	data, err := s.objectStorage.Get(ctx, storageProfile, s3Key)
+	if err != nil {
+		return nil, fmt.Errorf("getting watermark image: %w", err)
+	}
+
+	s.watermarkCache.put(s3Key, data, time.Now())
+	return data, nil
+}
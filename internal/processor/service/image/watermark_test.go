@@ -0,0 +1,73 @@
+package image
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatermarkCache_Get(t *testing.T) {
+	now := time.Now()
+	cache := newWatermarkCache(2, time.Minute)
+	cache.put("a", []byte("a"), now)
+
+	data, ok := cache.get("a", now.Add(30*time.Second))
+	require.True(t, ok)
+	require.Equal(t, []byte("a"), data)
+
+	_, ok = cache.get("b", now)
+	require.False(t, ok)
+
+	_, ok = cache.get("a", now.Add(2*time.Minute))
+	require.False(t, ok)
+	require.Empty(t, cache.entries)
+}
+
+func TestWatermarkCache_Put(t *testing.T) {
+	tests := []struct {
+		name     string // description of this test case
+		putAt    []time.Duration
+		lastPut  time.Duration
+		wantKeys []string
+	}{
+		{
+			name:     "evicts entry closest to expiration",
+			putAt:    []time.Duration{0, 10 * time.Second},
+			lastPut:  20 * time.Second,
+			wantKeys: []string{"key-1", "new"},
+		},
+		{
+			name:     "evicts expired entries",
+			putAt:    []time.Duration{0, 10 * time.Second},
+			lastPut:  2 * time.Minute,
+			wantKeys: []string{"new"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			cache := newWatermarkCache(len(tt.putAt), time.Minute)
+			for i, at := range tt.putAt {
+				cache.put(fmt.Sprintf("key-%d", i), []byte("data"), now.Add(at))
+			}
+
+			cache.put("new", []byte("data"), now.Add(tt.lastPut))
+			require.ElementsMatch(t, tt.wantKeys, slices.Collect(maps.Keys(cache.entries)))
+		})
+	}
+}
+
+func TestWatermarkCache_Put_Overwrite(t *testing.T) {
+	now := time.Now()
+	cache := newWatermarkCache(1, time.Minute)
+	cache.put("a", []byte("old"), now)
+	cache.put("a", []byte("new"), now.Add(30*time.Second))
+
+	data, ok := cache.get("a", now.Add(80*time.Second))
+	require.True(t, ok)
+	require.Equal(t, []byte("new"), data)
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// Backend is the object storage of a single storage profile.
+type Backend interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+}
+
+// Router routes objects to the backend of a storage profile. An empty profile
+// name is routed to the default profile.
+type Router struct {
+	defaultProfile string
+	backends       map[string]Backend
+}
+
+func NewRouter(defaultProfile string, backends map[string]Backend) (*Router, error) {
+	if _, ok := backends[defaultProfile]; !ok {
+		return nil, fmt.Errorf("default storage profile %q not found", defaultProfile)
+	}
+
+	return &Router{
+		defaultProfile: defaultProfile,
+		backends:       backends,
+	}, nil
+}
+
+func (r *Router) Get(ctx context.Context, storageProfile, key string) ([]byte, error) {
+	backend, err := r.backend(storageProfile)
+	if err != nil {
+		return nil, err
+	}
+	return backend.Get(ctx, key)
+}
+
+func (r *Router) Put(ctx context.Context, storageProfile, key string, data []byte,
+	contentType string,
+) error {
+	backend, err := r.backend(storageProfile)
+	if err != nil {
+		return err
+	}
+	return backend.Put(ctx, key, data, contentType)
+}
+
+func (r *Router) backend(profile string) (Backend, error) {
+	if profile == "" {
+		profile = r.defaultProfile
+	}
+
+	backend, ok := r.backends[profile]
+	if !ok {
+		return nil, apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Storage profile %q is not configured", profile)
+	}
+	return backend, nil
+}
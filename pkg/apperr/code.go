@@ -53,6 +53,7 @@ var (
 // 413 Request Entity Too Large
 var (
 	CodeRequestEntityTooLarge = Code{9000, "REQUEST_ENTITY_TOO_LARGE"}
+	CodeImageTooLarge         = Code{9001, "IMAGE_TOO_LARGE"}
 )
 
 // 418 I'm a Teapot
@@ -0,0 +1,100 @@
+package audits
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"slices"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// Action is an administrative or destructive change recorded in audit logs.
+type Action string
+
+const (
+	ActionProjectCreate              Action = "PROJECT_CREATE"
+	ActionProjectUpdate              Action = "PROJECT_UPDATE"
+	ActionProjectDelete              Action = "PROJECT_DELETE"
+	ActionProjectRestore             Action = "PROJECT_RESTORE"
+	ActionProjectMemberAdd           Action = "PROJECT_MEMBER_ADD"
+	ActionProjectMemberUpdate        Action = "PROJECT_MEMBER_UPDATE"
+	ActionProjectMemberRemove        Action = "PROJECT_MEMBER_REMOVE"
+	ActionServiceAccountCreate       Action = "SERVICE_ACCOUNT_CREATE"
+	ActionServiceAccountUpdate       Action = "SERVICE_ACCOUNT_UPDATE"
+	ActionServiceAccountDelete       Action = "SERVICE_ACCOUNT_DELETE"
+	ActionServiceAccountStaleWarn    Action = "SERVICE_ACCOUNT_STALE_WARN"
+	ActionServiceAccountDisable      Action = "SERVICE_ACCOUNT_DISABLE"
+	ActionServiceAccountAPIKeyCreate Action = "SERVICE_ACCOUNT_API_KEY_CREATE"
+	ActionServiceAccountAPIKeyRevoke Action = "SERVICE_ACCOUNT_API_KEY_REVOKE"
+	ActionImageDelete                Action = "IMAGE_DELETE"
+	ActionImageRestore               Action = "IMAGE_RESTORE"
+	ActionWatermarkDelete            Action = "WATERMARK_DELETE"
+	ActionUserUpdate                 Action = "USER_UPDATE"
+	ActionUserSessionsRevoke         Action = "USER_SESSIONS_REVOKE"
+)
+
+var allActions = []Action{
+	ActionProjectCreate,
+	ActionProjectUpdate,
+	ActionProjectDelete,
+	ActionProjectRestore,
+	ActionProjectMemberAdd,
+	ActionProjectMemberUpdate,
+	ActionProjectMemberRemove,
+	ActionServiceAccountCreate,
+	ActionServiceAccountUpdate,
+	ActionServiceAccountDelete,
+	ActionServiceAccountStaleWarn,
+	ActionServiceAccountDisable,
+	ActionServiceAccountAPIKeyCreate,
+	ActionServiceAccountAPIKeyRevoke,
+	ActionImageDelete,
+	ActionImageRestore,
+	ActionWatermarkDelete,
+	ActionUserUpdate,
+	ActionUserSessionsRevoke,
+}
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = Action("")
+	_ sql.Scanner   = (*Action)(nil)
+)
+
+func (a Action) Validate() error {
+	if !slices.Contains(allActions, a) {
+		return apperr.NewError(apperr.CodeBadRequest).WithSummary("Unexpected audit action %q", a)
+	}
+	return nil
+}
+
+func (a Action) Value() (driver.Value, error) {
+	return string(a), nil
+}
+
+func (a *Action) Scan(value any) error {
+	str, err := scanString(value)
+	if err != nil {
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of audit action: %[1]T(%[1]v)", value)
+	}
+	*a = Action(str)
+	return nil
+}
+
+// scanString converts a column value to a string.
+func scanString(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case []byte:
+		return string(v), nil
+	case string:
+		return v, nil
+	case fmt.Stringer:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("unexpected type %T", value)
+	}
+}
@@ -0,0 +1,51 @@
+package audits
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// ActorType is the kind of identity performing an audited action.
+type ActorType string
+
+const (
+	ActorTypeUser           ActorType = "USER"
+	ActorTypeServiceAccount ActorType = "SERVICE_ACCOUNT"
+	// ActorTypeSystem performs actions without an authenticated identity, such
+	// as background jobs.
+	ActorTypeSystem ActorType = "SYSTEM"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = ActorType("")
+	_ sql.Scanner   = (*ActorType)(nil)
+)
+
+func (t ActorType) Validate() error {
+	switch t {
+	case ActorTypeUser:
+	case ActorTypeServiceAccount:
+	case ActorTypeSystem:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected audit actor type %q", t)
+	}
+	return nil
+}
+
+func (t ActorType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+func (t *ActorType) Scan(value any) error {
+	str, err := scanString(value)
+	if err != nil {
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of audit actor type: %[1]T(%[1]v)", value)
+	}
+	*t = ActorType(str)
+	return nil
+}
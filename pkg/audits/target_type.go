@@ -0,0 +1,57 @@
+package audits
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// TargetType is the kind of resource changed by an audited action.
+type TargetType string
+
+const (
+	TargetTypeProject              TargetType = "PROJECT"
+	TargetTypeProjectMember        TargetType = "PROJECT_MEMBER"
+	TargetTypeServiceAccount       TargetType = "SERVICE_ACCOUNT"
+	TargetTypeServiceAccountAPIKey TargetType = "SERVICE_ACCOUNT_API_KEY"
+	TargetTypeImage                TargetType = "IMAGE"
+	TargetTypeWatermark            TargetType = "WATERMARK"
+	TargetTypeUser                 TargetType = "USER"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = TargetType("")
+	_ sql.Scanner   = (*TargetType)(nil)
+)
+
+func (t TargetType) Validate() error {
+	switch t {
+	case TargetTypeProject:
+	case TargetTypeProjectMember:
+	case TargetTypeServiceAccount:
+	case TargetTypeServiceAccountAPIKey:
+	case TargetTypeImage:
+	case TargetTypeWatermark:
+	case TargetTypeUser:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected audit target type %q", t)
+	}
+	return nil
+}
+
+func (t TargetType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+func (t *TargetType) Scan(value any) error {
+	str, err := scanString(value)
+	if err != nil {
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of audit target type: %[1]T(%[1]v)", value)
+	}
+	*t = TargetType(str)
+	return nil
+}
@@ -1,9 +1,15 @@
 package awshelpers
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/samber/lo"
 
 	"github.com/isutare412/imageer/pkg/apperr"
 )
@@ -39,3 +45,39 @@ func isErrorS3NotFound(err error) bool {
 	var s3Err *types.NotFound
 	return errors.As(err, &s3Err)
 }
+
+type S3ClientConfig struct {
+	// Region overrides the region of the default AWS config if set.
+	Region string
+	// Endpoint is set to use S3-compatible storages other than AWS S3.
+	Endpoint string
+	// AccessKeyID and SecretAccessKey override credentials of the default AWS
+	// config if set.
+	AccessKeyID     string
+	SecretAccessKey string
+	UsePathStyle    bool
+}
+
+// NewS3Client creates an S3 client from the default AWS config overridden by
+// cfg.
+func NewS3Client(ctx context.Context, cfg S3ClientConfig) (*s3.Client, error) {
+	var opts []func(*config.LoadOptions) error
+	if cfg.Region != "" {
+		opts = append(opts, config.WithRegion(cfg.Region))
+	}
+	if cfg.AccessKeyID != "" {
+		opts = append(opts, config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, "")))
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("loading default aws config: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		o.BaseEndpoint = lo.EmptyableToPtr(cfg.Endpoint)
+		o.UsePathStyle = cfg.UsePathStyle
+	})
+	return client, nil
+}
@@ -0,0 +1,14 @@
+package awshelpers
+
+import (
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// IsObjectCreatedEvent reports whether the record notifies object creation.
+// AWS S3 names such events ObjectCreated:*, while S3-compatible storages like
+// MinIO prefix them with "s3:".
+func IsObjectCreatedEvent(record events.S3EventRecord) bool {
+	return strings.HasPrefix(strings.TrimPrefix(record.EventName, "s3:"), "ObjectCreated:")
+}
@@ -0,0 +1,65 @@
+package azurehelpers
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+type ContainerClientConfig struct {
+	// ServiceURL is the blob service URL of the storage account. It defaults to
+	// https://<account>.blob.core.windows.net if empty.
+	ServiceURL  string
+	AccountName string
+	AccountKey  string
+	Container   string
+}
+
+// NewContainerClient creates a container client authorized by the shared key
+// of the storage account, which is also required to sign SAS URLs.
+func NewContainerClient(cfg ContainerClientConfig) (*container.Client, error) {
+	cred, err := container.NewSharedKeyCredential(cfg.AccountName, cfg.AccountKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating shared key credential: %w", err)
+	}
+
+	serviceURL := cfg.ServiceURL
+	if serviceURL == "" {
+		serviceURL = fmt.Sprintf("https://%s.blob.core.windows.net", cfg.AccountName)
+	}
+
+	containerURL, err := url.JoinPath(serviceURL, cfg.Container)
+	if err != nil {
+		return nil, fmt.Errorf("building container URL: %w", err)
+	}
+
+	client, err := container.NewClientWithSharedKeyCredential(containerURL, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating container client: %w", err)
+	}
+	return client, nil
+}
+
+func WrapBlobError(err error, msg string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound):
+		return apperr.NewError(apperr.CodeNotFound).
+			WithCause(err).
+			WithSummary("Resource not found").
+			WithDetail(msg, args...)
+
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithCause(err).
+			WithSummary("Unexpected Azure Blob error").
+			WithDetail(msg, args...)
+	}
+}
@@ -14,11 +14,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/isutare412/imageer/pkg/audits"
 	"github.com/isutare412/imageer/pkg/dbhelpers"
 	"github.com/isutare412/imageer/pkg/images"
+	"github.com/isutare412/imageer/pkg/projects"
 	"github.com/isutare412/imageer/pkg/serviceaccounts"
 	"github.com/isutare412/imageer/pkg/users"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -45,6 +48,17 @@ const (
 	UpdatedAt ListImagesParamsSortBy = "updatedAt"
 )
 
+// AddProjectMemberRequest defines model for AddProjectMemberRequest.
+type AddProjectMemberRequest struct {
+	// Email The email address of the user to add.
+	Email openapi_types.Email `json:"email"`
+
+	// Role The role of the user in the project. Viewers read resources of the
+	// project, editors manage images and watermarks as well, and owners
+	// manage members as well.
+	Role ProjectMemberRole `json:"role"`
+}
+
 // AppError defines model for AppError.
 type AppError struct {
 	// CodeID Error code ID for programmatic handling
@@ -57,6 +71,93 @@ type AppError struct {
 	Message string `json:"message"`
 }
 
+// AuditAction The audited change.
+type AuditAction = audits.Action
+
+// AuditActorType The kind of identity making the change. SYSTEM is for changes without
+// an authenticated identity.
+type AuditActorType = audits.ActorType
+
+// AuditChange defines model for AuditChange.
+type AuditChange struct {
+	// After The JSON value after the change, absent if it is unset.
+	After json.RawMessage `json:"after,omitempty"`
+
+	// Before The JSON value before the change, absent if it was unset.
+	Before json.RawMessage `json:"before,omitempty"`
+
+	// Field The name of the changed field of the target.
+	Field string `json:"field"`
+}
+
+// AuditEntries defines model for AuditEntries.
+type AuditEntries struct {
+	Items []AuditEntry `json:"items"`
+
+	// Total The total number of audit entries.
+	Total int64 `json:"total"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action The audited change.
+	Action AuditAction `json:"action"`
+
+	// ActorID The ID of the user or service account, absent for the system.
+	ActorID string `json:"actorId,omitempty"`
+
+	// ActorName The email of the user or the name of the service account.
+	ActorName string `json:"actorName,omitempty"`
+
+	// ActorType The kind of identity making the change. SYSTEM is for changes without
+	// an authenticated identity.
+	ActorType AuditActorType `json:"actorType"`
+
+	// Changes Fields of the target changed by the action.
+	Changes []AuditChange `json:"changes"`
+
+	// CreatedAt The time when the change was made.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ID The unique identifier of the audit entry.
+	ID string `json:"id"`
+
+	// RemoteIP The IP address of the client making the change.
+	RemoteIP string `json:"remoteIp,omitempty"`
+
+	// RequestID The ID of the request making the change.
+	RequestID string `json:"requestId,omitempty"`
+
+	// TargetID The ID of the changed resource. Project members are identified by
+	// the project ID and the user ID joined with a slash.
+	TargetID string `json:"targetId"`
+
+	// TargetType The kind of resource changed.
+	TargetType AuditTargetType `json:"targetType"`
+}
+
+// AuditTargetType The kind of resource changed.
+type AuditTargetType = audits.TargetType
+
+// AuthProvider defines model for AuthProvider.
+type AuthProvider struct {
+	// DisplayName The human-readable name of the OIDC provider.
+	DisplayName string `json:"displayName"`
+
+	// Name The name of the OIDC provider used in sign-in paths.
+	Name string `json:"name"`
+}
+
+// AuthProviders defines model for AuthProviders.
+type AuthProviders struct {
+	Items []AuthProvider `json:"items"`
+}
+
+// ChromaSubsampling The chroma subsampling of encoded images:
+//   - 420: Halve the color resolution. Smaller files, with color bleeding on sharp edges.
+//   - 444: Keep the full color resolution.
+type ChromaSubsampling = images.ChromaSubsampling
+
 // CreatePresetRequest defines model for CreatePresetRequest.
 type CreatePresetRequest struct {
 	// Anchor The anchor position for image cropping.
@@ -65,13 +166,22 @@ type CreatePresetRequest struct {
 	// Default Indicates if this preset is the default one.
 	Default bool `json:"default"`
 
+	// Densities Pixel density multipliers of a variant set. A variant is rendered
+	// for each density, scaling width and height. Requires width or
+	// height, and cannot be used with widths.
+	Densities *[]float64 `json:"densities,omitempty"`
+
+	// Encoder Encoder options of AVIF, HEIC and JXL outputs. Ignored by other formats.
+	Encoder *PresetEncoder `json:"encoder,omitempty"`
+
 	// Fit The fit mode for image conversion:
 	//   - COVER: Scale the image to cover the target dimensions. Some parts may be cropped.
 	//   - CONTAIN: Scale the image to fit within the target dimensions. No cropping occurs. Empty areas may be filled with background color.
 	//   - FILL: Scale the image to fill the target dimensions. The image may be distorted.
 	Fit *ImageFit `json:"fit,omitempty"`
 
-	// Format The content type of the image.
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format *ImageFormat `json:"format,omitempty"`
 
 	// Height The height of the image in pixels.
@@ -83,15 +193,51 @@ type CreatePresetRequest struct {
 	// Quality The quality of the image (1-100). Default is 80.
 	Quality *int64 `json:"quality,omitempty"`
 
+	// Watermark Watermark composited onto the image after resizing. The watermark is placed at the center of the image unless anchor is provided.
+	Watermark *PresetWatermark `json:"watermark,omitempty"`
+
 	// Width The width of the image in pixels.
 	Width *int64 `json:"width,omitempty"`
+
+	// Widths Widths in pixels of a variant set. A variant is rendered for each
+	// width, scaling height to keep the aspect ratio of the preset.
+	Widths *[]int64 `json:"widths,omitempty"`
+
+	// WithoutEnlargement If true, variants are never rendered larger than the original
+	// image. Target dimensions are scaled down to fit the original while
+	// keeping their aspect ratio.
+	WithoutEnlargement bool `json:"withoutEnlargement,omitempty"`
 }
 
 // CreateProjectAdminRequest defines model for CreateProjectAdminRequest.
 type CreateProjectAdminRequest struct {
+	// CdnBaseURL Base URL of the CDN serving images of the project, without a
+	// trailing slash. The default CDN is used if absent.
+	CdnBaseURL *string `json:"cdnBaseUrl,omitempty"`
+
+	// KeyTemplate Template of object paths of images of the project. Supported
+	// placeholders are {project}, {image}, {preset}, {variant}, {ext},
+	// {yyyy}, {mm} and {dd}; {image}, {variant} and {ext} are required.
+	// Objects are stored in the default layout if absent.
+	KeyTemplate *string `json:"keyTemplate,omitempty"`
+
 	// Name The name of the project.
 	Name    string                `json:"name"`
 	Presets []CreatePresetRequest `json:"presets,omitempty"`
+
+	// Retention Decides how long objects of images of a project are kept. Images and
+	// their originals are kept forever unless specified.
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// StorageProfile Name of the storage profile to store objects of the project in. It
+	// cannot be changed after creation. The default profile is used if
+	// absent.
+	StorageProfile *string `json:"storageProfile,omitempty"`
+
+	// Visibility The visibility of the project. Images of public projects are served
+	// through the CDN, while images of private projects are served through
+	// short-lived presigned URLs only. Projects are public unless specified.
+	Visibility *ProjectVisibility `json:"visibility,omitempty"`
 }
 
 // CreateServiceAccountAdminRequest defines model for CreateServiceAccountAdminRequest.
@@ -105,20 +251,77 @@ type CreateServiceAccountAdminRequest struct {
 	// Name The name of the service account.
 	Name string `json:"name"`
 
+	// Permissions Permissions granted on resources in the access scope.
+	Permissions []ServiceAccountPermission `json:"permissions"`
+
 	// ProjectIDs List of project IDs to associate with the service account.
 	ProjectIDs []string `json:"projectIds,omitempty"`
 }
 
+// CreateServiceAccountAPIKeyAdminRequest defines model for CreateServiceAccountApiKeyAdminRequest.
+type CreateServiceAccountAPIKeyAdminRequest struct {
+	// ExpireAt The expiration time of the API key.
+	ExpireAt *time.Time `json:"expireAt,omitempty"`
+
+	// Name The name of the API key.
+	Name string `json:"name"`
+}
+
 // CreateUploadURLRequest defines model for CreateUploadUrlRequest.
 type CreateUploadURLRequest struct {
+	// ExternalID ID of the image in the client system.
+	ExternalID *string `json:"externalId,omitempty"`
+
 	// FileName The name of the file to be uploaded.
 	FileName string `json:"fileName"`
 
-	// Format The content type of the image.
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format ImageFormat `json:"format"`
 
+	// Metadata Arbitrary key/value metadata of the image. Keys are at most 64 and
+	// values at most 512 characters long.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
 	// PresetNames List of preset names to apply to the image. If not provided, default presets will be applied.
 	PresetNames []string `json:"presetNames,omitempty"`
+
+	// Tags Tags of the image. Duplicates are removed.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// CreateWatermarkUploadURLRequest defines model for CreateWatermarkUploadUrlRequest.
+type CreateWatermarkUploadURLRequest struct {
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
+	Format ImageFormat `json:"format"`
+
+	// Name The name of the watermark.
+	Name string `json:"name"`
+}
+
+// CropBox Rectangle to keep as fractions of the image width and height.
+type CropBox struct {
+	// Height Height of the rectangle.
+	Height float64 `json:"height"`
+
+	// Width Width of the rectangle.
+	Width float64 `json:"width"`
+
+	// X Left edge of the rectangle.
+	X float64 `json:"x"`
+
+	// Y Top edge of the rectangle.
+	Y float64 `json:"y"`
+}
+
+// FocalPoint Point of interest as fractions of the image width and height.
+type FocalPoint struct {
+	// X Horizontal position from the left edge.
+	X float64 `json:"x"`
+
+	// Y Vertical position from the top edge.
+	Y float64 `json:"y"`
 }
 
 // Image defines model for Image.
@@ -126,20 +329,51 @@ type Image struct {
 	// CreatedAt The creation time of the image.
 	CreatedAt time.Time `json:"createdAt"`
 
-	// Format The content type of the image.
+	// DeletedAt The deletion time of the image. Absent unless deleted.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// ExternalID ID of the image in the client system. Absent if not set.
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// Focus Region of interest of an image. At most one of focalPoint and cropBox
+	// can be set.
+	Focus *ImageFocus `json:"focus,omitempty"`
+
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format ImageFormat `json:"format"`
 
 	// ID The unique identifier of the image.
 	ID string `json:"id"`
 
+	// Metadata Arbitrary key/value metadata of the image.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// OriginalState The state of the original image, which is changed by the retention
+	// policy of the project.
+	OriginalState ImageOriginalState `json:"originalState"`
+
+	// Srcsets Ready-made srcset attribute values of ready variants, keyed by
+	// preset name.
+	Srcsets map[string]string `json:"srcsets,omitempty"`
+
 	// State The current state of the image.
 	State ImageState `json:"state"`
 
+	// Tags Tags of the image.
+	Tags []string `json:"tags,omitempty"`
+
 	// UpdatedAt The last update time of the image.
 	UpdatedAt time.Time `json:"updatedAt"`
 
-	// URL The URL of the original image.
-	URL string `json:"url"`
+	// URL The URL of the original image. URLs of the image and its variants
+	// are presigned if the image belongs to a private project. Absent if
+	// the original is deleted by the retention policy of the project.
+	URL *string `json:"url,omitempty"`
+
+	// URLExpireAt The expiry time of presigned URLs. Absent if the image belongs to a
+	// public project.
+	URLExpireAt *time.Time `json:"urlExpireAt,omitempty"`
 
 	// Variants List of image variants with applied presets.
 	Variants []ImageVariant `json:"variants,omitempty"`
@@ -154,9 +388,24 @@ type ImageAnchor = images.Anchor
 //   - FILL: Scale the image to fill the target dimensions. The image may be distorted.
 type ImageFit = images.Fit
 
-// ImageFormat The content type of the image.
+// ImageFocus Region of interest of an image. At most one of focalPoint and cropBox
+// can be set.
+type ImageFocus struct {
+	// CropBox Rectangle to keep as fractions of the image width and height.
+	CropBox *CropBox `json:"cropBox,omitempty"`
+
+	// FocalPoint Point of interest as fractions of the image width and height.
+	FocalPoint *FocalPoint `json:"focalPoint,omitempty"`
+}
+
+// ImageFormat The content type of the image. JXL (JPEG XL) is only available as the
+// output format of presets.
 type ImageFormat = images.Format
 
+// ImageOriginalState The state of the original image, which is changed by the retention
+// policy of the project.
+type ImageOriginalState = images.OriginalState
+
 // ImageState The current state of the image.
 type ImageState = images.State
 
@@ -165,7 +414,13 @@ type ImageVariant struct {
 	// CreatedAt The creation time of the image variant.
 	CreatedAt time.Time `json:"createdAt"`
 
-	// Format The content type of the image.
+	// Descriptor The srcset descriptor of the variant within the variant set of its
+	// preset, such as 2x or 640w. Omitted if the preset has no variant
+	// set.
+	Descriptor *string `json:"descriptor,omitempty"`
+
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format ImageFormat `json:"format"`
 
 	// ID The unique identifier of the image variant.
@@ -177,7 +432,8 @@ type ImageVariant struct {
 	// PresetName The name of the preset.
 	PresetName string `json:"presetName"`
 
-	// State The current state of the image variant.
+	// State The current state of the image variant. SKIPPED variants of a variant
+	// set are not rendered as they would upscale the original image.
 	State ImageVariantState `json:"state"`
 
 	// UpdatedAt The time when the image variant was updated.
@@ -187,7 +443,8 @@ type ImageVariant struct {
 	URL string `json:"url"`
 }
 
-// ImageVariantState The current state of the image variant.
+// ImageVariantState The current state of the image variant. SKIPPED variants of a variant
+// set are not rendered as they would upscale the original image.
 type ImageVariantState = images.VariantState
 
 // Images defines model for Images.
@@ -198,6 +455,11 @@ type Images struct {
 	Total int64 `json:"total"`
 }
 
+// OriginalRetention What happens to original images once all of their variants are ready.
+// Originals are either kept as they are, deleted, or moved to the
+// infrequent access storage class of the storage.
+type OriginalRetention = projects.OriginalRetention
+
 // Preset defines model for Preset.
 type Preset struct {
 	// Anchor The anchor position for image cropping.
@@ -209,13 +471,22 @@ type Preset struct {
 	// Default Indicates if this preset is the default one.
 	Default bool `json:"default"`
 
+	// Densities Pixel density multipliers of a variant set. A variant is rendered
+	// for each density, scaling width and height. Requires width or
+	// height, and cannot be used with widths.
+	Densities *[]float64 `json:"densities,omitempty"`
+
+	// Encoder Encoder options of AVIF, HEIC and JXL outputs. Ignored by other formats.
+	Encoder *PresetEncoder `json:"encoder,omitempty"`
+
 	// Fit The fit mode for image conversion:
 	//   - COVER: Scale the image to cover the target dimensions. Some parts may be cropped.
 	//   - CONTAIN: Scale the image to fit within the target dimensions. No cropping occurs. Empty areas may be filled with background color.
 	//   - FILL: Scale the image to fill the target dimensions. The image may be distorted.
 	Fit *ImageFit `json:"fit,omitempty"`
 
-	// Format The content type of the image.
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format ImageFormat `json:"format"`
 
 	// Height The height of the image in pixels.
@@ -233,29 +504,165 @@ type Preset struct {
 	// UpdatedAt The last update time of the preset.
 	UpdatedAt time.Time `json:"updatedAt"`
 
+	// Watermark Watermark composited onto the image after resizing. The watermark is placed at the center of the image unless anchor is provided.
+	Watermark *PresetWatermark `json:"watermark,omitempty"`
+
 	// Width The width of the image in pixels.
 	Width *int64 `json:"width,omitempty"`
+
+	// Widths Widths in pixels of a variant set. A variant is rendered for each
+	// width, scaling height to keep the aspect ratio of the preset.
+	Widths *[]int64 `json:"widths,omitempty"`
+
+	// WithoutEnlargement If true, variants are never rendered larger than the original
+	// image. Target dimensions are scaled down to fit the original while
+	// keeping their aspect ratio.
+	WithoutEnlargement bool `json:"withoutEnlargement"`
+}
+
+// PresetEncoder Encoder options of AVIF, HEIC and JXL outputs. Ignored by other formats.
+type PresetEncoder struct {
+	// ChromaSubsampling The chroma subsampling of encoded images:
+	//   - 420: Halve the color resolution. Smaller files, with color bleeding on sharp edges.
+	//   - 444: Keep the full color resolution.
+	ChromaSubsampling *ChromaSubsampling `json:"chromaSubsampling,omitempty"`
+
+	// Effort CPU effort of the encoder (0-9). Higher values produce smaller files but take longer. Default depends on the format.
+	Effort *int64 `json:"effort,omitempty"`
+
+	// Lossless Encodes images losslessly, ignoring quality.
+	Lossless bool `json:"lossless,omitempty"`
+}
+
+// PresetWatermark Watermark composited onto the image after resizing. The watermark is placed at the center of the image unless anchor is provided.
+type PresetWatermark struct {
+	// Anchor The anchor position for image cropping.
+	Anchor *ImageAnchor `json:"anchor,omitempty"`
+
+	// Margin The margin from the anchored edges in pixels. Default is 0.
+	Margin *int64 `json:"margin,omitempty"`
+
+	// Opacity The opacity of the watermark (0-1]. Default is 1.
+	Opacity *float64 `json:"opacity,omitempty"`
+
+	// Scale The width of the watermark relative to the width of the image (0-1]. Default is 0.2.
+	Scale *float64 `json:"scale,omitempty"`
+
+	// WatermarkID The unique identifier of the watermark.
+	WatermarkID string `json:"watermarkId"`
 }
 
 // Project defines model for Project.
 type Project struct {
+	// CdnBaseURL Base URL of the CDN serving images of the project, without a
+	// trailing slash. The default CDN is used if absent.
+	CdnBaseURL *string `json:"cdnBaseUrl,omitempty"`
+
 	// CreatedAt The creation time of the project.
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DeletedAt The deletion time of the project. Absent unless deleted.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
 	// ID The unique identifier of the project.
 	ID string `json:"id"`
 
 	// ImageCount The total number of images in the project.
 	ImageCount int64 `json:"imageCount"`
 
+	// KeyTemplate Template of object paths of images of the project. Supported
+	// placeholders are {project}, {image}, {preset}, {variant}, {ext},
+	// {yyyy}, {mm} and {dd}; {image}, {variant} and {ext} are required.
+	// Objects are stored in the default layout if absent.
+	KeyTemplate *string `json:"keyTemplate,omitempty"`
+
 	// Name The name of the project.
 	Name string `json:"name"`
 
 	// Presets List of presets to apply to images of the project.
 	Presets []Preset `json:"presets"`
 
+	// Retention Decides how long objects of images of a project are kept. Images and
+	// their originals are kept forever unless specified.
+	Retention RetentionPolicy `json:"retention"`
+
+	// StorageProfile Name of the storage profile objects of the project are stored in.
+	// Absent for projects stored in the default profile before storage
+	// profiles were introduced.
+	StorageProfile *string `json:"storageProfile,omitempty"`
+
 	// UpdatedAt The last update time of the project.
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Visibility The visibility of the project. Images of public projects are served
+	// through the CDN, while images of private projects are served through
+	// short-lived presigned URLs only. Projects are public unless specified.
+	Visibility ProjectVisibility `json:"visibility"`
+}
+
+// ProjectDeletion defines model for ProjectDeletion.
+type ProjectDeletion struct {
+	// CreatedAt The creation time of the deletion job.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FinishedAt The time when the job succeeded or was canceled.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// ID The unique identifier of the deletion job.
+	ID string `json:"id"`
+
+	// LastError The error of the last run, which is retried later. Absent unless
+	// the last run failed.
+	LastError *string `json:"lastError,omitempty"`
+
+	// ProjectID The ID of the deleted project.
+	ProjectID string `json:"projectId"`
+
+	// ProjectName The name of the deleted project.
+	ProjectName string `json:"projectName"`
+
+	// PurgedImages The number of images purged so far.
+	PurgedImages int64 `json:"purgedImages"`
+
+	// StartAfter The time after which the job starts, unless canceled.
+	StartAfter time.Time `json:"startAfter"`
+
+	// State The current state of the project deletion job.
+	State ProjectDeletionState `json:"state"`
+
+	// TotalImages The number of images to purge, counted when the job starts.
+	TotalImages int64 `json:"totalImages"`
+
+	// UpdatedAt The last update time of the deletion job.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ProjectDeletionState The current state of the project deletion job.
+type ProjectDeletionState = projects.DeletionState
+
+// ProjectMember defines model for ProjectMember.
+type ProjectMember struct {
+	// CreatedAt The time the user joined the project.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Role The role of the user in the project. Viewers read resources of the
+	// project, editors manage images and watermarks as well, and owners
+	// manage members as well.
+	Role ProjectMemberRole `json:"role"`
+
+	// UpdatedAt The last update time of the membership.
+	UpdatedAt time.Time `json:"updatedAt"`
+	User      User      `json:"user"`
+}
+
+// ProjectMemberRole The role of the user in the project. Viewers read resources of the
+// project, editors manage images and watermarks as well, and owners
+// manage members as well.
+type ProjectMemberRole = projects.MemberRole
+
+// ProjectMembers defines model for ProjectMembers.
+type ProjectMembers struct {
+	Items []ProjectMember `json:"items"`
 }
 
 // ProjectReference defines model for ProjectReference.
@@ -267,6 +674,11 @@ type ProjectReference struct {
 	Name string `json:"name"`
 }
 
+// ProjectVisibility The visibility of the project. Images of public projects are served
+// through the CDN, while images of private projects are served through
+// short-lived presigned URLs only. Projects are public unless specified.
+type ProjectVisibility = projects.Visibility
+
 // Projects defines model for Projects.
 type Projects struct {
 	Items []Project `json:"items"`
@@ -284,6 +696,23 @@ type ReprocessImagesAdminRequest struct {
 	ReprocessAll bool `json:"reprocessAll,omitempty"`
 }
 
+// RetentionPolicy Decides how long objects of images of a project are kept. Images and
+// their originals are kept forever unless specified.
+type RetentionPolicy struct {
+	// ImageExpireDays The number of days after creation when images are deleted entirely.
+	// Images never expire if zero.
+	ImageExpireDays int `json:"imageExpireDays"`
+
+	// Original What happens to original images once all of their variants are ready.
+	// Originals are either kept as they are, deleted, or moved to the
+	// infrequent access storage class of the storage.
+	Original OriginalRetention `json:"original"`
+
+	// OriginalDays The number of days after all variants of an image are ready when
+	// the original retention is applied.
+	OriginalDays int `json:"originalDays"`
+}
+
 // ServiceAccount defines model for ServiceAccount.
 type ServiceAccount struct {
 	// AccessScope The access scope of the service account.
@@ -292,18 +721,40 @@ type ServiceAccount struct {
 	// CreatedAt The creation time of the service account.
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DisabledAt The time the service account was disabled. Disabled service accounts
+	// fail to authenticate until enabled again.
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
+
+	// DisabledReason The reason the service account was disabled. UNUSED means the service
+	// account was not used for a while.
+	DisabledReason *ServiceAccountDisabledReason `json:"disabledReason,omitempty"`
+
 	// ExpireAt The expiration time of the service account token.
 	ExpireAt *time.Time `json:"expireAt,omitempty"`
 
 	// ID The unique identifier of the service account.
 	ID string `json:"id"`
 
+	// LastUsedAt The last authentication time by any credential of the service
+	// account, which is recorded with a delay of up to a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// LastUsedIP The remote IP address of the last authentication.
+	LastUsedIP *string `json:"lastUsedIp,omitempty"`
+
 	// Name The name of the service account.
 	Name string `json:"name"`
 
+	// Permissions Permissions granted on resources in the access scope.
+	Permissions []ServiceAccountPermission `json:"permissions"`
+
 	// Projects List of projects associated with the service account.
 	Projects []ProjectReference `json:"projects"`
 
+	// StaleWarnedAt The time the service account was warned to be disabled for not
+	// being used. It is cleared once the service account is used again.
+	StaleWarnedAt *time.Time `json:"staleWarnedAt,omitempty"`
+
 	// UpdatedAt The last update time of the service account.
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -311,29 +762,90 @@ type ServiceAccount struct {
 // ServiceAccountAccessScope The access scope of the service account.
 type ServiceAccountAccessScope = serviceaccounts.AccessScope
 
+// ServiceAccountAPIKey defines model for ServiceAccountApiKey.
+type ServiceAccountAPIKey struct {
+	// CreatedAt The creation time of the API key.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpireAt The expiration time of the API key.
+	ExpireAt *time.Time `json:"expireAt,omitempty"`
+
+	// ID The unique identifier of the API key.
+	ID string `json:"id"`
+
+	// LastUsedAt The last time the API key was used, recorded at a resolution of
+	// minutes. Omitted if the API key was never used.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// Name The name of the API key.
+	Name string `json:"name"`
+
+	// Prefix The leading characters of the API key identifying it.
+	Prefix string `json:"prefix"`
+}
+
+// ServiceAccountAPIKeys defines model for ServiceAccountApiKeys.
+type ServiceAccountAPIKeys struct {
+	Items []ServiceAccountAPIKey `json:"items"`
+}
+
+// ServiceAccountDisabledReason The reason the service account was disabled. UNUSED means the service
+// account was not used for a while.
+type ServiceAccountDisabledReason = serviceaccounts.DisabledReason
+
+// ServiceAccountPermission A permission of the service account. Each permission allows a group of
+// operations on resources in the access scope of the service account.
+type ServiceAccountPermission = serviceaccounts.Permission
+
 // ServiceAccountWithAPIKey defines model for ServiceAccountWithApiKey.
 type ServiceAccountWithAPIKey struct {
 	// AccessScope The access scope of the service account.
 	AccessScope ServiceAccountAccessScope `json:"accessScope"`
 
-	// APIKey The API key for the service account.
+	// APIKey The API key for the service account, which is not retrievable
+	// afterwards.
 	APIKey string `json:"apiKey"`
 
+	// APIKeyID The unique identifier of the API key.
+	APIKeyID string `json:"apiKeyId"`
+
 	// CreatedAt The creation time of the service account.
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DisabledAt The time the service account was disabled. Disabled service accounts
+	// fail to authenticate until enabled again.
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
+
+	// DisabledReason The reason the service account was disabled. UNUSED means the service
+	// account was not used for a while.
+	DisabledReason *ServiceAccountDisabledReason `json:"disabledReason,omitempty"`
+
 	// ExpireAt The expiration time of the service account token.
 	ExpireAt *time.Time `json:"expireAt,omitempty"`
 
 	// ID The unique identifier of the service account.
 	ID string `json:"id"`
 
+	// LastUsedAt The last authentication time by any credential of the service
+	// account, which is recorded with a delay of up to a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// LastUsedIP The remote IP address of the last authentication.
+	LastUsedIP *string `json:"lastUsedIp,omitempty"`
+
 	// Name The name of the service account.
 	Name string `json:"name"`
 
+	// Permissions Permissions granted on resources in the access scope.
+	Permissions []ServiceAccountPermission `json:"permissions"`
+
 	// Projects List of projects associated with the service account.
 	Projects []ProjectReference `json:"projects"`
 
+	// StaleWarnedAt The time the service account was warned to be disabled for not
+	// being used. It is cleared once the service account is used again.
+	StaleWarnedAt *time.Time `json:"staleWarnedAt,omitempty"`
+
 	// UpdatedAt The last update time of the service account.
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -349,11 +861,50 @@ type ServiceAccounts struct {
 // SortDirection The sort direction for list operations.
 type SortDirection = dbhelpers.SortDirection
 
+// UpdateImageRequest Absent fields are left unchanged. An empty externalId clears the
+// external ID, and empty tags or metadata clear them.
+type UpdateImageRequest struct {
+	// ExternalID ID of the image in the client system.
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// Metadata Metadata replacing the one of the image.
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// Tags Tags replacing the ones of the image.
+	Tags *[]string `json:"tags,omitempty"`
+}
+
 // UpdateProjectAdminRequest defines model for UpdateProjectAdminRequest.
 type UpdateProjectAdminRequest struct {
+	// CdnBaseURL Base URL of the CDN serving images of the project. Set to an empty
+	// string to restore the default CDN.
+	CdnBaseURL *string `json:"cdnBaseUrl,omitempty"`
+
+	// KeyTemplate Template of object paths of images of the project. Set to an empty
+	// string to restore the default layout. Objects of existing images
+	// are not moved.
+	KeyTemplate *string `json:"keyTemplate,omitempty"`
+
 	// Name The name of the project.
 	Name    *string               `json:"name,omitempty"`
 	Presets []UpsertPresetRequest `json:"presets,omitempty"`
+
+	// Retention Decides how long objects of images of a project are kept. Images and
+	// their originals are kept forever unless specified.
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// Visibility The visibility of the project. Images of public projects are served
+	// through the CDN, while images of private projects are served through
+	// short-lived presigned URLs only. Projects are public unless specified.
+	Visibility *ProjectVisibility `json:"visibility,omitempty"`
+}
+
+// UpdateProjectMemberRequest defines model for UpdateProjectMemberRequest.
+type UpdateProjectMemberRequest struct {
+	// Role The role of the user in the project. Viewers read resources of the
+	// project, editors manage images and watermarks as well, and owners
+	// manage members as well.
+	Role ProjectMemberRole `json:"role"`
 }
 
 // UpdateServiceAccountAdminRequest defines model for UpdateServiceAccountAdminRequest.
@@ -361,16 +912,30 @@ type UpdateServiceAccountAdminRequest struct {
 	// AccessScope The access scope of the service account.
 	AccessScope *ServiceAccountAccessScope `json:"accessScope,omitempty"`
 
+	// Enable Whether to enable the service account again if disabled. Stale
+	// warnings are cleared as well.
+	Enable bool `json:"enable,omitempty"`
+
 	// ExpireAt The expiration time of the service account token.
 	ExpireAt *time.Time `json:"expireAt,omitempty"`
 
 	// Name The name of the service account.
 	Name *string `json:"name,omitempty"`
 
+	// Permissions Permissions granted on resources in the access scope. Permissions
+	// are unchanged if omitted.
+	Permissions []ServiceAccountPermission `json:"permissions,omitempty"`
+
 	// ProjectIDs List of project IDs to associate with the service account.
 	ProjectIDs []string `json:"projectIds,omitempty"`
 }
 
+// UpdateUserAdminRequest defines model for UpdateUserAdminRequest.
+type UpdateUserAdminRequest struct {
+	// Role The role of the user.
+	Role UserRole `json:"role"`
+}
+
 // UploadURL defines model for UploadUrl.
 type UploadURL struct {
 	// ExpiresAt The expiration time of the presigned URL.
@@ -396,13 +961,22 @@ type UpsertPresetRequest struct {
 	// Default Indicates if this preset is the default one.
 	Default *bool `json:"default,omitempty"`
 
+	// Densities Pixel density multipliers of a variant set. A variant is rendered
+	// for each density, scaling width and height. Requires width or
+	// height, and cannot be used with widths.
+	Densities *[]float64 `json:"densities,omitempty"`
+
+	// Encoder Encoder options of AVIF, HEIC and JXL outputs. Ignored by other formats.
+	Encoder *PresetEncoder `json:"encoder,omitempty"`
+
 	// Fit The fit mode for image conversion:
 	//   - COVER: Scale the image to cover the target dimensions. Some parts may be cropped.
 	//   - CONTAIN: Scale the image to fit within the target dimensions. No cropping occurs. Empty areas may be filled with background color.
 	//   - FILL: Scale the image to fill the target dimensions. The image may be distorted.
 	Fit *ImageFit `json:"fit,omitempty"`
 
-	// Format The content type of the image.
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
 	Format *ImageFormat `json:"format,omitempty"`
 
 	// Height The height of the image in pixels.
@@ -417,8 +991,24 @@ type UpsertPresetRequest struct {
 	// Quality The quality of the image (1-100). Default is 80.
 	Quality *int64 `json:"quality,omitempty"`
 
+	// RemoveWatermark If true, the preset stops applying its watermark. Cannot be used
+	// with watermark.
+	RemoveWatermark *bool `json:"removeWatermark,omitempty"`
+
+	// Watermark Watermark composited onto the image after resizing. The watermark is placed at the center of the image unless anchor is provided.
+	Watermark *PresetWatermark `json:"watermark,omitempty"`
+
 	// Width The width of the image in pixels.
 	Width *int64 `json:"width,omitempty"`
+
+	// Widths Widths in pixels of a variant set. A variant is rendered for each
+	// width, scaling height to keep the aspect ratio of the preset.
+	Widths *[]int64 `json:"widths,omitempty"`
+
+	// WithoutEnlargement If true, variants are never rendered larger than the original
+	// image. Target dimensions are scaled down to fit the original while
+	// keeping their aspect ratio.
+	WithoutEnlargement *bool `json:"withoutEnlargement,omitempty"`
 }
 
 // User defines model for User.
@@ -441,6 +1031,9 @@ type User struct {
 	// Role The role of the user.
 	Role UserRole `json:"role"`
 
+	// SuspendedAt The time the user was suspended. Absent unless suspended.
+	SuspendedAt *time.Time `json:"suspendedAt,omitempty"`
+
 	// UpdatedAt The last update time of the user.
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -448,12 +1041,111 @@ type User struct {
 // UserRole The role of the user.
 type UserRole = users.Role
 
+// Users defines model for Users.
+type Users struct {
+	Items []User `json:"items"`
+
+	// Total The total number of users.
+	Total int64 `json:"total"`
+}
+
+// Watermark defines model for Watermark.
+type Watermark struct {
+	// CreatedAt The creation time of the watermark.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Format The content type of the image. JXL (JPEG XL) is only available as the
+	// output format of presets.
+	Format ImageFormat `json:"format"`
+
+	// ID The unique identifier of the watermark.
+	ID string `json:"id"`
+
+	// Name The name of the watermark.
+	Name string `json:"name"`
+
+	// State The upload state of the watermark. Presets can reference only ready
+	// watermarks.
+	State WatermarkState `json:"state"`
+
+	// UpdatedAt The last update time of the watermark.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// URL The URL of the watermark.
+	URL string `json:"url"`
+}
+
+// WatermarkState The upload state of the watermark. Presets can reference only ready
+// watermarks.
+type WatermarkState = images.WatermarkState
+
+// WatermarkUploadURL defines model for WatermarkUploadUrl.
+type WatermarkUploadURL struct {
+	// ExpiresAt The expiration time of the presigned URL.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Header Additional headers required for the upload request.
+	Header map[string]string `json:"header"`
+
+	// URL The presigned URL for uploading the watermark. It must be called with PUT method.
+	URL       string    `json:"url"`
+	Watermark Watermark `json:"watermark"`
+}
+
+// Watermarks defines model for Watermarks.
+type Watermarks struct {
+	Items []Watermark `json:"items"`
+
+	// Total The total number of watermarks.
+	Total int64 `json:"total"`
+}
+
+// ActorIDQuery defines model for ActorIdQuery.
+type ActorIDQuery = string
+
+// APIKeyIDPath defines model for ApiKeyIdPath.
+type APIKeyIDPath = string
+
+// AuditActionQuery The audited change.
+type AuditActionQuery = AuditAction
+
+// AuditTargetTypeQuery The kind of resource changed.
+type AuditTargetTypeQuery = AuditTargetType
+
+// CreatedAfterQuery defines model for CreatedAfterQuery.
+type CreatedAfterQuery = time.Time
+
+// CreatedBeforeQuery defines model for CreatedBeforeQuery.
+type CreatedBeforeQuery = time.Time
+
+// DeletedQuery defines model for DeletedQuery.
+type DeletedQuery = bool
+
+// ExternalIDQuery defines model for ExternalIdQuery.
+type ExternalIDQuery = string
+
+// FileNamePrefixQuery defines model for FileNamePrefixQuery.
+type FileNamePrefixQuery = string
+
+// FormatQuery The content type of the image. JXL (JPEG XL) is only available as the
+// output format of presets.
+type FormatQuery = ImageFormat
+
 // ImageIDPath defines model for ImageIdPath.
 type ImageIDPath = string
 
+// KeywordQuery defines model for KeywordQuery.
+type KeywordQuery = string
+
 // LimitQuery defines model for LimitQuery.
 type LimitQuery = int64
 
+// MetadataQuery defines model for MetadataQuery.
+type MetadataQuery map[string]string
+
+// OIDCProviderPath defines model for OIDCProviderPath.
+type OIDCProviderPath = string
+
 // OffsetQuery defines model for OffsetQuery.
 type OffsetQuery = int64
 
@@ -472,12 +1164,57 @@ type SortByQuery string
 // SortOrderQuery The sort direction for list operations.
 type SortOrderQuery = SortDirection
 
+// SuspendedQuery defines model for SuspendedQuery.
+type SuspendedQuery = bool
+
+// TagQuery defines model for TagQuery.
+type TagQuery = []string
+
+// TargetIDQuery defines model for TargetIdQuery.
+type TargetIDQuery = string
+
+// UserIDPath defines model for UserIdPath.
+type UserIDPath = string
+
+// UserRoleQuery The role of the user.
+type UserRoleQuery = UserRole
+
 // WaitUntilProcessedQuery defines model for WaitUntilProcessedQuery.
 type WaitUntilProcessedQuery = bool
 
+// WatermarkIDPath defines model for WatermarkIdPath.
+type WatermarkIDPath = string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse = AppError
 
+// ListAuditLogsAdminParams defines parameters for ListAuditLogsAdmin.
+type ListAuditLogsAdminParams struct {
+	// Offset Offset for pagination
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// ActorID List only audit entries of the user or service account
+	ActorID *ActorIDQuery `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// Action List only audit entries of the action
+	Action *AuditActionQuery `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetType List only audit entries of the target type
+	TargetType *AuditTargetTypeQuery `form:"targetType,omitempty" json:"targetType,omitempty"`
+
+	// TargetID List only audit entries of the target
+	TargetID *TargetIDQuery `form:"targetId,omitempty" json:"targetId,omitempty"`
+
+	// CreatedAfter List only resources created at or after the time
+	CreatedAfter *CreatedAfterQuery `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore List only resources created before the time
+	CreatedBefore *CreatedBeforeQuery `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+}
+
 // ListProjectsAdminParams defines parameters for ListProjectsAdmin.
 type ListProjectsAdminParams struct {
 	// Offset Offset for pagination
@@ -485,6 +1222,9 @@ type ListProjectsAdminParams struct {
 
 	// Limit Limit for pagination
 	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Deleted List only soft-deleted resources waiting for purge
+	Deleted *DeletedQuery `form:"deleted,omitempty" json:"deleted,omitempty"`
 }
 
 // ListImagesAdminParams defines parameters for ListImagesAdmin.
@@ -500,35 +1240,93 @@ type ListImagesAdminParams struct {
 
 	// SortOrder Sort direction
 	SortOrder *SortOrderQuery `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
-}
 
-// ListImagesAdminParamsSortBy defines parameters for ListImagesAdmin.
-type ListImagesAdminParamsSortBy string
+	// Deleted List only soft-deleted resources waiting for purge
+	Deleted *DeletedQuery `form:"deleted,omitempty" json:"deleted,omitempty"`
 
-// ListServiceAccountsAdminParams defines parameters for ListServiceAccountsAdmin.
-type ListServiceAccountsAdminParams struct {
-	// Offset Offset for pagination
+	// ExternalID List only images with the external ID
+	ExternalID *ExternalIDQuery `form:"externalId,omitempty" json:"externalId,omitempty"`
+
+	// Tag List only images having all of the tags
+	Tag *TagQuery `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Metadata List only images having all of the metadata entries, given as
+	// metadata[key]=value
+	Metadata *MetadataQuery `json:"metadata,omitempty"`
+
+	// FileNamePrefix List only images whose file names start with the prefix
+	FileNamePrefix *FileNamePrefixQuery `form:"fileNamePrefix,omitempty" json:"fileNamePrefix,omitempty"`
+
+	// Format List only images of the format
+	Format *FormatQuery `form:"format,omitempty" json:"format,omitempty"`
+
+	// CreatedAfter List only resources created at or after the time
+	CreatedAfter *CreatedAfterQuery `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore List only resources created before the time
+	CreatedBefore *CreatedBeforeQuery `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+}
+
+// ListImagesAdminParamsSortBy defines parameters for ListImagesAdmin.
+type ListImagesAdminParamsSortBy string
+
+// ListServiceAccountsAdminParams defines parameters for ListServiceAccountsAdmin.
+type ListServiceAccountsAdminParams struct {
+	// Offset Offset for pagination
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListUsersAdminParams defines parameters for ListUsersAdmin.
+type ListUsersAdminParams struct {
+	// Offset Offset for pagination
 	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
 
 	// Limit Limit for pagination
 	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Keyword Match users whose nicknames or emails contain the keyword, ignoring case
+	Keyword *KeywordQuery `form:"keyword,omitempty" json:"keyword,omitempty"`
+
+	// Role Match users of the role
+	Role *UserRoleQuery `form:"role,omitempty" json:"role,omitempty"`
+
+	// Suspended Match suspended users if true, and active users if false
+	Suspended *SuspendedQuery `form:"suspended,omitempty" json:"suspended,omitempty"`
 }
 
-// StartGoogleSignInParams defines parameters for StartGoogleSignIn.
-type StartGoogleSignInParams struct {
+// SignOutParams defines parameters for SignOut.
+type SignOutParams struct {
+	// All Whether to revoke all sessions of the user, signing out everywhere.
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
+}
+
+// StartSignInParams defines parameters for StartSignIn.
+type StartSignInParams struct {
 	// Redirect The path to redirect to after successful sign-in. Defaults to root path if not provided.
 	Redirect *RedirectQuery `form:"redirect,omitempty" json:"redirect,omitempty"`
 }
 
-// FinishGoogleSignInParams defines parameters for FinishGoogleSignIn.
-type FinishGoogleSignInParams struct {
-	// Code The authorization code returned by Google after sign-in.
+// FinishSignInParams defines parameters for FinishSignIn.
+type FinishSignInParams struct {
+	// Code The authorization code returned by the OIDC provider after sign-in.
 	Code string `form:"code" json:"code"`
 
 	// State The state parameter to prevent CSRF attacks.
 	State string `form:"state" json:"state"`
 }
 
+// ListProjectsParams defines parameters for ListProjects.
+type ListProjectsParams struct {
+	// Offset Offset for pagination
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListImagesParams defines parameters for ListImages.
 type ListImagesParams struct {
 	// Offset Offset for pagination
@@ -542,6 +1340,28 @@ type ListImagesParams struct {
 
 	// SortOrder Sort direction
 	SortOrder *SortOrderQuery `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	// ExternalID List only images with the external ID
+	ExternalID *ExternalIDQuery `form:"externalId,omitempty" json:"externalId,omitempty"`
+
+	// Tag List only images having all of the tags
+	Tag *TagQuery `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Metadata List only images having all of the metadata entries, given as
+	// metadata[key]=value
+	Metadata *MetadataQuery `json:"metadata,omitempty"`
+
+	// FileNamePrefix List only images whose file names start with the prefix
+	FileNamePrefix *FileNamePrefixQuery `form:"fileNamePrefix,omitempty" json:"fileNamePrefix,omitempty"`
+
+	// Format List only images of the format
+	Format *FormatQuery `form:"format,omitempty" json:"format,omitempty"`
+
+	// CreatedAfter List only resources created at or after the time
+	CreatedAfter *CreatedAfterQuery `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore List only resources created before the time
+	CreatedBefore *CreatedBeforeQuery `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
 }
 
 // ListImagesParamsSortBy defines parameters for ListImages.
@@ -553,6 +1373,36 @@ type GetImageParams struct {
 	WaitUntilProcessed *WaitUntilProcessedQuery `form:"waitUntilProcessed,omitempty" json:"waitUntilProcessed,omitempty"`
 }
 
+// ListWatermarksParams defines parameters for ListWatermarks.
+type ListWatermarksParams struct {
+	// Offset Offset for pagination
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeliverImageParams defines parameters for DeliverImage.
+type DeliverImageParams struct {
+	// Accept Media types the client can display.
+	Accept *string `json:"Accept,omitempty"`
+
+	// DPR Device pixel ratio of the client.
+	DPR *float64 `json:"DPR,omitempty"`
+
+	// Width Intended display width of the image in physical pixels.
+	Width *int64 `json:"Width,omitempty"`
+
+	// SecCHDPR Device pixel ratio of the client. Precedes DPR.
+	SecCHDPR *float64 `json:"Sec-CH-DPR,omitempty"`
+
+	// SecCHWidth Intended display width of the image in physical pixels. Precedes Width.
+	SecCHWidth *int64 `json:"Sec-CH-Width,omitempty"`
+
+	// IfNoneMatch Entity tag of the image cached by the client.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
 // CreateProjectAdminJSONRequestBody defines body for CreateProjectAdmin for application/json ContentType.
 type CreateProjectAdminJSONRequestBody = CreateProjectAdminRequest
 
@@ -568,9 +1418,30 @@ type CreateServiceAccountAdminJSONRequestBody = CreateServiceAccountAdminRequest
 // UpdateServiceAccountAdminJSONRequestBody defines body for UpdateServiceAccountAdmin for application/json ContentType.
 type UpdateServiceAccountAdminJSONRequestBody = UpdateServiceAccountAdminRequest
 
+// CreateServiceAccountAPIKeyAdminJSONRequestBody defines body for CreateServiceAccountAPIKeyAdmin for application/json ContentType.
+type CreateServiceAccountAPIKeyAdminJSONRequestBody = CreateServiceAccountAPIKeyAdminRequest
+
+// UpdateUserAdminJSONRequestBody defines body for UpdateUserAdmin for application/json ContentType.
+type UpdateUserAdminJSONRequestBody = UpdateUserAdminRequest
+
 // CreateUploadURLJSONRequestBody defines body for CreateUploadURL for application/json ContentType.
 type CreateUploadURLJSONRequestBody = CreateUploadURLRequest
 
+// UpdateImageJSONRequestBody defines body for UpdateImage for application/json ContentType.
+type UpdateImageJSONRequestBody = UpdateImageRequest
+
+// UpdateImageFocusJSONRequestBody defines body for UpdateImageFocus for application/json ContentType.
+type UpdateImageFocusJSONRequestBody = ImageFocus
+
+// AddProjectMemberJSONRequestBody defines body for AddProjectMember for application/json ContentType.
+type AddProjectMemberJSONRequestBody = AddProjectMemberRequest
+
+// UpdateProjectMemberJSONRequestBody defines body for UpdateProjectMember for application/json ContentType.
+type UpdateProjectMemberJSONRequestBody = UpdateProjectMemberRequest
+
+// CreateWatermarkUploadURLJSONRequestBody defines body for CreateWatermarkUploadURL for application/json ContentType.
+type CreateWatermarkUploadURLJSONRequestBody = CreateWatermarkUploadURLRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -644,6 +1515,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListAuditLogsAdmin request
+	ListAuditLogsAdmin(ctx context.Context, params *ListAuditLogsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListProjectsAdmin request
 	ListProjectsAdmin(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -663,6 +1537,9 @@ type ClientInterface interface {
 
 	UpdateProjectAdmin(ctx context.Context, projectID ProjectIDPath, body UpdateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetProjectDeletionAdmin request
+	GetProjectDeletionAdmin(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListImagesAdmin request
 	ListImagesAdmin(ctx context.Context, projectID ProjectIDPath, params *ListImagesAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -674,6 +1551,12 @@ type ClientInterface interface {
 	// DeleteImageAdmin request
 	DeleteImageAdmin(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RestoreImageAdmin request
+	RestoreImageAdmin(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreProjectAdmin request
+	RestoreProjectAdmin(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListServiceAccountsAdmin request
 	ListServiceAccountsAdmin(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -693,14 +1576,51 @@ type ClientInterface interface {
 
 	UpdateServiceAccountAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, body UpdateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// StartGoogleSignIn request
-	StartGoogleSignIn(ctx context.Context, params *StartGoogleSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ListServiceAccountAPIKeysAdmin request
+	ListServiceAccountAPIKeysAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateServiceAccountAPIKeyAdminWithBody request with any body
+	CreateServiceAccountAPIKeyAdminWithBody(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateServiceAccountAPIKeyAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, body CreateServiceAccountAPIKeyAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeServiceAccountAPIKeyAdmin request
+	RevokeServiceAccountAPIKeyAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, apiKeyID APIKeyIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUsersAdmin request
+	ListUsersAdmin(ctx context.Context, params *ListUsersAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserAdmin request
+	GetUserAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserAdminWithBody request with any body
+	UpdateUserAdminWithBody(ctx context.Context, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUserAdmin(ctx context.Context, userID UserIDPath, body UpdateUserAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// FinishGoogleSignIn request
-	FinishGoogleSignIn(ctx context.Context, params *FinishGoogleSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// RevokeUserSessionsAdmin request
+	RevokeUserSessionsAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SuspendUserAdmin request
+	SuspendUserAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnsuspendUserAdmin request
+	UnsuspendUserAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAuthProviders request
+	ListAuthProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SignOut request
-	SignOut(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	SignOut(ctx context.Context, params *SignOutParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartSignIn request
+	StartSignIn(ctx context.Context, provider OIDCProviderPath, params *StartSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FinishSignIn request
+	FinishSignIn(ctx context.Context, provider OIDCProviderPath, params *FinishSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjects request
+	ListProjects(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetProject request
 	GetProject(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -719,8 +1639,69 @@ type ClientInterface interface {
 	// GetImage request
 	GetImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateImageWithBody request with any body
+	UpdateImageWithBody(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteUpload request
+	CompleteUpload(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateImageFocusWithBody request with any body
+	UpdateImageFocusWithBody(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateImageFocus(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreImage request
+	RestoreImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjectMembers request
+	ListProjectMembers(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddProjectMemberWithBody request with any body
+	AddProjectMemberWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddProjectMember(ctx context.Context, projectID ProjectIDPath, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveProjectMember request
+	RemoveProjectMember(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectMemberWithBody request with any body
+	UpdateProjectMemberWithBody(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectMember(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, body UpdateProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWatermarks request
+	ListWatermarks(ctx context.Context, projectID ProjectIDPath, params *ListWatermarksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWatermarkUploadURLWithBody request with any body
+	CreateWatermarkUploadURLWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWatermarkUploadURL(ctx context.Context, projectID ProjectIDPath, body CreateWatermarkUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWatermark request
+	DeleteWatermark(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteWatermarkUpload request
+	CompleteWatermarkUpload(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetCurrentUser request
 	GetCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeliverImage request
+	DeliverImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, presetName string, params *DeliverImageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListAuditLogsAdmin(ctx context.Context, params *ListAuditLogsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditLogsAdminRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) ListProjectsAdmin(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -807,6 +1788,18 @@ func (c *Client) UpdateProjectAdmin(ctx context.Context, projectID ProjectIDPath
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetProjectDeletionAdmin(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectDeletionAdminRequest(c.Server, projectID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListImagesAdmin(ctx context.Context, projectID ProjectIDPath, params *ListImagesAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListImagesAdminRequest(c.Server, projectID, params)
 	if err != nil {
@@ -855,6 +1848,30 @@ func (c *Client) DeleteImageAdmin(ctx context.Context, projectID ProjectIDPath,
 	return c.Client.Do(req)
 }
 
+func (c *Client) RestoreImageAdmin(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreImageAdminRequest(c.Server, projectID, imageID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreProjectAdmin(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreProjectAdminRequest(c.Server, projectID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListServiceAccountsAdmin(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListServiceAccountsAdminRequest(c.Server, params)
 	if err != nil {
@@ -939,8 +1956,8 @@ func (c *Client) UpdateServiceAccountAdmin(ctx context.Context, serviceAccountID
 	return c.Client.Do(req)
 }
 
-func (c *Client) StartGoogleSignIn(ctx context.Context, params *StartGoogleSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStartGoogleSignInRequest(c.Server, params)
+func (c *Client) ListServiceAccountAPIKeysAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListServiceAccountAPIKeysAdminRequest(c.Server, serviceAccountID)
 	if err != nil {
 		return nil, err
 	}
@@ -951,8 +1968,8 @@ func (c *Client) StartGoogleSignIn(ctx context.Context, params *StartGoogleSignI
 	return c.Client.Do(req)
 }
 
-func (c *Client) FinishGoogleSignIn(ctx context.Context, params *FinishGoogleSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFinishGoogleSignInRequest(c.Server, params)
+func (c *Client) CreateServiceAccountAPIKeyAdminWithBody(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateServiceAccountAPIKeyAdminRequestWithBody(c.Server, serviceAccountID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -963,8 +1980,8 @@ func (c *Client) FinishGoogleSignIn(ctx context.Context, params *FinishGoogleSig
 	return c.Client.Do(req)
 }
 
-func (c *Client) SignOut(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSignOutRequest(c.Server)
+func (c *Client) CreateServiceAccountAPIKeyAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, body CreateServiceAccountAPIKeyAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateServiceAccountAPIKeyAdminRequest(c.Server, serviceAccountID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -975,8 +1992,8 @@ func (c *Client) SignOut(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProject(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectRequest(c.Server, projectID)
+func (c *Client) RevokeServiceAccountAPIKeyAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, apiKeyID APIKeyIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeServiceAccountAPIKeyAdminRequest(c.Server, serviceAccountID, apiKeyID)
 	if err != nil {
 		return nil, err
 	}
@@ -987,8 +2004,8 @@ func (c *Client) GetProject(ctx context.Context, projectID ProjectIDPath, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListImages(ctx context.Context, projectID ProjectIDPath, params *ListImagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListImagesRequest(c.Server, projectID, params)
+func (c *Client) ListUsersAdmin(ctx context.Context, params *ListUsersAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUsersAdminRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -999,8 +2016,8 @@ func (c *Client) ListImages(ctx context.Context, projectID ProjectIDPath, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateUploadURLWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUploadURLRequestWithBody(c.Server, projectID, contentType, body)
+func (c *Client) GetUserAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserAdminRequest(c.Server, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -1011,8 +2028,8 @@ func (c *Client) CreateUploadURLWithBody(ctx context.Context, projectID ProjectI
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateUploadURL(ctx context.Context, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUploadURLRequest(c.Server, projectID, body)
+func (c *Client) UpdateUserAdminWithBody(ctx context.Context, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserAdminRequestWithBody(c.Server, userID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1023,8 +2040,8 @@ func (c *Client) CreateUploadURL(ctx context.Context, projectID ProjectIDPath, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteImageRequest(c.Server, projectID, imageID)
+func (c *Client) UpdateUserAdmin(ctx context.Context, userID UserIDPath, body UpdateUserAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserAdminRequest(c.Server, userID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1035,8 +2052,8 @@ func (c *Client) DeleteImage(ctx context.Context, projectID ProjectIDPath, image
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetImageRequest(c.Server, projectID, imageID, params)
+func (c *Client) RevokeUserSessionsAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeUserSessionsAdminRequest(c.Server, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -1047,8 +2064,8 @@ func (c *Client) GetImage(ctx context.Context, projectID ProjectIDPath, imageID
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCurrentUserRequest(c.Server)
+func (c *Client) SuspendUserAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSuspendUserAdminRequest(c.Server, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -1059,243 +2076,388 @@ func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-// NewListProjectsAdminRequest generates requests for ListProjectsAdmin
-func NewListProjectsAdminRequest(server string, params *ListProjectsAdminParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UnsuspendUserAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnsuspendUserAdminRequest(c.Server, userID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListAuthProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuthProvidersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) SignOut(ctx context.Context, params *SignOutParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignOutRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateProjectAdminRequest calls the generic CreateProjectAdmin builder with application/json body
-func NewCreateProjectAdminRequest(server string, body CreateProjectAdminJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) StartSignIn(ctx context.Context, provider OIDCProviderPath, params *StartSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartSignInRequest(c.Server, provider, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectAdminRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateProjectAdminRequestWithBody generates requests for CreateProjectAdmin with any type of body
-func NewCreateProjectAdminRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) FinishSignIn(ctx context.Context, provider OIDCProviderPath, params *FinishSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinishSignInRequest(c.Server, provider, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListProjects(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetProject(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectRequest(c.Server, projectID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+func (c *Client) ListImages(ctx context.Context, projectID ProjectIDPath, params *ListImagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListImagesRequest(c.Server, projectID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteProjectAdminRequest generates requests for DeleteProjectAdmin
-func NewDeleteProjectAdminRequest(server string, projectID ProjectIDPath) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+func (c *Client) CreateUploadURLWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUploadURLRequestWithBody(c.Server, projectID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateUploadURL(ctx context.Context, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUploadURLRequest(c.Server, projectID, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteImageRequest(c.Server, projectID, imageID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) GetImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetImageRequest(c.Server, projectID, imageID, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetProjectAdminRequest generates requests for GetProjectAdmin
-func NewGetProjectAdminRequest(server string, projectID ProjectIDPath) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+func (c *Client) UpdateImageWithBody(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateImageRequestWithBody(c.Server, projectID, imageID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateImageRequest(c.Server, projectID, imageID, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) CompleteUpload(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteUploadRequest(c.Server, projectID, imageID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateImageFocusWithBody(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateImageFocusRequestWithBody(c.Server, projectID, imageID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UpdateImageFocus(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateImageFocusRequest(c.Server, projectID, imageID, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) RestoreImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreImageRequest(c.Server, projectID, imageID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateProjectAdminRequest calls the generic UpdateProjectAdmin builder with application/json body
-func NewUpdateProjectAdminRequest(server string, projectID ProjectIDPath, body UpdateProjectAdminJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListProjectMembers(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectMembersRequest(c.Server, projectID)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectAdminRequestWithBody(server, projectID, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateProjectAdminRequestWithBody generates requests for UpdateProjectAdmin with any type of body
-func NewUpdateProjectAdminRequestWithBody(server string, projectID ProjectIDPath, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) AddProjectMemberWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddProjectMemberRequestWithBody(c.Server, projectID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) AddProjectMember(ctx context.Context, projectID ProjectIDPath, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddProjectMemberRequest(c.Server, projectID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+func (c *Client) RemoveProjectMember(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveProjectMemberRequest(c.Server, projectID, userID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateProjectMemberWithBody(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectMemberRequestWithBody(c.Server, projectID, userID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) UpdateProjectMember(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, body UpdateProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectMemberRequest(c.Server, projectID, userID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListWatermarks(ctx context.Context, projectID ProjectIDPath, params *ListWatermarksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWatermarksRequest(c.Server, projectID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) CreateWatermarkUploadURLWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWatermarkUploadURLRequestWithBody(c.Server, projectID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) CreateWatermarkUploadURL(ctx context.Context, projectID ProjectIDPath, body CreateWatermarkUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWatermarkUploadURLRequest(c.Server, projectID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DeleteWatermark(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWatermarkRequest(c.Server, projectID, watermarkID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListImagesAdminRequest generates requests for ListImagesAdmin
-func NewListImagesAdminRequest(server string, projectID ProjectIDPath, params *ListImagesAdminParams) (*http.Request, error) {
-	var err error
+func (c *Client) CompleteWatermarkUpload(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteWatermarkUploadRequest(c.Server, projectID, watermarkID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCurrentUserRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+func (c *Client) DeliverImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, presetName string, params *DeliverImageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeliverImageRequest(c.Server, projectID, imageID, presetName, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListAuditLogsAdminRequest generates requests for ListAuditLogsAdmin
+func NewListAuditLogsAdminRequest(server string, params *ListAuditLogsAdminParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s/images", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/audit-logs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1340,9 +2502,9 @@ func NewListImagesAdminRequest(server string, projectID ProjectIDPath, params *L
 
 		}
 
-		if params.SortBy != nil {
+		if params.ActorID != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actorId", runtime.ParamLocationQuery, *params.ActorID); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1356,9 +2518,9 @@ func NewListImagesAdminRequest(server string, projectID ProjectIDPath, params *L
 
 		}
 
-		if params.SortOrder != nil {
+		if params.Action != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1372,98 +2534,74 @@ func NewListImagesAdminRequest(server string, projectID ProjectIDPath, params *L
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+		if params.TargetType != nil {
 
-// NewReprocessImagesAdminRequest calls the generic ReprocessImagesAdmin builder with application/json body
-func NewReprocessImagesAdminRequest(server string, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewReprocessImagesAdminRequestWithBody(server, projectID, "application/json", bodyReader)
-}
-
-// NewReprocessImagesAdminRequestWithBody generates requests for ReprocessImagesAdmin with any type of body
-func NewReprocessImagesAdminRequestWithBody(server string, projectID ProjectIDPath, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s/images/reprocess", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "targetType", runtime.ParamLocationQuery, *params.TargetType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	req.Header.Add("Content-Type", contentType)
+		if params.TargetID != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "targetId", runtime.ParamLocationQuery, *params.TargetID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewDeleteImageAdminRequest generates requests for DeleteImageAdmin
-func NewDeleteImageAdminRequest(server string, projectID ProjectIDPath, imageID ImageIDPath) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.CreatedAfter != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam1 string
+		}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "imageId", runtime.ParamLocationPath, imageID)
-	if err != nil {
-		return nil, err
-	}
+		if params.CreatedBefore != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s/images/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1471,8 +2609,8 @@ func NewDeleteImageAdminRequest(server string, projectID ProjectIDPath, imageID
 	return req, nil
 }
 
-// NewListServiceAccountsAdminRequest generates requests for ListServiceAccountsAdmin
-func NewListServiceAccountsAdminRequest(server string, params *ListServiceAccountsAdminParams) (*http.Request, error) {
+// NewListProjectsAdminRequest generates requests for ListProjectsAdmin
+func NewListProjectsAdminRequest(server string, params *ListProjectsAdminParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1480,7 +2618,7 @@ func NewListServiceAccountsAdminRequest(server string, params *ListServiceAccoun
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts")
+	operationPath := fmt.Sprintf("/api/v1/admin/projects")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1525,6 +2663,22 @@ func NewListServiceAccountsAdminRequest(server string, params *ListServiceAccoun
 
 		}
 
+		if params.Deleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deleted", runtime.ParamLocationQuery, *params.Deleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -1536,19 +2690,19 @@ func NewListServiceAccountsAdminRequest(server string, params *ListServiceAccoun
 	return req, nil
 }
 
-// NewCreateServiceAccountAdminRequest calls the generic CreateServiceAccountAdmin builder with application/json body
-func NewCreateServiceAccountAdminRequest(server string, body CreateServiceAccountAdminJSONRequestBody) (*http.Request, error) {
+// NewCreateProjectAdminRequest calls the generic CreateProjectAdmin builder with application/json body
+func NewCreateProjectAdminRequest(server string, body CreateProjectAdminJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateServiceAccountAdminRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateProjectAdminRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateServiceAccountAdminRequestWithBody generates requests for CreateServiceAccountAdmin with any type of body
-func NewCreateServiceAccountAdminRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateProjectAdminRequestWithBody generates requests for CreateProjectAdmin with any type of body
+func NewCreateProjectAdminRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1556,7 +2710,7 @@ func NewCreateServiceAccountAdminRequestWithBody(server string, contentType stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts")
+	operationPath := fmt.Sprintf("/api/v1/admin/projects")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1576,13 +2730,13 @@ func NewCreateServiceAccountAdminRequestWithBody(server string, contentType stri
 	return req, nil
 }
 
-// NewDeleteServiceAccountAdminRequest generates requests for DeleteServiceAccountAdmin
-func NewDeleteServiceAccountAdminRequest(server string, serviceAccountID ServiceAccountIDPath) (*http.Request, error) {
+// NewDeleteProjectAdminRequest generates requests for DeleteProjectAdmin
+func NewDeleteProjectAdminRequest(server string, projectID ProjectIDPath) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountId", runtime.ParamLocationPath, serviceAccountID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -1592,7 +2746,7 @@ func NewDeleteServiceAccountAdminRequest(server string, serviceAccountID Service
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1610,13 +2764,13 @@ func NewDeleteServiceAccountAdminRequest(server string, serviceAccountID Service
 	return req, nil
 }
 
-// NewGetServiceAccountAdminRequest generates requests for GetServiceAccountAdmin
-func NewGetServiceAccountAdminRequest(server string, serviceAccountID ServiceAccountIDPath) (*http.Request, error) {
+// NewGetProjectAdminRequest generates requests for GetProjectAdmin
+func NewGetProjectAdminRequest(server string, projectID ProjectIDPath) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountId", runtime.ParamLocationPath, serviceAccountID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -1626,7 +2780,7 @@ func NewGetServiceAccountAdminRequest(server string, serviceAccountID ServiceAcc
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1644,24 +2798,24 @@ func NewGetServiceAccountAdminRequest(server string, serviceAccountID ServiceAcc
 	return req, nil
 }
 
-// NewUpdateServiceAccountAdminRequest calls the generic UpdateServiceAccountAdmin builder with application/json body
-func NewUpdateServiceAccountAdminRequest(server string, serviceAccountID ServiceAccountIDPath, body UpdateServiceAccountAdminJSONRequestBody) (*http.Request, error) {
+// NewUpdateProjectAdminRequest calls the generic UpdateProjectAdmin builder with application/json body
+func NewUpdateProjectAdminRequest(server string, projectID ProjectIDPath, body UpdateProjectAdminJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateServiceAccountAdminRequestWithBody(server, serviceAccountID, "application/json", bodyReader)
+	return NewUpdateProjectAdminRequestWithBody(server, projectID, "application/json", bodyReader)
 }
 
-// NewUpdateServiceAccountAdminRequestWithBody generates requests for UpdateServiceAccountAdmin with any type of body
-func NewUpdateServiceAccountAdminRequestWithBody(server string, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateProjectAdminRequestWithBody generates requests for UpdateProjectAdmin with any type of body
+func NewUpdateProjectAdminRequestWithBody(server string, projectID ProjectIDPath, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountId", runtime.ParamLocationPath, serviceAccountID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -1671,7 +2825,7 @@ func NewUpdateServiceAccountAdminRequestWithBody(server string, serviceAccountID
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1691,16 +2845,23 @@ func NewUpdateServiceAccountAdminRequestWithBody(server string, serviceAccountID
 	return req, nil
 }
 
-// NewStartGoogleSignInRequest generates requests for StartGoogleSignIn
-func NewStartGoogleSignInRequest(server string, params *StartGoogleSignInParams) (*http.Request, error) {
+// NewGetProjectDeletionAdminRequest generates requests for GetProjectDeletionAdmin
+func NewGetProjectDeletionAdminRequest(server string, projectID ProjectIDPath) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/auth/google/sign-in")
+	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s/deletion", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1710,28 +2871,6 @@ func NewStartGoogleSignInRequest(server string, params *StartGoogleSignInParams)
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Redirect != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "redirect", runtime.ParamLocationQuery, *params.Redirect); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1740,16 +2879,23 @@ func NewStartGoogleSignInRequest(server string, params *StartGoogleSignInParams)
 	return req, nil
 }
 
-// NewFinishGoogleSignInRequest generates requests for FinishGoogleSignIn
-func NewFinishGoogleSignInRequest(server string, params *FinishGoogleSignInParams) (*http.Request, error) {
+// NewListImagesAdminRequest generates requests for ListImagesAdmin
+func NewListImagesAdminRequest(server string, projectID ProjectIDPath, params *ListImagesAdminParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/auth/google/sign-in/callback")
+	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s/images", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1762,134 +2908,137 @@ func NewFinishGoogleSignInRequest(server string, params *FinishGoogleSignInParam
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, params.Code); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, params.State); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.SortBy != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewSignOutRequest generates requests for SignOut
-func NewSignOutRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/auth/sign-out")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewGetProjectRequest generates requests for GetProject
-func NewGetProjectRequest(server string, projectID ProjectIDPath) (*http.Request, error) {
-	var err error
+		if params.SortOrder != nil {
 
-	var pathParam0 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.Deleted != nil {
 
-	operationPath := fmt.Sprintf("/api/v1/projects/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deleted", runtime.ParamLocationQuery, *params.Deleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.ExternalID != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "externalId", runtime.ParamLocationQuery, *params.ExternalID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewListImagesRequest generates requests for ListImages
-func NewListImagesRequest(server string, projectID ProjectIDPath, params *ListImagesParams) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.Tag != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/api/v1/projects/%s/images", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.Metadata != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "metadata", runtime.ParamLocationQuery, *params.Metadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		}
 
-		if params.Offset != nil {
+		if params.FileNamePrefix != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fileNamePrefix", runtime.ParamLocationQuery, *params.FileNamePrefix); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1903,9 +3052,9 @@ func NewListImagesRequest(server string, projectID ProjectIDPath, params *ListIm
 
 		}
 
-		if params.Limit != nil {
+		if params.Format != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1919,9 +3068,9 @@ func NewListImagesRequest(server string, projectID ProjectIDPath, params *ListIm
 
 		}
 
-		if params.SortBy != nil {
+		if params.CreatedAfter != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1935,9 +3084,9 @@ func NewListImagesRequest(server string, projectID ProjectIDPath, params *ListIm
 
 		}
 
-		if params.SortOrder != nil {
+		if params.CreatedBefore != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1962,19 +3111,19 @@ func NewListImagesRequest(server string, projectID ProjectIDPath, params *ListIm
 	return req, nil
 }
 
-// NewCreateUploadURLRequest calls the generic CreateUploadURL builder with application/json body
-func NewCreateUploadURLRequest(server string, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody) (*http.Request, error) {
+// NewReprocessImagesAdminRequest calls the generic ReprocessImagesAdmin builder with application/json body
+func NewReprocessImagesAdminRequest(server string, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateUploadURLRequestWithBody(server, projectID, "application/json", bodyReader)
+	return NewReprocessImagesAdminRequestWithBody(server, projectID, "application/json", bodyReader)
 }
 
-// NewCreateUploadURLRequestWithBody generates requests for CreateUploadURL with any type of body
-func NewCreateUploadURLRequestWithBody(server string, projectID ProjectIDPath, contentType string, body io.Reader) (*http.Request, error) {
+// NewReprocessImagesAdminRequestWithBody generates requests for ReprocessImagesAdmin with any type of body
+func NewReprocessImagesAdminRequestWithBody(server string, projectID ProjectIDPath, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1989,7 +3138,7 @@ func NewCreateUploadURLRequestWithBody(server string, projectID ProjectIDPath, c
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/upload-url", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s/images/reprocess", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2009,8 +3158,8 @@ func NewCreateUploadURLRequestWithBody(server string, projectID ProjectIDPath, c
 	return req, nil
 }
 
-// NewDeleteImageRequest generates requests for DeleteImage
-func NewDeleteImageRequest(server string, projectID ProjectIDPath, imageID ImageIDPath) (*http.Request, error) {
+// NewDeleteImageAdminRequest generates requests for DeleteImageAdmin
+func NewDeleteImageAdminRequest(server string, projectID ProjectIDPath, imageID ImageIDPath) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2032,7 +3181,7 @@ func NewDeleteImageRequest(server string, projectID ProjectIDPath, imageID Image
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s/images/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2050,8 +3199,8 @@ func NewDeleteImageRequest(server string, projectID ProjectIDPath, imageID Image
 	return req, nil
 }
 
-// NewGetImageRequest generates requests for GetImage
-func NewGetImageRequest(server string, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams) (*http.Request, error) {
+// NewRestoreImageAdminRequest generates requests for RestoreImageAdmin
+func NewRestoreImageAdminRequest(server string, projectID ProjectIDPath, imageID ImageIDPath) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2073,7 +3222,7 @@ func NewGetImageRequest(server string, projectID ProjectIDPath, imageID ImageIDP
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s/images/%s/restore", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2083,29 +3232,7 @@ func NewGetImageRequest(server string, projectID ProjectIDPath, imageID ImageIDP
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.WaitUntilProcessed != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "waitUntilProcessed", runtime.ParamLocationQuery, *params.WaitUntilProcessed); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2113,16 +3240,23 @@ func NewGetImageRequest(server string, projectID ProjectIDPath, imageID ImageIDP
 	return req, nil
 }
 
-// NewGetCurrentUserRequest generates requests for GetCurrentUser
-func NewGetCurrentUserRequest(server string) (*http.Request, error) {
+// NewRestoreProjectAdminRequest generates requests for RestoreProjectAdmin
+func NewRestoreProjectAdminRequest(server string, projectID ProjectIDPath) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/me")
+	operationPath := fmt.Sprintf("/api/v1/admin/projects/%s/restore", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2132,7 +3266,7 @@ func NewGetCurrentUserRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2140,889 +3274,4777 @@ func NewGetCurrentUserRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewListServiceAccountsAdminRequest generates requests for ListServiceAccountsAdmin
+func NewListServiceAccountsAdminRequest(server string, params *ListServiceAccountsAdminParams) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListProjectsAdminWithResponse request
-	ListProjectsAdminWithResponse(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*ListProjectsAdminResponse, error)
-
-	// CreateProjectAdminWithBodyWithResponse request with any body
-	CreateProjectAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateProjectAdminWithResponse(ctx context.Context, body CreateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// DeleteProjectAdminWithResponse request
-	DeleteProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*DeleteProjectAdminResponse, error)
+		if params.Offset != nil {
 
-	// GetProjectAdminWithResponse request
-	GetProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectAdminResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// UpdateProjectAdminWithBodyWithResponse request with any body
-	UpdateProjectAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectAdminResponse, error)
+		}
 
-	UpdateProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body UpdateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectAdminResponse, error)
+		if params.Limit != nil {
 
-	// ListImagesAdminWithResponse request
-	ListImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesAdminParams, reqEditors ...RequestEditorFn) (*ListImagesAdminResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// ReprocessImagesAdminWithBodyWithResponse request with any body
-	ReprocessImagesAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error)
+		}
 
-	ReprocessImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// DeleteImageAdminWithResponse request
-	DeleteImageAdminWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageAdminResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListServiceAccountsAdminWithResponse request
-	ListServiceAccountsAdminWithResponse(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*ListServiceAccountsAdminResponse, error)
+	return req, nil
+}
 
-	// CreateServiceAccountAdminWithBodyWithResponse request with any body
-	CreateServiceAccountAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error)
+// NewCreateServiceAccountAdminRequest calls the generic CreateServiceAccountAdmin builder with application/json body
+func NewCreateServiceAccountAdminRequest(server string, body CreateServiceAccountAdminJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateServiceAccountAdminRequestWithBody(server, "application/json", bodyReader)
+}
 
-	CreateServiceAccountAdminWithResponse(ctx context.Context, body CreateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error)
+// NewCreateServiceAccountAdminRequestWithBody generates requests for CreateServiceAccountAdmin with any type of body
+func NewCreateServiceAccountAdminRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// DeleteServiceAccountAdminWithResponse request
-	DeleteServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*DeleteServiceAccountAdminResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetServiceAccountAdminWithResponse request
-	GetServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*GetServiceAccountAdminResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateServiceAccountAdminWithBodyWithResponse request with any body
-	UpdateServiceAccountAdminWithBodyWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateServiceAccountAdminResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, body UpdateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateServiceAccountAdminResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// StartGoogleSignInWithResponse request
-	StartGoogleSignInWithResponse(ctx context.Context, params *StartGoogleSignInParams, reqEditors ...RequestEditorFn) (*StartGoogleSignInResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// FinishGoogleSignInWithResponse request
-	FinishGoogleSignInWithResponse(ctx context.Context, params *FinishGoogleSignInParams, reqEditors ...RequestEditorFn) (*FinishGoogleSignInResponse, error)
+	return req, nil
+}
 
-	// SignOutWithResponse request
-	SignOutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOutResponse, error)
+// NewDeleteServiceAccountAdminRequest generates requests for DeleteServiceAccountAdmin
+func NewDeleteServiceAccountAdminRequest(server string, serviceAccountID ServiceAccountIDPath) (*http.Request, error) {
+	var err error
 
-	// GetProjectWithResponse request
-	GetProjectWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+	var pathParam0 string
 
-	// ListImagesWithResponse request
-	ListImagesWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesParams, reqEditors ...RequestEditorFn) (*ListImagesResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountId", runtime.ParamLocationPath, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateUploadURLWithBodyWithResponse request with any body
-	CreateUploadURLWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateUploadURLWithResponse(ctx context.Context, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteImageWithResponse request
-	DeleteImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetImageWithResponse request
-	GetImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams, reqEditors ...RequestEditorFn) (*GetImageResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCurrentUserWithResponse request
-	GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error)
+	return req, nil
 }
 
-type ListProjectsAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Projects
-	JSONDefault  *ErrorResponse
-}
+// NewGetServiceAccountAdminRequest generates requests for GetServiceAccountAdmin
+func NewGetServiceAccountAdminRequest(server string, serviceAccountID ServiceAccountIDPath) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListProjectsAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountId", runtime.ParamLocationPath, serviceAccountID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListProjectsAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateProjectAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Project
-	JSONDefault  *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateProjectAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteProjectAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteProjectAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateServiceAccountAdminRequest calls the generic UpdateServiceAccountAdmin builder with application/json body
+func NewUpdateServiceAccountAdminRequest(server string, serviceAccountID ServiceAccountIDPath, body UpdateServiceAccountAdminJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateServiceAccountAdminRequestWithBody(server, serviceAccountID, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateServiceAccountAdminRequestWithBody generates requests for UpdateServiceAccountAdmin with any type of body
+func NewUpdateServiceAccountAdminRequestWithBody(server string, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetProjectAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Project
-	JSONDefault  *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetProjectAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountId", runtime.ParamLocationPath, serviceAccountID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateProjectAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Project
-	JSONDefault  *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateProjectAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProjectAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListImagesAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Images
-	JSONDefault  *ErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListImagesAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListServiceAccountAPIKeysAdminRequest generates requests for ListServiceAccountAPIKeysAdmin
+func NewListServiceAccountAPIKeysAdminRequest(server string, serviceAccountID ServiceAccountIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountId", runtime.ParamLocationPath, serviceAccountID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListImagesAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ReprocessImagesAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Images
-	JSONDefault  *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts/%s/api-keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ReprocessImagesAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReprocessImagesAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteImageAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteImageAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateServiceAccountAPIKeyAdminRequest calls the generic CreateServiceAccountAPIKeyAdmin builder with application/json body
+func NewCreateServiceAccountAPIKeyAdminRequest(server string, serviceAccountID ServiceAccountIDPath, body CreateServiceAccountAPIKeyAdminJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateServiceAccountAPIKeyAdminRequestWithBody(server, serviceAccountID, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteImageAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewCreateServiceAccountAPIKeyAdminRequestWithBody generates requests for CreateServiceAccountAPIKeyAdmin with any type of body
+func NewCreateServiceAccountAPIKeyAdminRequestWithBody(server string, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type ListServiceAccountsAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceAccounts
-	JSONDefault  *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r ListServiceAccountsAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountId", runtime.ParamLocationPath, serviceAccountID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListServiceAccountsAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateServiceAccountAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceAccountWithAPIKey
-	JSONDefault  *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts/%s/api-keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateServiceAccountAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateServiceAccountAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteServiceAccountAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r DeleteServiceAccountAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteServiceAccountAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewRevokeServiceAccountAPIKeyAdminRequest generates requests for RevokeServiceAccountAPIKeyAdmin
+func NewRevokeServiceAccountAPIKeyAdminRequest(server string, serviceAccountID ServiceAccountIDPath, apiKeyID APIKeyIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceAccountId", runtime.ParamLocationPath, serviceAccountID)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetServiceAccountAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceAccount
-	JSONDefault  *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetServiceAccountAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apiKeyId", runtime.ParamLocationPath, apiKeyID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetServiceAccountAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateServiceAccountAdminResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceAccount
-	JSONDefault  *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/admin/service-accounts/%s/api-keys/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateServiceAccountAdminResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateServiceAccountAdminResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type StartGoogleSignInResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r StartGoogleSignInResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListUsersAdminRequest generates requests for ListUsersAdmin
+func NewListUsersAdminRequest(server string, params *ListUsersAdminParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StartGoogleSignInResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/admin/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type FinishGoogleSignInResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Role != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Suspended != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "suspended", runtime.ParamLocationQuery, *params.Suspended); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserAdminRequest generates requests for GetUserAdmin
+func NewGetUserAdminRequest(server string, userID UserIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserAdminRequest calls the generic UpdateUserAdmin builder with application/json body
+func NewUpdateUserAdminRequest(server string, userID UserIDPath, body UpdateUserAdminJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserAdminRequestWithBody(server, userID, "application/json", bodyReader)
+}
+
+// NewUpdateUserAdminRequestWithBody generates requests for UpdateUserAdmin with any type of body
+func NewUpdateUserAdminRequestWithBody(server string, userID UserIDPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeUserSessionsAdminRequest generates requests for RevokeUserSessionsAdmin
+func NewRevokeUserSessionsAdminRequest(server string, userID UserIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/revoke-sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSuspendUserAdminRequest generates requests for SuspendUserAdmin
+func NewSuspendUserAdminRequest(server string, userID UserIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/suspend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnsuspendUserAdminRequest generates requests for UnsuspendUserAdmin
+func NewUnsuspendUserAdminRequest(server string, userID UserIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/unsuspend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAuthProvidersRequest generates requests for ListAuthProviders
+func NewListAuthProvidersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/providers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSignOutRequest generates requests for SignOut
+func NewSignOutRequest(server string, params *SignOutParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/sign-out")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.All != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all", runtime.ParamLocationQuery, *params.All); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStartSignInRequest generates requests for StartSignIn
+func NewStartSignInRequest(server string, provider OIDCProviderPath, params *StartSignInParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/%s/sign-in", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Redirect != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "redirect", runtime.ParamLocationQuery, *params.Redirect); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFinishSignInRequest generates requests for FinishSignIn
+func NewFinishSignInRequest(server string, provider OIDCProviderPath, params *FinishSignInParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/%s/sign-in/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, params.Code); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, params.State); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProjectsRequest generates requests for ListProjects
+func NewListProjectsRequest(server string, params *ListProjectsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectRequest generates requests for GetProject
+func NewGetProjectRequest(server string, projectID ProjectIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListImagesRequest generates requests for ListImages
+func NewListImagesRequest(server string, projectID ProjectIDPath, params *ListImagesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/images", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortOrder != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExternalID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "externalId", runtime.ParamLocationQuery, *params.ExternalID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Metadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "metadata", runtime.ParamLocationQuery, *params.Metadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FileNamePrefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fileNamePrefix", runtime.ParamLocationQuery, *params.FileNamePrefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUploadURLRequest calls the generic CreateUploadURL builder with application/json body
+func NewCreateUploadURLRequest(server string, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUploadURLRequestWithBody(server, projectID, "application/json", bodyReader)
+}
+
+// NewCreateUploadURLRequestWithBody generates requests for CreateUploadURL with any type of body
+func NewCreateUploadURLRequestWithBody(server string, projectID ProjectIDPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/upload-url", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteImageRequest generates requests for DeleteImage
+func NewDeleteImageRequest(server string, projectID ProjectIDPath, imageID ImageIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "imageId", runtime.ParamLocationPath, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetImageRequest generates requests for GetImage
+func NewGetImageRequest(server string, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "imageId", runtime.ParamLocationPath, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.WaitUntilProcessed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "waitUntilProcessed", runtime.ParamLocationQuery, *params.WaitUntilProcessed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateImageRequest calls the generic UpdateImage builder with application/json body
+func NewUpdateImageRequest(server string, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateImageRequestWithBody(server, projectID, imageID, "application/json", bodyReader)
+}
+
+// NewUpdateImageRequestWithBody generates requests for UpdateImage with any type of body
+func NewUpdateImageRequestWithBody(server string, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "imageId", runtime.ParamLocationPath, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCompleteUploadRequest generates requests for CompleteUpload
+func NewCompleteUploadRequest(server string, projectID ProjectIDPath, imageID ImageIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "imageId", runtime.ParamLocationPath, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/%s/complete-upload", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateImageFocusRequest calls the generic UpdateImageFocus builder with application/json body
+func NewUpdateImageFocusRequest(server string, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageFocusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateImageFocusRequestWithBody(server, projectID, imageID, "application/json", bodyReader)
+}
+
+// NewUpdateImageFocusRequestWithBody generates requests for UpdateImageFocus with any type of body
+func NewUpdateImageFocusRequestWithBody(server string, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "imageId", runtime.ParamLocationPath, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/%s/focus", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestoreImageRequest generates requests for RestoreImage
+func NewRestoreImageRequest(server string, projectID ProjectIDPath, imageID ImageIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "imageId", runtime.ParamLocationPath, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/images/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProjectMembersRequest generates requests for ListProjectMembers
+func NewListProjectMembersRequest(server string, projectID ProjectIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddProjectMemberRequest calls the generic AddProjectMember builder with application/json body
+func NewAddProjectMemberRequest(server string, projectID ProjectIDPath, body AddProjectMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddProjectMemberRequestWithBody(server, projectID, "application/json", bodyReader)
+}
+
+// NewAddProjectMemberRequestWithBody generates requests for AddProjectMember with any type of body
+func NewAddProjectMemberRequestWithBody(server string, projectID ProjectIDPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveProjectMemberRequest generates requests for RemoveProjectMember
+func NewRemoveProjectMemberRequest(server string, projectID ProjectIDPath, userID UserIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectMemberRequest calls the generic UpdateProjectMember builder with application/json body
+func NewUpdateProjectMemberRequest(server string, projectID ProjectIDPath, userID UserIDPath, body UpdateProjectMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectMemberRequestWithBody(server, projectID, userID, "application/json", bodyReader)
+}
+
+// NewUpdateProjectMemberRequestWithBody generates requests for UpdateProjectMember with any type of body
+func NewUpdateProjectMemberRequestWithBody(server string, projectID ProjectIDPath, userID UserIDPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListWatermarksRequest generates requests for ListWatermarks
+func NewListWatermarksRequest(server string, projectID ProjectIDPath, params *ListWatermarksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/watermarks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWatermarkUploadURLRequest calls the generic CreateWatermarkUploadURL builder with application/json body
+func NewCreateWatermarkUploadURLRequest(server string, projectID ProjectIDPath, body CreateWatermarkUploadURLJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWatermarkUploadURLRequestWithBody(server, projectID, "application/json", bodyReader)
+}
+
+// NewCreateWatermarkUploadURLRequestWithBody generates requests for CreateWatermarkUploadURL with any type of body
+func NewCreateWatermarkUploadURLRequestWithBody(server string, projectID ProjectIDPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/watermarks/upload-url", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWatermarkRequest generates requests for DeleteWatermark
+func NewDeleteWatermarkRequest(server string, projectID ProjectIDPath, watermarkID WatermarkIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "watermarkId", runtime.ParamLocationPath, watermarkID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/watermarks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCompleteWatermarkUploadRequest generates requests for CompleteWatermarkUpload
+func NewCompleteWatermarkUploadRequest(server string, projectID ProjectIDPath, watermarkID WatermarkIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "watermarkId", runtime.ParamLocationPath, watermarkID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/watermarks/%s/complete-upload", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCurrentUserRequest generates requests for GetCurrentUser
+func NewGetCurrentUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeliverImageRequest generates requests for DeliverImage
+func NewDeliverImageRequest(server string, projectID ProjectIDPath, imageID ImageIDPath, presetName string, params *DeliverImageParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "imageId", runtime.ParamLocationPath, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "presetName", runtime.ParamLocationPath, presetName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/i/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Accept != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Accept", runtime.ParamLocationHeader, *params.Accept)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Accept", headerParam0)
+		}
+
+		if params.DPR != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "DPR", runtime.ParamLocationHeader, *params.DPR)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("DPR", headerParam1)
+		}
+
+		if params.Width != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Width", runtime.ParamLocationHeader, *params.Width)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Width", headerParam2)
+		}
+
+		if params.SecCHDPR != nil {
+			var headerParam3 string
+
+			headerParam3, err = runtime.StyleParamWithLocation("simple", false, "Sec-CH-DPR", runtime.ParamLocationHeader, *params.SecCHDPR)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Sec-CH-DPR", headerParam3)
+		}
+
+		if params.SecCHWidth != nil {
+			var headerParam4 string
+
+			headerParam4, err = runtime.StyleParamWithLocation("simple", false, "Sec-CH-Width", runtime.ParamLocationHeader, *params.SecCHWidth)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Sec-CH-Width", headerParam4)
+		}
+
+		if params.IfNoneMatch != nil {
+			var headerParam5 string
+
+			headerParam5, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam5)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAuditLogsAdminWithResponse request
+	ListAuditLogsAdminWithResponse(ctx context.Context, params *ListAuditLogsAdminParams, reqEditors ...RequestEditorFn) (*ListAuditLogsAdminResponse, error)
+
+	// ListProjectsAdminWithResponse request
+	ListProjectsAdminWithResponse(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*ListProjectsAdminResponse, error)
+
+	// CreateProjectAdminWithBodyWithResponse request with any body
+	CreateProjectAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error)
+
+	CreateProjectAdminWithResponse(ctx context.Context, body CreateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error)
+
+	// DeleteProjectAdminWithResponse request
+	DeleteProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*DeleteProjectAdminResponse, error)
+
+	// GetProjectAdminWithResponse request
+	GetProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectAdminResponse, error)
+
+	// UpdateProjectAdminWithBodyWithResponse request with any body
+	UpdateProjectAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectAdminResponse, error)
+
+	UpdateProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body UpdateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectAdminResponse, error)
+
+	// GetProjectDeletionAdminWithResponse request
+	GetProjectDeletionAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectDeletionAdminResponse, error)
+
+	// ListImagesAdminWithResponse request
+	ListImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesAdminParams, reqEditors ...RequestEditorFn) (*ListImagesAdminResponse, error)
+
+	// ReprocessImagesAdminWithBodyWithResponse request with any body
+	ReprocessImagesAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error)
+
+	ReprocessImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error)
+
+	// DeleteImageAdminWithResponse request
+	DeleteImageAdminWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageAdminResponse, error)
+
+	// RestoreImageAdminWithResponse request
+	RestoreImageAdminWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*RestoreImageAdminResponse, error)
+
+	// RestoreProjectAdminWithResponse request
+	RestoreProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*RestoreProjectAdminResponse, error)
+
+	// ListServiceAccountsAdminWithResponse request
+	ListServiceAccountsAdminWithResponse(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*ListServiceAccountsAdminResponse, error)
+
+	// CreateServiceAccountAdminWithBodyWithResponse request with any body
+	CreateServiceAccountAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error)
+
+	CreateServiceAccountAdminWithResponse(ctx context.Context, body CreateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error)
+
+	// DeleteServiceAccountAdminWithResponse request
+	DeleteServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*DeleteServiceAccountAdminResponse, error)
+
+	// GetServiceAccountAdminWithResponse request
+	GetServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*GetServiceAccountAdminResponse, error)
+
+	// UpdateServiceAccountAdminWithBodyWithResponse request with any body
+	UpdateServiceAccountAdminWithBodyWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateServiceAccountAdminResponse, error)
+
+	UpdateServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, body UpdateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateServiceAccountAdminResponse, error)
+
+	// ListServiceAccountAPIKeysAdminWithResponse request
+	ListServiceAccountAPIKeysAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*ListServiceAccountAPIKeysAdminResponse, error)
+
+	// CreateServiceAccountAPIKeyAdminWithBodyWithResponse request with any body
+	CreateServiceAccountAPIKeyAdminWithBodyWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceAccountAPIKeyAdminResponse, error)
+
+	CreateServiceAccountAPIKeyAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, body CreateServiceAccountAPIKeyAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceAccountAPIKeyAdminResponse, error)
+
+	// RevokeServiceAccountAPIKeyAdminWithResponse request
+	RevokeServiceAccountAPIKeyAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, apiKeyID APIKeyIDPath, reqEditors ...RequestEditorFn) (*RevokeServiceAccountAPIKeyAdminResponse, error)
+
+	// ListUsersAdminWithResponse request
+	ListUsersAdminWithResponse(ctx context.Context, params *ListUsersAdminParams, reqEditors ...RequestEditorFn) (*ListUsersAdminResponse, error)
+
+	// GetUserAdminWithResponse request
+	GetUserAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*GetUserAdminResponse, error)
+
+	// UpdateUserAdminWithBodyWithResponse request with any body
+	UpdateUserAdminWithBodyWithResponse(ctx context.Context, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserAdminResponse, error)
+
+	UpdateUserAdminWithResponse(ctx context.Context, userID UserIDPath, body UpdateUserAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserAdminResponse, error)
+
+	// RevokeUserSessionsAdminWithResponse request
+	RevokeUserSessionsAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*RevokeUserSessionsAdminResponse, error)
+
+	// SuspendUserAdminWithResponse request
+	SuspendUserAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*SuspendUserAdminResponse, error)
+
+	// UnsuspendUserAdminWithResponse request
+	UnsuspendUserAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*UnsuspendUserAdminResponse, error)
+
+	// ListAuthProvidersWithResponse request
+	ListAuthProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAuthProvidersResponse, error)
+
+	// SignOutWithResponse request
+	SignOutWithResponse(ctx context.Context, params *SignOutParams, reqEditors ...RequestEditorFn) (*SignOutResponse, error)
+
+	// StartSignInWithResponse request
+	StartSignInWithResponse(ctx context.Context, provider OIDCProviderPath, params *StartSignInParams, reqEditors ...RequestEditorFn) (*StartSignInResponse, error)
+
+	// FinishSignInWithResponse request
+	FinishSignInWithResponse(ctx context.Context, provider OIDCProviderPath, params *FinishSignInParams, reqEditors ...RequestEditorFn) (*FinishSignInResponse, error)
+
+	// ListProjectsWithResponse request
+	ListProjectsWithResponse(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error)
+
+	// GetProjectWithResponse request
+	GetProjectWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+
+	// ListImagesWithResponse request
+	ListImagesWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesParams, reqEditors ...RequestEditorFn) (*ListImagesResponse, error)
+
+	// CreateUploadURLWithBodyWithResponse request with any body
+	CreateUploadURLWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error)
+
+	CreateUploadURLWithResponse(ctx context.Context, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error)
+
+	// DeleteImageWithResponse request
+	DeleteImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error)
+
+	// GetImageWithResponse request
+	GetImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams, reqEditors ...RequestEditorFn) (*GetImageResponse, error)
+
+	// UpdateImageWithBodyWithResponse request with any body
+	UpdateImageWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateImageResponse, error)
+
+	UpdateImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateImageResponse, error)
+
+	// CompleteUploadWithResponse request
+	CompleteUploadWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*CompleteUploadResponse, error)
+
+	// UpdateImageFocusWithBodyWithResponse request with any body
+	UpdateImageFocusWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateImageFocusResponse, error)
+
+	UpdateImageFocusWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateImageFocusResponse, error)
+
+	// RestoreImageWithResponse request
+	RestoreImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*RestoreImageResponse, error)
+
+	// ListProjectMembersWithResponse request
+	ListProjectMembersWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*ListProjectMembersResponse, error)
+
+	// AddProjectMemberWithBodyWithResponse request with any body
+	AddProjectMemberWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error)
+
+	AddProjectMemberWithResponse(ctx context.Context, projectID ProjectIDPath, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error)
+
+	// RemoveProjectMemberWithResponse request
+	RemoveProjectMemberWithResponse(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, reqEditors ...RequestEditorFn) (*RemoveProjectMemberResponse, error)
+
+	// UpdateProjectMemberWithBodyWithResponse request with any body
+	UpdateProjectMemberWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectMemberResponse, error)
+
+	UpdateProjectMemberWithResponse(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, body UpdateProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectMemberResponse, error)
+
+	// ListWatermarksWithResponse request
+	ListWatermarksWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListWatermarksParams, reqEditors ...RequestEditorFn) (*ListWatermarksResponse, error)
+
+	// CreateWatermarkUploadURLWithBodyWithResponse request with any body
+	CreateWatermarkUploadURLWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWatermarkUploadURLResponse, error)
+
+	CreateWatermarkUploadURLWithResponse(ctx context.Context, projectID ProjectIDPath, body CreateWatermarkUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWatermarkUploadURLResponse, error)
+
+	// DeleteWatermarkWithResponse request
+	DeleteWatermarkWithResponse(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*DeleteWatermarkResponse, error)
+
+	// CompleteWatermarkUploadWithResponse request
+	CompleteWatermarkUploadWithResponse(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*CompleteWatermarkUploadResponse, error)
+
+	// GetCurrentUserWithResponse request
+	GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error)
+
+	// DeliverImageWithResponse request
+	DeliverImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, presetName string, params *DeliverImageParams, reqEditors ...RequestEditorFn) (*DeliverImageResponse, error)
+}
+
+type ListAuditLogsAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditEntries
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditLogsAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditLogsAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectsAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Projects
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectsAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectsAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProjectAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ProjectDeletion
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProjectAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectDeletionAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectDeletion
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectDeletionAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectDeletionAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListImagesAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Images
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListImagesAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListImagesAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReprocessImagesAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Images
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReprocessImagesAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReprocessImagesAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteImageAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteImageAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteImageAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreImageAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Image
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreImageAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreImageAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreProjectAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreProjectAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreProjectAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListServiceAccountsAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccounts
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListServiceAccountsAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListServiceAccountsAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateServiceAccountAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccountWithAPIKey
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateServiceAccountAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateServiceAccountAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteServiceAccountAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteServiceAccountAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteServiceAccountAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetServiceAccountAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccount
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetServiceAccountAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetServiceAccountAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateServiceAccountAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccount
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateServiceAccountAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateServiceAccountAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListServiceAccountAPIKeysAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccountAPIKeys
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListServiceAccountAPIKeysAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListServiceAccountAPIKeysAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateServiceAccountAPIKeyAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccountWithAPIKey
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateServiceAccountAPIKeyAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateServiceAccountAPIKeyAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeServiceAccountAPIKeyAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeServiceAccountAPIKeyAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeServiceAccountAPIKeyAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUsersAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Users
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUsersAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUsersAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeUserSessionsAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeUserSessionsAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeUserSessionsAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SuspendUserAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SuspendUserAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SuspendUserAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnsuspendUserAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnsuspendUserAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnsuspendUserAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAuthProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthProviders
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuthProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuthProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SignOutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SignOutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SignOutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartSignInResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StartSignInResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartSignInResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FinishSignInResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r FinishSignInResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FinishSignInResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Projects
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListImagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Images
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListImagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListImagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUploadURLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadURL
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUploadURLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUploadURLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Image
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Image
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Image
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateImageFocusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Image
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateImageFocusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateImageFocusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Image
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectMembers
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddProjectMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectMember
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddProjectMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddProjectMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveProjectMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveProjectMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveProjectMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProjectMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectMember
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWatermarksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Watermarks
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWatermarksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWatermarksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWatermarkUploadURLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WatermarkUploadURL
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWatermarkUploadURLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWatermarkUploadURLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWatermarkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWatermarkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWatermarkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteWatermarkUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Watermark
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteWatermarkUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteWatermarkUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCurrentUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCurrentUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCurrentUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeliverImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 	JSONDefault  *ErrorResponse
 }
 
-// Status returns HTTPResponse.Status
-func (r FinishGoogleSignInResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// Status returns HTTPResponse.Status
+func (r DeliverImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeliverImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListAuditLogsAdminWithResponse request returning *ListAuditLogsAdminResponse
+func (c *ClientWithResponses) ListAuditLogsAdminWithResponse(ctx context.Context, params *ListAuditLogsAdminParams, reqEditors ...RequestEditorFn) (*ListAuditLogsAdminResponse, error) {
+	rsp, err := c.ListAuditLogsAdmin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditLogsAdminResponse(rsp)
+}
+
+// ListProjectsAdminWithResponse request returning *ListProjectsAdminResponse
+func (c *ClientWithResponses) ListProjectsAdminWithResponse(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*ListProjectsAdminResponse, error) {
+	rsp, err := c.ListProjectsAdmin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectsAdminResponse(rsp)
+}
+
+// CreateProjectAdminWithBodyWithResponse request with arbitrary body returning *CreateProjectAdminResponse
+func (c *ClientWithResponses) CreateProjectAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error) {
+	rsp, err := c.CreateProjectAdminWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectAdminWithResponse(ctx context.Context, body CreateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error) {
+	rsp, err := c.CreateProjectAdmin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectAdminResponse(rsp)
+}
+
+// DeleteProjectAdminWithResponse request returning *DeleteProjectAdminResponse
+func (c *ClientWithResponses) DeleteProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*DeleteProjectAdminResponse, error) {
+	rsp, err := c.DeleteProjectAdmin(ctx, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectAdminResponse(rsp)
+}
+
+// GetProjectAdminWithResponse request returning *GetProjectAdminResponse
+func (c *ClientWithResponses) GetProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectAdminResponse, error) {
+	rsp, err := c.GetProjectAdmin(ctx, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectAdminResponse(rsp)
+}
+
+// UpdateProjectAdminWithBodyWithResponse request with arbitrary body returning *UpdateProjectAdminResponse
+func (c *ClientWithResponses) UpdateProjectAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectAdminResponse, error) {
+	rsp, err := c.UpdateProjectAdminWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body UpdateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectAdminResponse, error) {
+	rsp, err := c.UpdateProjectAdmin(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectAdminResponse(rsp)
+}
+
+// GetProjectDeletionAdminWithResponse request returning *GetProjectDeletionAdminResponse
+func (c *ClientWithResponses) GetProjectDeletionAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectDeletionAdminResponse, error) {
+	rsp, err := c.GetProjectDeletionAdmin(ctx, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectDeletionAdminResponse(rsp)
+}
+
+// ListImagesAdminWithResponse request returning *ListImagesAdminResponse
+func (c *ClientWithResponses) ListImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesAdminParams, reqEditors ...RequestEditorFn) (*ListImagesAdminResponse, error) {
+	rsp, err := c.ListImagesAdmin(ctx, projectID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListImagesAdminResponse(rsp)
+}
+
+// ReprocessImagesAdminWithBodyWithResponse request with arbitrary body returning *ReprocessImagesAdminResponse
+func (c *ClientWithResponses) ReprocessImagesAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error) {
+	rsp, err := c.ReprocessImagesAdminWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReprocessImagesAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReprocessImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error) {
+	rsp, err := c.ReprocessImagesAdmin(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReprocessImagesAdminResponse(rsp)
+}
+
+// DeleteImageAdminWithResponse request returning *DeleteImageAdminResponse
+func (c *ClientWithResponses) DeleteImageAdminWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageAdminResponse, error) {
+	rsp, err := c.DeleteImageAdmin(ctx, projectID, imageID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteImageAdminResponse(rsp)
+}
+
+// RestoreImageAdminWithResponse request returning *RestoreImageAdminResponse
+func (c *ClientWithResponses) RestoreImageAdminWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*RestoreImageAdminResponse, error) {
+	rsp, err := c.RestoreImageAdmin(ctx, projectID, imageID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreImageAdminResponse(rsp)
+}
+
+// RestoreProjectAdminWithResponse request returning *RestoreProjectAdminResponse
+func (c *ClientWithResponses) RestoreProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*RestoreProjectAdminResponse, error) {
+	rsp, err := c.RestoreProjectAdmin(ctx, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreProjectAdminResponse(rsp)
+}
+
+// ListServiceAccountsAdminWithResponse request returning *ListServiceAccountsAdminResponse
+func (c *ClientWithResponses) ListServiceAccountsAdminWithResponse(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*ListServiceAccountsAdminResponse, error) {
+	rsp, err := c.ListServiceAccountsAdmin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListServiceAccountsAdminResponse(rsp)
+}
+
+// CreateServiceAccountAdminWithBodyWithResponse request with arbitrary body returning *CreateServiceAccountAdminResponse
+func (c *ClientWithResponses) CreateServiceAccountAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error) {
+	rsp, err := c.CreateServiceAccountAdminWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateServiceAccountAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateServiceAccountAdminWithResponse(ctx context.Context, body CreateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error) {
+	rsp, err := c.CreateServiceAccountAdmin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateServiceAccountAdminResponse(rsp)
+}
+
+// DeleteServiceAccountAdminWithResponse request returning *DeleteServiceAccountAdminResponse
+func (c *ClientWithResponses) DeleteServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*DeleteServiceAccountAdminResponse, error) {
+	rsp, err := c.DeleteServiceAccountAdmin(ctx, serviceAccountID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteServiceAccountAdminResponse(rsp)
+}
+
+// GetServiceAccountAdminWithResponse request returning *GetServiceAccountAdminResponse
+func (c *ClientWithResponses) GetServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*GetServiceAccountAdminResponse, error) {
+	rsp, err := c.GetServiceAccountAdmin(ctx, serviceAccountID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetServiceAccountAdminResponse(rsp)
+}
+
+// UpdateServiceAccountAdminWithBodyWithResponse request with arbitrary body returning *UpdateServiceAccountAdminResponse
+func (c *ClientWithResponses) UpdateServiceAccountAdminWithBodyWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateServiceAccountAdminResponse, error) {
+	rsp, err := c.UpdateServiceAccountAdminWithBody(ctx, serviceAccountID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateServiceAccountAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, body UpdateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateServiceAccountAdminResponse, error) {
+	rsp, err := c.UpdateServiceAccountAdmin(ctx, serviceAccountID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateServiceAccountAdminResponse(rsp)
+}
+
+// ListServiceAccountAPIKeysAdminWithResponse request returning *ListServiceAccountAPIKeysAdminResponse
+func (c *ClientWithResponses) ListServiceAccountAPIKeysAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*ListServiceAccountAPIKeysAdminResponse, error) {
+	rsp, err := c.ListServiceAccountAPIKeysAdmin(ctx, serviceAccountID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListServiceAccountAPIKeysAdminResponse(rsp)
+}
+
+// CreateServiceAccountAPIKeyAdminWithBodyWithResponse request with arbitrary body returning *CreateServiceAccountAPIKeyAdminResponse
+func (c *ClientWithResponses) CreateServiceAccountAPIKeyAdminWithBodyWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceAccountAPIKeyAdminResponse, error) {
+	rsp, err := c.CreateServiceAccountAPIKeyAdminWithBody(ctx, serviceAccountID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateServiceAccountAPIKeyAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateServiceAccountAPIKeyAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, body CreateServiceAccountAPIKeyAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceAccountAPIKeyAdminResponse, error) {
+	rsp, err := c.CreateServiceAccountAPIKeyAdmin(ctx, serviceAccountID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateServiceAccountAPIKeyAdminResponse(rsp)
+}
+
+// RevokeServiceAccountAPIKeyAdminWithResponse request returning *RevokeServiceAccountAPIKeyAdminResponse
+func (c *ClientWithResponses) RevokeServiceAccountAPIKeyAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, apiKeyID APIKeyIDPath, reqEditors ...RequestEditorFn) (*RevokeServiceAccountAPIKeyAdminResponse, error) {
+	rsp, err := c.RevokeServiceAccountAPIKeyAdmin(ctx, serviceAccountID, apiKeyID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeServiceAccountAPIKeyAdminResponse(rsp)
+}
+
+// ListUsersAdminWithResponse request returning *ListUsersAdminResponse
+func (c *ClientWithResponses) ListUsersAdminWithResponse(ctx context.Context, params *ListUsersAdminParams, reqEditors ...RequestEditorFn) (*ListUsersAdminResponse, error) {
+	rsp, err := c.ListUsersAdmin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUsersAdminResponse(rsp)
+}
+
+// GetUserAdminWithResponse request returning *GetUserAdminResponse
+func (c *ClientWithResponses) GetUserAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*GetUserAdminResponse, error) {
+	rsp, err := c.GetUserAdmin(ctx, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserAdminResponse(rsp)
+}
+
+// UpdateUserAdminWithBodyWithResponse request with arbitrary body returning *UpdateUserAdminResponse
+func (c *ClientWithResponses) UpdateUserAdminWithBodyWithResponse(ctx context.Context, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserAdminResponse, error) {
+	rsp, err := c.UpdateUserAdminWithBody(ctx, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserAdminWithResponse(ctx context.Context, userID UserIDPath, body UpdateUserAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserAdminResponse, error) {
+	rsp, err := c.UpdateUserAdmin(ctx, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserAdminResponse(rsp)
+}
+
+// RevokeUserSessionsAdminWithResponse request returning *RevokeUserSessionsAdminResponse
+func (c *ClientWithResponses) RevokeUserSessionsAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*RevokeUserSessionsAdminResponse, error) {
+	rsp, err := c.RevokeUserSessionsAdmin(ctx, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeUserSessionsAdminResponse(rsp)
+}
+
+// SuspendUserAdminWithResponse request returning *SuspendUserAdminResponse
+func (c *ClientWithResponses) SuspendUserAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*SuspendUserAdminResponse, error) {
+	rsp, err := c.SuspendUserAdmin(ctx, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSuspendUserAdminResponse(rsp)
+}
+
+// UnsuspendUserAdminWithResponse request returning *UnsuspendUserAdminResponse
+func (c *ClientWithResponses) UnsuspendUserAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*UnsuspendUserAdminResponse, error) {
+	rsp, err := c.UnsuspendUserAdmin(ctx, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnsuspendUserAdminResponse(rsp)
+}
+
+// ListAuthProvidersWithResponse request returning *ListAuthProvidersResponse
+func (c *ClientWithResponses) ListAuthProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAuthProvidersResponse, error) {
+	rsp, err := c.ListAuthProviders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuthProvidersResponse(rsp)
+}
+
+// SignOutWithResponse request returning *SignOutResponse
+func (c *ClientWithResponses) SignOutWithResponse(ctx context.Context, params *SignOutParams, reqEditors ...RequestEditorFn) (*SignOutResponse, error) {
+	rsp, err := c.SignOut(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignOutResponse(rsp)
+}
+
+// StartSignInWithResponse request returning *StartSignInResponse
+func (c *ClientWithResponses) StartSignInWithResponse(ctx context.Context, provider OIDCProviderPath, params *StartSignInParams, reqEditors ...RequestEditorFn) (*StartSignInResponse, error) {
+	rsp, err := c.StartSignIn(ctx, provider, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartSignInResponse(rsp)
+}
+
+// FinishSignInWithResponse request returning *FinishSignInResponse
+func (c *ClientWithResponses) FinishSignInWithResponse(ctx context.Context, provider OIDCProviderPath, params *FinishSignInParams, reqEditors ...RequestEditorFn) (*FinishSignInResponse, error) {
+	rsp, err := c.FinishSignIn(ctx, provider, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinishSignInResponse(rsp)
+}
+
+// ListProjectsWithResponse request returning *ListProjectsResponse
+func (c *ClientWithResponses) ListProjectsWithResponse(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error) {
+	rsp, err := c.ListProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectsResponse(rsp)
+}
+
+// GetProjectWithResponse request returning *GetProjectResponse
+func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+	rsp, err := c.GetProject(ctx, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectResponse(rsp)
+}
+
+// ListImagesWithResponse request returning *ListImagesResponse
+func (c *ClientWithResponses) ListImagesWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesParams, reqEditors ...RequestEditorFn) (*ListImagesResponse, error) {
+	rsp, err := c.ListImages(ctx, projectID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListImagesResponse(rsp)
+}
+
+// CreateUploadURLWithBodyWithResponse request with arbitrary body returning *CreateUploadURLResponse
+func (c *ClientWithResponses) CreateUploadURLWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error) {
+	rsp, err := c.CreateUploadURLWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUploadURLResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUploadURLWithResponse(ctx context.Context, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error) {
+	rsp, err := c.CreateUploadURL(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUploadURLResponse(rsp)
+}
+
+// DeleteImageWithResponse request returning *DeleteImageResponse
+func (c *ClientWithResponses) DeleteImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error) {
+	rsp, err := c.DeleteImage(ctx, projectID, imageID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteImageResponse(rsp)
+}
+
+// GetImageWithResponse request returning *GetImageResponse
+func (c *ClientWithResponses) GetImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams, reqEditors ...RequestEditorFn) (*GetImageResponse, error) {
+	rsp, err := c.GetImage(ctx, projectID, imageID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetImageResponse(rsp)
+}
+
+// UpdateImageWithBodyWithResponse request with arbitrary body returning *UpdateImageResponse
+func (c *ClientWithResponses) UpdateImageWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateImageResponse, error) {
+	rsp, err := c.UpdateImageWithBody(ctx, projectID, imageID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateImageResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateImageResponse, error) {
+	rsp, err := c.UpdateImage(ctx, projectID, imageID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateImageResponse(rsp)
+}
+
+// CompleteUploadWithResponse request returning *CompleteUploadResponse
+func (c *ClientWithResponses) CompleteUploadWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*CompleteUploadResponse, error) {
+	rsp, err := c.CompleteUpload(ctx, projectID, imageID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteUploadResponse(rsp)
+}
+
+// UpdateImageFocusWithBodyWithResponse request with arbitrary body returning *UpdateImageFocusResponse
+func (c *ClientWithResponses) UpdateImageFocusWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateImageFocusResponse, error) {
+	rsp, err := c.UpdateImageFocusWithBody(ctx, projectID, imageID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateImageFocusResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateImageFocusWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateImageFocusResponse, error) {
+	rsp, err := c.UpdateImageFocus(ctx, projectID, imageID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateImageFocusResponse(rsp)
+}
+
+// RestoreImageWithResponse request returning *RestoreImageResponse
+func (c *ClientWithResponses) RestoreImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*RestoreImageResponse, error) {
+	rsp, err := c.RestoreImage(ctx, projectID, imageID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreImageResponse(rsp)
+}
+
+// ListProjectMembersWithResponse request returning *ListProjectMembersResponse
+func (c *ClientWithResponses) ListProjectMembersWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*ListProjectMembersResponse, error) {
+	rsp, err := c.ListProjectMembers(ctx, projectID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectMembersResponse(rsp)
+}
+
+// AddProjectMemberWithBodyWithResponse request with arbitrary body returning *AddProjectMemberResponse
+func (c *ClientWithResponses) AddProjectMemberWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error) {
+	rsp, err := c.AddProjectMemberWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddProjectMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddProjectMemberWithResponse(ctx context.Context, projectID ProjectIDPath, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error) {
+	rsp, err := c.AddProjectMember(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddProjectMemberResponse(rsp)
+}
+
+// RemoveProjectMemberWithResponse request returning *RemoveProjectMemberResponse
+func (c *ClientWithResponses) RemoveProjectMemberWithResponse(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, reqEditors ...RequestEditorFn) (*RemoveProjectMemberResponse, error) {
+	rsp, err := c.RemoveProjectMember(ctx, projectID, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveProjectMemberResponse(rsp)
+}
+
+// UpdateProjectMemberWithBodyWithResponse request with arbitrary body returning *UpdateProjectMemberResponse
+func (c *ClientWithResponses) UpdateProjectMemberWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectMemberResponse, error) {
+	rsp, err := c.UpdateProjectMemberWithBody(ctx, projectID, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectMemberWithResponse(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, body UpdateProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectMemberResponse, error) {
+	rsp, err := c.UpdateProjectMember(ctx, projectID, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectMemberResponse(rsp)
+}
+
+// ListWatermarksWithResponse request returning *ListWatermarksResponse
+func (c *ClientWithResponses) ListWatermarksWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListWatermarksParams, reqEditors ...RequestEditorFn) (*ListWatermarksResponse, error) {
+	rsp, err := c.ListWatermarks(ctx, projectID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWatermarksResponse(rsp)
+}
+
+// CreateWatermarkUploadURLWithBodyWithResponse request with arbitrary body returning *CreateWatermarkUploadURLResponse
+func (c *ClientWithResponses) CreateWatermarkUploadURLWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWatermarkUploadURLResponse, error) {
+	rsp, err := c.CreateWatermarkUploadURLWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWatermarkUploadURLResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWatermarkUploadURLWithResponse(ctx context.Context, projectID ProjectIDPath, body CreateWatermarkUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWatermarkUploadURLResponse, error) {
+	rsp, err := c.CreateWatermarkUploadURL(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWatermarkUploadURLResponse(rsp)
+}
+
+// DeleteWatermarkWithResponse request returning *DeleteWatermarkResponse
+func (c *ClientWithResponses) DeleteWatermarkWithResponse(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*DeleteWatermarkResponse, error) {
+	rsp, err := c.DeleteWatermark(ctx, projectID, watermarkID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWatermarkResponse(rsp)
+}
+
+// CompleteWatermarkUploadWithResponse request returning *CompleteWatermarkUploadResponse
+func (c *ClientWithResponses) CompleteWatermarkUploadWithResponse(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*CompleteWatermarkUploadResponse, error) {
+	rsp, err := c.CompleteWatermarkUpload(ctx, projectID, watermarkID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteWatermarkUploadResponse(rsp)
+}
+
+// GetCurrentUserWithResponse request returning *GetCurrentUserResponse
+func (c *ClientWithResponses) GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error) {
+	rsp, err := c.GetCurrentUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCurrentUserResponse(rsp)
+}
+
+// DeliverImageWithResponse request returning *DeliverImageResponse
+func (c *ClientWithResponses) DeliverImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, presetName string, params *DeliverImageParams, reqEditors ...RequestEditorFn) (*DeliverImageResponse, error) {
+	rsp, err := c.DeliverImage(ctx, projectID, imageID, presetName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeliverImageResponse(rsp)
+}
+
+// ParseListAuditLogsAdminResponse parses an HTTP response from a ListAuditLogsAdminWithResponse call
+func ParseListAuditLogsAdminResponse(rsp *http.Response) (*ListAuditLogsAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuditLogsAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditEntries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectsAdminResponse parses an HTTP response from a ListProjectsAdminWithResponse call
+func ParseListProjectsAdminResponse(rsp *http.Response) (*ListProjectsAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectsAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Projects
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectAdminResponse parses an HTTP response from a CreateProjectAdminWithResponse call
+func ParseCreateProjectAdminResponse(rsp *http.Response) (*CreateProjectAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProjectAdminResponse parses an HTTP response from a DeleteProjectAdminWithResponse call
+func ParseDeleteProjectAdminResponse(rsp *http.Response) (*DeleteProjectAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ProjectDeletion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectAdminResponse parses an HTTP response from a GetProjectAdminWithResponse call
+func ParseGetProjectAdminResponse(rsp *http.Response) (*GetProjectAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProjectAdminResponse parses an HTTP response from a UpdateProjectAdminWithResponse call
+func ParseUpdateProjectAdminResponse(rsp *http.Response) (*UpdateProjectAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProjectAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectDeletionAdminResponse parses an HTTP response from a GetProjectDeletionAdminWithResponse call
+func ParseGetProjectDeletionAdminResponse(rsp *http.Response) (*GetProjectDeletionAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectDeletionAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectDeletion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListImagesAdminResponse parses an HTTP response from a ListImagesAdminWithResponse call
+func ParseListImagesAdminResponse(rsp *http.Response) (*ListImagesAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListImagesAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Images
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReprocessImagesAdminResponse parses an HTTP response from a ReprocessImagesAdminWithResponse call
+func ParseReprocessImagesAdminResponse(rsp *http.Response) (*ReprocessImagesAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReprocessImagesAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Images
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteImageAdminResponse parses an HTTP response from a DeleteImageAdminWithResponse call
+func ParseDeleteImageAdminResponse(rsp *http.Response) (*DeleteImageAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteImageAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreImageAdminResponse parses an HTTP response from a RestoreImageAdminWithResponse call
+func ParseRestoreImageAdminResponse(rsp *http.Response) (*RestoreImageAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreImageAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FinishGoogleSignInResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Image
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
-}
 
-type SignOutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SignOutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRestoreProjectAdminResponse parses an HTTP response from a RestoreProjectAdminWithResponse call
+func ParseRestoreProjectAdminResponse(rsp *http.Response) (*RestoreProjectAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SignOutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &RestoreProjectAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Project
-	JSONDefault  *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ListImagesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Images
-	JSONDefault  *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListImagesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListServiceAccountsAdminResponse parses an HTTP response from a ListServiceAccountsAdminWithResponse call
+func ParseListServiceAccountsAdminResponse(rsp *http.Response) (*ListServiceAccountsAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListImagesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListServiceAccountsAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateUploadURLResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UploadURL
-	JSONDefault  *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceAccounts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreateUploadURLResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateUploadURLResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteImageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteImageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateServiceAccountAdminResponse parses an HTTP response from a CreateServiceAccountAdminWithResponse call
+func ParseCreateServiceAccountAdminResponse(rsp *http.Response) (*CreateServiceAccountAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteImageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateServiceAccountAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetImageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Image
-	JSONDefault  *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceAccountWithAPIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetImageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetImageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteServiceAccountAdminResponse parses an HTTP response from a DeleteServiceAccountAdminWithResponse call
+func ParseDeleteServiceAccountAdminResponse(rsp *http.Response) (*DeleteServiceAccountAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCurrentUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSONDefault  *ErrorResponse
-}
+	response := &DeleteServiceAccountAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetCurrentUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCurrentUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetServiceAccountAdminResponse parses an HTTP response from a GetServiceAccountAdminWithResponse call
+func ParseGetServiceAccountAdminResponse(rsp *http.Response) (*GetServiceAccountAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetServiceAccountAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceAccount
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
+
+	return response, nil
 }
 
-// ListProjectsAdminWithResponse request returning *ListProjectsAdminResponse
-func (c *ClientWithResponses) ListProjectsAdminWithResponse(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*ListProjectsAdminResponse, error) {
-	rsp, err := c.ListProjectsAdmin(ctx, params, reqEditors...)
+// ParseUpdateServiceAccountAdminResponse parses an HTTP response from a UpdateServiceAccountAdminWithResponse call
+func ParseUpdateServiceAccountAdminResponse(rsp *http.Response) (*UpdateServiceAccountAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectsAdminResponse(rsp)
-}
 
-// CreateProjectAdminWithBodyWithResponse request with arbitrary body returning *CreateProjectAdminResponse
-func (c *ClientWithResponses) CreateProjectAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error) {
-	rsp, err := c.CreateProjectAdminWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateServiceAccountAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateProjectAdminResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateProjectAdminWithResponse(ctx context.Context, body CreateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error) {
-	rsp, err := c.CreateProjectAdmin(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceAccount
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseCreateProjectAdminResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteProjectAdminWithResponse request returning *DeleteProjectAdminResponse
-func (c *ClientWithResponses) DeleteProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*DeleteProjectAdminResponse, error) {
-	rsp, err := c.DeleteProjectAdmin(ctx, projectID, reqEditors...)
+// ParseListServiceAccountAPIKeysAdminResponse parses an HTTP response from a ListServiceAccountAPIKeysAdminWithResponse call
+func ParseListServiceAccountAPIKeysAdminResponse(rsp *http.Response) (*ListServiceAccountAPIKeysAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectAdminResponse(rsp)
-}
 
-// GetProjectAdminWithResponse request returning *GetProjectAdminResponse
-func (c *ClientWithResponses) GetProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectAdminResponse, error) {
-	rsp, err := c.GetProjectAdmin(ctx, projectID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListServiceAccountAPIKeysAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetProjectAdminResponse(rsp)
-}
 
-// UpdateProjectAdminWithBodyWithResponse request with arbitrary body returning *UpdateProjectAdminResponse
-func (c *ClientWithResponses) UpdateProjectAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectAdminResponse, error) {
-	rsp, err := c.UpdateProjectAdminWithBody(ctx, projectID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceAccountAPIKeys
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseUpdateProjectAdminResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body UpdateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectAdminResponse, error) {
-	rsp, err := c.UpdateProjectAdmin(ctx, projectID, body, reqEditors...)
+// ParseCreateServiceAccountAPIKeyAdminResponse parses an HTTP response from a CreateServiceAccountAPIKeyAdminWithResponse call
+func ParseCreateServiceAccountAPIKeyAdminResponse(rsp *http.Response) (*CreateServiceAccountAPIKeyAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectAdminResponse(rsp)
-}
 
-// ListImagesAdminWithResponse request returning *ListImagesAdminResponse
-func (c *ClientWithResponses) ListImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesAdminParams, reqEditors ...RequestEditorFn) (*ListImagesAdminResponse, error) {
-	rsp, err := c.ListImagesAdmin(ctx, projectID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateServiceAccountAPIKeyAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListImagesAdminResponse(rsp)
-}
 
-// ReprocessImagesAdminWithBodyWithResponse request with arbitrary body returning *ReprocessImagesAdminResponse
-func (c *ClientWithResponses) ReprocessImagesAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error) {
-	rsp, err := c.ReprocessImagesAdminWithBody(ctx, projectID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceAccountWithAPIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseReprocessImagesAdminResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ReprocessImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error) {
-	rsp, err := c.ReprocessImagesAdmin(ctx, projectID, body, reqEditors...)
+// ParseRevokeServiceAccountAPIKeyAdminResponse parses an HTTP response from a RevokeServiceAccountAPIKeyAdminWithResponse call
+func ParseRevokeServiceAccountAPIKeyAdminResponse(rsp *http.Response) (*RevokeServiceAccountAPIKeyAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseReprocessImagesAdminResponse(rsp)
-}
 
-// DeleteImageAdminWithResponse request returning *DeleteImageAdminResponse
-func (c *ClientWithResponses) DeleteImageAdminWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageAdminResponse, error) {
-	rsp, err := c.DeleteImageAdmin(ctx, projectID, imageID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RevokeServiceAccountAPIKeyAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteImageAdminResponse(rsp)
-}
 
-// ListServiceAccountsAdminWithResponse request returning *ListServiceAccountsAdminResponse
-func (c *ClientWithResponses) ListServiceAccountsAdminWithResponse(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*ListServiceAccountsAdminResponse, error) {
-	rsp, err := c.ListServiceAccountsAdmin(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseListServiceAccountsAdminResponse(rsp)
+
+	return response, nil
 }
 
-// CreateServiceAccountAdminWithBodyWithResponse request with arbitrary body returning *CreateServiceAccountAdminResponse
-func (c *ClientWithResponses) CreateServiceAccountAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error) {
-	rsp, err := c.CreateServiceAccountAdminWithBody(ctx, contentType, body, reqEditors...)
+// ParseListUsersAdminResponse parses an HTTP response from a ListUsersAdminWithResponse call
+func ParseListUsersAdminResponse(rsp *http.Response) (*ListUsersAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateServiceAccountAdminResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateServiceAccountAdminWithResponse(ctx context.Context, body CreateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error) {
-	rsp, err := c.CreateServiceAccountAdmin(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListUsersAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateServiceAccountAdminResponse(rsp)
-}
 
-// DeleteServiceAccountAdminWithResponse request returning *DeleteServiceAccountAdminResponse
-func (c *ClientWithResponses) DeleteServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*DeleteServiceAccountAdminResponse, error) {
-	rsp, err := c.DeleteServiceAccountAdmin(ctx, serviceAccountID, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Users
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseDeleteServiceAccountAdminResponse(rsp)
+
+	return response, nil
 }
 
-// GetServiceAccountAdminWithResponse request returning *GetServiceAccountAdminResponse
-func (c *ClientWithResponses) GetServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*GetServiceAccountAdminResponse, error) {
-	rsp, err := c.GetServiceAccountAdmin(ctx, serviceAccountID, reqEditors...)
+// ParseGetUserAdminResponse parses an HTTP response from a GetUserAdminWithResponse call
+func ParseGetUserAdminResponse(rsp *http.Response) (*GetUserAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetServiceAccountAdminResponse(rsp)
-}
 
-// UpdateServiceAccountAdminWithBodyWithResponse request with arbitrary body returning *UpdateServiceAccountAdminResponse
-func (c *ClientWithResponses) UpdateServiceAccountAdminWithBodyWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateServiceAccountAdminResponse, error) {
-	rsp, err := c.UpdateServiceAccountAdminWithBody(ctx, serviceAccountID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetUserAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateServiceAccountAdminResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, body UpdateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateServiceAccountAdminResponse, error) {
-	rsp, err := c.UpdateServiceAccountAdmin(ctx, serviceAccountID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseUpdateServiceAccountAdminResponse(rsp)
+
+	return response, nil
 }
 
-// StartGoogleSignInWithResponse request returning *StartGoogleSignInResponse
-func (c *ClientWithResponses) StartGoogleSignInWithResponse(ctx context.Context, params *StartGoogleSignInParams, reqEditors ...RequestEditorFn) (*StartGoogleSignInResponse, error) {
-	rsp, err := c.StartGoogleSignIn(ctx, params, reqEditors...)
+// ParseUpdateUserAdminResponse parses an HTTP response from a UpdateUserAdminWithResponse call
+func ParseUpdateUserAdminResponse(rsp *http.Response) (*UpdateUserAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStartGoogleSignInResponse(rsp)
-}
 
-// FinishGoogleSignInWithResponse request returning *FinishGoogleSignInResponse
-func (c *ClientWithResponses) FinishGoogleSignInWithResponse(ctx context.Context, params *FinishGoogleSignInParams, reqEditors ...RequestEditorFn) (*FinishGoogleSignInResponse, error) {
-	rsp, err := c.FinishGoogleSignIn(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateUserAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFinishGoogleSignInResponse(rsp)
-}
 
-// SignOutWithResponse request returning *SignOutResponse
-func (c *ClientWithResponses) SignOutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOutResponse, error) {
-	rsp, err := c.SignOut(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseSignOutResponse(rsp)
+
+	return response, nil
 }
 
-// GetProjectWithResponse request returning *GetProjectResponse
-func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
-	rsp, err := c.GetProject(ctx, projectID, reqEditors...)
+// ParseRevokeUserSessionsAdminResponse parses an HTTP response from a RevokeUserSessionsAdminWithResponse call
+func ParseRevokeUserSessionsAdminResponse(rsp *http.Response) (*RevokeUserSessionsAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProjectResponse(rsp)
-}
 
-// ListImagesWithResponse request returning *ListImagesResponse
-func (c *ClientWithResponses) ListImagesWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesParams, reqEditors ...RequestEditorFn) (*ListImagesResponse, error) {
-	rsp, err := c.ListImages(ctx, projectID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RevokeUserSessionsAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListImagesResponse(rsp)
-}
 
-// CreateUploadURLWithBodyWithResponse request with arbitrary body returning *CreateUploadURLResponse
-func (c *ClientWithResponses) CreateUploadURLWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error) {
-	rsp, err := c.CreateUploadURLWithBody(ctx, projectID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseCreateUploadURLResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateUploadURLWithResponse(ctx context.Context, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error) {
-	rsp, err := c.CreateUploadURL(ctx, projectID, body, reqEditors...)
+// ParseSuspendUserAdminResponse parses an HTTP response from a SuspendUserAdminWithResponse call
+func ParseSuspendUserAdminResponse(rsp *http.Response) (*SuspendUserAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateUploadURLResponse(rsp)
-}
 
-// DeleteImageWithResponse request returning *DeleteImageResponse
-func (c *ClientWithResponses) DeleteImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error) {
-	rsp, err := c.DeleteImage(ctx, projectID, imageID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SuspendUserAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteImageResponse(rsp)
-}
 
-// GetImageWithResponse request returning *GetImageResponse
-func (c *ClientWithResponses) GetImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams, reqEditors ...RequestEditorFn) (*GetImageResponse, error) {
-	rsp, err := c.GetImage(ctx, projectID, imageID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetImageResponse(rsp)
+
+	return response, nil
 }
 
-// GetCurrentUserWithResponse request returning *GetCurrentUserResponse
-func (c *ClientWithResponses) GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error) {
-	rsp, err := c.GetCurrentUser(ctx, reqEditors...)
+// ParseUnsuspendUserAdminResponse parses an HTTP response from a UnsuspendUserAdminWithResponse call
+func ParseUnsuspendUserAdminResponse(rsp *http.Response) (*UnsuspendUserAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCurrentUserResponse(rsp)
+
+	response := &UnsuspendUserAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseListProjectsAdminResponse parses an HTTP response from a ListProjectsAdminWithResponse call
-func ParseListProjectsAdminResponse(rsp *http.Response) (*ListProjectsAdminResponse, error) {
+// ParseListAuthProvidersResponse parses an HTTP response from a ListAuthProvidersWithResponse call
+func ParseListAuthProvidersResponse(rsp *http.Response) (*ListAuthProvidersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectsAdminResponse{
+	response := &ListAuthProvidersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Projects
+		var dest AuthProviders
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3040,27 +8062,46 @@ func ParseListProjectsAdminResponse(rsp *http.Response) (*ListProjectsAdminRespo
 	return response, nil
 }
 
-// ParseCreateProjectAdminResponse parses an HTTP response from a CreateProjectAdminWithResponse call
-func ParseCreateProjectAdminResponse(rsp *http.Response) (*CreateProjectAdminResponse, error) {
+// ParseSignOutResponse parses an HTTP response from a SignOutWithResponse call
+func ParseSignOutResponse(rsp *http.Response) (*SignOutResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectAdminResponse{
+	response := &SignOutResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Project
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartSignInResponse parses an HTTP response from a StartSignInWithResponse call
+func ParseStartSignInResponse(rsp *http.Response) (*StartSignInResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartSignInResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3073,15 +8114,15 @@ func ParseCreateProjectAdminResponse(rsp *http.Response) (*CreateProjectAdminRes
 	return response, nil
 }
 
-// ParseDeleteProjectAdminResponse parses an HTTP response from a DeleteProjectAdminWithResponse call
-func ParseDeleteProjectAdminResponse(rsp *http.Response) (*DeleteProjectAdminResponse, error) {
+// ParseFinishSignInResponse parses an HTTP response from a FinishSignInWithResponse call
+func ParseFinishSignInResponse(rsp *http.Response) (*FinishSignInResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectAdminResponse{
+	response := &FinishSignInResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3099,22 +8140,22 @@ func ParseDeleteProjectAdminResponse(rsp *http.Response) (*DeleteProjectAdminRes
 	return response, nil
 }
 
-// ParseGetProjectAdminResponse parses an HTTP response from a GetProjectAdminWithResponse call
-func ParseGetProjectAdminResponse(rsp *http.Response) (*GetProjectAdminResponse, error) {
+// ParseListProjectsResponse parses an HTTP response from a ListProjectsWithResponse call
+func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectAdminResponse{
+	response := &ListProjectsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Project
+		var dest Projects
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3132,15 +8173,15 @@ func ParseGetProjectAdminResponse(rsp *http.Response) (*GetProjectAdminResponse,
 	return response, nil
 }
 
-// ParseUpdateProjectAdminResponse parses an HTTP response from a UpdateProjectAdminWithResponse call
-func ParseUpdateProjectAdminResponse(rsp *http.Response) (*UpdateProjectAdminResponse, error) {
+// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectAdminResponse{
+	response := &GetProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3165,15 +8206,15 @@ func ParseUpdateProjectAdminResponse(rsp *http.Response) (*UpdateProjectAdminRes
 	return response, nil
 }
 
-// ParseListImagesAdminResponse parses an HTTP response from a ListImagesAdminWithResponse call
-func ParseListImagesAdminResponse(rsp *http.Response) (*ListImagesAdminResponse, error) {
+// ParseListImagesResponse parses an HTTP response from a ListImagesWithResponse call
+func ParseListImagesResponse(rsp *http.Response) (*ListImagesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListImagesAdminResponse{
+	response := &ListImagesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3198,22 +8239,22 @@ func ParseListImagesAdminResponse(rsp *http.Response) (*ListImagesAdminResponse,
 	return response, nil
 }
 
-// ParseReprocessImagesAdminResponse parses an HTTP response from a ReprocessImagesAdminWithResponse call
-func ParseReprocessImagesAdminResponse(rsp *http.Response) (*ReprocessImagesAdminResponse, error) {
+// ParseCreateUploadURLResponse parses an HTTP response from a CreateUploadURLWithResponse call
+func ParseCreateUploadURLResponse(rsp *http.Response) (*CreateUploadURLResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReprocessImagesAdminResponse{
+	response := &CreateUploadURLResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Images
+		var dest UploadURL
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3231,15 +8272,15 @@ func ParseReprocessImagesAdminResponse(rsp *http.Response) (*ReprocessImagesAdmi
 	return response, nil
 }
 
-// ParseDeleteImageAdminResponse parses an HTTP response from a DeleteImageAdminWithResponse call
-func ParseDeleteImageAdminResponse(rsp *http.Response) (*DeleteImageAdminResponse, error) {
+// ParseDeleteImageResponse parses an HTTP response from a DeleteImageWithResponse call
+func ParseDeleteImageResponse(rsp *http.Response) (*DeleteImageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteImageAdminResponse{
+	response := &DeleteImageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3257,22 +8298,22 @@ func ParseDeleteImageAdminResponse(rsp *http.Response) (*DeleteImageAdminRespons
 	return response, nil
 }
 
-// ParseListServiceAccountsAdminResponse parses an HTTP response from a ListServiceAccountsAdminWithResponse call
-func ParseListServiceAccountsAdminResponse(rsp *http.Response) (*ListServiceAccountsAdminResponse, error) {
+// ParseGetImageResponse parses an HTTP response from a GetImageWithResponse call
+func ParseGetImageResponse(rsp *http.Response) (*GetImageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListServiceAccountsAdminResponse{
+	response := &GetImageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ServiceAccounts
+		var dest Image
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3290,22 +8331,22 @@ func ParseListServiceAccountsAdminResponse(rsp *http.Response) (*ListServiceAcco
 	return response, nil
 }
 
-// ParseCreateServiceAccountAdminResponse parses an HTTP response from a CreateServiceAccountAdminWithResponse call
-func ParseCreateServiceAccountAdminResponse(rsp *http.Response) (*CreateServiceAccountAdminResponse, error) {
+// ParseUpdateImageResponse parses an HTTP response from a UpdateImageWithResponse call
+func ParseUpdateImageResponse(rsp *http.Response) (*UpdateImageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateServiceAccountAdminResponse{
+	response := &UpdateImageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ServiceAccountWithAPIKey
+		var dest Image
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3323,20 +8364,27 @@ func ParseCreateServiceAccountAdminResponse(rsp *http.Response) (*CreateServiceA
 	return response, nil
 }
 
-// ParseDeleteServiceAccountAdminResponse parses an HTTP response from a DeleteServiceAccountAdminWithResponse call
-func ParseDeleteServiceAccountAdminResponse(rsp *http.Response) (*DeleteServiceAccountAdminResponse, error) {
+// ParseCompleteUploadResponse parses an HTTP response from a CompleteUploadWithResponse call
+func ParseCompleteUploadResponse(rsp *http.Response) (*CompleteUploadResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteServiceAccountAdminResponse{
+	response := &CompleteUploadResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Image
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3349,22 +8397,22 @@ func ParseDeleteServiceAccountAdminResponse(rsp *http.Response) (*DeleteServiceA
 	return response, nil
 }
 
-// ParseGetServiceAccountAdminResponse parses an HTTP response from a GetServiceAccountAdminWithResponse call
-func ParseGetServiceAccountAdminResponse(rsp *http.Response) (*GetServiceAccountAdminResponse, error) {
+// ParseUpdateImageFocusResponse parses an HTTP response from a UpdateImageFocusWithResponse call
+func ParseUpdateImageFocusResponse(rsp *http.Response) (*UpdateImageFocusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetServiceAccountAdminResponse{
+	response := &UpdateImageFocusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ServiceAccount
+		var dest Image
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3382,22 +8430,22 @@ func ParseGetServiceAccountAdminResponse(rsp *http.Response) (*GetServiceAccount
 	return response, nil
 }
 
-// ParseUpdateServiceAccountAdminResponse parses an HTTP response from a UpdateServiceAccountAdminWithResponse call
-func ParseUpdateServiceAccountAdminResponse(rsp *http.Response) (*UpdateServiceAccountAdminResponse, error) {
+// ParseRestoreImageResponse parses an HTTP response from a RestoreImageWithResponse call
+func ParseRestoreImageResponse(rsp *http.Response) (*RestoreImageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateServiceAccountAdminResponse{
+	response := &RestoreImageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ServiceAccount
+		var dest Image
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3415,20 +8463,27 @@ func ParseUpdateServiceAccountAdminResponse(rsp *http.Response) (*UpdateServiceA
 	return response, nil
 }
 
-// ParseStartGoogleSignInResponse parses an HTTP response from a StartGoogleSignInWithResponse call
-func ParseStartGoogleSignInResponse(rsp *http.Response) (*StartGoogleSignInResponse, error) {
+// ParseListProjectMembersResponse parses an HTTP response from a ListProjectMembersWithResponse call
+func ParseListProjectMembersResponse(rsp *http.Response) (*ListProjectMembersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StartGoogleSignInResponse{
+	response := &ListProjectMembersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectMembers
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3441,20 +8496,27 @@ func ParseStartGoogleSignInResponse(rsp *http.Response) (*StartGoogleSignInRespo
 	return response, nil
 }
 
-// ParseFinishGoogleSignInResponse parses an HTTP response from a FinishGoogleSignInWithResponse call
-func ParseFinishGoogleSignInResponse(rsp *http.Response) (*FinishGoogleSignInResponse, error) {
+// ParseAddProjectMemberResponse parses an HTTP response from a AddProjectMemberWithResponse call
+func ParseAddProjectMemberResponse(rsp *http.Response) (*AddProjectMemberResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FinishGoogleSignInResponse{
+	response := &AddProjectMemberResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3467,15 +8529,15 @@ func ParseFinishGoogleSignInResponse(rsp *http.Response) (*FinishGoogleSignInRes
 	return response, nil
 }
 
-// ParseSignOutResponse parses an HTTP response from a SignOutWithResponse call
-func ParseSignOutResponse(rsp *http.Response) (*SignOutResponse, error) {
+// ParseRemoveProjectMemberResponse parses an HTTP response from a RemoveProjectMemberWithResponse call
+func ParseRemoveProjectMemberResponse(rsp *http.Response) (*RemoveProjectMemberResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SignOutResponse{
+	response := &RemoveProjectMemberResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3493,22 +8555,22 @@ func ParseSignOutResponse(rsp *http.Response) (*SignOutResponse, error) {
 	return response, nil
 }
 
-// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
-func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
+// ParseUpdateProjectMemberResponse parses an HTTP response from a UpdateProjectMemberWithResponse call
+func ParseUpdateProjectMemberResponse(rsp *http.Response) (*UpdateProjectMemberResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectResponse{
+	response := &UpdateProjectMemberResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Project
+		var dest ProjectMember
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3526,22 +8588,22 @@ func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
 	return response, nil
 }
 
-// ParseListImagesResponse parses an HTTP response from a ListImagesWithResponse call
-func ParseListImagesResponse(rsp *http.Response) (*ListImagesResponse, error) {
+// ParseListWatermarksResponse parses an HTTP response from a ListWatermarksWithResponse call
+func ParseListWatermarksResponse(rsp *http.Response) (*ListWatermarksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListImagesResponse{
+	response := &ListWatermarksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Images
+		var dest Watermarks
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3559,22 +8621,22 @@ func ParseListImagesResponse(rsp *http.Response) (*ListImagesResponse, error) {
 	return response, nil
 }
 
-// ParseCreateUploadURLResponse parses an HTTP response from a CreateUploadURLWithResponse call
-func ParseCreateUploadURLResponse(rsp *http.Response) (*CreateUploadURLResponse, error) {
+// ParseCreateWatermarkUploadURLResponse parses an HTTP response from a CreateWatermarkUploadURLWithResponse call
+func ParseCreateWatermarkUploadURLResponse(rsp *http.Response) (*CreateWatermarkUploadURLResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateUploadURLResponse{
+	response := &CreateWatermarkUploadURLResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UploadURL
+		var dest WatermarkUploadURL
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3592,15 +8654,15 @@ func ParseCreateUploadURLResponse(rsp *http.Response) (*CreateUploadURLResponse,
 	return response, nil
 }
 
-// ParseDeleteImageResponse parses an HTTP response from a DeleteImageWithResponse call
-func ParseDeleteImageResponse(rsp *http.Response) (*DeleteImageResponse, error) {
+// ParseDeleteWatermarkResponse parses an HTTP response from a DeleteWatermarkWithResponse call
+func ParseDeleteWatermarkResponse(rsp *http.Response) (*DeleteWatermarkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteImageResponse{
+	response := &DeleteWatermarkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3618,22 +8680,22 @@ func ParseDeleteImageResponse(rsp *http.Response) (*DeleteImageResponse, error)
 	return response, nil
 }
 
-// ParseGetImageResponse parses an HTTP response from a GetImageWithResponse call
-func ParseGetImageResponse(rsp *http.Response) (*GetImageResponse, error) {
+// ParseCompleteWatermarkUploadResponse parses an HTTP response from a CompleteWatermarkUploadWithResponse call
+func ParseCompleteWatermarkUploadResponse(rsp *http.Response) (*CompleteWatermarkUploadResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetImageResponse{
+	response := &CompleteWatermarkUploadResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Image
+		var dest Watermark
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3683,3 +8745,29 @@ func ParseGetCurrentUserResponse(rsp *http.Response) (*GetCurrentUserResponse, e
 
 	return response, nil
 }
+
+// ParseDeliverImageResponse parses an HTTP response from a DeliverImageWithResponse call
+func ParseDeliverImageResponse(rsp *http.Response) (*DeliverImageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeliverImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
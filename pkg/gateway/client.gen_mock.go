@@ -81,6 +81,86 @@ func (m *MockClientInterface) EXPECT() *MockClientInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AddProjectMember mocks base method.
+func (m *MockClientInterface) AddProjectMember(ctx context.Context, projectID ProjectIDPath, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddProjectMember", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProjectMember indicates an expected call of AddProjectMember.
+func (mr *MockClientInterfaceMockRecorder) AddProjectMember(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProjectMember", reflect.TypeOf((*MockClientInterface)(nil).AddProjectMember), varargs...)
+}
+
+// AddProjectMemberWithBody mocks base method.
+func (m *MockClientInterface) AddProjectMemberWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddProjectMemberWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProjectMemberWithBody indicates an expected call of AddProjectMemberWithBody.
+func (mr *MockClientInterfaceMockRecorder) AddProjectMemberWithBody(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProjectMemberWithBody", reflect.TypeOf((*MockClientInterface)(nil).AddProjectMemberWithBody), varargs...)
+}
+
+// CompleteUpload mocks base method.
+func (m *MockClientInterface) CompleteUpload(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompleteUpload", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockClientInterfaceMockRecorder) CompleteUpload(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockClientInterface)(nil).CompleteUpload), varargs...)
+}
+
+// CompleteWatermarkUpload mocks base method.
+func (m *MockClientInterface) CompleteWatermarkUpload(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, watermarkID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompleteWatermarkUpload", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteWatermarkUpload indicates an expected call of CompleteWatermarkUpload.
+func (mr *MockClientInterfaceMockRecorder) CompleteWatermarkUpload(ctx, projectID, watermarkID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, watermarkID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteWatermarkUpload", reflect.TypeOf((*MockClientInterface)(nil).CompleteWatermarkUpload), varargs...)
+}
+
 // CreateProjectAdmin mocks base method.
 func (m *MockClientInterface) CreateProjectAdmin(ctx context.Context, body CreateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -121,6 +201,46 @@ func (mr *MockClientInterfaceMockRecorder) CreateProjectAdminWithBody(ctx, conte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAdminWithBody", reflect.TypeOf((*MockClientInterface)(nil).CreateProjectAdminWithBody), varargs...)
 }
 
+// CreateServiceAccountAPIKeyAdmin mocks base method.
+func (m *MockClientInterface) CreateServiceAccountAPIKeyAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, body CreateServiceAccountAPIKeyAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, serviceAccountID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceAccountAPIKeyAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccountAPIKeyAdmin indicates an expected call of CreateServiceAccountAPIKeyAdmin.
+func (mr *MockClientInterfaceMockRecorder) CreateServiceAccountAPIKeyAdmin(ctx, serviceAccountID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, serviceAccountID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountAPIKeyAdmin", reflect.TypeOf((*MockClientInterface)(nil).CreateServiceAccountAPIKeyAdmin), varargs...)
+}
+
+// CreateServiceAccountAPIKeyAdminWithBody mocks base method.
+func (m *MockClientInterface) CreateServiceAccountAPIKeyAdminWithBody(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, serviceAccountID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceAccountAPIKeyAdminWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccountAPIKeyAdminWithBody indicates an expected call of CreateServiceAccountAPIKeyAdminWithBody.
+func (mr *MockClientInterfaceMockRecorder) CreateServiceAccountAPIKeyAdminWithBody(ctx, serviceAccountID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, serviceAccountID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountAPIKeyAdminWithBody", reflect.TypeOf((*MockClientInterface)(nil).CreateServiceAccountAPIKeyAdminWithBody), varargs...)
+}
+
 // CreateServiceAccountAdmin mocks base method.
 func (m *MockClientInterface) CreateServiceAccountAdmin(ctx context.Context, body CreateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +321,46 @@ func (mr *MockClientInterfaceMockRecorder) CreateUploadURLWithBody(ctx, projectI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUploadURLWithBody", reflect.TypeOf((*MockClientInterface)(nil).CreateUploadURLWithBody), varargs...)
 }
 
+// CreateWatermarkUploadURL mocks base method.
+func (m *MockClientInterface) CreateWatermarkUploadURL(ctx context.Context, projectID ProjectIDPath, body CreateWatermarkUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWatermarkUploadURL", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWatermarkUploadURL indicates an expected call of CreateWatermarkUploadURL.
+func (mr *MockClientInterfaceMockRecorder) CreateWatermarkUploadURL(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWatermarkUploadURL", reflect.TypeOf((*MockClientInterface)(nil).CreateWatermarkUploadURL), varargs...)
+}
+
+// CreateWatermarkUploadURLWithBody mocks base method.
+func (m *MockClientInterface) CreateWatermarkUploadURLWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWatermarkUploadURLWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWatermarkUploadURLWithBody indicates an expected call of CreateWatermarkUploadURLWithBody.
+func (mr *MockClientInterfaceMockRecorder) CreateWatermarkUploadURLWithBody(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWatermarkUploadURLWithBody", reflect.TypeOf((*MockClientInterface)(nil).CreateWatermarkUploadURLWithBody), varargs...)
+}
+
 // DeleteImage mocks base method.
 func (m *MockClientInterface) DeleteImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -281,24 +441,64 @@ func (mr *MockClientInterfaceMockRecorder) DeleteServiceAccountAdmin(ctx, servic
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccountAdmin", reflect.TypeOf((*MockClientInterface)(nil).DeleteServiceAccountAdmin), varargs...)
 }
 
-// FinishGoogleSignIn mocks base method.
-func (m *MockClientInterface) FinishGoogleSignIn(ctx context.Context, params *FinishGoogleSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// DeleteWatermark mocks base method.
+func (m *MockClientInterface) DeleteWatermark(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, params}
+	varargs := []any{ctx, projectID, watermarkID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "FinishGoogleSignIn", varargs...)
+	ret := m.ctrl.Call(m, "DeleteWatermark", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FinishGoogleSignIn indicates an expected call of FinishGoogleSignIn.
-func (mr *MockClientInterfaceMockRecorder) FinishGoogleSignIn(ctx, params any, reqEditors ...any) *gomock.Call {
+// DeleteWatermark indicates an expected call of DeleteWatermark.
+func (mr *MockClientInterfaceMockRecorder) DeleteWatermark(ctx, projectID, watermarkID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishGoogleSignIn", reflect.TypeOf((*MockClientInterface)(nil).FinishGoogleSignIn), varargs...)
+	varargs := append([]any{ctx, projectID, watermarkID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWatermark", reflect.TypeOf((*MockClientInterface)(nil).DeleteWatermark), varargs...)
+}
+
+// DeliverImage mocks base method.
+func (m *MockClientInterface) DeliverImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, presetName string, params *DeliverImageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID, presetName, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeliverImage", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverImage indicates an expected call of DeliverImage.
+func (mr *MockClientInterfaceMockRecorder) DeliverImage(ctx, projectID, imageID, presetName, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID, presetName, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverImage", reflect.TypeOf((*MockClientInterface)(nil).DeliverImage), varargs...)
+}
+
+// FinishSignIn mocks base method.
+func (m *MockClientInterface) FinishSignIn(ctx context.Context, provider OIDCProviderPath, params *FinishSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, provider, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FinishSignIn", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishSignIn indicates an expected call of FinishSignIn.
+func (mr *MockClientInterfaceMockRecorder) FinishSignIn(ctx, provider, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, provider, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishSignIn", reflect.TypeOf((*MockClientInterface)(nil).FinishSignIn), varargs...)
 }
 
 // GetCurrentUser mocks base method.
@@ -381,6 +581,26 @@ func (mr *MockClientInterfaceMockRecorder) GetProjectAdmin(ctx, projectID any, r
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectAdmin", reflect.TypeOf((*MockClientInterface)(nil).GetProjectAdmin), varargs...)
 }
 
+// GetProjectDeletionAdmin mocks base method.
+func (m *MockClientInterface) GetProjectDeletionAdmin(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectDeletionAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectDeletionAdmin indicates an expected call of GetProjectDeletionAdmin.
+func (mr *MockClientInterfaceMockRecorder) GetProjectDeletionAdmin(ctx, projectID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDeletionAdmin", reflect.TypeOf((*MockClientInterface)(nil).GetProjectDeletionAdmin), varargs...)
+}
+
 // GetServiceAccountAdmin mocks base method.
 func (m *MockClientInterface) GetServiceAccountAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -401,6 +621,66 @@ func (mr *MockClientInterfaceMockRecorder) GetServiceAccountAdmin(ctx, serviceAc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccountAdmin", reflect.TypeOf((*MockClientInterface)(nil).GetServiceAccountAdmin), varargs...)
 }
 
+// GetUserAdmin mocks base method.
+func (m *MockClientInterface) GetUserAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAdmin indicates an expected call of GetUserAdmin.
+func (mr *MockClientInterfaceMockRecorder) GetUserAdmin(ctx, userID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAdmin", reflect.TypeOf((*MockClientInterface)(nil).GetUserAdmin), varargs...)
+}
+
+// ListAuditLogsAdmin mocks base method.
+func (m *MockClientInterface) ListAuditLogsAdmin(ctx context.Context, params *ListAuditLogsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditLogsAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogsAdmin indicates an expected call of ListAuditLogsAdmin.
+func (mr *MockClientInterfaceMockRecorder) ListAuditLogsAdmin(ctx, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogsAdmin", reflect.TypeOf((*MockClientInterface)(nil).ListAuditLogsAdmin), varargs...)
+}
+
+// ListAuthProviders mocks base method.
+func (m *MockClientInterface) ListAuthProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuthProviders", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuthProviders indicates an expected call of ListAuthProviders.
+func (mr *MockClientInterfaceMockRecorder) ListAuthProviders(ctx any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthProviders", reflect.TypeOf((*MockClientInterface)(nil).ListAuthProviders), varargs...)
+}
+
 // ListImages mocks base method.
 func (m *MockClientInterface) ListImages(ctx context.Context, projectID ProjectIDPath, params *ListImagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -441,708 +721,1748 @@ func (mr *MockClientInterfaceMockRecorder) ListImagesAdmin(ctx, projectID, param
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImagesAdmin", reflect.TypeOf((*MockClientInterface)(nil).ListImagesAdmin), varargs...)
 }
 
-// ListProjectsAdmin mocks base method.
-func (m *MockClientInterface) ListProjectsAdmin(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListProjectMembers mocks base method.
+func (m *MockClientInterface) ListProjectMembers(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, params}
+	varargs := []any{ctx, projectID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListProjectsAdmin", varargs...)
+	ret := m.ctrl.Call(m, "ListProjectMembers", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListProjectsAdmin indicates an expected call of ListProjectsAdmin.
-func (mr *MockClientInterfaceMockRecorder) ListProjectsAdmin(ctx, params any, reqEditors ...any) *gomock.Call {
+// ListProjectMembers indicates an expected call of ListProjectMembers.
+func (mr *MockClientInterfaceMockRecorder) ListProjectMembers(ctx, projectID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsAdmin", reflect.TypeOf((*MockClientInterface)(nil).ListProjectsAdmin), varargs...)
+	varargs := append([]any{ctx, projectID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectMembers", reflect.TypeOf((*MockClientInterface)(nil).ListProjectMembers), varargs...)
 }
 
-// ListServiceAccountsAdmin mocks base method.
-func (m *MockClientInterface) ListServiceAccountsAdmin(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListProjects mocks base method.
+func (m *MockClientInterface) ListProjects(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListServiceAccountsAdmin", varargs...)
+	ret := m.ctrl.Call(m, "ListProjects", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListServiceAccountsAdmin indicates an expected call of ListServiceAccountsAdmin.
-func (mr *MockClientInterfaceMockRecorder) ListServiceAccountsAdmin(ctx, params any, reqEditors ...any) *gomock.Call {
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockClientInterfaceMockRecorder) ListProjects(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccountsAdmin", reflect.TypeOf((*MockClientInterface)(nil).ListServiceAccountsAdmin), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockClientInterface)(nil).ListProjects), varargs...)
 }
 
-// ReprocessImagesAdmin mocks base method.
-func (m *MockClientInterface) ReprocessImagesAdmin(ctx context.Context, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListProjectsAdmin mocks base method.
+func (m *MockClientInterface) ListProjectsAdmin(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, body}
+	varargs := []any{ctx, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ReprocessImagesAdmin", varargs...)
+	ret := m.ctrl.Call(m, "ListProjectsAdmin", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReprocessImagesAdmin indicates an expected call of ReprocessImagesAdmin.
-func (mr *MockClientInterfaceMockRecorder) ReprocessImagesAdmin(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+// ListProjectsAdmin indicates an expected call of ListProjectsAdmin.
+func (mr *MockClientInterfaceMockRecorder) ListProjectsAdmin(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprocessImagesAdmin", reflect.TypeOf((*MockClientInterface)(nil).ReprocessImagesAdmin), varargs...)
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsAdmin", reflect.TypeOf((*MockClientInterface)(nil).ListProjectsAdmin), varargs...)
 }
 
-// ReprocessImagesAdminWithBody mocks base method.
-func (m *MockClientInterface) ReprocessImagesAdminWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListServiceAccountAPIKeysAdmin mocks base method.
+func (m *MockClientInterface) ListServiceAccountAPIKeysAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, contentType, body}
+	varargs := []any{ctx, serviceAccountID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ReprocessImagesAdminWithBody", varargs...)
+	ret := m.ctrl.Call(m, "ListServiceAccountAPIKeysAdmin", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReprocessImagesAdminWithBody indicates an expected call of ReprocessImagesAdminWithBody.
-func (mr *MockClientInterfaceMockRecorder) ReprocessImagesAdminWithBody(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+// ListServiceAccountAPIKeysAdmin indicates an expected call of ListServiceAccountAPIKeysAdmin.
+func (mr *MockClientInterfaceMockRecorder) ListServiceAccountAPIKeysAdmin(ctx, serviceAccountID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprocessImagesAdminWithBody", reflect.TypeOf((*MockClientInterface)(nil).ReprocessImagesAdminWithBody), varargs...)
+	varargs := append([]any{ctx, serviceAccountID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccountAPIKeysAdmin", reflect.TypeOf((*MockClientInterface)(nil).ListServiceAccountAPIKeysAdmin), varargs...)
 }
 
-// SignOut mocks base method.
-func (m *MockClientInterface) SignOut(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListServiceAccountsAdmin mocks base method.
+func (m *MockClientInterface) ListServiceAccountsAdmin(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
+	varargs := []any{ctx, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SignOut", varargs...)
+	ret := m.ctrl.Call(m, "ListServiceAccountsAdmin", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SignOut indicates an expected call of SignOut.
-func (mr *MockClientInterfaceMockRecorder) SignOut(ctx any, reqEditors ...any) *gomock.Call {
+// ListServiceAccountsAdmin indicates an expected call of ListServiceAccountsAdmin.
+func (mr *MockClientInterfaceMockRecorder) ListServiceAccountsAdmin(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOut", reflect.TypeOf((*MockClientInterface)(nil).SignOut), varargs...)
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccountsAdmin", reflect.TypeOf((*MockClientInterface)(nil).ListServiceAccountsAdmin), varargs...)
 }
 
-// StartGoogleSignIn mocks base method.
-func (m *MockClientInterface) StartGoogleSignIn(ctx context.Context, params *StartGoogleSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListUsersAdmin mocks base method.
+func (m *MockClientInterface) ListUsersAdmin(ctx context.Context, params *ListUsersAdminParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "StartGoogleSignIn", varargs...)
+	ret := m.ctrl.Call(m, "ListUsersAdmin", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StartGoogleSignIn indicates an expected call of StartGoogleSignIn.
-func (mr *MockClientInterfaceMockRecorder) StartGoogleSignIn(ctx, params any, reqEditors ...any) *gomock.Call {
+// ListUsersAdmin indicates an expected call of ListUsersAdmin.
+func (mr *MockClientInterfaceMockRecorder) ListUsersAdmin(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGoogleSignIn", reflect.TypeOf((*MockClientInterface)(nil).StartGoogleSignIn), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersAdmin", reflect.TypeOf((*MockClientInterface)(nil).ListUsersAdmin), varargs...)
 }
 
-// UpdateProjectAdmin mocks base method.
-func (m *MockClientInterface) UpdateProjectAdmin(ctx context.Context, projectID ProjectIDPath, body UpdateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// ListWatermarks mocks base method.
+func (m *MockClientInterface) ListWatermarks(ctx context.Context, projectID ProjectIDPath, params *ListWatermarksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, body}
+	varargs := []any{ctx, projectID, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateProjectAdmin", varargs...)
+	ret := m.ctrl.Call(m, "ListWatermarks", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateProjectAdmin indicates an expected call of UpdateProjectAdmin.
-func (mr *MockClientInterfaceMockRecorder) UpdateProjectAdmin(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+// ListWatermarks indicates an expected call of ListWatermarks.
+func (mr *MockClientInterfaceMockRecorder) ListWatermarks(ctx, projectID, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectAdmin", reflect.TypeOf((*MockClientInterface)(nil).UpdateProjectAdmin), varargs...)
+	varargs := append([]any{ctx, projectID, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWatermarks", reflect.TypeOf((*MockClientInterface)(nil).ListWatermarks), varargs...)
 }
 
-// UpdateProjectAdminWithBody mocks base method.
-func (m *MockClientInterface) UpdateProjectAdminWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// RemoveProjectMember mocks base method.
+func (m *MockClientInterface) RemoveProjectMember(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, userID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveProjectMember", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveProjectMember indicates an expected call of RemoveProjectMember.
+func (mr *MockClientInterfaceMockRecorder) RemoveProjectMember(ctx, projectID, userID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProjectMember", reflect.TypeOf((*MockClientInterface)(nil).RemoveProjectMember), varargs...)
+}
+
+// ReprocessImagesAdmin mocks base method.
+func (m *MockClientInterface) ReprocessImagesAdmin(ctx context.Context, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReprocessImagesAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReprocessImagesAdmin indicates an expected call of ReprocessImagesAdmin.
+func (mr *MockClientInterfaceMockRecorder) ReprocessImagesAdmin(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprocessImagesAdmin", reflect.TypeOf((*MockClientInterface)(nil).ReprocessImagesAdmin), varargs...)
+}
+
+// ReprocessImagesAdminWithBody mocks base method.
+func (m *MockClientInterface) ReprocessImagesAdminWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, projectID, contentType, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateProjectAdminWithBody", varargs...)
+	ret := m.ctrl.Call(m, "ReprocessImagesAdminWithBody", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateProjectAdminWithBody indicates an expected call of UpdateProjectAdminWithBody.
-func (mr *MockClientInterfaceMockRecorder) UpdateProjectAdminWithBody(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+// ReprocessImagesAdminWithBody indicates an expected call of ReprocessImagesAdminWithBody.
+func (mr *MockClientInterfaceMockRecorder) ReprocessImagesAdminWithBody(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectAdminWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateProjectAdminWithBody), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprocessImagesAdminWithBody", reflect.TypeOf((*MockClientInterface)(nil).ReprocessImagesAdminWithBody), varargs...)
 }
 
-// UpdateServiceAccountAdmin mocks base method.
-func (m *MockClientInterface) UpdateServiceAccountAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, body UpdateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// RestoreImage mocks base method.
+func (m *MockClientInterface) RestoreImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, serviceAccountID, body}
+	varargs := []any{ctx, projectID, imageID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateServiceAccountAdmin", varargs...)
+	ret := m.ctrl.Call(m, "RestoreImage", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateServiceAccountAdmin indicates an expected call of UpdateServiceAccountAdmin.
-func (mr *MockClientInterfaceMockRecorder) UpdateServiceAccountAdmin(ctx, serviceAccountID, body any, reqEditors ...any) *gomock.Call {
+// RestoreImage indicates an expected call of RestoreImage.
+func (mr *MockClientInterfaceMockRecorder) RestoreImage(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, serviceAccountID, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceAccountAdmin", reflect.TypeOf((*MockClientInterface)(nil).UpdateServiceAccountAdmin), varargs...)
+	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreImage", reflect.TypeOf((*MockClientInterface)(nil).RestoreImage), varargs...)
 }
 
-// UpdateServiceAccountAdminWithBody mocks base method.
-func (m *MockClientInterface) UpdateServiceAccountAdminWithBody(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// RestoreImageAdmin mocks base method.
+func (m *MockClientInterface) RestoreImageAdmin(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, serviceAccountID, contentType, body}
+	varargs := []any{ctx, projectID, imageID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateServiceAccountAdminWithBody", varargs...)
+	ret := m.ctrl.Call(m, "RestoreImageAdmin", varargs...)
 	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateServiceAccountAdminWithBody indicates an expected call of UpdateServiceAccountAdminWithBody.
-func (mr *MockClientInterfaceMockRecorder) UpdateServiceAccountAdminWithBody(ctx, serviceAccountID, contentType, body any, reqEditors ...any) *gomock.Call {
+// RestoreImageAdmin indicates an expected call of RestoreImageAdmin.
+func (mr *MockClientInterfaceMockRecorder) RestoreImageAdmin(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, serviceAccountID, contentType, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceAccountAdminWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateServiceAccountAdminWithBody), varargs...)
+	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreImageAdmin", reflect.TypeOf((*MockClientInterface)(nil).RestoreImageAdmin), varargs...)
 }
 
-// MockClientWithResponsesInterface is a mock of ClientWithResponsesInterface interface.
-type MockClientWithResponsesInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockClientWithResponsesInterfaceMockRecorder
-	isgomock struct{}
+// RestoreProjectAdmin mocks base method.
+func (m *MockClientInterface) RestoreProjectAdmin(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreProjectAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockClientWithResponsesInterfaceMockRecorder is the mock recorder for MockClientWithResponsesInterface.
-type MockClientWithResponsesInterfaceMockRecorder struct {
-	mock *MockClientWithResponsesInterface
+// RestoreProjectAdmin indicates an expected call of RestoreProjectAdmin.
+func (mr *MockClientInterfaceMockRecorder) RestoreProjectAdmin(ctx, projectID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProjectAdmin", reflect.TypeOf((*MockClientInterface)(nil).RestoreProjectAdmin), varargs...)
 }
 
-// NewMockClientWithResponsesInterface creates a new mock instance.
-func NewMockClientWithResponsesInterface(ctrl *gomock.Controller) *MockClientWithResponsesInterface {
-	mock := &MockClientWithResponsesInterface{ctrl: ctrl}
-	mock.recorder = &MockClientWithResponsesInterfaceMockRecorder{mock}
-	return mock
+// RevokeServiceAccountAPIKeyAdmin mocks base method.
+func (m *MockClientInterface) RevokeServiceAccountAPIKeyAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, apiKeyID APIKeyIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, serviceAccountID, apiKeyID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeServiceAccountAPIKeyAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockClientWithResponsesInterface) EXPECT() *MockClientWithResponsesInterfaceMockRecorder {
-	return m.recorder
+// RevokeServiceAccountAPIKeyAdmin indicates an expected call of RevokeServiceAccountAPIKeyAdmin.
+func (mr *MockClientInterfaceMockRecorder) RevokeServiceAccountAPIKeyAdmin(ctx, serviceAccountID, apiKeyID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, serviceAccountID, apiKeyID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeServiceAccountAPIKeyAdmin", reflect.TypeOf((*MockClientInterface)(nil).RevokeServiceAccountAPIKeyAdmin), varargs...)
 }
 
-// CreateProjectAdminWithBodyWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) CreateProjectAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error) {
+// RevokeUserSessionsAdmin mocks base method.
+func (m *MockClientInterface) RevokeUserSessionsAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, contentType, body}
+	varargs := []any{ctx, userID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateProjectAdminWithBodyWithResponse", varargs...)
-	ret0, _ := ret[0].(*CreateProjectAdminResponse)
+	ret := m.ctrl.Call(m, "RevokeUserSessionsAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateProjectAdminWithBodyWithResponse indicates an expected call of CreateProjectAdminWithBodyWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateProjectAdminWithBodyWithResponse(ctx, contentType, body any, reqEditors ...any) *gomock.Call {
+// RevokeUserSessionsAdmin indicates an expected call of RevokeUserSessionsAdmin.
+func (mr *MockClientInterfaceMockRecorder) RevokeUserSessionsAdmin(ctx, userID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, contentType, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAdminWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateProjectAdminWithBodyWithResponse), varargs...)
+	varargs := append([]any{ctx, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessionsAdmin", reflect.TypeOf((*MockClientInterface)(nil).RevokeUserSessionsAdmin), varargs...)
 }
 
-// CreateProjectAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) CreateProjectAdminWithResponse(ctx context.Context, body CreateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error) {
+// SignOut mocks base method.
+func (m *MockClientInterface) SignOut(ctx context.Context, params *SignOutParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, body}
+	varargs := []any{ctx, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateProjectAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*CreateProjectAdminResponse)
+	ret := m.ctrl.Call(m, "SignOut", varargs...)
+	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateProjectAdminWithResponse indicates an expected call of CreateProjectAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateProjectAdminWithResponse(ctx, body any, reqEditors ...any) *gomock.Call {
+// SignOut indicates an expected call of SignOut.
+func (mr *MockClientInterfaceMockRecorder) SignOut(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateProjectAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOut", reflect.TypeOf((*MockClientInterface)(nil).SignOut), varargs...)
 }
 
-// CreateServiceAccountAdminWithBodyWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) CreateServiceAccountAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error) {
+// StartSignIn mocks base method.
+func (m *MockClientInterface) StartSignIn(ctx context.Context, provider OIDCProviderPath, params *StartSignInParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, contentType, body}
+	varargs := []any{ctx, provider, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateServiceAccountAdminWithBodyWithResponse", varargs...)
-	ret0, _ := ret[0].(*CreateServiceAccountAdminResponse)
+	ret := m.ctrl.Call(m, "StartSignIn", varargs...)
+	ret0, _ := ret[0].(*http.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateServiceAccountAdminWithBodyWithResponse indicates an expected call of CreateServiceAccountAdminWithBodyWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateServiceAccountAdminWithBodyWithResponse(ctx, contentType, body any, reqEditors ...any) *gomock.Call {
+// StartSignIn indicates an expected call of StartSignIn.
+func (mr *MockClientInterfaceMockRecorder) StartSignIn(ctx, provider, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, contentType, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountAdminWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateServiceAccountAdminWithBodyWithResponse), varargs...)
+	varargs := append([]any{ctx, provider, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSignIn", reflect.TypeOf((*MockClientInterface)(nil).StartSignIn), varargs...)
 }
 
-// CreateServiceAccountAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) CreateServiceAccountAdminWithResponse(ctx context.Context, body CreateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error) {
+// SuspendUserAdmin mocks base method.
+func (m *MockClientInterface) SuspendUserAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, body}
+	varargs := []any{ctx, userID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateServiceAccountAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*CreateServiceAccountAdminResponse)
+	ret := m.ctrl.Call(m, "SuspendUserAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendUserAdmin indicates an expected call of SuspendUserAdmin.
+func (mr *MockClientInterfaceMockRecorder) SuspendUserAdmin(ctx, userID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUserAdmin", reflect.TypeOf((*MockClientInterface)(nil).SuspendUserAdmin), varargs...)
+}
+
+// UnsuspendUserAdmin mocks base method.
+func (m *MockClientInterface) UnsuspendUserAdmin(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnsuspendUserAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsuspendUserAdmin indicates an expected call of UnsuspendUserAdmin.
+func (mr *MockClientInterfaceMockRecorder) UnsuspendUserAdmin(ctx, userID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsuspendUserAdmin", reflect.TypeOf((*MockClientInterface)(nil).UnsuspendUserAdmin), varargs...)
+}
+
+// UpdateImage mocks base method.
+func (m *MockClientInterface) UpdateImage(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateImage", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImage indicates an expected call of UpdateImage.
+func (mr *MockClientInterfaceMockRecorder) UpdateImage(ctx, projectID, imageID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImage", reflect.TypeOf((*MockClientInterface)(nil).UpdateImage), varargs...)
+}
+
+// UpdateImageFocus mocks base method.
+func (m *MockClientInterface) UpdateImageFocus(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateImageFocus", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImageFocus indicates an expected call of UpdateImageFocus.
+func (mr *MockClientInterfaceMockRecorder) UpdateImageFocus(ctx, projectID, imageID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageFocus", reflect.TypeOf((*MockClientInterface)(nil).UpdateImageFocus), varargs...)
+}
+
+// UpdateImageFocusWithBody mocks base method.
+func (m *MockClientInterface) UpdateImageFocusWithBody(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateImageFocusWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImageFocusWithBody indicates an expected call of UpdateImageFocusWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateImageFocusWithBody(ctx, projectID, imageID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageFocusWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateImageFocusWithBody), varargs...)
+}
+
+// UpdateImageWithBody mocks base method.
+func (m *MockClientInterface) UpdateImageWithBody(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateImageWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImageWithBody indicates an expected call of UpdateImageWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateImageWithBody(ctx, projectID, imageID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateImageWithBody), varargs...)
+}
+
+// UpdateProjectAdmin mocks base method.
+func (m *MockClientInterface) UpdateProjectAdmin(ctx context.Context, projectID ProjectIDPath, body UpdateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProjectAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectAdmin indicates an expected call of UpdateProjectAdmin.
+func (mr *MockClientInterfaceMockRecorder) UpdateProjectAdmin(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectAdmin", reflect.TypeOf((*MockClientInterface)(nil).UpdateProjectAdmin), varargs...)
+}
+
+// UpdateProjectAdminWithBody mocks base method.
+func (m *MockClientInterface) UpdateProjectAdminWithBody(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProjectAdminWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectAdminWithBody indicates an expected call of UpdateProjectAdminWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateProjectAdminWithBody(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectAdminWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateProjectAdminWithBody), varargs...)
+}
+
+// UpdateProjectMember mocks base method.
+func (m *MockClientInterface) UpdateProjectMember(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, body UpdateProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, userID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProjectMember", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectMember indicates an expected call of UpdateProjectMember.
+func (mr *MockClientInterfaceMockRecorder) UpdateProjectMember(ctx, projectID, userID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, userID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectMember", reflect.TypeOf((*MockClientInterface)(nil).UpdateProjectMember), varargs...)
+}
+
+// UpdateProjectMemberWithBody mocks base method.
+func (m *MockClientInterface) UpdateProjectMemberWithBody(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, userID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProjectMemberWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectMemberWithBody indicates an expected call of UpdateProjectMemberWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateProjectMemberWithBody(ctx, projectID, userID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, userID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectMemberWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateProjectMemberWithBody), varargs...)
+}
+
+// UpdateServiceAccountAdmin mocks base method.
+func (m *MockClientInterface) UpdateServiceAccountAdmin(ctx context.Context, serviceAccountID ServiceAccountIDPath, body UpdateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, serviceAccountID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceAccountAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceAccountAdmin indicates an expected call of UpdateServiceAccountAdmin.
+func (mr *MockClientInterfaceMockRecorder) UpdateServiceAccountAdmin(ctx, serviceAccountID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, serviceAccountID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceAccountAdmin", reflect.TypeOf((*MockClientInterface)(nil).UpdateServiceAccountAdmin), varargs...)
+}
+
+// UpdateServiceAccountAdminWithBody mocks base method.
+func (m *MockClientInterface) UpdateServiceAccountAdminWithBody(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, serviceAccountID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceAccountAdminWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceAccountAdminWithBody indicates an expected call of UpdateServiceAccountAdminWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateServiceAccountAdminWithBody(ctx, serviceAccountID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, serviceAccountID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceAccountAdminWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateServiceAccountAdminWithBody), varargs...)
+}
+
+// UpdateUserAdmin mocks base method.
+func (m *MockClientInterface) UpdateUserAdmin(ctx context.Context, userID UserIDPath, body UpdateUserAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserAdmin", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserAdmin indicates an expected call of UpdateUserAdmin.
+func (mr *MockClientInterfaceMockRecorder) UpdateUserAdmin(ctx, userID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAdmin", reflect.TypeOf((*MockClientInterface)(nil).UpdateUserAdmin), varargs...)
+}
+
+// UpdateUserAdminWithBody mocks base method.
+func (m *MockClientInterface) UpdateUserAdminWithBody(ctx context.Context, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserAdminWithBody", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserAdminWithBody indicates an expected call of UpdateUserAdminWithBody.
+func (mr *MockClientInterfaceMockRecorder) UpdateUserAdminWithBody(ctx, userID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAdminWithBody", reflect.TypeOf((*MockClientInterface)(nil).UpdateUserAdminWithBody), varargs...)
+}
+
+// MockClientWithResponsesInterface is a mock of ClientWithResponsesInterface interface.
+type MockClientWithResponsesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientWithResponsesInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockClientWithResponsesInterfaceMockRecorder is the mock recorder for MockClientWithResponsesInterface.
+type MockClientWithResponsesInterfaceMockRecorder struct {
+	mock *MockClientWithResponsesInterface
+}
+
+// NewMockClientWithResponsesInterface creates a new mock instance.
+func NewMockClientWithResponsesInterface(ctrl *gomock.Controller) *MockClientWithResponsesInterface {
+	mock := &MockClientWithResponsesInterface{ctrl: ctrl}
+	mock.recorder = &MockClientWithResponsesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientWithResponsesInterface) EXPECT() *MockClientWithResponsesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddProjectMemberWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) AddProjectMemberWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddProjectMemberWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*AddProjectMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProjectMemberWithBodyWithResponse indicates an expected call of AddProjectMemberWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) AddProjectMemberWithBodyWithResponse(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProjectMemberWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).AddProjectMemberWithBodyWithResponse), varargs...)
+}
+
+// AddProjectMemberWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) AddProjectMemberWithResponse(ctx context.Context, projectID ProjectIDPath, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddProjectMemberWithResponse", varargs...)
+	ret0, _ := ret[0].(*AddProjectMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProjectMemberWithResponse indicates an expected call of AddProjectMemberWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) AddProjectMemberWithResponse(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProjectMemberWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).AddProjectMemberWithResponse), varargs...)
+}
+
+// CompleteUploadWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CompleteUploadWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*CompleteUploadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompleteUploadWithResponse", varargs...)
+	ret0, _ := ret[0].(*CompleteUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteUploadWithResponse indicates an expected call of CompleteUploadWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CompleteUploadWithResponse(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUploadWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CompleteUploadWithResponse), varargs...)
+}
+
+// CompleteWatermarkUploadWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CompleteWatermarkUploadWithResponse(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*CompleteWatermarkUploadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, watermarkID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompleteWatermarkUploadWithResponse", varargs...)
+	ret0, _ := ret[0].(*CompleteWatermarkUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteWatermarkUploadWithResponse indicates an expected call of CompleteWatermarkUploadWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CompleteWatermarkUploadWithResponse(ctx, projectID, watermarkID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, watermarkID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteWatermarkUploadWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CompleteWatermarkUploadWithResponse), varargs...)
+}
+
+// CreateProjectAdminWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateProjectAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateProjectAdminWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateProjectAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectAdminWithBodyWithResponse indicates an expected call of CreateProjectAdminWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateProjectAdminWithBodyWithResponse(ctx, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAdminWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateProjectAdminWithBodyWithResponse), varargs...)
+}
+
+// CreateProjectAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateProjectAdminWithResponse(ctx context.Context, body CreateProjectAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateProjectAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateProjectAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectAdminWithResponse indicates an expected call of CreateProjectAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateProjectAdminWithResponse(ctx, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateProjectAdminWithResponse), varargs...)
+}
+
+// CreateServiceAccountAPIKeyAdminWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateServiceAccountAPIKeyAdminWithBodyWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceAccountAPIKeyAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, serviceAccountID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceAccountAPIKeyAdminWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateServiceAccountAPIKeyAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccountAPIKeyAdminWithBodyWithResponse indicates an expected call of CreateServiceAccountAPIKeyAdminWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateServiceAccountAPIKeyAdminWithBodyWithResponse(ctx, serviceAccountID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, serviceAccountID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountAPIKeyAdminWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateServiceAccountAPIKeyAdminWithBodyWithResponse), varargs...)
+}
+
+// CreateServiceAccountAPIKeyAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateServiceAccountAPIKeyAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, body CreateServiceAccountAPIKeyAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceAccountAPIKeyAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, serviceAccountID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceAccountAPIKeyAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateServiceAccountAPIKeyAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccountAPIKeyAdminWithResponse indicates an expected call of CreateServiceAccountAPIKeyAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateServiceAccountAPIKeyAdminWithResponse(ctx, serviceAccountID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, serviceAccountID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountAPIKeyAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateServiceAccountAPIKeyAdminWithResponse), varargs...)
+}
+
+// CreateServiceAccountAdminWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateServiceAccountAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceAccountAdminWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateServiceAccountAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccountAdminWithBodyWithResponse indicates an expected call of CreateServiceAccountAdminWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateServiceAccountAdminWithBodyWithResponse(ctx, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountAdminWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateServiceAccountAdminWithBodyWithResponse), varargs...)
+}
+
+// CreateServiceAccountAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateServiceAccountAdminWithResponse(ctx context.Context, body CreateServiceAccountAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceAccountAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceAccountAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateServiceAccountAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccountAdminWithResponse indicates an expected call of CreateServiceAccountAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateServiceAccountAdminWithResponse(ctx, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateServiceAccountAdminWithResponse), varargs...)
+}
+
+// CreateUploadURLWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateUploadURLWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateUploadURLWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateUploadURLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUploadURLWithBodyWithResponse indicates an expected call of CreateUploadURLWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateUploadURLWithBodyWithResponse(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUploadURLWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateUploadURLWithBodyWithResponse), varargs...)
+}
+
+// CreateUploadURLWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateUploadURLWithResponse(ctx context.Context, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateUploadURLWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateUploadURLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUploadURLWithResponse indicates an expected call of CreateUploadURLWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateUploadURLWithResponse(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUploadURLWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateUploadURLWithResponse), varargs...)
+}
+
+// CreateWatermarkUploadURLWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateWatermarkUploadURLWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWatermarkUploadURLResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWatermarkUploadURLWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateWatermarkUploadURLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWatermarkUploadURLWithBodyWithResponse indicates an expected call of CreateWatermarkUploadURLWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateWatermarkUploadURLWithBodyWithResponse(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWatermarkUploadURLWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateWatermarkUploadURLWithBodyWithResponse), varargs...)
+}
+
+// CreateWatermarkUploadURLWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) CreateWatermarkUploadURLWithResponse(ctx context.Context, projectID ProjectIDPath, body CreateWatermarkUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWatermarkUploadURLResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWatermarkUploadURLWithResponse", varargs...)
+	ret0, _ := ret[0].(*CreateWatermarkUploadURLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWatermarkUploadURLWithResponse indicates an expected call of CreateWatermarkUploadURLWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateWatermarkUploadURLWithResponse(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWatermarkUploadURLWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateWatermarkUploadURLWithResponse), varargs...)
+}
+
+// DeleteImageAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) DeleteImageAdminWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteImageAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*DeleteImageAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImageAdminWithResponse indicates an expected call of DeleteImageAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteImageAdminWithResponse(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeleteImageAdminWithResponse), varargs...)
+}
+
+// DeleteImageWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) DeleteImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteImageWithResponse", varargs...)
+	ret0, _ := ret[0].(*DeleteImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImageWithResponse indicates an expected call of DeleteImageWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteImageWithResponse(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeleteImageWithResponse), varargs...)
+}
+
+// DeleteProjectAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) DeleteProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*DeleteProjectAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteProjectAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*DeleteProjectAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectAdminWithResponse indicates an expected call of DeleteProjectAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteProjectAdminWithResponse(ctx, projectID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeleteProjectAdminWithResponse), varargs...)
+}
+
+// DeleteServiceAccountAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) DeleteServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*DeleteServiceAccountAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, serviceAccountID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceAccountAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*DeleteServiceAccountAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServiceAccountAdminWithResponse indicates an expected call of DeleteServiceAccountAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteServiceAccountAdminWithResponse(ctx, serviceAccountID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, serviceAccountID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccountAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeleteServiceAccountAdminWithResponse), varargs...)
+}
+
+// DeleteWatermarkWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) DeleteWatermarkWithResponse(ctx context.Context, projectID ProjectIDPath, watermarkID WatermarkIDPath, reqEditors ...RequestEditorFn) (*DeleteWatermarkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, watermarkID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWatermarkWithResponse", varargs...)
+	ret0, _ := ret[0].(*DeleteWatermarkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWatermarkWithResponse indicates an expected call of DeleteWatermarkWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteWatermarkWithResponse(ctx, projectID, watermarkID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, watermarkID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWatermarkWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeleteWatermarkWithResponse), varargs...)
+}
+
+// DeliverImageWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) DeliverImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, presetName string, params *DeliverImageParams, reqEditors ...RequestEditorFn) (*DeliverImageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID, presetName, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeliverImageWithResponse", varargs...)
+	ret0, _ := ret[0].(*DeliverImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverImageWithResponse indicates an expected call of DeliverImageWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) DeliverImageWithResponse(ctx, projectID, imageID, presetName, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID, presetName, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverImageWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeliverImageWithResponse), varargs...)
+}
+
+// FinishSignInWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) FinishSignInWithResponse(ctx context.Context, provider OIDCProviderPath, params *FinishSignInParams, reqEditors ...RequestEditorFn) (*FinishSignInResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, provider, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FinishSignInWithResponse", varargs...)
+	ret0, _ := ret[0].(*FinishSignInResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishSignInWithResponse indicates an expected call of FinishSignInWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) FinishSignInWithResponse(ctx, provider, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, provider, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishSignInWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).FinishSignInWithResponse), varargs...)
+}
+
+// GetCurrentUserWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCurrentUserWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetCurrentUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentUserWithResponse indicates an expected call of GetCurrentUserWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetCurrentUserWithResponse(ctx any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUserWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetCurrentUserWithResponse), varargs...)
+}
+
+// GetImageWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams, reqEditors ...RequestEditorFn) (*GetImageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, imageID, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetImageWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageWithResponse indicates an expected call of GetImageWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetImageWithResponse(ctx, projectID, imageID, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, imageID, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetImageWithResponse), varargs...)
+}
+
+// GetProjectAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetProjectAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectAdminWithResponse indicates an expected call of GetProjectAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetProjectAdminWithResponse(ctx, projectID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetProjectAdminWithResponse), varargs...)
+}
+
+// GetProjectDeletionAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetProjectDeletionAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectDeletionAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectDeletionAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetProjectDeletionAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectDeletionAdminWithResponse indicates an expected call of GetProjectDeletionAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetProjectDeletionAdminWithResponse(ctx, projectID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDeletionAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetProjectDeletionAdminWithResponse), varargs...)
+}
+
+// GetProjectWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetProjectWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectWithResponse indicates an expected call of GetProjectWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetProjectWithResponse(ctx, projectID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetProjectWithResponse), varargs...)
+}
+
+// GetServiceAccountAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*GetServiceAccountAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, serviceAccountID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetServiceAccountAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetServiceAccountAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceAccountAdminWithResponse indicates an expected call of GetServiceAccountAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetServiceAccountAdminWithResponse(ctx, serviceAccountID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, serviceAccountID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccountAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetServiceAccountAdminWithResponse), varargs...)
+}
+
+// GetUserAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) GetUserAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*GetUserAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*GetUserAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAdminWithResponse indicates an expected call of GetUserAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetUserAdminWithResponse(ctx, userID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetUserAdminWithResponse), varargs...)
+}
+
+// ListAuditLogsAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListAuditLogsAdminWithResponse(ctx context.Context, params *ListAuditLogsAdminParams, reqEditors ...RequestEditorFn) (*ListAuditLogsAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditLogsAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListAuditLogsAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogsAdminWithResponse indicates an expected call of ListAuditLogsAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListAuditLogsAdminWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogsAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListAuditLogsAdminWithResponse), varargs...)
+}
+
+// ListAuthProvidersWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListAuthProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAuthProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuthProvidersWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListAuthProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuthProvidersWithResponse indicates an expected call of ListAuthProvidersWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListAuthProvidersWithResponse(ctx any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthProvidersWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListAuthProvidersWithResponse), varargs...)
+}
+
+// ListImagesAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesAdminParams, reqEditors ...RequestEditorFn) (*ListImagesAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImagesAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListImagesAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImagesAdminWithResponse indicates an expected call of ListImagesAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListImagesAdminWithResponse(ctx, projectID, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImagesAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListImagesAdminWithResponse), varargs...)
+}
+
+// ListImagesWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListImagesWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesParams, reqEditors ...RequestEditorFn) (*ListImagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImagesWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListImagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImagesWithResponse indicates an expected call of ListImagesWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListImagesWithResponse(ctx, projectID, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImagesWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListImagesWithResponse), varargs...)
+}
+
+// ListProjectMembersWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListProjectMembersWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*ListProjectMembersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectMembersWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListProjectMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectMembersWithResponse indicates an expected call of ListProjectMembersWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProjectMembersWithResponse(ctx, projectID any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectMembersWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProjectMembersWithResponse), varargs...)
+}
+
+// ListProjectsAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListProjectsAdminWithResponse(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*ListProjectsAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectsAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListProjectsAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsAdminWithResponse indicates an expected call of ListProjectsAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProjectsAdminWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProjectsAdminWithResponse), varargs...)
+}
+
+// ListProjectsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListProjectsWithResponse(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectsWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListProjectsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateServiceAccountAdminWithResponse indicates an expected call of CreateServiceAccountAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateServiceAccountAdminWithResponse(ctx, body any, reqEditors ...any) *gomock.Call {
+// ListProjectsWithResponse indicates an expected call of ListProjectsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProjectsWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateServiceAccountAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProjectsWithResponse), varargs...)
 }
 
-// CreateUploadURLWithBodyWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) CreateUploadURLWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error) {
+// ListServiceAccountAPIKeysAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListServiceAccountAPIKeysAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*ListServiceAccountAPIKeysAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, contentType, body}
+	varargs := []any{ctx, serviceAccountID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateUploadURLWithBodyWithResponse", varargs...)
-	ret0, _ := ret[0].(*CreateUploadURLResponse)
+	ret := m.ctrl.Call(m, "ListServiceAccountAPIKeysAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListServiceAccountAPIKeysAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUploadURLWithBodyWithResponse indicates an expected call of CreateUploadURLWithBodyWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateUploadURLWithBodyWithResponse(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+// ListServiceAccountAPIKeysAdminWithResponse indicates an expected call of ListServiceAccountAPIKeysAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListServiceAccountAPIKeysAdminWithResponse(ctx, serviceAccountID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUploadURLWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateUploadURLWithBodyWithResponse), varargs...)
+	varargs := append([]any{ctx, serviceAccountID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccountAPIKeysAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListServiceAccountAPIKeysAdminWithResponse), varargs...)
 }
 
-// CreateUploadURLWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) CreateUploadURLWithResponse(ctx context.Context, projectID ProjectIDPath, body CreateUploadURLJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUploadURLResponse, error) {
+// ListServiceAccountsAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListServiceAccountsAdminWithResponse(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*ListServiceAccountsAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, body}
+	varargs := []any{ctx, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateUploadURLWithResponse", varargs...)
-	ret0, _ := ret[0].(*CreateUploadURLResponse)
+	ret := m.ctrl.Call(m, "ListServiceAccountsAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListServiceAccountsAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUploadURLWithResponse indicates an expected call of CreateUploadURLWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) CreateUploadURLWithResponse(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+// ListServiceAccountsAdminWithResponse indicates an expected call of ListServiceAccountsAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListServiceAccountsAdminWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUploadURLWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).CreateUploadURLWithResponse), varargs...)
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccountsAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListServiceAccountsAdminWithResponse), varargs...)
 }
 
-// DeleteImageAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) DeleteImageAdminWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageAdminResponse, error) {
+// ListUsersAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListUsersAdminWithResponse(ctx context.Context, params *ListUsersAdminParams, reqEditors ...RequestEditorFn) (*ListUsersAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, imageID}
+	varargs := []any{ctx, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteImageAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*DeleteImageAdminResponse)
+	ret := m.ctrl.Call(m, "ListUsersAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListUsersAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteImageAdminWithResponse indicates an expected call of DeleteImageAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteImageAdminWithResponse(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
+// ListUsersAdminWithResponse indicates an expected call of ListUsersAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListUsersAdminWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeleteImageAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListUsersAdminWithResponse), varargs...)
 }
 
-// DeleteImageWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) DeleteImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*DeleteImageResponse, error) {
+// ListWatermarksWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ListWatermarksWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListWatermarksParams, reqEditors ...RequestEditorFn) (*ListWatermarksResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, imageID}
+	varargs := []any{ctx, projectID, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteImageWithResponse", varargs...)
-	ret0, _ := ret[0].(*DeleteImageResponse)
+	ret := m.ctrl.Call(m, "ListWatermarksWithResponse", varargs...)
+	ret0, _ := ret[0].(*ListWatermarksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteImageWithResponse indicates an expected call of DeleteImageWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteImageWithResponse(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
+// ListWatermarksWithResponse indicates an expected call of ListWatermarksWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ListWatermarksWithResponse(ctx, projectID, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeleteImageWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWatermarksWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListWatermarksWithResponse), varargs...)
 }
 
-// DeleteProjectAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) DeleteProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*DeleteProjectAdminResponse, error) {
+// RemoveProjectMemberWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) RemoveProjectMemberWithResponse(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, reqEditors ...RequestEditorFn) (*RemoveProjectMemberResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID}
+	varargs := []any{ctx, projectID, userID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteProjectAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*DeleteProjectAdminResponse)
+	ret := m.ctrl.Call(m, "RemoveProjectMemberWithResponse", varargs...)
+	ret0, _ := ret[0].(*RemoveProjectMemberResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteProjectAdminWithResponse indicates an expected call of DeleteProjectAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteProjectAdminWithResponse(ctx, projectID any, reqEditors ...any) *gomock.Call {
+// RemoveProjectMemberWithResponse indicates an expected call of RemoveProjectMemberWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) RemoveProjectMemberWithResponse(ctx, projectID, userID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeleteProjectAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProjectMemberWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RemoveProjectMemberWithResponse), varargs...)
 }
 
-// DeleteServiceAccountAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) DeleteServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*DeleteServiceAccountAdminResponse, error) {
+// ReprocessImagesAdminWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ReprocessImagesAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, serviceAccountID}
+	varargs := []any{ctx, projectID, contentType, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteServiceAccountAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*DeleteServiceAccountAdminResponse)
+	ret := m.ctrl.Call(m, "ReprocessImagesAdminWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*ReprocessImagesAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteServiceAccountAdminWithResponse indicates an expected call of DeleteServiceAccountAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) DeleteServiceAccountAdminWithResponse(ctx, serviceAccountID any, reqEditors ...any) *gomock.Call {
+// ReprocessImagesAdminWithBodyWithResponse indicates an expected call of ReprocessImagesAdminWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ReprocessImagesAdminWithBodyWithResponse(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, serviceAccountID}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccountAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).DeleteServiceAccountAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprocessImagesAdminWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ReprocessImagesAdminWithBodyWithResponse), varargs...)
 }
 
-// FinishGoogleSignInWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) FinishGoogleSignInWithResponse(ctx context.Context, params *FinishGoogleSignInParams, reqEditors ...RequestEditorFn) (*FinishGoogleSignInResponse, error) {
+// ReprocessImagesAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ReprocessImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, params}
+	varargs := []any{ctx, projectID, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "FinishGoogleSignInWithResponse", varargs...)
-	ret0, _ := ret[0].(*FinishGoogleSignInResponse)
+	ret := m.ctrl.Call(m, "ReprocessImagesAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*ReprocessImagesAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FinishGoogleSignInWithResponse indicates an expected call of FinishGoogleSignInWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) FinishGoogleSignInWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
+// ReprocessImagesAdminWithResponse indicates an expected call of ReprocessImagesAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ReprocessImagesAdminWithResponse(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishGoogleSignInWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).FinishGoogleSignInWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprocessImagesAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ReprocessImagesAdminWithResponse), varargs...)
 }
 
-// GetCurrentUserWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResponse, error) {
+// RestoreImageAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) RestoreImageAdminWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*RestoreImageAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
+	varargs := []any{ctx, projectID, imageID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetCurrentUserWithResponse", varargs...)
-	ret0, _ := ret[0].(*GetCurrentUserResponse)
+	ret := m.ctrl.Call(m, "RestoreImageAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*RestoreImageAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCurrentUserWithResponse indicates an expected call of GetCurrentUserWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) GetCurrentUserWithResponse(ctx any, reqEditors ...any) *gomock.Call {
+// RestoreImageAdminWithResponse indicates an expected call of RestoreImageAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) RestoreImageAdminWithResponse(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUserWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetCurrentUserWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreImageAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RestoreImageAdminWithResponse), varargs...)
 }
 
-// GetImageWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) GetImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, params *GetImageParams, reqEditors ...RequestEditorFn) (*GetImageResponse, error) {
+// RestoreImageWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) RestoreImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, reqEditors ...RequestEditorFn) (*RestoreImageResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, imageID, params}
+	varargs := []any{ctx, projectID, imageID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetImageWithResponse", varargs...)
-	ret0, _ := ret[0].(*GetImageResponse)
+	ret := m.ctrl.Call(m, "RestoreImageWithResponse", varargs...)
+	ret0, _ := ret[0].(*RestoreImageResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetImageWithResponse indicates an expected call of GetImageWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) GetImageWithResponse(ctx, projectID, imageID, params any, reqEditors ...any) *gomock.Call {
+// RestoreImageWithResponse indicates an expected call of RestoreImageWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) RestoreImageWithResponse(ctx, projectID, imageID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, imageID, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetImageWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, imageID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreImageWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RestoreImageWithResponse), varargs...)
 }
 
-// GetProjectAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) GetProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectAdminResponse, error) {
+// RestoreProjectAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) RestoreProjectAdminWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*RestoreProjectAdminResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, projectID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetProjectAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*GetProjectAdminResponse)
+	ret := m.ctrl.Call(m, "RestoreProjectAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*RestoreProjectAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProjectAdminWithResponse indicates an expected call of GetProjectAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) GetProjectAdminWithResponse(ctx, projectID any, reqEditors ...any) *gomock.Call {
+// RestoreProjectAdminWithResponse indicates an expected call of RestoreProjectAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) RestoreProjectAdminWithResponse(ctx, projectID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, projectID}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetProjectAdminWithResponse), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProjectAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RestoreProjectAdminWithResponse), varargs...)
 }
 
-// GetProjectWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) GetProjectWithResponse(ctx context.Context, projectID ProjectIDPath, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+// RevokeServiceAccountAPIKeyAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) RevokeServiceAccountAPIKeyAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, apiKeyID APIKeyIDPath, reqEditors ...RequestEditorFn) (*RevokeServiceAccountAPIKeyAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID}
+	varargs := []any{ctx, serviceAccountID, apiKeyID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetProjectWithResponse", varargs...)
-	ret0, _ := ret[0].(*GetProjectResponse)
+	ret := m.ctrl.Call(m, "RevokeServiceAccountAPIKeyAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*RevokeServiceAccountAPIKeyAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProjectWithResponse indicates an expected call of GetProjectWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) GetProjectWithResponse(ctx, projectID any, reqEditors ...any) *gomock.Call {
+// RevokeServiceAccountAPIKeyAdminWithResponse indicates an expected call of RevokeServiceAccountAPIKeyAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) RevokeServiceAccountAPIKeyAdminWithResponse(ctx, serviceAccountID, apiKeyID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetProjectWithResponse), varargs...)
+	varargs := append([]any{ctx, serviceAccountID, apiKeyID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeServiceAccountAPIKeyAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RevokeServiceAccountAPIKeyAdminWithResponse), varargs...)
 }
 
-// GetServiceAccountAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) GetServiceAccountAdminWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, reqEditors ...RequestEditorFn) (*GetServiceAccountAdminResponse, error) {
+// RevokeUserSessionsAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) RevokeUserSessionsAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*RevokeUserSessionsAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, serviceAccountID}
+	varargs := []any{ctx, userID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetServiceAccountAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*GetServiceAccountAdminResponse)
+	ret := m.ctrl.Call(m, "RevokeUserSessionsAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*RevokeUserSessionsAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetServiceAccountAdminWithResponse indicates an expected call of GetServiceAccountAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) GetServiceAccountAdminWithResponse(ctx, serviceAccountID any, reqEditors ...any) *gomock.Call {
+// RevokeUserSessionsAdminWithResponse indicates an expected call of RevokeUserSessionsAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) RevokeUserSessionsAdminWithResponse(ctx, userID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, serviceAccountID}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccountAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetServiceAccountAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessionsAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).RevokeUserSessionsAdminWithResponse), varargs...)
 }
 
-// ListImagesAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) ListImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesAdminParams, reqEditors ...RequestEditorFn) (*ListImagesAdminResponse, error) {
+// SignOutWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) SignOutWithResponse(ctx context.Context, params *SignOutParams, reqEditors ...RequestEditorFn) (*SignOutResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, params}
+	varargs := []any{ctx, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListImagesAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*ListImagesAdminResponse)
+	ret := m.ctrl.Call(m, "SignOutWithResponse", varargs...)
+	ret0, _ := ret[0].(*SignOutResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListImagesAdminWithResponse indicates an expected call of ListImagesAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) ListImagesAdminWithResponse(ctx, projectID, params any, reqEditors ...any) *gomock.Call {
+// SignOutWithResponse indicates an expected call of SignOutWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) SignOutWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImagesAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListImagesAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOutWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).SignOutWithResponse), varargs...)
 }
 
-// ListImagesWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) ListImagesWithResponse(ctx context.Context, projectID ProjectIDPath, params *ListImagesParams, reqEditors ...RequestEditorFn) (*ListImagesResponse, error) {
+// StartSignInWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) StartSignInWithResponse(ctx context.Context, provider OIDCProviderPath, params *StartSignInParams, reqEditors ...RequestEditorFn) (*StartSignInResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, params}
+	varargs := []any{ctx, provider, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListImagesWithResponse", varargs...)
-	ret0, _ := ret[0].(*ListImagesResponse)
+	ret := m.ctrl.Call(m, "StartSignInWithResponse", varargs...)
+	ret0, _ := ret[0].(*StartSignInResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListImagesWithResponse indicates an expected call of ListImagesWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) ListImagesWithResponse(ctx, projectID, params any, reqEditors ...any) *gomock.Call {
+// StartSignInWithResponse indicates an expected call of StartSignInWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) StartSignInWithResponse(ctx, provider, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImagesWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListImagesWithResponse), varargs...)
+	varargs := append([]any{ctx, provider, params}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSignInWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).StartSignInWithResponse), varargs...)
 }
 
-// ListProjectsAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) ListProjectsAdminWithResponse(ctx context.Context, params *ListProjectsAdminParams, reqEditors ...RequestEditorFn) (*ListProjectsAdminResponse, error) {
+// SuspendUserAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) SuspendUserAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*SuspendUserAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, params}
+	varargs := []any{ctx, userID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListProjectsAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*ListProjectsAdminResponse)
+	ret := m.ctrl.Call(m, "SuspendUserAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*SuspendUserAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListProjectsAdminWithResponse indicates an expected call of ListProjectsAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProjectsAdminWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
+// SuspendUserAdminWithResponse indicates an expected call of SuspendUserAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) SuspendUserAdminWithResponse(ctx, userID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProjectsAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUserAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).SuspendUserAdminWithResponse), varargs...)
 }
 
-// ListServiceAccountsAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) ListServiceAccountsAdminWithResponse(ctx context.Context, params *ListServiceAccountsAdminParams, reqEditors ...RequestEditorFn) (*ListServiceAccountsAdminResponse, error) {
+// UnsuspendUserAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UnsuspendUserAdminWithResponse(ctx context.Context, userID UserIDPath, reqEditors ...RequestEditorFn) (*UnsuspendUserAdminResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, params}
+	varargs := []any{ctx, userID}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListServiceAccountsAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*ListServiceAccountsAdminResponse)
+	ret := m.ctrl.Call(m, "UnsuspendUserAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*UnsuspendUserAdminResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListServiceAccountsAdminWithResponse indicates an expected call of ListServiceAccountsAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) ListServiceAccountsAdminWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
+// UnsuspendUserAdminWithResponse indicates an expected call of UnsuspendUserAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UnsuspendUserAdminWithResponse(ctx, userID any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccountsAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListServiceAccountsAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, userID}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsuspendUserAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UnsuspendUserAdminWithResponse), varargs...)
 }
 
-// ReprocessImagesAdminWithBodyWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) ReprocessImagesAdminWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error) {
+// UpdateImageFocusWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateImageFocusWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateImageFocusResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, contentType, body}
+	varargs := []any{ctx, projectID, imageID, contentType, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ReprocessImagesAdminWithBodyWithResponse", varargs...)
-	ret0, _ := ret[0].(*ReprocessImagesAdminResponse)
+	ret := m.ctrl.Call(m, "UpdateImageFocusWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateImageFocusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReprocessImagesAdminWithBodyWithResponse indicates an expected call of ReprocessImagesAdminWithBodyWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) ReprocessImagesAdminWithBodyWithResponse(ctx, projectID, contentType, body any, reqEditors ...any) *gomock.Call {
+// UpdateImageFocusWithBodyWithResponse indicates an expected call of UpdateImageFocusWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateImageFocusWithBodyWithResponse(ctx, projectID, imageID, contentType, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, contentType, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprocessImagesAdminWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ReprocessImagesAdminWithBodyWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, imageID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageFocusWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateImageFocusWithBodyWithResponse), varargs...)
 }
 
-// ReprocessImagesAdminWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) ReprocessImagesAdminWithResponse(ctx context.Context, projectID ProjectIDPath, body ReprocessImagesAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*ReprocessImagesAdminResponse, error) {
+// UpdateImageFocusWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateImageFocusWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateImageFocusResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, projectID, body}
+	varargs := []any{ctx, projectID, imageID, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ReprocessImagesAdminWithResponse", varargs...)
-	ret0, _ := ret[0].(*ReprocessImagesAdminResponse)
+	ret := m.ctrl.Call(m, "UpdateImageFocusWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateImageFocusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReprocessImagesAdminWithResponse indicates an expected call of ReprocessImagesAdminWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) ReprocessImagesAdminWithResponse(ctx, projectID, body any, reqEditors ...any) *gomock.Call {
+// UpdateImageFocusWithResponse indicates an expected call of UpdateImageFocusWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateImageFocusWithResponse(ctx, projectID, imageID, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, projectID, body}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprocessImagesAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ReprocessImagesAdminWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, imageID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageFocusWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateImageFocusWithResponse), varargs...)
 }
 
-// SignOutWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) SignOutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOutResponse, error) {
+// UpdateImageWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateImageWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateImageResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
+	varargs := []any{ctx, projectID, imageID, contentType, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SignOutWithResponse", varargs...)
-	ret0, _ := ret[0].(*SignOutResponse)
+	ret := m.ctrl.Call(m, "UpdateImageWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateImageResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SignOutWithResponse indicates an expected call of SignOutWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) SignOutWithResponse(ctx any, reqEditors ...any) *gomock.Call {
+// UpdateImageWithBodyWithResponse indicates an expected call of UpdateImageWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateImageWithBodyWithResponse(ctx, projectID, imageID, contentType, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOutWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).SignOutWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, imageID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateImageWithBodyWithResponse), varargs...)
 }
 
-// StartGoogleSignInWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) StartGoogleSignInWithResponse(ctx context.Context, params *StartGoogleSignInParams, reqEditors ...RequestEditorFn) (*StartGoogleSignInResponse, error) {
+// UpdateImageWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateImageWithResponse(ctx context.Context, projectID ProjectIDPath, imageID ImageIDPath, body UpdateImageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateImageResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, params}
+	varargs := []any{ctx, projectID, imageID, body}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "StartGoogleSignInWithResponse", varargs...)
-	ret0, _ := ret[0].(*StartGoogleSignInResponse)
+	ret := m.ctrl.Call(m, "UpdateImageWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateImageResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StartGoogleSignInWithResponse indicates an expected call of StartGoogleSignInWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) StartGoogleSignInWithResponse(ctx, params any, reqEditors ...any) *gomock.Call {
+// UpdateImageWithResponse indicates an expected call of UpdateImageWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateImageWithResponse(ctx, projectID, imageID, body any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, params}, reqEditors...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGoogleSignInWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).StartGoogleSignInWithResponse), varargs...)
+	varargs := append([]any{ctx, projectID, imageID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateImageWithResponse), varargs...)
 }
 
 // UpdateProjectAdminWithBodyWithResponse mocks base method.
@@ -1185,6 +2505,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateProjectAdminWithRe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateProjectAdminWithResponse), varargs...)
 }
 
+// UpdateProjectMemberWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateProjectMemberWithBodyWithResponse(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, userID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProjectMemberWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateProjectMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectMemberWithBodyWithResponse indicates an expected call of UpdateProjectMemberWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateProjectMemberWithBodyWithResponse(ctx, projectID, userID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, userID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectMemberWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateProjectMemberWithBodyWithResponse), varargs...)
+}
+
+// UpdateProjectMemberWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateProjectMemberWithResponse(ctx context.Context, projectID ProjectIDPath, userID UserIDPath, body UpdateProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, projectID, userID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProjectMemberWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateProjectMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectMemberWithResponse indicates an expected call of UpdateProjectMemberWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateProjectMemberWithResponse(ctx, projectID, userID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, projectID, userID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectMemberWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateProjectMemberWithResponse), varargs...)
+}
+
 // UpdateServiceAccountAdminWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) UpdateServiceAccountAdminWithBodyWithResponse(ctx context.Context, serviceAccountID ServiceAccountIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateServiceAccountAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -1224,3 +2584,43 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateServiceAccountAdmi
 	varargs := append([]any{ctx, serviceAccountID, body}, reqEditors...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceAccountAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateServiceAccountAdminWithResponse), varargs...)
 }
+
+// UpdateUserAdminWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateUserAdminWithBodyWithResponse(ctx context.Context, userID UserIDPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID, contentType, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserAdminWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateUserAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserAdminWithBodyWithResponse indicates an expected call of UpdateUserAdminWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateUserAdminWithBodyWithResponse(ctx, userID, contentType, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID, contentType, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAdminWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateUserAdminWithBodyWithResponse), varargs...)
+}
+
+// UpdateUserAdminWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) UpdateUserAdminWithResponse(ctx context.Context, userID UserIDPath, body UpdateUserAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID, body}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserAdminWithResponse", varargs...)
+	ret0, _ := ret[0].(*UpdateUserAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserAdminWithResponse indicates an expected call of UpdateUserAdminWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) UpdateUserAdminWithResponse(ctx, userID, body any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID, body}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAdminWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).UpdateUserAdminWithResponse), varargs...)
+}
@@ -0,0 +1,59 @@
+package gcphelpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+type StorageClientConfig struct {
+	// Endpoint overrides the endpoint of Google Cloud Storage if set, e.g. to
+	// use an emulator.
+	Endpoint string
+	// CredentialsFile is the path of a service account key file. Application
+	// default credentials are used if not set.
+	CredentialsFile string
+}
+
+func NewStorageClient(ctx context.Context, cfg StorageClientConfig) (*storage.Client, error) {
+	var opts []option.ClientOption
+	if cfg.Endpoint != "" {
+		opts = append(opts, option.WithEndpoint(cfg.Endpoint))
+	}
+	if cfg.CredentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(cfg.CredentialsFile))
+	}
+
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating storage client: %w", err)
+	}
+	return client, nil
+}
+
+func WrapStorageError(err error, msg string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrObjectNotExist):
+		fallthrough
+	case errors.Is(err, storage.ErrBucketNotExist):
+		return apperr.NewError(apperr.CodeNotFound).
+			WithCause(err).
+			WithSummary("Resource not found").
+			WithDetail(msg, args...)
+
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithCause(err).
+			WithSummary("Unexpected GCS error").
+			WithDetail(msg, args...)
+	}
+}
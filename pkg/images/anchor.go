@@ -65,6 +65,20 @@ func (a Anchor) Validate() error {
 	return nil
 }
 
+func (a Anchor) ValidateForWatermark() error {
+	switch a {
+	case AnchorCenter:
+	case AnchorNorth:
+	case AnchorSouth:
+	case AnchorEast:
+	case AnchorWest:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected image anchor %q for watermark", a)
+	}
+	return nil
+}
+
 func (a Anchor) ToProto() imageerv1.ImageAnchor {
 	switch a {
 	case AnchorSmart:
@@ -0,0 +1,86 @@
+package images
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+	imageerv1 "github.com/isutare412/imageer/pkg/protogen/imageer/v1"
+)
+
+type ChromaSubsampling string
+
+const (
+	// ChromaSubsampling420 halves the color resolution in both directions. It
+	// produces smaller files, with color bleeding on sharp edges.
+	ChromaSubsampling420 ChromaSubsampling = "420"
+
+	// ChromaSubsampling444 keeps the full color resolution.
+	ChromaSubsampling444 ChromaSubsampling = "444"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = ChromaSubsampling("")
+	_ sql.Scanner   = (*ChromaSubsampling)(nil)
+)
+
+func NewChromaSubsamplingFromProto(c imageerv1.ChromaSubsampling) ChromaSubsampling {
+	switch c {
+	case imageerv1.ChromaSubsampling_CHROMA_SUBSAMPLING_420:
+		return ChromaSubsampling420
+	case imageerv1.ChromaSubsampling_CHROMA_SUBSAMPLING_444:
+		return ChromaSubsampling444
+	default:
+		return ""
+	}
+}
+
+func (c ChromaSubsampling) Validate() error {
+	switch c {
+	case ChromaSubsampling420:
+	case ChromaSubsampling444:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected chroma subsampling %q", c)
+	}
+	return nil
+}
+
+func (c ChromaSubsampling) ToProto() imageerv1.ChromaSubsampling {
+	switch c {
+	case ChromaSubsampling420:
+		return imageerv1.ChromaSubsampling_CHROMA_SUBSAMPLING_420
+	case ChromaSubsampling444:
+		return imageerv1.ChromaSubsampling_CHROMA_SUBSAMPLING_444
+	default:
+		return imageerv1.ChromaSubsampling_CHROMA_SUBSAMPLING_UNSPECIFIED
+	}
+}
+
+func (c ChromaSubsampling) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+func (c *ChromaSubsampling) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of chroma subsampling: %[1]T(%[1]v)", value)
+	}
+
+	*c = ChromaSubsampling(str)
+	return nil
+}
@@ -0,0 +1,82 @@
+package images
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// Descriptor is the srcset descriptor of an image variant, such as "2x" for a
+// pixel density or "640w" for a width. Empty for the single variant of a preset
+// without a variant set.
+type Descriptor string
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = Descriptor("")
+	_ sql.Scanner   = (*Descriptor)(nil)
+)
+
+func NewDensityDescriptor(density float64) Descriptor {
+	return Descriptor(strconv.FormatFloat(density, 'f', -1, 64) + "x")
+}
+
+func NewWidthDescriptor(width int64) Descriptor {
+	return Descriptor(strconv.FormatInt(width, 10) + "w")
+}
+
+// Density returns the pixel density of the descriptor, if it is one.
+func (d Descriptor) Density() (float64, bool) {
+	num, ok := strings.CutSuffix(string(d), "x")
+	if !ok {
+		return 0, false
+	}
+	density, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, false
+	}
+	return density, true
+}
+
+// Width returns the width of the descriptor in pixels, if it is one.
+func (d Descriptor) Width() (int64, bool) {
+	num, ok := strings.CutSuffix(string(d), "w")
+	if !ok {
+		return 0, false
+	}
+	width, err := strconv.ParseInt(num, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return width, true
+}
+
+func (d Descriptor) Value() (driver.Value, error) {
+	return string(d), nil
+}
+
+func (d *Descriptor) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of image variant descriptor: %[1]T(%[1]v)", value)
+	}
+
+	*d = Descriptor(str)
+	return nil
+}
@@ -0,0 +1,89 @@
+package images
+
+import (
+	"github.com/isutare412/imageer/pkg/apperr"
+	imageerv1 "github.com/isutare412/imageer/pkg/protogen/imageer/v1"
+)
+
+// FocalPoint is the point of interest of an image. Coordinates are fractions
+// of the image width and height, with (0, 0) at the top-left corner.
+type FocalPoint struct {
+	X float64
+	Y float64
+}
+
+func NewFocalPointFromProto(p *imageerv1.FocalPoint) *FocalPoint {
+	if p == nil {
+		return nil
+	}
+	return &FocalPoint{
+		X: p.X,
+		Y: p.Y,
+	}
+}
+
+func (p FocalPoint) Validate() error {
+	if p.X < 0 || p.X > 1 || p.Y < 0 || p.Y > 1 {
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Focal point (%g, %g) must be within [0, 1]", p.X, p.Y)
+	}
+	return nil
+}
+
+func (p *FocalPoint) ToProto() *imageerv1.FocalPoint {
+	if p == nil {
+		return nil
+	}
+	return &imageerv1.FocalPoint{
+		X: p.X,
+		Y: p.Y,
+	}
+}
+
+// CropBox is a rectangle of an image to keep. Values are fractions of the image
+// width and height, with (0, 0) at the top-left corner.
+type CropBox struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+func NewCropBoxFromProto(b *imageerv1.CropBox) *CropBox {
+	if b == nil {
+		return nil
+	}
+	return &CropBox{
+		X:      b.X,
+		Y:      b.Y,
+		Width:  b.Width,
+		Height: b.Height,
+	}
+}
+
+func (b CropBox) Validate() error {
+	switch {
+	case b.X < 0 || b.Y < 0:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Crop box origin (%g, %g) must not be negative", b.X, b.Y)
+	case b.Width <= 0 || b.Height <= 0:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Crop box size %gx%g must be positive", b.Width, b.Height)
+	case b.X+b.Width > 1 || b.Y+b.Height > 1:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Crop box must be within the image")
+	}
+	return nil
+}
+
+func (b *CropBox) ToProto() *imageerv1.CropBox {
+	if b == nil {
+		return nil
+	}
+	return &imageerv1.CropBox{
+		X:      b.X,
+		Y:      b.Y,
+		Width:  b.Width,
+		Height: b.Height,
+	}
+}
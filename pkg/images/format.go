@@ -19,6 +19,7 @@ const (
 	FormatWebp Format = "WEBP"
 	FormatAVIF Format = "AVIF"
 	FormatHEIC Format = "HEIC"
+	FormatJXL  Format = "JXL"
 )
 
 // Ensure interfaces are implemented
@@ -39,6 +40,8 @@ func NewFormatFromProto(f imageerv1.ImageFormat) Format {
 		return FormatAVIF
 	case imageerv1.ImageFormat_IMAGE_FORMAT_HEIC:
 		return FormatHEIC
+	case imageerv1.ImageFormat_IMAGE_FORMAT_JXL:
+		return FormatJXL
 	default:
 		return ""
 	}
@@ -55,17 +58,35 @@ func (f Format) Validate() error {
 	case FormatWebp:
 	case FormatAVIF:
 	case FormatHEIC:
+	case FormatJXL:
 	default:
 		return apperr.NewError(apperr.CodeBadRequest).WithSummary("Unexpected image format %q", f)
 	}
 	return nil
 }
 
+func (f Format) ValidateForUpload() error {
+	switch f {
+	case FormatJPEG:
+	case FormatPNG:
+	case FormatWebp:
+	case FormatAVIF:
+	case FormatHEIC:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected image format %q for upload", f)
+	}
+	return nil
+}
+
 func (f Format) ValidateForPreset() error {
 	switch f {
 	case FormatJPEG:
 	case FormatPNG:
 	case FormatWebp:
+	case FormatAVIF:
+	case FormatHEIC:
+	case FormatJXL:
 	default:
 		return apperr.NewError(apperr.CodeBadRequest).
 			WithSummary("Unexpected image format %q for preset", f)
@@ -73,6 +94,18 @@ func (f Format) ValidateForPreset() error {
 	return nil
 }
 
+func (f Format) ValidateForWatermark() error {
+	switch f {
+	case FormatJPEG:
+	case FormatPNG:
+	case FormatWebp:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected image format %q for watermark", f)
+	}
+	return nil
+}
+
 func (f Format) Extension() string {
 	switch f {
 	case FormatJPEG:
@@ -85,6 +118,8 @@ func (f Format) Extension() string {
 		return "avif"
 	case FormatHEIC:
 		return "heic"
+	case FormatJXL:
+		return "jxl"
 	default:
 		return ""
 	}
@@ -102,6 +137,8 @@ func (f Format) ContentType() string {
 		return "image/avif"
 	case FormatHEIC:
 		return "image/heic"
+	case FormatJXL:
+		return "image/jxl"
 	default:
 		return ""
 	}
@@ -119,6 +156,8 @@ func (f Format) ToProto() imageerv1.ImageFormat {
 		return imageerv1.ImageFormat_IMAGE_FORMAT_AVIF
 	case FormatHEIC:
 		return imageerv1.ImageFormat_IMAGE_FORMAT_HEIC
+	case FormatJXL:
+		return imageerv1.ImageFormat_IMAGE_FORMAT_JXL
 	default:
 		return imageerv1.ImageFormat_IMAGE_FORMAT_UNSPECIFIED
 	}
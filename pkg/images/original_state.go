@@ -0,0 +1,63 @@
+package images
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// OriginalState is the state of the original object of an image, which is
+// changed by retention policies of projects.
+type OriginalState string
+
+const (
+	OriginalStateStored           OriginalState = "STORED"
+	OriginalStateInfrequentAccess OriginalState = "INFREQUENT_ACCESS"
+	OriginalStateDeleted          OriginalState = "DELETED"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = OriginalState("")
+	_ sql.Scanner   = (*OriginalState)(nil)
+)
+
+func (s OriginalState) Validate() error {
+	switch s {
+	case OriginalStateStored:
+	case OriginalStateInfrequentAccess:
+	case OriginalStateDeleted:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected original state %q", s)
+	}
+	return nil
+}
+
+func (s OriginalState) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+func (s *OriginalState) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of original state: %[1]T(%[1]v)", value)
+	}
+
+	*s = OriginalState(str)
+	return nil
+}
@@ -17,6 +17,10 @@ const (
 	VariantStateProcessing    VariantState = "PROCESSING"
 	VariantStateFailed        VariantState = "FAILED"
 	VariantStateReady         VariantState = "READY"
+
+	// VariantStateSkipped marks a variant of a variant set that is not rendered
+	// as it would upscale the original image.
+	VariantStateSkipped VariantState = "SKIPPED"
 )
 
 // Ensure interfaces are implemented
@@ -32,6 +36,7 @@ func (s VariantState) Validate() error {
 	case VariantStateProcessing:
 	case VariantStateFailed:
 	case VariantStateReady:
+	case VariantStateSkipped:
 	default:
 		return apperr.NewError(apperr.CodeBadRequest).WithSummary("Unexpected image variant state %q", s)
 	}
@@ -50,6 +55,8 @@ func (s VariantState) ToProto() imageerv1.ImageVariantState {
 		return imageerv1.ImageVariantState_IMAGE_VARIANT_STATE_FAILED
 	case VariantStateReady:
 		return imageerv1.ImageVariantState_IMAGE_VARIANT_STATE_READY
+	case VariantStateSkipped:
+		return imageerv1.ImageVariantState_IMAGE_VARIANT_STATE_SKIPPED
 	default:
 		return imageerv1.ImageVariantState_IMAGE_VARIANT_STATE_UNSPECIFIED
 	}
@@ -83,7 +90,7 @@ func (s *VariantState) Scan(value any) error {
 
 func (s VariantState) IsTerminal() bool {
 	switch s {
-	case VariantStateReady, VariantStateFailed, VariantStateUploadExpired:
+	case VariantStateReady, VariantStateFailed, VariantStateUploadExpired, VariantStateSkipped:
 		return true
 	default:
 		return false
@@ -0,0 +1,61 @@
+package images
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// WatermarkState is the upload state of a watermark. Presets can reference
+// watermarks only after their objects are uploaded.
+type WatermarkState string
+
+const (
+	WatermarkStateUploadPending WatermarkState = "UPLOAD_PENDING"
+	WatermarkStateReady         WatermarkState = "READY"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = WatermarkState("")
+	_ sql.Scanner   = (*WatermarkState)(nil)
+)
+
+func (s WatermarkState) Validate() error {
+	switch s {
+	case WatermarkStateUploadPending:
+	case WatermarkStateReady:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected watermark state %q", s)
+	}
+	return nil
+}
+
+func (s WatermarkState) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+func (s *WatermarkState) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of watermark state: %[1]T(%[1]v)", value)
+	}
+
+	*s = WatermarkState(str)
+	return nil
+}
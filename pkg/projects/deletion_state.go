@@ -0,0 +1,77 @@
+package projects
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// DeletionState is the state of the job purging a deleted project.
+type DeletionState string
+
+const (
+	// DeletionStatePending waits for the restore window of the project to pass.
+	DeletionStatePending DeletionState = "PENDING"
+	// DeletionStateRunning purges images of the project in batches.
+	DeletionStateRunning DeletionState = "RUNNING"
+	// DeletionStateSucceeded has purged the project entirely.
+	DeletionStateSucceeded DeletionState = "SUCCEEDED"
+	// DeletionStateCanceled is set when the project is restored in time.
+	DeletionStateCanceled DeletionState = "CANCELED"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = DeletionState("")
+	_ sql.Scanner   = (*DeletionState)(nil)
+)
+
+func (s DeletionState) Validate() error {
+	switch s {
+	case DeletionStatePending:
+	case DeletionStateRunning:
+	case DeletionStateSucceeded:
+	case DeletionStateCanceled:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected project deletion state %q", s)
+	}
+	return nil
+}
+
+func (s DeletionState) IsTerminal() bool {
+	switch s {
+	case DeletionStateSucceeded, DeletionStateCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s DeletionState) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+func (s *DeletionState) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch val := value.(type) {
+	case []byte:
+		str = string(val)
+	case string:
+		str = val
+	case fmt.Stringer:
+		str = val.String()
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of project deletion state: %[1]T(%[1]v)", value)
+	}
+
+	*s = DeletionState(str)
+	return nil
+}
@@ -0,0 +1,85 @@
+package projects
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// KeyTemplate is a template of object paths of a project. Placeholders are
+// replaced as follows.
+//
+//   - {project}: ID of the project
+//   - {image}: ID of the image
+//   - {preset}: name of the preset, or "original" for original images
+//   - {variant}: object name of the variant, or "original" for original images
+//   - {ext}: file extension of the image format
+//   - {yyyy}, {mm}, {dd}: creation date of the image in UTC
+type KeyTemplate string
+
+type KeyTemplateParams struct {
+	ProjectID string
+	ImageID   string
+	Preset    string
+	Variant   string
+	Extension string
+	CreatedAt time.Time
+}
+
+var keyTemplatePlaceholderPattern = regexp.MustCompile(`\{([^{}]*)\}`)
+
+var keyTemplatePlaceholders = []string{
+	"project", "image", "preset", "variant", "ext", "yyyy", "mm", "dd",
+}
+
+// keyTemplateRequiredPlaceholders keep object paths unique per image and
+// variant.
+var keyTemplateRequiredPlaceholders = []string{"image", "variant", "ext"}
+
+func (t KeyTemplate) Validate() error {
+	if strings.HasPrefix(string(t), "/") || strings.HasSuffix(string(t), "/") {
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Key template %q must not start or end with a slash", t)
+	}
+	if strings.Contains(string(t), "..") || strings.Contains(string(t), "//") {
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Key template %q must not contain empty or relative path segments", t)
+	}
+
+	found := make(map[string]bool)
+	for _, match := range keyTemplatePlaceholderPattern.FindAllStringSubmatch(string(t), -1) {
+		name := match[1]
+		if !slices.Contains(keyTemplatePlaceholders, name) {
+			return apperr.NewError(apperr.CodeBadRequest).
+				WithSummary("Unexpected placeholder {%s} in key template %q", name, t)
+		}
+		found[name] = true
+	}
+
+	for _, name := range keyTemplateRequiredPlaceholders {
+		if !found[name] {
+			return apperr.NewError(apperr.CodeBadRequest).
+				WithSummary("Key template %q must contain placeholder {%s}", t, name)
+		}
+	}
+	return nil
+}
+
+// Render returns the object path by replacing placeholders of the template.
+func (t KeyTemplate) Render(params KeyTemplateParams) string {
+	createdAt := params.CreatedAt.UTC()
+	replacer := strings.NewReplacer(
+		"{project}", params.ProjectID,
+		"{image}", params.ImageID,
+		"{preset}", params.Preset,
+		"{variant}", params.Variant,
+		"{ext}", params.Extension,
+		"{yyyy}", createdAt.Format("2006"),
+		"{mm}", createdAt.Format("01"),
+		"{dd}", createdAt.Format("02"),
+	)
+	return replacer.Replace(string(t))
+}
@@ -0,0 +1,84 @@
+package projects
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// MemberRole is the role of a user in a project. Each role includes the
+// permissions of the roles below it.
+type MemberRole string
+
+const (
+	// MemberRoleOwner manages members of the project in addition to editing.
+	MemberRoleOwner MemberRole = "OWNER"
+	// MemberRoleEditor uploads, updates and deletes images and watermarks.
+	MemberRoleEditor MemberRole = "EDITOR"
+	// MemberRoleViewer reads the project and its images.
+	MemberRoleViewer MemberRole = "VIEWER"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = MemberRole("")
+	_ sql.Scanner   = (*MemberRole)(nil)
+)
+
+func (r MemberRole) Validate() error {
+	switch r {
+	case MemberRoleOwner:
+	case MemberRoleEditor:
+	case MemberRoleViewer:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected project member role %q", r)
+	}
+	return nil
+}
+
+// Includes reports whether the role has the permissions of other.
+func (r MemberRole) Includes(other MemberRole) bool {
+	return r.rank() >= other.rank()
+}
+
+func (r MemberRole) rank() int {
+	switch r {
+	case MemberRoleOwner:
+		return 3
+	case MemberRoleEditor:
+		return 2
+	case MemberRoleViewer:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (r MemberRole) Value() (driver.Value, error) {
+	return string(r), nil
+}
+
+func (r *MemberRole) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch val := value.(type) {
+	case []byte:
+		str = string(val)
+	case string:
+		str = val
+	case fmt.Stringer:
+		str = val.String()
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of project member role: %[1]T(%[1]v)", value)
+	}
+
+	*r = MemberRole(str)
+	return nil
+}
@@ -0,0 +1,67 @@
+package projects
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// OriginalRetention decides what happens to original images of a project once
+// all of their variants are ready.
+type OriginalRetention string
+
+const (
+	// OriginalRetentionKeep keeps originals as they are.
+	OriginalRetentionKeep OriginalRetention = "KEEP"
+	// OriginalRetentionDelete deletes originals from the storage.
+	OriginalRetentionDelete OriginalRetention = "DELETE"
+	// OriginalRetentionInfrequentAccess moves originals to the infrequent
+	// access storage class of the storage.
+	OriginalRetentionInfrequentAccess OriginalRetention = "INFREQUENT_ACCESS"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = OriginalRetention("")
+	_ sql.Scanner   = (*OriginalRetention)(nil)
+)
+
+func (r OriginalRetention) Validate() error {
+	switch r {
+	case OriginalRetentionKeep:
+	case OriginalRetentionDelete:
+	case OriginalRetentionInfrequentAccess:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected original retention %q", r)
+	}
+	return nil
+}
+
+func (r OriginalRetention) Value() (driver.Value, error) {
+	return string(r), nil
+}
+
+func (r *OriginalRetention) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch val := value.(type) {
+	case []byte:
+		str = string(val)
+	case string:
+		str = val
+	case fmt.Stringer:
+		str = val.String()
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of original retention: %[1]T(%[1]v)", value)
+	}
+
+	*r = OriginalRetention(str)
+	return nil
+}
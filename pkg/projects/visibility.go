@@ -0,0 +1,59 @@
+package projects
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+type Visibility string
+
+const (
+	VisibilityPublic  Visibility = "PUBLIC"
+	VisibilityPrivate Visibility = "PRIVATE"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = Visibility("")
+	_ sql.Scanner   = (*Visibility)(nil)
+)
+
+func (v Visibility) Validate() error {
+	switch v {
+	case VisibilityPublic:
+	case VisibilityPrivate:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected project visibility %q", v)
+	}
+	return nil
+}
+
+func (v Visibility) Value() (driver.Value, error) {
+	return string(v), nil
+}
+
+func (v *Visibility) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch val := value.(type) {
+	case []byte:
+		str = string(val)
+	case string:
+		str = val
+	case fmt.Stringer:
+		str = val.String()
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of project visibility: %[1]T(%[1]v)", value)
+	}
+
+	*v = Visibility(str)
+	return nil
+}
@@ -31,6 +31,7 @@ const (
 	ImageFormat_IMAGE_FORMAT_WEBP        ImageFormat = 3
 	ImageFormat_IMAGE_FORMAT_AVIF        ImageFormat = 4
 	ImageFormat_IMAGE_FORMAT_HEIC        ImageFormat = 5
+	ImageFormat_IMAGE_FORMAT_JXL         ImageFormat = 6
 )
 
 // Enum value maps for ImageFormat.
@@ -42,6 +43,7 @@ var (
 		3: "IMAGE_FORMAT_WEBP",
 		4: "IMAGE_FORMAT_AVIF",
 		5: "IMAGE_FORMAT_HEIC",
+		6: "IMAGE_FORMAT_JXL",
 	}
 	ImageFormat_value = map[string]int32{
 		"IMAGE_FORMAT_UNSPECIFIED": 0,
@@ -50,6 +52,7 @@ var (
 		"IMAGE_FORMAT_WEBP":        3,
 		"IMAGE_FORMAT_AVIF":        4,
 		"IMAGE_FORMAT_HEIC":        5,
+		"IMAGE_FORMAT_JXL":         6,
 	}
 )
 
@@ -193,6 +196,55 @@ func (ImageAnchor) EnumDescriptor() ([]byte, []int) {
 	return file_imageer_v1_image_proto_rawDescGZIP(), []int{2}
 }
 
+type ChromaSubsampling int32
+
+const (
+	ChromaSubsampling_CHROMA_SUBSAMPLING_UNSPECIFIED ChromaSubsampling = 0
+	ChromaSubsampling_CHROMA_SUBSAMPLING_420         ChromaSubsampling = 1
+	ChromaSubsampling_CHROMA_SUBSAMPLING_444         ChromaSubsampling = 2
+)
+
+// Enum value maps for ChromaSubsampling.
+var (
+	ChromaSubsampling_name = map[int32]string{
+		0: "CHROMA_SUBSAMPLING_UNSPECIFIED",
+		1: "CHROMA_SUBSAMPLING_420",
+		2: "CHROMA_SUBSAMPLING_444",
+	}
+	ChromaSubsampling_value = map[string]int32{
+		"CHROMA_SUBSAMPLING_UNSPECIFIED": 0,
+		"CHROMA_SUBSAMPLING_420":         1,
+		"CHROMA_SUBSAMPLING_444":         2,
+	}
+)
+
+func (x ChromaSubsampling) Enum() *ChromaSubsampling {
+	p := new(ChromaSubsampling)
+	*p = x
+	return p
+}
+
+func (x ChromaSubsampling) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChromaSubsampling) Descriptor() protoreflect.EnumDescriptor {
+	return file_imageer_v1_image_proto_enumTypes[3].Descriptor()
+}
+
+func (ChromaSubsampling) Type() protoreflect.EnumType {
+	return &file_imageer_v1_image_proto_enumTypes[3]
+}
+
+func (x ChromaSubsampling) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChromaSubsampling.Descriptor instead.
+func (ChromaSubsampling) EnumDescriptor() ([]byte, []int) {
+	return file_imageer_v1_image_proto_rawDescGZIP(), []int{3}
+}
+
 type ImageState int32
 
 const (
@@ -232,11 +284,11 @@ func (x ImageState) String() string {
 }
 
 func (ImageState) Descriptor() protoreflect.EnumDescriptor {
-	return file_imageer_v1_image_proto_enumTypes[3].Descriptor()
+	return file_imageer_v1_image_proto_enumTypes[4].Descriptor()
 }
 
 func (ImageState) Type() protoreflect.EnumType {
-	return &file_imageer_v1_image_proto_enumTypes[3]
+	return &file_imageer_v1_image_proto_enumTypes[4]
 }
 
 func (x ImageState) Number() protoreflect.EnumNumber {
@@ -245,7 +297,7 @@ func (x ImageState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ImageState.Descriptor instead.
 func (ImageState) EnumDescriptor() ([]byte, []int) {
-	return file_imageer_v1_image_proto_rawDescGZIP(), []int{3}
+	return file_imageer_v1_image_proto_rawDescGZIP(), []int{4}
 }
 
 type ImageVariantState int32
@@ -257,6 +309,7 @@ const (
 	ImageVariantState_IMAGE_VARIANT_STATE_PROCESSING     ImageVariantState = 2
 	ImageVariantState_IMAGE_VARIANT_STATE_FAILED         ImageVariantState = 3
 	ImageVariantState_IMAGE_VARIANT_STATE_READY          ImageVariantState = 4
+	ImageVariantState_IMAGE_VARIANT_STATE_SKIPPED        ImageVariantState = 6
 )
 
 // Enum value maps for ImageVariantState.
@@ -268,6 +321,7 @@ var (
 		2: "IMAGE_VARIANT_STATE_PROCESSING",
 		3: "IMAGE_VARIANT_STATE_FAILED",
 		4: "IMAGE_VARIANT_STATE_READY",
+		6: "IMAGE_VARIANT_STATE_SKIPPED",
 	}
 	ImageVariantState_value = map[string]int32{
 		"IMAGE_VARIANT_STATE_UNSPECIFIED":    0,
@@ -276,6 +330,7 @@ var (
 		"IMAGE_VARIANT_STATE_PROCESSING":     2,
 		"IMAGE_VARIANT_STATE_FAILED":         3,
 		"IMAGE_VARIANT_STATE_READY":          4,
+		"IMAGE_VARIANT_STATE_SKIPPED":        6,
 	}
 )
 
@@ -290,11 +345,11 @@ func (x ImageVariantState) String() string {
 }
 
 func (ImageVariantState) Descriptor() protoreflect.EnumDescriptor {
-	return file_imageer_v1_image_proto_enumTypes[4].Descriptor()
+	return file_imageer_v1_image_proto_enumTypes[5].Descriptor()
 }
 
 func (ImageVariantState) Type() protoreflect.EnumType {
-	return &file_imageer_v1_image_proto_enumTypes[4]
+	return &file_imageer_v1_image_proto_enumTypes[5]
 }
 
 func (x ImageVariantState) Number() protoreflect.EnumNumber {
@@ -303,22 +358,27 @@ func (x ImageVariantState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ImageVariantState.Descriptor instead.
 func (ImageVariantState) EnumDescriptor() ([]byte, []int) {
-	return file_imageer_v1_image_proto_rawDescGZIP(), []int{4}
+	return file_imageer_v1_image_proto_rawDescGZIP(), []int{5}
 }
 
 type Image struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	Format        ImageFormat            `protobuf:"varint,5,opt,name=format,proto3,enum=imageer.v1.ImageFormat" json:"format,omitempty"`
-	State         ImageState             `protobuf:"varint,6,opt,name=state,proto3,enum=imageer.v1.ImageState" json:"state,omitempty"`
-	S3Key         string                 `protobuf:"bytes,7,opt,name=s3_key,json=s3Key,proto3" json:"s3_key,omitempty"`
-	Url           string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,9,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FileName   string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Format     ImageFormat            `protobuf:"varint,5,opt,name=format,proto3,enum=imageer.v1.ImageFormat" json:"format,omitempty"`
+	State      ImageState             `protobuf:"varint,6,opt,name=state,proto3,enum=imageer.v1.ImageState" json:"state,omitempty"`
+	S3Key      string                 `protobuf:"bytes,7,opt,name=s3_key,json=s3Key,proto3" json:"s3_key,omitempty"`
+	Url        string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	ProjectId  string                 `protobuf:"bytes,9,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	FocalPoint *FocalPoint            `protobuf:"bytes,10,opt,name=focal_point,json=focalPoint,proto3" json:"focal_point,omitempty"`
+	CropBox    *CropBox               `protobuf:"bytes,11,opt,name=crop_box,json=cropBox,proto3" json:"crop_box,omitempty"`
+	// Storage profile the objects of the image are stored in. Empty means the
+	// default profile.
+	StorageProfile string `protobuf:"bytes,12,opt,name=storage_profile,json=storageProfile,proto3" json:"storage_profile,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Image) Reset() {
@@ -414,6 +474,147 @@ func (x *Image) GetProjectId() string {
 	return ""
 }
 
+func (x *Image) GetFocalPoint() *FocalPoint {
+	if x != nil {
+		return x.FocalPoint
+	}
+	return nil
+}
+
+func (x *Image) GetCropBox() *CropBox {
+	if x != nil {
+		return x.CropBox
+	}
+	return nil
+}
+
+func (x *Image) GetStorageProfile() string {
+	if x != nil {
+		return x.StorageProfile
+	}
+	return ""
+}
+
+type FocalPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FocalPoint) Reset() {
+	*x = FocalPoint{}
+	mi := &file_imageer_v1_image_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FocalPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FocalPoint) ProtoMessage() {}
+
+func (x *FocalPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_imageer_v1_image_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FocalPoint.ProtoReflect.Descriptor instead.
+func (*FocalPoint) Descriptor() ([]byte, []int) {
+	return file_imageer_v1_image_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FocalPoint) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *FocalPoint) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type CropBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width         float64                `protobuf:"fixed64,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        float64                `protobuf:"fixed64,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CropBox) Reset() {
+	*x = CropBox{}
+	mi := &file_imageer_v1_image_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CropBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CropBox) ProtoMessage() {}
+
+func (x *CropBox) ProtoReflect() protoreflect.Message {
+	mi := &file_imageer_v1_image_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CropBox.ProtoReflect.Descriptor instead.
+func (*CropBox) Descriptor() ([]byte, []int) {
+	return file_imageer_v1_image_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CropBox) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CropBox) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CropBox) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CropBox) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 type ImageVariant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -430,7 +631,7 @@ type ImageVariant struct {
 
 func (x *ImageVariant) Reset() {
 	*x = ImageVariant{}
-	mi := &file_imageer_v1_image_proto_msgTypes[1]
+	mi := &file_imageer_v1_image_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -442,7 +643,7 @@ func (x *ImageVariant) String() string {
 func (*ImageVariant) ProtoMessage() {}
 
 func (x *ImageVariant) ProtoReflect() protoreflect.Message {
-	mi := &file_imageer_v1_image_proto_msgTypes[1]
+	mi := &file_imageer_v1_image_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +656,7 @@ func (x *ImageVariant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
 func (*ImageVariant) Descriptor() ([]byte, []int) {
-	return file_imageer_v1_image_proto_rawDescGZIP(), []int{1}
+	return file_imageer_v1_image_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ImageVariant) GetId() string {
@@ -519,7 +720,7 @@ var File_imageer_v1_image_proto protoreflect.FileDescriptor
 const file_imageer_v1_image_proto_rawDesc = "" +
 	"\n" +
 	"\x16imageer/v1/image.proto\x12\n" +
-	"imageer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x02\n" +
+	"imageer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe3\x03\n" +
 	"\x05Image\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
@@ -532,7 +733,21 @@ const file_imageer_v1_image_proto_rawDesc = "" +
 	"\x06s3_key\x18\a \x01(\tR\x05s3Key\x12\x10\n" +
 	"\x03url\x18\b \x01(\tR\x03url\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\t \x01(\tR\tprojectId\"\xbe\x02\n" +
+	"project_id\x18\t \x01(\tR\tprojectId\x127\n" +
+	"\vfocal_point\x18\n" +
+	" \x01(\v2\x16.imageer.v1.FocalPointR\n" +
+	"focalPoint\x12.\n" +
+	"\bcrop_box\x18\v \x01(\v2\x13.imageer.v1.CropBoxR\acropBox\x12'\n" +
+	"\x0fstorage_profile\x18\f \x01(\tR\x0estorageProfile\"(\n" +
+	"\n" +
+	"FocalPoint\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x01R\x01y\"S\n" +
+	"\aCropBox\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x01R\x01y\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x01R\x06height\"\xbe\x02\n" +
 	"\fImageVariant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
@@ -543,14 +758,15 @@ const file_imageer_v1_image_proto_rawDesc = "" +
 	"\x05state\x18\x05 \x01(\x0e2\x1d.imageer.v1.ImageVariantStateR\x05state\x12\x15\n" +
 	"\x06s3_key\x18\x06 \x01(\tR\x05s3Key\x12\x10\n" +
 	"\x03url\x18\a \x01(\tR\x03url\x12\x19\n" +
-	"\bimage_id\x18\b \x01(\tR\aimageId*\x9d\x01\n" +
+	"\bimage_id\x18\b \x01(\tR\aimageId*\xb3\x01\n" +
 	"\vImageFormat\x12\x1c\n" +
 	"\x18IMAGE_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11IMAGE_FORMAT_JPEG\x10\x01\x12\x14\n" +
 	"\x10IMAGE_FORMAT_PNG\x10\x02\x12\x15\n" +
 	"\x11IMAGE_FORMAT_WEBP\x10\x03\x12\x15\n" +
 	"\x11IMAGE_FORMAT_AVIF\x10\x04\x12\x15\n" +
-	"\x11IMAGE_FORMAT_HEIC\x10\x05*e\n" +
+	"\x11IMAGE_FORMAT_HEIC\x10\x05\x12\x14\n" +
+	"\x10IMAGE_FORMAT_JXL\x10\x06*e\n" +
 	"\bImageFit\x12\x19\n" +
 	"\x15IMAGE_FIT_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fIMAGE_FIT_COVER\x10\x01\x12\x15\n" +
@@ -563,21 +779,26 @@ const file_imageer_v1_image_proto_rawDesc = "" +
 	"\x12IMAGE_ANCHOR_NORTH\x10\x03\x12\x16\n" +
 	"\x12IMAGE_ANCHOR_SOUTH\x10\x04\x12\x15\n" +
 	"\x11IMAGE_ANCHOR_EAST\x10\x05\x12\x15\n" +
-	"\x11IMAGE_ANCHOR_WEST\x10\x06*\x98\x01\n" +
+	"\x11IMAGE_ANCHOR_WEST\x10\x06*o\n" +
+	"\x11ChromaSubsampling\x12\"\n" +
+	"\x1eCHROMA_SUBSAMPLING_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CHROMA_SUBSAMPLING_420\x10\x01\x12\x1a\n" +
+	"\x16CHROMA_SUBSAMPLING_444\x10\x02*\x98\x01\n" +
 	"\n" +
 	"ImageState\x12\x1b\n" +
 	"\x17IMAGE_STATE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aIMAGE_STATE_UPLOAD_PENDING\x10\x01\x12\x1e\n" +
 	"\x1aIMAGE_STATE_UPLOAD_EXPIRED\x10\x04\x12\x16\n" +
 	"\x12IMAGE_STATE_FAILED\x10\x02\x12\x15\n" +
-	"\x11IMAGE_STATE_READY\x10\x03*\xeb\x01\n" +
+	"\x11IMAGE_STATE_READY\x10\x03*\x8c\x02\n" +
 	"\x11ImageVariantState\x12#\n" +
 	"\x1fIMAGE_VARIANT_STATE_UNSPECIFIED\x10\x00\x12&\n" +
 	"\"IMAGE_VARIANT_STATE_UPLOAD_PENDING\x10\x01\x12&\n" +
 	"\"IMAGE_VARIANT_STATE_UPLOAD_EXPIRED\x10\x05\x12\"\n" +
 	"\x1eIMAGE_VARIANT_STATE_PROCESSING\x10\x02\x12\x1e\n" +
 	"\x1aIMAGE_VARIANT_STATE_FAILED\x10\x03\x12\x1d\n" +
-	"\x19IMAGE_VARIANT_STATE_READY\x10\x04B\x9d\x01\n" +
+	"\x19IMAGE_VARIANT_STATE_READY\x10\x04\x12\x1f\n" +
+	"\x1bIMAGE_VARIANT_STATE_SKIPPED\x10\x06B\x9d\x01\n" +
 	"\x0ecom.imageer.v1B\n" +
 	"ImageProtoP\x01Z6github.com/isutare412/imageer/gen/imageer/v1;imageerv1\xa2\x02\x03IXX\xaa\x02\n" +
 	"Imageer.V1\xca\x02\n" +
@@ -595,32 +816,37 @@ func file_imageer_v1_image_proto_rawDescGZIP() []byte {
 	return file_imageer_v1_image_proto_rawDescData
 }
 
-var file_imageer_v1_image_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_imageer_v1_image_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_imageer_v1_image_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_imageer_v1_image_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_imageer_v1_image_proto_goTypes = []any{
 	(ImageFormat)(0),              // 0: imageer.v1.ImageFormat
 	(ImageFit)(0),                 // 1: imageer.v1.ImageFit
 	(ImageAnchor)(0),              // 2: imageer.v1.ImageAnchor
-	(ImageState)(0),               // 3: imageer.v1.ImageState
-	(ImageVariantState)(0),        // 4: imageer.v1.ImageVariantState
-	(*Image)(nil),                 // 5: imageer.v1.Image
-	(*ImageVariant)(nil),          // 6: imageer.v1.ImageVariant
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(ChromaSubsampling)(0),        // 3: imageer.v1.ChromaSubsampling
+	(ImageState)(0),               // 4: imageer.v1.ImageState
+	(ImageVariantState)(0),        // 5: imageer.v1.ImageVariantState
+	(*Image)(nil),                 // 6: imageer.v1.Image
+	(*FocalPoint)(nil),            // 7: imageer.v1.FocalPoint
+	(*CropBox)(nil),               // 8: imageer.v1.CropBox
+	(*ImageVariant)(nil),          // 9: imageer.v1.ImageVariant
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
 }
 var file_imageer_v1_image_proto_depIdxs = []int32{
-	7, // 0: imageer.v1.Image.created_at:type_name -> google.protobuf.Timestamp
-	7, // 1: imageer.v1.Image.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: imageer.v1.Image.format:type_name -> imageer.v1.ImageFormat
-	3, // 3: imageer.v1.Image.state:type_name -> imageer.v1.ImageState
-	7, // 4: imageer.v1.ImageVariant.created_at:type_name -> google.protobuf.Timestamp
-	7, // 5: imageer.v1.ImageVariant.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 6: imageer.v1.ImageVariant.format:type_name -> imageer.v1.ImageFormat
-	4, // 7: imageer.v1.ImageVariant.state:type_name -> imageer.v1.ImageVariantState
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	10, // 0: imageer.v1.Image.created_at:type_name -> google.protobuf.Timestamp
+	10, // 1: imageer.v1.Image.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: imageer.v1.Image.format:type_name -> imageer.v1.ImageFormat
+	4,  // 3: imageer.v1.Image.state:type_name -> imageer.v1.ImageState
+	7,  // 4: imageer.v1.Image.focal_point:type_name -> imageer.v1.FocalPoint
+	8,  // 5: imageer.v1.Image.crop_box:type_name -> imageer.v1.CropBox
+	10, // 6: imageer.v1.ImageVariant.created_at:type_name -> google.protobuf.Timestamp
+	10, // 7: imageer.v1.ImageVariant.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: imageer.v1.ImageVariant.format:type_name -> imageer.v1.ImageFormat
+	5,  // 9: imageer.v1.ImageVariant.state:type_name -> imageer.v1.ImageVariantState
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_imageer_v1_image_proto_init() }
@@ -633,8 +859,8 @@ func file_imageer_v1_image_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_imageer_v1_image_proto_rawDesc), len(file_imageer_v1_image_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   2,
+			NumEnums:      6,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
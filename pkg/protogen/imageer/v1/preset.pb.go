@@ -23,20 +23,24 @@ const (
 )
 
 type Preset struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Default       bool                   `protobuf:"varint,5,opt,name=default,proto3" json:"default,omitempty"`
-	Format        ImageFormat            `protobuf:"varint,6,opt,name=format,proto3,enum=imageer.v1.ImageFormat" json:"format,omitempty"`
-	Quality       int32                  `protobuf:"varint,7,opt,name=quality,proto3" json:"quality,omitempty"`
-	Fit           ImageFit               `protobuf:"varint,8,opt,name=fit,proto3,enum=imageer.v1.ImageFit" json:"fit,omitempty"`
-	Anchor        ImageAnchor            `protobuf:"varint,9,opt,name=anchor,proto3,enum=imageer.v1.ImageAnchor" json:"anchor,omitempty"`
-	Width         *int32                 `protobuf:"varint,10,opt,name=width,proto3,oneof" json:"width,omitempty"`
-	Height        *int32                 `protobuf:"varint,11,opt,name=height,proto3,oneof" json:"height,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name               string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Default            bool                   `protobuf:"varint,5,opt,name=default,proto3" json:"default,omitempty"`
+	Format             ImageFormat            `protobuf:"varint,6,opt,name=format,proto3,enum=imageer.v1.ImageFormat" json:"format,omitempty"`
+	Quality            int32                  `protobuf:"varint,7,opt,name=quality,proto3" json:"quality,omitempty"`
+	Fit                ImageFit               `protobuf:"varint,8,opt,name=fit,proto3,enum=imageer.v1.ImageFit" json:"fit,omitempty"`
+	Anchor             ImageAnchor            `protobuf:"varint,9,opt,name=anchor,proto3,enum=imageer.v1.ImageAnchor" json:"anchor,omitempty"`
+	Width              *int32                 `protobuf:"varint,10,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height             *int32                 `protobuf:"varint,11,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	Watermark          *PresetWatermark       `protobuf:"bytes,12,opt,name=watermark,proto3" json:"watermark,omitempty"`
+	SkipUpscale        bool                   `protobuf:"varint,13,opt,name=skip_upscale,json=skipUpscale,proto3" json:"skip_upscale,omitempty"`
+	WithoutEnlargement bool                   `protobuf:"varint,14,opt,name=without_enlargement,json=withoutEnlargement,proto3" json:"without_enlargement,omitempty"`
+	Encoder            *PresetEncoder         `protobuf:"bytes,15,opt,name=encoder,proto3" json:"encoder,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Preset) Reset() {
@@ -146,12 +150,184 @@ func (x *Preset) GetHeight() int32 {
 	return 0
 }
 
+func (x *Preset) GetWatermark() *PresetWatermark {
+	if x != nil {
+		return x.Watermark
+	}
+	return nil
+}
+
+func (x *Preset) GetSkipUpscale() bool {
+	if x != nil {
+		return x.SkipUpscale
+	}
+	return false
+}
+
+func (x *Preset) GetWithoutEnlargement() bool {
+	if x != nil {
+		return x.WithoutEnlargement
+	}
+	return false
+}
+
+func (x *Preset) GetEncoder() *PresetEncoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return nil
+}
+
+type PresetWatermark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WatermarkId   string                 `protobuf:"bytes,1,opt,name=watermark_id,json=watermarkId,proto3" json:"watermark_id,omitempty"`
+	S3Key         string                 `protobuf:"bytes,2,opt,name=s3_key,json=s3Key,proto3" json:"s3_key,omitempty"`
+	Anchor        ImageAnchor            `protobuf:"varint,3,opt,name=anchor,proto3,enum=imageer.v1.ImageAnchor" json:"anchor,omitempty"`
+	Opacity       float32                `protobuf:"fixed32,4,opt,name=opacity,proto3" json:"opacity,omitempty"`
+	Margin        int32                  `protobuf:"varint,5,opt,name=margin,proto3" json:"margin,omitempty"`
+	Scale         float32                `protobuf:"fixed32,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresetWatermark) Reset() {
+	*x = PresetWatermark{}
+	mi := &file_imageer_v1_preset_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetWatermark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetWatermark) ProtoMessage() {}
+
+func (x *PresetWatermark) ProtoReflect() protoreflect.Message {
+	mi := &file_imageer_v1_preset_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetWatermark.ProtoReflect.Descriptor instead.
+func (*PresetWatermark) Descriptor() ([]byte, []int) {
+	return file_imageer_v1_preset_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PresetWatermark) GetWatermarkId() string {
+	if x != nil {
+		return x.WatermarkId
+	}
+	return ""
+}
+
+func (x *PresetWatermark) GetS3Key() string {
+	if x != nil {
+		return x.S3Key
+	}
+	return ""
+}
+
+func (x *PresetWatermark) GetAnchor() ImageAnchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return ImageAnchor_IMAGE_ANCHOR_UNSPECIFIED
+}
+
+func (x *PresetWatermark) GetOpacity() float32 {
+	if x != nil {
+		return x.Opacity
+	}
+	return 0
+}
+
+func (x *PresetWatermark) GetMargin() int32 {
+	if x != nil {
+		return x.Margin
+	}
+	return 0
+}
+
+func (x *PresetWatermark) GetScale() float32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+type PresetEncoder struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Effort            *int32                 `protobuf:"varint,1,opt,name=effort,proto3,oneof" json:"effort,omitempty"`
+	Lossless          bool                   `protobuf:"varint,2,opt,name=lossless,proto3" json:"lossless,omitempty"`
+	ChromaSubsampling ChromaSubsampling      `protobuf:"varint,3,opt,name=chroma_subsampling,json=chromaSubsampling,proto3,enum=imageer.v1.ChromaSubsampling" json:"chroma_subsampling,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PresetEncoder) Reset() {
+	*x = PresetEncoder{}
+	mi := &file_imageer_v1_preset_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetEncoder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetEncoder) ProtoMessage() {}
+
+func (x *PresetEncoder) ProtoReflect() protoreflect.Message {
+	mi := &file_imageer_v1_preset_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetEncoder.ProtoReflect.Descriptor instead.
+func (*PresetEncoder) Descriptor() ([]byte, []int) {
+	return file_imageer_v1_preset_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PresetEncoder) GetEffort() int32 {
+	if x != nil && x.Effort != nil {
+		return *x.Effort
+	}
+	return 0
+}
+
+func (x *PresetEncoder) GetLossless() bool {
+	if x != nil {
+		return x.Lossless
+	}
+	return false
+}
+
+func (x *PresetEncoder) GetChromaSubsampling() ChromaSubsampling {
+	if x != nil {
+		return x.ChromaSubsampling
+	}
+	return ChromaSubsampling_CHROMA_SUBSAMPLING_UNSPECIFIED
+}
+
 var File_imageer_v1_preset_proto protoreflect.FileDescriptor
 
 const file_imageer_v1_preset_proto_rawDesc = "" +
 	"\n" +
 	"\x17imageer/v1/preset.proto\x12\n" +
-	"imageer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16imageer/v1/image.proto\"\xad\x03\n" +
+	"imageer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16imageer/v1/image.proto\"\xf1\x04\n" +
 	"\x06Preset\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
@@ -166,9 +342,25 @@ const file_imageer_v1_preset_proto_rawDesc = "" +
 	"\x06anchor\x18\t \x01(\x0e2\x17.imageer.v1.ImageAnchorR\x06anchor\x12\x19\n" +
 	"\x05width\x18\n" +
 	" \x01(\x05H\x00R\x05width\x88\x01\x01\x12\x1b\n" +
-	"\x06height\x18\v \x01(\x05H\x01R\x06height\x88\x01\x01B\b\n" +
+	"\x06height\x18\v \x01(\x05H\x01R\x06height\x88\x01\x01\x129\n" +
+	"\twatermark\x18\f \x01(\v2\x1b.imageer.v1.PresetWatermarkR\twatermark\x12!\n" +
+	"\fskip_upscale\x18\r \x01(\bR\vskipUpscale\x12/\n" +
+	"\x13without_enlargement\x18\x0e \x01(\bR\x12withoutEnlargement\x123\n" +
+	"\aencoder\x18\x0f \x01(\v2\x19.imageer.v1.PresetEncoderR\aencoderB\b\n" +
 	"\x06_widthB\t\n" +
-	"\a_heightB\x9e\x01\n" +
+	"\a_height\"\xc4\x01\n" +
+	"\x0fPresetWatermark\x12!\n" +
+	"\fwatermark_id\x18\x01 \x01(\tR\vwatermarkId\x12\x15\n" +
+	"\x06s3_key\x18\x02 \x01(\tR\x05s3Key\x12/\n" +
+	"\x06anchor\x18\x03 \x01(\x0e2\x17.imageer.v1.ImageAnchorR\x06anchor\x12\x18\n" +
+	"\aopacity\x18\x04 \x01(\x02R\aopacity\x12\x16\n" +
+	"\x06margin\x18\x05 \x01(\x05R\x06margin\x12\x14\n" +
+	"\x05scale\x18\x06 \x01(\x02R\x05scale\"\xa1\x01\n" +
+	"\rPresetEncoder\x12\x1b\n" +
+	"\x06effort\x18\x01 \x01(\x05H\x00R\x06effort\x88\x01\x01\x12\x1a\n" +
+	"\blossless\x18\x02 \x01(\bR\blossless\x12L\n" +
+	"\x12chroma_subsampling\x18\x03 \x01(\x0e2\x1d.imageer.v1.ChromaSubsamplingR\x11chromaSubsamplingB\t\n" +
+	"\a_effortB\x9e\x01\n" +
 	"\x0ecom.imageer.v1B\vPresetProtoP\x01Z6github.com/isutare412/imageer/gen/imageer/v1;imageerv1\xa2\x02\x03IXX\xaa\x02\n" +
 	"Imageer.V1\xca\x02\n" +
 	"Imageer\\V1\xe2\x02\x16Imageer\\V1\\GPBMetadata\xea\x02\vImageer::V1b\x06proto3"
@@ -185,25 +377,32 @@ func file_imageer_v1_preset_proto_rawDescGZIP() []byte {
 	return file_imageer_v1_preset_proto_rawDescData
 }
 
-var file_imageer_v1_preset_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_imageer_v1_preset_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_imageer_v1_preset_proto_goTypes = []any{
 	(*Preset)(nil),                // 0: imageer.v1.Preset
-	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
-	(ImageFormat)(0),              // 2: imageer.v1.ImageFormat
-	(ImageFit)(0),                 // 3: imageer.v1.ImageFit
-	(ImageAnchor)(0),              // 4: imageer.v1.ImageAnchor
+	(*PresetWatermark)(nil),       // 1: imageer.v1.PresetWatermark
+	(*PresetEncoder)(nil),         // 2: imageer.v1.PresetEncoder
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(ImageFormat)(0),              // 4: imageer.v1.ImageFormat
+	(ImageFit)(0),                 // 5: imageer.v1.ImageFit
+	(ImageAnchor)(0),              // 6: imageer.v1.ImageAnchor
+	(ChromaSubsampling)(0),        // 7: imageer.v1.ChromaSubsampling
 }
 var file_imageer_v1_preset_proto_depIdxs = []int32{
-	1, // 0: imageer.v1.Preset.created_at:type_name -> google.protobuf.Timestamp
-	1, // 1: imageer.v1.Preset.updated_at:type_name -> google.protobuf.Timestamp
-	2, // 2: imageer.v1.Preset.format:type_name -> imageer.v1.ImageFormat
-	3, // 3: imageer.v1.Preset.fit:type_name -> imageer.v1.ImageFit
-	4, // 4: imageer.v1.Preset.anchor:type_name -> imageer.v1.ImageAnchor
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	3, // 0: imageer.v1.Preset.created_at:type_name -> google.protobuf.Timestamp
+	3, // 1: imageer.v1.Preset.updated_at:type_name -> google.protobuf.Timestamp
+	4, // 2: imageer.v1.Preset.format:type_name -> imageer.v1.ImageFormat
+	5, // 3: imageer.v1.Preset.fit:type_name -> imageer.v1.ImageFit
+	6, // 4: imageer.v1.Preset.anchor:type_name -> imageer.v1.ImageAnchor
+	1, // 5: imageer.v1.Preset.watermark:type_name -> imageer.v1.PresetWatermark
+	2, // 6: imageer.v1.Preset.encoder:type_name -> imageer.v1.PresetEncoder
+	6, // 7: imageer.v1.PresetWatermark.anchor:type_name -> imageer.v1.ImageAnchor
+	7, // 8: imageer.v1.PresetEncoder.chroma_subsampling:type_name -> imageer.v1.ChromaSubsampling
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_imageer_v1_preset_proto_init() }
@@ -213,13 +412,14 @@ func file_imageer_v1_preset_proto_init() {
 	}
 	file_imageer_v1_image_proto_init()
 	file_imageer_v1_preset_proto_msgTypes[0].OneofWrappers = []any{}
+	file_imageer_v1_preset_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_imageer_v1_preset_proto_rawDesc), len(file_imageer_v1_preset_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
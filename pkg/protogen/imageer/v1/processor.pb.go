@@ -100,6 +100,7 @@ type ImageProcessResult struct {
 	ErrorCode      int32                  `protobuf:"varint,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
 	ErrorMessage   string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	ProcessingTime *durationpb.Duration   `protobuf:"bytes,7,opt,name=processing_time,json=processingTime,proto3" json:"processing_time,omitempty"`
+	IsSkipped      bool                   `protobuf:"varint,9,opt,name=is_skipped,json=isSkipped,proto3" json:"is_skipped,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -190,6 +191,13 @@ func (x *ImageProcessResult) GetProcessingTime() *durationpb.Duration {
 	return nil
 }
 
+func (x *ImageProcessResult) GetIsSkipped() bool {
+	if x != nil {
+		return x.IsSkipped
+	}
+	return false
+}
+
 var File_imageer_v1_processor_proto protoreflect.FileDescriptor
 
 const file_imageer_v1_processor_proto_rawDesc = "" +
@@ -203,7 +211,7 @@ const file_imageer_v1_processor_proto_rawDesc = "" +
 	"\x06preset\x18\x03 \x01(\v2\x12.imageer.v1.PresetR\x06preset\x1a?\n" +
 	"\x11TraceContextEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb5\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd4\x03\n" +
 	"\x12ImageProcessResult\x12U\n" +
 	"\rtrace_context\x18\b \x03(\v20.imageer.v1.ImageProcessResult.TraceContextEntryR\ftraceContext\x12\x19\n" +
 	"\bimage_id\x18\x01 \x01(\tR\aimageId\x12(\n" +
@@ -214,7 +222,9 @@ const file_imageer_v1_processor_proto_rawDesc = "" +
 	"\n" +
 	"error_code\x18\x05 \x01(\x05R\terrorCode\x12#\n" +
 	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12B\n" +
-	"\x0fprocessing_time\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0eprocessingTime\x1a?\n" +
+	"\x0fprocessing_time\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0eprocessingTime\x12\x1d\n" +
+	"\n" +
+	"is_skipped\x18\t \x01(\bR\tisSkipped\x1a?\n" +
 	"\x11TraceContextEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\xa1\x01\n" +
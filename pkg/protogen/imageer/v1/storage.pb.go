@@ -22,13 +22,14 @@ const (
 )
 
 type ImageS3DeleteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TraceContext  map[string]string      `protobuf:"bytes,4,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	S3Keys        []string               `protobuf:"bytes,3,rep,name=s3_keys,json=s3Keys,proto3" json:"s3_keys,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TraceContext   map[string]string      `protobuf:"bytes,4,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ImageId        string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	S3Keys         []string               `protobuf:"bytes,3,rep,name=s3_keys,json=s3Keys,proto3" json:"s3_keys,omitempty"`
+	StorageProfile string                 `protobuf:"bytes,5,opt,name=storage_profile,json=storageProfile,proto3" json:"storage_profile,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ImageS3DeleteRequest) Reset() {
@@ -89,18 +90,26 @@ func (x *ImageS3DeleteRequest) GetS3Keys() []string {
 	return nil
 }
 
+func (x *ImageS3DeleteRequest) GetStorageProfile() string {
+	if x != nil {
+		return x.StorageProfile
+	}
+	return ""
+}
+
 var File_imageer_v1_storage_proto protoreflect.FileDescriptor
 
 const file_imageer_v1_storage_proto_rawDesc = "" +
 	"\n" +
 	"\x18imageer/v1/storage.proto\x12\n" +
-	"imageer.v1\"\x83\x02\n" +
+	"imageer.v1\"\xac\x02\n" +
 	"\x14ImageS3DeleteRequest\x12W\n" +
 	"\rtrace_context\x18\x04 \x03(\v22.imageer.v1.ImageS3DeleteRequest.TraceContextEntryR\ftraceContext\x12\x19\n" +
 	"\bimage_id\x18\x01 \x01(\tR\aimageId\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x17\n" +
-	"\as3_keys\x18\x03 \x03(\tR\x06s3Keys\x1a?\n" +
+	"\as3_keys\x18\x03 \x03(\tR\x06s3Keys\x12'\n" +
+	"\x0fstorage_profile\x18\x05 \x01(\tR\x0estorageProfile\x1a?\n" +
 	"\x11TraceContextEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x9f\x01\n" +
@@ -0,0 +1,57 @@
+package serviceaccounts
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+type DisabledReason string
+
+const (
+	DisabledReasonUnused DisabledReason = "UNUSED"
+)
+
+// Ensure interfaces are implemented
+var (
+	_ driver.Valuer = DisabledReason("")
+	_ sql.Scanner   = (*DisabledReason)(nil)
+)
+
+func (r DisabledReason) Validate() error {
+	switch r {
+	case DisabledReasonUnused:
+	default:
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected service account disabled reason %q", r)
+	}
+	return nil
+}
+
+func (r DisabledReason) Value() (driver.Value, error) {
+	return string(r), nil
+}
+
+func (r *DisabledReason) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return apperr.NewError(apperr.CodeInternalServerError).
+			WithSummary("Invalid value of disabled reason: %[1]T(%[1]v)", value)
+	}
+
+	*r = DisabledReason(str)
+	return nil
+}
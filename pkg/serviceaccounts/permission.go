@@ -0,0 +1,55 @@
+package serviceaccounts
+
+import (
+	"slices"
+
+	"github.com/isutare412/imageer/pkg/apperr"
+)
+
+// Permission allows service accounts to call a group of operations on
+// resources in their access scope.
+type Permission string
+
+const (
+	PermissionProjectsRead         Permission = "projects:read"
+	PermissionProjectsWrite        Permission = "projects:write"
+	PermissionMembersRead          Permission = "members:read"
+	PermissionMembersWrite         Permission = "members:write"
+	PermissionImagesRead           Permission = "images:read"
+	PermissionImagesWrite          Permission = "images:write"
+	PermissionImagesDelete         Permission = "images:delete"
+	PermissionWatermarksRead       Permission = "watermarks:read"
+	PermissionWatermarksWrite      Permission = "watermarks:write"
+	PermissionServiceAccountsRead  Permission = "service-accounts:read"
+	PermissionServiceAccountsWrite Permission = "service-accounts:write"
+	PermissionUsersRead            Permission = "users:read"
+	PermissionUsersWrite           Permission = "users:write"
+	PermissionAuditLogsRead        Permission = "audit-logs:read"
+)
+
+// AllPermissions lists every permission, which are granted to service
+// accounts created before permissions existed.
+var AllPermissions = []Permission{
+	PermissionProjectsRead,
+	PermissionProjectsWrite,
+	PermissionMembersRead,
+	PermissionMembersWrite,
+	PermissionImagesRead,
+	PermissionImagesWrite,
+	PermissionImagesDelete,
+	PermissionWatermarksRead,
+	PermissionWatermarksWrite,
+	PermissionServiceAccountsRead,
+	PermissionServiceAccountsWrite,
+	PermissionUsersRead,
+	PermissionUsersWrite,
+	PermissionAuditLogsRead,
+}
+
+func (p Permission) Validate() error {
+	if !slices.Contains(AllPermissions, p) {
+		return apperr.NewError(apperr.CodeBadRequest).
+			WithSummary("Unexpected service account permission %q", p)
+	}
+	return nil
+}
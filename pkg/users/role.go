@@ -31,6 +31,22 @@ func (s Role) Validate() error {
 	return nil
 }
 
+// Includes reports whether the role has the permissions of other.
+func (s Role) Includes(other Role) bool {
+	return s.rank() >= other.rank()
+}
+
+func (s Role) rank() int {
+	switch s {
+	case RoleAdmin:
+		return 2
+	case RoleGuest:
+		return 1
+	default:
+		return 0
+	}
+}
+
 func (s Role) Value() (driver.Value, error) {
 	return string(s), nil
 }
@@ -11,13 +11,16 @@ export type Schemas = components['schemas'];
 export type Project = Schemas['Project'];
 export type Projects = Schemas['Projects'];
 export type Preset = Schemas['Preset'];
+export type PresetEncoder = Schemas['PresetEncoder'];
 export type Image = Schemas['Image'];
 export type ImageVariant = Schemas['ImageVariant'];
 export type UploadUrl = Schemas['UploadUrl'];
 export type User = Schemas['User'];
+export type AuthProvider = Schemas['AuthProvider'];
 export type ServiceAccount = Schemas['ServiceAccount'];
 export type ServiceAccountWithApiKey = Schemas['ServiceAccountWithApiKey'];
 export type ServiceAccounts = Schemas['ServiceAccounts'];
+export type ServiceAccountApiKey = Schemas['ServiceAccountApiKey'];
 export type AppError = Schemas['AppError'];
 
 // Enums
@@ -26,8 +29,10 @@ export type ImageVariantState = Schemas['ImageVariantState'];
 export type ImageFormat = Schemas['ImageFormat'];
 export type ImageFit = Schemas['ImageFit'];
 export type ImageAnchor = Schemas['ImageAnchor'];
+export type ChromaSubsampling = Schemas['ChromaSubsampling'];
 export type UserRole = Schemas['UserRole'];
 export type ServiceAccountAccessScope = Schemas['ServiceAccountAccessScope'];
+export type ServiceAccountPermission = Schemas['ServiceAccountPermission'];
 
 // Request types
 export type CreateProjectRequest = Schemas['CreateProjectAdminRequest'];
@@ -37,6 +42,7 @@ export type UpsertPresetRequest = Schemas['UpsertPresetRequest'];
 export type CreateUploadUrlRequest = Schemas['CreateUploadUrlRequest'];
 export type CreateServiceAccountRequest = Schemas['CreateServiceAccountAdminRequest'];
 export type UpdateServiceAccountRequest = Schemas['UpdateServiceAccountAdminRequest'];
+export type CreateServiceAccountApiKeyRequest = Schemas['CreateServiceAccountApiKeyAdminRequest'];
 export type ReprocessImagesRequest = Schemas['ReprocessImagesAdminRequest'];
 
 export interface ClientOptions {
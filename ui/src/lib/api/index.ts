@@ -5,13 +5,16 @@ export type {
   Project,
   Projects,
   Preset,
+  PresetEncoder,
   Image,
   ImageVariant,
   UploadUrl,
   User,
+  AuthProvider,
   ServiceAccount,
   ServiceAccountWithApiKey,
   ServiceAccounts,
+  ServiceAccountApiKey,
   AppError,
   // Enums
   ImageState,
@@ -19,8 +22,10 @@ export type {
   ImageFormat,
   ImageFit,
   ImageAnchor,
+  ChromaSubsampling,
   UserRole,
   ServiceAccountAccessScope,
+  ServiceAccountPermission,
   // Request types
   CreateProjectRequest,
   UpdateProjectRequest,
@@ -29,6 +34,7 @@ export type {
   CreateUploadUrlRequest,
   CreateServiceAccountRequest,
   UpdateServiceAccountRequest,
+  CreateServiceAccountApiKeyRequest,
   ReprocessImagesRequest,
 } from './client';
 
@@ -34,13 +34,59 @@ export interface paths {
         /** Update project details */
         put: operations["updateProjectAdmin"];
         post?: never;
-        /** Delete a project */
+        /**
+         * Delete a project
+         * @description The project and its images are soft-deleted, which blocks new uploads
+         *     to the project. They can be restored until the returned deletion job
+         *     starts after the restore window. The job purges the images and their
+         *     objects in batches, then the project itself.
+         */
         delete: operations["deleteProjectAdmin"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
+    "/api/v1/admin/projects/{projectId}/deletion": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get the latest deletion job of a project */
+        get: operations["getProjectDeletionAdmin"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/admin/projects/{projectId}/restore": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Restore a deleted project
+         * @description Images deleted along with the project are restored as well. Images
+         *     deleted before the project stay deleted. The pending deletion job of
+         *     the project is canceled; projects whose deletion jobs are running
+         *     cannot be restored.
+         */
+        post: operations["restoreProjectAdmin"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/v1/admin/projects/{projectId}/images/reprocess": {
         parameters: {
             query?: never;
@@ -92,6 +138,23 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/api/v1/admin/projects/{projectId}/images/{imageId}/restore": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Restore a deleted image */
+        post: operations["restoreImageAdmin"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/v1/admin/service-accounts": {
         parameters: {
             query?: never;
@@ -129,6 +192,164 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/api/v1/admin/service-accounts/{serviceAccountId}/api-keys": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List API keys of a service account */
+        get: operations["listServiceAccountApiKeysAdmin"];
+        put?: never;
+        /**
+         * Mint a new API key of a service account
+         * @description Mints a new API key, which is shown only in this response. Existing API
+         *     keys stay valid, so that keys are rotated by minting a new key and
+         *     revoking the old one after clients switch to the new key.
+         */
+        post: operations["createServiceAccountApiKeyAdmin"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/admin/service-accounts/{serviceAccountId}/api-keys/{apiKeyId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Revoke an API key of a service account */
+        delete: operations["revokeServiceAccountApiKeyAdmin"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/admin/users": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List and search users */
+        get: operations["listUsersAdmin"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/admin/users/{userId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get user details */
+        get: operations["getUserAdmin"];
+        /**
+         * Change the role of a user
+         * @description The role applies to tokens issued before as well.
+         */
+        put: operations["updateUserAdmin"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/admin/users/{userId}/suspend": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Suspend a user
+         * @description Tokens of suspended users are rejected, and suspended users cannot sign
+         *     in until unsuspended.
+         */
+        post: operations["suspendUserAdmin"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/admin/users/{userId}/revoke-sessions": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Revoke all sessions of a user
+         * @description Signs the user out everywhere. Tokens of the revoked sessions are
+         *     rejected immediately.
+         */
+        post: operations["revokeUserSessionsAdmin"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/admin/users/{userId}/unsuspend": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Unsuspend a user */
+        post: operations["unsuspendUserAdmin"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/admin/audit-logs": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * List audit log entries
+         * @description List entries of administrative and destructive changes from the most
+         *     recent. Entries older than the retention are purged.
+         */
+        get: operations["listAuditLogsAdmin"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/v1/users/me": {
         parameters: {
             query?: never;
@@ -146,15 +367,32 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/api/v1/auth/google/sign-in": {
+    "/api/v1/auth/providers": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List OIDC providers available for sign-in */
+        get: operations["listAuthProviders"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/auth/{provider}/sign-in": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** Start Google Sign-In */
-        get: operations["startGoogleSignIn"];
+        /** Start sign-in with an OIDC provider */
+        get: operations["startSignIn"];
         put?: never;
         post?: never;
         delete?: never;
@@ -163,15 +401,15 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/api/v1/auth/google/sign-in/callback": {
+    "/api/v1/auth/{provider}/sign-in/callback": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** Finish Google Sign-In */
-        get: operations["finishGoogleSignIn"];
+        /** Finish sign-in with an OIDC provider */
+        get: operations["finishSignIn"];
         put?: never;
         post?: never;
         delete?: never;
@@ -189,7 +427,11 @@ export interface paths {
         };
         get?: never;
         put?: never;
-        /** Sign out the current user */
+        /**
+         * Sign out the current user
+         * @description Revokes the session of the current token, so that the token is rejected
+         *     even if it has been copied elsewhere.
+         */
         post: operations["signOut"];
         delete?: never;
         options?: never;
@@ -197,6 +439,28 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/api/v1/projects": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * List accessible projects
+         * @description Lists projects the caller can access. Users see projects they are
+         *     members of, while admins and service accounts with full access scope
+         *     see all projects.
+         */
+        get: operations["listProjects"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/v1/projects/{projectId}": {
         parameters: {
             query?: never;
@@ -259,41 +523,273 @@ export interface paths {
         get: operations["getImage"];
         put?: never;
         post?: never;
-        /** Delete an image */
+        /**
+         * Delete an image
+         * @description The image is soft-deleted, and can be restored until it is purged
+         *     along with its objects after the restore window.
+         */
         delete: operations["deleteImage"];
         options?: never;
         head?: never;
-        patch?: never;
+        /** Update the external ID, tags or metadata of an image */
+        patch: operations["updateImage"];
         trace?: never;
     };
-}
-export type webhooks = Record<string, never>;
-export interface components {
-    schemas: {
+    "/api/v1/projects/{projectId}/images/{imageId}/restore": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
         /**
-         * @description The current state of the image.
-         * @example READY
-         * @enum {string}
+         * Restore a deleted image
+         * @description Images of deleted projects cannot be restored on their own. Restore the
+         *     project instead.
          */
-        ImageState: "UPLOAD_PENDING" | "UPLOAD_EXPIRED" | "READY" | "FAILED";
+        post: operations["restoreImage"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/projects/{projectId}/images/{imageId}/focus": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
         /**
-         * @description The current state of the image variant.
-         * @example READY
-         * @enum {string}
+         * Set the focal point or crop box of an image
+         * @description Cover presets crop variants around the focus of the image instead of
+         *     their anchor. Variants of such presets are re-rendered to new URLs when
+         *     the focus changes, so that caches never serve the previous crop. Send an
+         *     empty object to clear the focus.
          */
-        ImageVariantState: "UPLOAD_PENDING" | "UPLOAD_EXPIRED" | "PROCESSING" | "READY" | "FAILED";
+        put: operations["updateImageFocus"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/projects/{projectId}/images/{imageId}/complete-upload": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
         /**
-         * @description The content type of the image.
-         * @example WEBP
-         * @enum {string}
+         * Start processing an uploaded image
+         * @description Clients call this after uploading the original to its presigned URL
+         *     when the storage does not notify the gateway of uploads. The gateway
+         *     checks that the object exists and starts processing. Calling it for an
+         *     image already being processed is a no-op. Uploads not completed by
+         *     events or clients are also found by a periodic sweep.
          */
-        ImageFormat: "JPEG" | "PNG" | "WEBP" | "AVIF" | "HEIC";
-        /**
-         * @description The fit mode for image conversion:
-         *       - COVER: Scale the image to cover the target dimensions. Some parts may be cropped.
-         *       - CONTAIN: Scale the image to fit within the target dimensions. No cropping occurs. Empty areas may be filled with background color.
-         *       - FILL: Scale the image to fill the target dimensions. The image may be distorted.
-         * @example CONTAIN
+        post: operations["completeUpload"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/projects/{projectId}/watermarks/upload-url": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Issue a presigned URL for uploading a watermark */
+        post: operations["createWatermarkUploadUrl"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/projects/{projectId}/watermarks/{watermarkId}/complete-upload": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Mark an uploaded watermark ready
+         * @description Clients call this after uploading the watermark to its presigned URL.
+         *     The gateway checks that the object exists and marks the watermark
+         *     ready, after which presets can reference it. Calling it for a ready
+         *     watermark is a no-op.
+         */
+        post: operations["completeWatermarkUpload"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/projects/{projectId}/watermarks": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List watermarks in a project */
+        get: operations["listWatermarks"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/projects/{projectId}/watermarks/{watermarkId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /**
+         * Delete a watermark
+         * @description Presets using the watermark stop applying it.
+         */
+        delete: operations["deleteWatermark"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/projects/{projectId}/members": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List members of a project */
+        get: operations["listProjectMembers"];
+        put?: never;
+        /**
+         * Add a member to a project
+         * @description The user must have signed in at least once.
+         */
+        post: operations["addProjectMember"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/v1/projects/{projectId}/members/{userId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        /**
+         * Change the role of a project member
+         * @description The last owner of a project cannot be demoted.
+         */
+        put: operations["updateProjectMember"];
+        post?: never;
+        /**
+         * Remove a member from a project
+         * @description The last owner of a project cannot be removed.
+         */
+        delete: operations["removeProjectMember"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/i/{projectId}/{imageId}/{presetName}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Deliver an image variant negotiated for the client
+         * @description Redirects to the ready variant of the preset family that suits the
+         *     client best. The family consists of the named preset and presets named
+         *     after it with a lowercase format suffix, such as `thumbnail-avif` and
+         *     `thumbnail-webp`. Formats are preferred in the order of AVIF, WEBP,
+         *     JXL, HEIC, JPEG and PNG among the ones the Accept header allows, and
+         *     variant sets are picked by the DPR and Width client hints. Redirects to
+         *     the original image if no acceptable variant of the family is ready yet,
+         *     or responds with 406 if the Accept header does not allow the format of
+         *     the original image either.
+         */
+        get: operations["deliverImage"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+}
+export type webhooks = Record<string, never>;
+export interface components {
+    schemas: {
+        /**
+         * @description The current state of the image.
+         * @example READY
+         * @enum {string}
+         */
+        ImageState: "UPLOAD_PENDING" | "UPLOAD_EXPIRED" | "READY" | "FAILED";
+        /**
+         * @description The state of the original image, which is changed by the retention
+         *     policy of the project.
+         * @example STORED
+         * @enum {string}
+         */
+        ImageOriginalState: "STORED" | "INFREQUENT_ACCESS" | "DELETED";
+        /**
+         * @description The current state of the image variant. SKIPPED variants of a variant
+         *     set are not rendered as they would upscale the original image.
+         * @example READY
+         * @enum {string}
+         */
+        ImageVariantState: "UPLOAD_PENDING" | "UPLOAD_EXPIRED" | "PROCESSING" | "READY" | "FAILED" | "SKIPPED";
+        /**
+         * @description The content type of the image. JXL (JPEG XL) is only available as the
+         *     output format of presets.
+         * @example WEBP
+         * @enum {string}
+         */
+        ImageFormat: "JPEG" | "PNG" | "WEBP" | "AVIF" | "HEIC" | "JXL";
+        /**
+         * @description The fit mode for image conversion:
+         *       - COVER: Scale the image to cover the target dimensions. Some parts may be cropped.
+         *       - CONTAIN: Scale the image to fit within the target dimensions. No cropping occurs. Empty areas may be filled with background color.
+         *       - FILL: Scale the image to fill the target dimensions. The image may be distorted.
+         * @example CONTAIN
          * @enum {string}
          */
         ImageFit: "COVER" | "CONTAIN" | "FILL";
@@ -303,30 +799,137 @@ export interface components {
          * @enum {string}
          */
         ImageAnchor: "SMART" | "CENTER" | "NORTH" | "EAST" | "SOUTH" | "WEST";
+        /**
+         * @description The chroma subsampling of encoded images:
+         *       - 420: Halve the color resolution. Smaller files, with color bleeding on sharp edges.
+         *       - 444: Keep the full color resolution.
+         * @example 444
+         * @enum {string}
+         */
+        ChromaSubsampling: "420" | "444";
         /**
          * @description The role of the user.
          * @example GUEST
          * @enum {string}
          */
         UserRole: "ADMIN" | "GUEST";
+        /**
+         * @description The role of the user in the project. Viewers read resources of the
+         *     project, editors manage images and watermarks as well, and owners
+         *     manage members as well.
+         * @example EDITOR
+         * @enum {string}
+         */
+        ProjectMemberRole: "OWNER" | "EDITOR" | "VIEWER";
         /**
          * @description The access scope of the service account.
          * @example PROJECT
          * @enum {string}
          */
         ServiceAccountAccessScope: "FULL" | "PROJECT";
+        /**
+         * @description The reason the service account was disabled. UNUSED means the service
+         *     account was not used for a while.
+         * @example UNUSED
+         * @enum {string}
+         */
+        ServiceAccountDisabledReason: "UNUSED";
+        /**
+         * @description A permission of the service account. Each permission allows a group of
+         *     operations on resources in the access scope of the service account.
+         * @example images:read
+         * @enum {string}
+         */
+        ServiceAccountPermission: "projects:read" | "projects:write" | "members:read" | "members:write" | "images:read" | "images:write" | "images:delete" | "watermarks:read" | "watermarks:write" | "service-accounts:read" | "service-accounts:write" | "users:read" | "users:write" | "audit-logs:read";
+        /**
+         * @description The audited change.
+         * @example PROJECT_DELETE
+         * @enum {string}
+         */
+        AuditAction: "PROJECT_CREATE" | "PROJECT_UPDATE" | "PROJECT_DELETE" | "PROJECT_RESTORE" | "PROJECT_MEMBER_ADD" | "PROJECT_MEMBER_UPDATE" | "PROJECT_MEMBER_REMOVE" | "SERVICE_ACCOUNT_CREATE" | "SERVICE_ACCOUNT_UPDATE" | "SERVICE_ACCOUNT_DELETE" | "SERVICE_ACCOUNT_STALE_WARN" | "SERVICE_ACCOUNT_DISABLE" | "SERVICE_ACCOUNT_API_KEY_CREATE" | "SERVICE_ACCOUNT_API_KEY_REVOKE" | "IMAGE_DELETE" | "IMAGE_RESTORE" | "WATERMARK_DELETE" | "USER_UPDATE" | "USER_SESSIONS_REVOKE";
+        /**
+         * @description The kind of identity making the change. SYSTEM is for changes without
+         *     an authenticated identity.
+         * @example USER
+         * @enum {string}
+         */
+        AuditActorType: "USER" | "SERVICE_ACCOUNT" | "SYSTEM";
+        /**
+         * @description The kind of resource changed.
+         * @example PROJECT
+         * @enum {string}
+         */
+        AuditTargetType: "PROJECT" | "PROJECT_MEMBER" | "SERVICE_ACCOUNT" | "SERVICE_ACCOUNT_API_KEY" | "IMAGE" | "WATERMARK" | "USER";
+        /**
+         * @description The visibility of the project. Images of public projects are served
+         *     through the CDN, while images of private projects are served through
+         *     short-lived presigned URLs only. Projects are public unless specified.
+         * @example PUBLIC
+         * @enum {string}
+         */
+        ProjectVisibility: "PUBLIC" | "PRIVATE";
+        /**
+         * @description What happens to original images once all of their variants are ready.
+         *     Originals are either kept as they are, deleted, or moved to the
+         *     infrequent access storage class of the storage.
+         * @example DELETE
+         * @enum {string}
+         */
+        OriginalRetention: "KEEP" | "DELETE" | "INFREQUENT_ACCESS";
         /**
          * @description The sort direction for list operations.
          * @example DESC
          * @enum {string}
          */
         SortDirection: "ASC" | "DESC";
+        /**
+         * @description Decides how long objects of images of a project are kept. Images and
+         *     their originals are kept forever unless specified.
+         */
+        RetentionPolicy: {
+            original: components["schemas"]["OriginalRetention"];
+            /**
+             * @description The number of days after all variants of an image are ready when
+             *     the original retention is applied.
+             * @example 30
+             */
+            originalDays: number;
+            /**
+             * @description The number of days after creation when images are deleted entirely.
+             *     Images never expire if zero.
+             * @example 0
+             */
+            imageExpireDays: number;
+        };
         CreateProjectAdminRequest: {
             /**
              * @description The name of the project.
              * @example test-project
              */
             name: string;
+            visibility?: components["schemas"]["ProjectVisibility"];
+            /**
+             * @description Template of object paths of images of the project. Supported
+             *     placeholders are {project}, {image}, {preset}, {variant}, {ext},
+             *     {yyyy}, {mm} and {dd}; {image}, {variant} and {ext} are required.
+             *     Objects are stored in the default layout if absent.
+             * @example {project}/{yyyy}/{mm}/{image}/{preset}-{variant}.{ext}
+             */
+            keyTemplate?: string;
+            /**
+             * @description Base URL of the CDN serving images of the project, without a
+             *     trailing slash. The default CDN is used if absent.
+             * @example https://images.example.com
+             */
+            cdnBaseUrl?: string;
+            /**
+             * @description Name of the storage profile to store objects of the project in. It
+             *     cannot be changed after creation. The default profile is used if
+             *     absent.
+             * @example default
+             */
+            storageProfile?: string;
+            retention?: components["schemas"]["RetentionPolicy"];
             presets?: components["schemas"]["CreatePresetRequest"][];
         };
         UpdateProjectAdminRequest: {
@@ -335,6 +938,21 @@ export interface components {
              * @example test-project
              */
             name?: string;
+            visibility?: components["schemas"]["ProjectVisibility"];
+            /**
+             * @description Template of object paths of images of the project. Set to an empty
+             *     string to restore the default layout. Objects of existing images
+             *     are not moved.
+             * @example {project}/{yyyy}/{mm}/{image}/{preset}-{variant}.{ext}
+             */
+            keyTemplate?: string;
+            /**
+             * @description Base URL of the CDN serving images of the project. Set to an empty
+             *     string to restore the default CDN.
+             * @example https://images.example.com
+             */
+            cdnBaseUrl?: string;
+            retention?: components["schemas"]["RetentionPolicy"];
             presets?: components["schemas"]["UpsertPresetRequest"][];
         };
         CreateServiceAccountAdminRequest: {
@@ -344,6 +962,8 @@ export interface components {
              */
             name: string;
             accessScope: components["schemas"]["ServiceAccountAccessScope"];
+            /** @description Permissions granted on resources in the access scope. */
+            permissions: components["schemas"]["ServiceAccountPermission"][];
             /** @description List of project IDs to associate with the service account. */
             projectIds?: string[];
             /**
@@ -353,6 +973,22 @@ export interface components {
              */
             expireAt?: string;
         };
+        CreateServiceAccountApiKeyAdminRequest: {
+            /**
+             * @description The name of the API key.
+             * @example rotation-2026-10
+             */
+            name: string;
+            /**
+             * Format: date-time
+             * @description The expiration time of the API key.
+             * @example 2023-10-01T12:00:00Z
+             */
+            expireAt?: string;
+        };
+        UpdateUserAdminRequest: {
+            role: components["schemas"]["UserRole"];
+        };
         UpdateServiceAccountAdminRequest: {
             /**
              * @description The name of the service account.
@@ -360,6 +996,11 @@ export interface components {
              */
             name?: string;
             accessScope?: components["schemas"]["ServiceAccountAccessScope"];
+            /**
+             * @description Permissions granted on resources in the access scope. Permissions
+             *     are unchanged if omitted.
+             */
+            permissions?: components["schemas"]["ServiceAccountPermission"][];
             /** @description List of project IDs to associate with the service account. */
             projectIds?: string[];
             /**
@@ -368,6 +1009,24 @@ export interface components {
              * @example 2023-10-01T12:00:00Z
              */
             expireAt?: string;
+            /**
+             * @description Whether to enable the service account again if disabled. Stale
+             *     warnings are cleared as well.
+             * @example true
+             */
+            enable?: boolean;
+        };
+        AddProjectMemberRequest: {
+            /**
+             * Format: email
+             * @description The email address of the user to add.
+             * @example user@example.com
+             */
+            email: string;
+            role: components["schemas"]["ProjectMemberRole"];
+        };
+        UpdateProjectMemberRequest: {
+            role: components["schemas"]["ProjectMemberRole"];
         };
         CreateUploadUrlRequest: {
             /**
@@ -378,6 +1037,31 @@ export interface components {
             format: components["schemas"]["ImageFormat"];
             /** @description List of preset names to apply to the image. If not provided, default presets will be applied. */
             presetNames?: string[];
+            /**
+             * @description ID of the image in the client system.
+             * @example cms-article-42
+             */
+            externalId?: string;
+            /** @description Tags of the image. Duplicates are removed. */
+            tags?: string[];
+            /**
+             * @description Arbitrary key/value metadata of the image. Keys are at most 64 and
+             *     values at most 512 characters long.
+             * @example {
+             *       "source": "cms"
+             *     }
+             */
+            metadata?: {
+                [key: string]: string;
+            };
+        };
+        CreateWatermarkUploadUrlRequest: {
+            /**
+             * @description The name of the watermark.
+             * @example company-logo
+             */
+            name: string;
+            format: components["schemas"]["ImageFormat"];
         };
         ReprocessImagesAdminRequest: {
             /**
@@ -427,6 +1111,37 @@ export interface components {
              * @example 800
              */
             height?: number;
+            /**
+             * @description If true, variants are never rendered larger than the original
+             *     image. Target dimensions are scaled down to fit the original while
+             *     keeping their aspect ratio.
+             * @default false
+             * @example true
+             */
+            withoutEnlargement: boolean;
+            /**
+             * @description Pixel density multipliers of a variant set. A variant is rendered
+             *     for each density, scaling width and height. Requires width or
+             *     height, and cannot be used with widths.
+             * @example [
+             *       1,
+             *       2,
+             *       3
+             *     ]
+             */
+            densities?: number[];
+            /**
+             * @description Widths in pixels of a variant set. A variant is rendered for each
+             *     width, scaling height to keep the aspect ratio of the preset.
+             * @example [
+             *       320,
+             *       640,
+             *       1280
+             *     ]
+             */
+            widths?: number[];
+            encoder?: components["schemas"]["PresetEncoder"];
+            watermark?: components["schemas"]["PresetWatermark"];
         };
         /**
          * @description If id is provided, the preset will be updated; otherwise, a new preset
@@ -470,6 +1185,42 @@ export interface components {
              * @example 800
              */
             height?: number;
+            /**
+             * @description If true, variants are never rendered larger than the original
+             *     image. Target dimensions are scaled down to fit the original while
+             *     keeping their aspect ratio.
+             * @example true
+             */
+            withoutEnlargement?: boolean;
+            /**
+             * @description Pixel density multipliers of a variant set. A variant is rendered
+             *     for each density, scaling width and height. Requires width or
+             *     height, and cannot be used with widths.
+             * @example [
+             *       1,
+             *       2,
+             *       3
+             *     ]
+             */
+            densities?: number[];
+            /**
+             * @description Widths in pixels of a variant set. A variant is rendered for each
+             *     width, scaling height to keep the aspect ratio of the preset.
+             * @example [
+             *       320,
+             *       640,
+             *       1280
+             *     ]
+             */
+            widths?: number[];
+            encoder?: components["schemas"]["PresetEncoder"];
+            watermark?: components["schemas"]["PresetWatermark"];
+            /**
+             * @description If true, the preset stops applying its watermark. Cannot be used
+             *     with watermark.
+             * @example false
+             */
+            removeWatermark?: boolean;
         };
         AppError: {
             /**
@@ -507,11 +1258,40 @@ export interface components {
              * @example 2023-10-01T12:00:00Z
              */
             updatedAt: string;
+            /**
+             * Format: date-time
+             * @description The deletion time of the project. Absent unless deleted.
+             * @example 2023-10-01T12:00:00Z
+             */
+            deletedAt?: string;
             /**
              * @description The name of the project.
              * @example test-project
              */
             name: string;
+            visibility: components["schemas"]["ProjectVisibility"];
+            /**
+             * @description Template of object paths of images of the project. Supported
+             *     placeholders are {project}, {image}, {preset}, {variant}, {ext},
+             *     {yyyy}, {mm} and {dd}; {image}, {variant} and {ext} are required.
+             *     Objects are stored in the default layout if absent.
+             * @example {project}/{yyyy}/{mm}/{image}/{preset}-{variant}.{ext}
+             */
+            keyTemplate?: string;
+            /**
+             * @description Base URL of the CDN serving images of the project, without a
+             *     trailing slash. The default CDN is used if absent.
+             * @example https://images.example.com
+             */
+            cdnBaseUrl?: string;
+            /**
+             * @description Name of the storage profile objects of the project are stored in.
+             *     Absent for projects stored in the default profile before storage
+             *     profiles were introduced.
+             * @example default
+             */
+            storageProfile?: string;
+            retention: components["schemas"]["RetentionPolicy"];
             /** @description List of presets to apply to images of the project. */
             presets: components["schemas"]["Preset"][];
             /**
@@ -530,9 +1310,94 @@ export interface components {
              */
             total: number;
         };
-        Preset: {
+        ProjectMember: {
+            user: components["schemas"]["User"];
+            role: components["schemas"]["ProjectMemberRole"];
             /**
-             * @description The unique identifier of the preset.
+             * Format: date-time
+             * @description The time the user joined the project.
+             * @example 2023-10-01T12:00:00Z
+             */
+            createdAt: string;
+            /**
+             * Format: date-time
+             * @description The last update time of the membership.
+             * @example 2023-10-01T12:00:00Z
+             */
+            updatedAt: string;
+        };
+        ProjectMembers: {
+            items: components["schemas"]["ProjectMember"][];
+        };
+        /**
+         * @description The current state of the project deletion job.
+         * @example PENDING
+         * @enum {string}
+         */
+        ProjectDeletionState: "PENDING" | "RUNNING" | "SUCCEEDED" | "CANCELED";
+        ProjectDeletion: {
+            /**
+             * @description The unique identifier of the deletion job.
+             * @example 426e634f-50dd-41a0-881b-c991441b3cd5
+             */
+            id: string;
+            /**
+             * Format: date-time
+             * @description The creation time of the deletion job.
+             * @example 2023-10-01T12:00:00Z
+             */
+            createdAt: string;
+            /**
+             * Format: date-time
+             * @description The last update time of the deletion job.
+             * @example 2023-10-01T12:00:00Z
+             */
+            updatedAt: string;
+            /**
+             * @description The ID of the deleted project.
+             * @example 426e634f-50dd-41a0-881b-c991441b3cd5
+             */
+            projectId: string;
+            /**
+             * @description The name of the deleted project.
+             * @example test-project
+             */
+            projectName: string;
+            state: components["schemas"]["ProjectDeletionState"];
+            /**
+             * Format: date-time
+             * @description The time after which the job starts, unless canceled.
+             * @example 2023-10-08T12:00:00Z
+             */
+            startAfter: string;
+            /**
+             * Format: int64
+             * @description The number of images to purge, counted when the job starts.
+             * @example 42
+             */
+            totalImages: number;
+            /**
+             * Format: int64
+             * @description The number of images purged so far.
+             * @example 20
+             */
+            purgedImages: number;
+            /**
+             * @description The error of the last run, which is retried later. Absent unless
+             *     the last run failed.
+             * @example Failed to purge image
+             */
+            lastError?: string;
+            /**
+             * Format: date-time
+             * @description The time when the job succeeded or was canceled.
+             * @example 2023-10-08T12:05:00Z
+             */
+            finishedAt?: string;
+        };
+        Preset: {
+            /**
+             * @description The unique identifier of the preset.
              * @example 426e634f-50dd-41a0-881b-c991441b3cd5
              */
             id: string;
@@ -579,6 +1444,149 @@ export interface components {
              * @example 800
              */
             height?: number;
+            /**
+             * @description If true, variants are never rendered larger than the original
+             *     image. Target dimensions are scaled down to fit the original while
+             *     keeping their aspect ratio.
+             * @example true
+             */
+            withoutEnlargement: boolean;
+            /**
+             * @description Pixel density multipliers of a variant set. A variant is rendered
+             *     for each density, scaling width and height. Requires width or
+             *     height, and cannot be used with widths.
+             * @example [
+             *       1,
+             *       2,
+             *       3
+             *     ]
+             */
+            densities?: number[];
+            /**
+             * @description Widths in pixels of a variant set. A variant is rendered for each
+             *     width, scaling height to keep the aspect ratio of the preset.
+             * @example [
+             *       320,
+             *       640,
+             *       1280
+             *     ]
+             */
+            widths?: number[];
+            encoder?: components["schemas"]["PresetEncoder"];
+            watermark?: components["schemas"]["PresetWatermark"];
+        };
+        /** @description Encoder options of AVIF, HEIC and JXL outputs. Ignored by other formats. */
+        PresetEncoder: {
+            /**
+             * Format: int64
+             * @description CPU effort of the encoder (0-9). Higher values produce smaller files but take longer. Default depends on the format.
+             * @example 4
+             */
+            effort?: number;
+            /**
+             * @description Encodes images losslessly, ignoring quality.
+             * @default false
+             * @example false
+             */
+            lossless: boolean;
+            chromaSubsampling?: components["schemas"]["ChromaSubsampling"];
+        };
+        /** @description Watermark composited onto the image after resizing. The watermark is placed at the center of the image unless anchor is provided. */
+        PresetWatermark: {
+            /**
+             * @description The unique identifier of the watermark.
+             * @example 426e634f-50dd-41a0-881b-c991441b3cd5
+             */
+            watermarkId: string;
+            anchor?: components["schemas"]["ImageAnchor"];
+            /**
+             * Format: double
+             * @description The opacity of the watermark (0-1]. Default is 1.
+             * @example 0.5
+             */
+            opacity?: number;
+            /**
+             * Format: int64
+             * @description The margin from the anchored edges in pixels. Default is 0.
+             * @example 16
+             */
+            margin?: number;
+            /**
+             * Format: double
+             * @description The width of the watermark relative to the width of the image (0-1]. Default is 0.2.
+             * @example 0.2
+             */
+            scale?: number;
+        };
+        Watermark: {
+            /**
+             * @description The unique identifier of the watermark.
+             * @example 426e634f-50dd-41a0-881b-c991441b3cd5
+             */
+            id: string;
+            /**
+             * Format: date-time
+             * @description The creation time of the watermark.
+             * @example 2023-10-01T12:00:00Z
+             */
+            createdAt: string;
+            /**
+             * Format: date-time
+             * @description The last update time of the watermark.
+             * @example 2023-10-01T12:00:00Z
+             */
+            updatedAt: string;
+            /**
+             * @description The name of the watermark.
+             * @example company-logo
+             */
+            name: string;
+            format: components["schemas"]["ImageFormat"];
+            /**
+             * @description The URL of the watermark.
+             * @example https://example.com/watermarks/company-logo.png
+             */
+            url: string;
+            state: components["schemas"]["WatermarkState"];
+        };
+        /**
+         * @description The upload state of the watermark. Presets can reference only ready
+         *     watermarks.
+         * @example READY
+         * @enum {string}
+         */
+        WatermarkState: "UPLOAD_PENDING" | "READY";
+        Watermarks: {
+            items: components["schemas"]["Watermark"][];
+            /**
+             * Format: int64
+             * @description The total number of watermarks.
+             * @example 100
+             */
+            total: number;
+        };
+        WatermarkUploadUrl: {
+            watermark: components["schemas"]["Watermark"];
+            /**
+             * @description The presigned URL for uploading the watermark. It must be called with PUT method.
+             * @example https://aws.com/upload?key=abc123
+             */
+            url: string;
+            /**
+             * @description Additional headers required for the upload request.
+             * @example {
+             *       "Host": "foo.s3.amazonaws.com"
+             *     }
+             */
+            header: {
+                [key: string]: string;
+            };
+            /**
+             * Format: date-time
+             * @description The expiration time of the presigned URL.
+             * @example 2023-10-01T12:00:00Z
+             */
+            expiresAt: string;
         };
         UploadUrl: {
             /**
@@ -625,15 +1633,130 @@ export interface components {
              * @example 2023-10-01T12:00:00Z
              */
             updatedAt: string;
+            /**
+             * Format: date-time
+             * @description The deletion time of the image. Absent unless deleted.
+             * @example 2023-10-01T12:00:00Z
+             */
+            deletedAt?: string;
             state: components["schemas"]["ImageState"];
             /**
-             * @description The URL of the original image.
+             * @description The URL of the original image. URLs of the image and its variants
+             *     are presigned if the image belongs to a private project. Absent if
+             *     the original is deleted by the retention policy of the project.
              * @example https://example.com/original/image.webp
              */
-            url: string;
+            url?: string;
+            originalState: components["schemas"]["ImageOriginalState"];
+            /**
+             * Format: date-time
+             * @description The expiry time of presigned URLs. Absent if the image belongs to a
+             *     public project.
+             * @example 2023-10-01T12:15:00Z
+             */
+            urlExpireAt?: string;
             format: components["schemas"]["ImageFormat"];
+            focus?: components["schemas"]["ImageFocus"];
+            /**
+             * @description Ready-made srcset attribute values of ready variants, keyed by
+             *     preset name.
+             * @example {
+             *       "thumb": "https://example.com/a.webp 1x, https://example.com/b.webp 2x"
+             *     }
+             */
+            srcsets?: {
+                [key: string]: string;
+            };
             /** @description List of image variants with applied presets. */
             variants?: components["schemas"]["ImageVariant"][];
+            /**
+             * @description ID of the image in the client system. Absent if not set.
+             * @example cms-article-42
+             */
+            externalId?: string;
+            /** @description Tags of the image. */
+            tags?: string[];
+            /**
+             * @description Arbitrary key/value metadata of the image.
+             * @example {
+             *       "source": "cms"
+             *     }
+             */
+            metadata?: {
+                [key: string]: string;
+            };
+        };
+        /**
+         * @description Absent fields are left unchanged. An empty externalId clears the
+         *     external ID, and empty tags or metadata clear them.
+         */
+        UpdateImageRequest: {
+            /**
+             * @description ID of the image in the client system.
+             * @example cms-article-42
+             */
+            externalId?: string;
+            /** @description Tags replacing the ones of the image. */
+            tags?: string[];
+            /**
+             * @description Metadata replacing the one of the image.
+             * @example {
+             *       "source": "cms"
+             *     }
+             */
+            metadata?: {
+                [key: string]: string;
+            };
+        };
+        /**
+         * @description Region of interest of an image. At most one of focalPoint and cropBox
+         *     can be set.
+         */
+        ImageFocus: {
+            focalPoint?: components["schemas"]["FocalPoint"];
+            cropBox?: components["schemas"]["CropBox"];
+        };
+        /** @description Point of interest as fractions of the image width and height. */
+        FocalPoint: {
+            /**
+             * Format: double
+             * @description Horizontal position from the left edge.
+             * @example 0.5
+             */
+            x: number;
+            /**
+             * Format: double
+             * @description Vertical position from the top edge.
+             * @example 0.3
+             */
+            y: number;
+        };
+        /** @description Rectangle to keep as fractions of the image width and height. */
+        CropBox: {
+            /**
+             * Format: double
+             * @description Left edge of the rectangle.
+             * @example 0.1
+             */
+            x: number;
+            /**
+             * Format: double
+             * @description Top edge of the rectangle.
+             * @example 0.2
+             */
+            y: number;
+            /**
+             * Format: double
+             * @description Width of the rectangle.
+             * @example 0.5
+             */
+            width: number;
+            /**
+             * Format: double
+             * @description Height of the rectangle.
+             * @example 0.5
+             */
+            height: number;
         };
         Images: {
             items: components["schemas"]["Image"][];
@@ -679,6 +1802,13 @@ export interface components {
              */
             url: string;
             format: components["schemas"]["ImageFormat"];
+            /**
+             * @description The srcset descriptor of the variant within the variant set of its
+             *     preset, such as 2x or 640w. Omitted if the preset has no variant
+             *     set.
+             * @example 2x
+             */
+            descriptor?: string;
         };
         ServiceAccount: {
             /**
@@ -710,92 +1840,268 @@ export interface components {
              */
             name: string;
             accessScope: components["schemas"]["ServiceAccountAccessScope"];
+            /** @description Permissions granted on resources in the access scope. */
+            permissions: components["schemas"]["ServiceAccountPermission"][];
             /** @description List of projects associated with the service account. */
             projects: components["schemas"]["ProjectReference"][];
-        };
-        ServiceAccountWithApiKey: components["schemas"]["ServiceAccount"] & {
             /**
-             * @description The API key for the service account.
-             * @example ak_SOEXTZL3Ww7BXyqMWdD5QpPfyPF5nXTy14PkpV9X6vySOzmQdpjHYXK26vbhDgDj
+             * Format: date-time
+             * @description The last authentication time by any credential of the service
+             *     account, which is recorded with a delay of up to a minute.
+             * @example 2023-10-01T12:00:00Z
              */
-            apiKey: string;
-        };
-        ServiceAccounts: {
-            items: components["schemas"]["ServiceAccount"][];
+            lastUsedAt?: string;
             /**
-             * Format: int64
-             * @description The total number of service accounts.
-             * @example 100
+             * @description The remote IP address of the last authentication.
+             * @example 203.0.113.7
              */
-            total: number;
+            lastUsedIp?: string;
+            /**
+             * Format: date-time
+             * @description The time the service account was warned to be disabled for not
+             *     being used. It is cleared once the service account is used again.
+             * @example 2023-10-01T12:00:00Z
+             */
+            staleWarnedAt?: string;
+            /**
+             * Format: date-time
+             * @description The time the service account was disabled. Disabled service accounts
+             *     fail to authenticate until enabled again.
+             * @example 2023-10-01T12:00:00Z
+             */
+            disabledAt?: string;
+            disabledReason?: components["schemas"]["ServiceAccountDisabledReason"];
         };
-        ProjectReference: {
+        ServiceAccountWithApiKey: components["schemas"]["ServiceAccount"] & {
             /**
-             * @description The unique identifier of the project.
+             * @description The unique identifier of the API key.
              * @example 426e634f-50dd-41a0-881b-c991441b3cd5
              */
-            id: string;
+            apiKeyId: string;
             /**
-             * @description The name of the project.
-             * @example test-project
+             * @description The API key for the service account, which is not retrievable
+             *     afterwards.
+             * @example ak_SOEXTZL3Ww7BXyqMWdD5QpPfyPF5nXTy14PkpV9X6vySOzmQdpjHYXK26vbhDgDj
              */
-            name: string;
+            apiKey: string;
         };
-        User: {
+        ServiceAccountApiKey: {
             /**
-             * @description The unique identifier of the user.
+             * @description The unique identifier of the API key.
              * @example 426e634f-50dd-41a0-881b-c991441b3cd5
              */
             id: string;
             /**
-             * @description The nickname of the user.
-             * @example johndoe
+             * Format: date-time
+             * @description The creation time of the API key.
+             * @example 2023-10-01T12:00:00Z
              */
-            nickname: string;
-            role: components["schemas"]["UserRole"];
+            createdAt: string;
             /**
-             * @description The email address of the user.
-             * @example user@example.com
+             * @description The name of the API key.
+             * @example default
              */
-            email: string;
+            name: string;
             /**
-             * Format: uri
-             * @description The photo URL of the user.
-             * @example https://example.com/photos/user.webp
+             * @description The leading characters of the API key identifying it.
+             * @example ak_SOEXTZL3
              */
-            photoUrl: string;
+            prefix: string;
             /**
              * Format: date-time
-             * @description The creation time of the user.
+             * @description The last time the API key was used, recorded at a resolution of
+             *     minutes. Omitted if the API key was never used.
              * @example 2023-10-01T12:00:00Z
              */
-            createdAt: string;
+            lastUsedAt?: string;
             /**
              * Format: date-time
-             * @description The last update time of the user.
+             * @description The expiration time of the API key.
              * @example 2023-10-01T12:00:00Z
              */
-            updatedAt: string;
+            expireAt?: string;
         };
-    };
-    responses: {
-        /** @description Error occurred during the request */
-        ErrorResponse: {
-            headers: {
-                [name: string]: unknown;
-            };
-            content: {
-                "application/json": components["schemas"]["AppError"];
-            };
+        ServiceAccountApiKeys: {
+            items: components["schemas"]["ServiceAccountApiKey"][];
         };
-    };
-    parameters: {
-        /** @description The ID of the project to which the image belongs. */
-        ProjectIdPath: string;
-        /** @description The ID of the service account. */
-        ServiceAccountIdPath: string;
-        /** @description The ID of the image. */
+        AuditChange: {
+            /**
+             * @description The name of the changed field of the target.
+             * @example Name
+             */
+            field: string;
+            /** @description The JSON value before the change, absent if it was unset. */
+            before?: unknown;
+            /** @description The JSON value after the change, absent if it is unset. */
+            after?: unknown;
+        };
+        AuditEntry: {
+            /**
+             * @description The unique identifier of the audit entry.
+             * @example 426e634f-50dd-41a0-881b-c991441b3cd5
+             */
+            id: string;
+            /**
+             * Format: date-time
+             * @description The time when the change was made.
+             * @example 2023-10-01T12:00:00Z
+             */
+            createdAt: string;
+            actorType: components["schemas"]["AuditActorType"];
+            /**
+             * @description The ID of the user or service account, absent for the system.
+             * @example 426e634f-50dd-41a0-881b-c991441b3cd5
+             */
+            actorId?: string;
+            /**
+             * @description The email of the user or the name of the service account.
+             * @example john@example.com
+             */
+            actorName?: string;
+            action: components["schemas"]["AuditAction"];
+            targetType: components["schemas"]["AuditTargetType"];
+            /**
+             * @description The ID of the changed resource. Project members are identified by
+             *     the project ID and the user ID joined with a slash.
+             * @example 426e634f-50dd-41a0-881b-c991441b3cd5
+             */
+            targetId: string;
+            /**
+             * @description The ID of the request making the change.
+             * @example 4bf92f3577b34da6a3ce929d0e0e4736
+             */
+            requestId?: string;
+            /**
+             * @description The IP address of the client making the change.
+             * @example 203.0.113.7
+             */
+            remoteIp?: string;
+            /** @description Fields of the target changed by the action. */
+            changes: components["schemas"]["AuditChange"][];
+        };
+        AuditEntries: {
+            items: components["schemas"]["AuditEntry"][];
+            /**
+             * Format: int64
+             * @description The total number of audit entries.
+             * @example 100
+             */
+            total: number;
+        };
+        ServiceAccounts: {
+            items: components["schemas"]["ServiceAccount"][];
+            /**
+             * Format: int64
+             * @description The total number of service accounts.
+             * @example 100
+             */
+            total: number;
+        };
+        ProjectReference: {
+            /**
+             * @description The unique identifier of the project.
+             * @example 426e634f-50dd-41a0-881b-c991441b3cd5
+             */
+            id: string;
+            /**
+             * @description The name of the project.
+             * @example test-project
+             */
+            name: string;
+        };
+        AuthProvider: {
+            /**
+             * @description The name of the OIDC provider used in sign-in paths.
+             * @example google
+             */
+            name: string;
+            /**
+             * @description The human-readable name of the OIDC provider.
+             * @example Google
+             */
+            displayName: string;
+        };
+        AuthProviders: {
+            items: components["schemas"]["AuthProvider"][];
+        };
+        User: {
+            /**
+             * @description The unique identifier of the user.
+             * @example 426e634f-50dd-41a0-881b-c991441b3cd5
+             */
+            id: string;
+            /**
+             * @description The nickname of the user.
+             * @example johndoe
+             */
+            nickname: string;
+            role: components["schemas"]["UserRole"];
+            /**
+             * @description The email address of the user.
+             * @example user@example.com
+             */
+            email: string;
+            /**
+             * Format: uri
+             * @description The photo URL of the user.
+             * @example https://example.com/photos/user.webp
+             */
+            photoUrl: string;
+            /**
+             * Format: date-time
+             * @description The creation time of the user.
+             * @example 2023-10-01T12:00:00Z
+             */
+            createdAt: string;
+            /**
+             * Format: date-time
+             * @description The last update time of the user.
+             * @example 2023-10-01T12:00:00Z
+             */
+            updatedAt: string;
+            /**
+             * Format: date-time
+             * @description The time the user was suspended. Absent unless suspended.
+             * @example 2023-10-01T12:00:00Z
+             */
+            suspendedAt?: string;
+        };
+        Users: {
+            items: components["schemas"]["User"][];
+            /**
+             * Format: int64
+             * @description The total number of users.
+             * @example 100
+             */
+            total: number;
+        };
+    };
+    responses: {
+        /** @description Error occurred during the request */
+        ErrorResponse: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/json": components["schemas"]["AppError"];
+            };
+        };
+    };
+    parameters: {
+        /** @description The name of the OIDC provider. */
+        OIDCProviderPath: string;
+        /** @description The ID of the project to which the image belongs. */
+        ProjectIdPath: string;
+        /** @description The ID of the service account. */
+        ServiceAccountIdPath: string;
+        /** @description The ID of the API key. */
+        ApiKeyIdPath: string;
+        /** @description The ID of the image. */
         ImageIdPath: string;
+        /** @description The ID of the watermark. */
+        WatermarkIdPath: string;
+        /** @description The ID of the user. */
+        UserIdPath: string;
         /** @description Offset for pagination */
         OffsetQuery: number;
         /** @description Limit for pagination */
@@ -804,6 +2110,41 @@ export interface components {
         SortByQuery: "createdAt" | "updatedAt";
         /** @description Sort direction */
         SortOrderQuery: components["schemas"]["SortDirection"];
+        /** @description List only images with the external ID */
+        ExternalIdQuery: string;
+        /** @description List only images having all of the tags */
+        TagQuery: string[];
+        /**
+         * @description List only images having all of the metadata entries, given as
+         *     metadata[key]=value
+         */
+        MetadataQuery: {
+            [key: string]: string;
+        };
+        /** @description List only images whose file names start with the prefix */
+        FileNamePrefixQuery: string;
+        /** @description List only images of the format */
+        FormatQuery: components["schemas"]["ImageFormat"];
+        /** @description List only resources created at or after the time */
+        CreatedAfterQuery: string;
+        /** @description List only resources created before the time */
+        CreatedBeforeQuery: string;
+        /** @description List only soft-deleted resources waiting for purge */
+        DeletedQuery: boolean;
+        /** @description List only audit entries of the user or service account */
+        ActorIdQuery: string;
+        /** @description List only audit entries of the action */
+        AuditActionQuery: components["schemas"]["AuditAction"];
+        /** @description List only audit entries of the target type */
+        AuditTargetTypeQuery: components["schemas"]["AuditTargetType"];
+        /** @description List only audit entries of the target */
+        TargetIdQuery: string;
+        /** @description Match users whose nicknames or emails contain the keyword, ignoring case */
+        KeywordQuery: string;
+        /** @description Match users of the role */
+        UserRoleQuery: components["schemas"]["UserRole"];
+        /** @description Match suspended users if true, and active users if false */
+        SuspendedQuery: boolean;
         /** @description Wait until the image processing is completed */
         WaitUntilProcessedQuery: boolean;
         /** @description The path to redirect to after successful sign-in. Defaults to root path if not provided. */
@@ -822,6 +2163,8 @@ export interface operations {
                 offset?: components["parameters"]["OffsetQuery"];
                 /** @description Limit for pagination */
                 limit?: components["parameters"]["LimitQuery"];
+                /** @description List only soft-deleted resources waiting for purge */
+                deleted?: components["parameters"]["DeletedQuery"];
             };
             header?: never;
             path?: never;
@@ -854,71 +2197,812 @@ export interface operations {
             };
         };
         responses: {
-            /** @description Successfully created project */
-            200: {
+            /** @description Successfully created project */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Project"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    getProjectAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved project details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Project"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    updateProjectAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": components["schemas"]["UpdateProjectAdminRequest"];
+            };
+        };
+        responses: {
+            /** @description Successfully updated project details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Project"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    deleteProjectAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully scheduled project deletion */
+            202: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectDeletion"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    getProjectDeletionAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved project deletion */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectDeletion"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    restoreProjectAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully restored project */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Project"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    reprocessImagesAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": components["schemas"]["ReprocessImagesAdminRequest"];
+            };
+        };
+        responses: {
+            /** @description Successfully triggered batch image reprocessing */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Images"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    listImagesAdmin: {
+        parameters: {
+            query?: {
+                /** @description Offset for pagination */
+                offset?: components["parameters"]["OffsetQuery"];
+                /** @description Limit for pagination */
+                limit?: components["parameters"]["LimitQuery"];
+                /** @description Field to sort by */
+                sortBy?: components["parameters"]["SortByQuery"];
+                /** @description Sort direction */
+                sortOrder?: components["parameters"]["SortOrderQuery"];
+                /** @description List only soft-deleted resources waiting for purge */
+                deleted?: components["parameters"]["DeletedQuery"];
+                /** @description List only images with the external ID */
+                externalId?: components["parameters"]["ExternalIdQuery"];
+                /** @description List only images having all of the tags */
+                tag?: components["parameters"]["TagQuery"];
+                /**
+                 * @description List only images having all of the metadata entries, given as
+                 *     metadata[key]=value
+                 */
+                metadata?: components["parameters"]["MetadataQuery"];
+                /** @description List only images whose file names start with the prefix */
+                fileNamePrefix?: components["parameters"]["FileNamePrefixQuery"];
+                /** @description List only images of the format */
+                format?: components["parameters"]["FormatQuery"];
+                /** @description List only resources created at or after the time */
+                createdAfter?: components["parameters"]["CreatedAfterQuery"];
+                /** @description List only resources created before the time */
+                createdBefore?: components["parameters"]["CreatedBeforeQuery"];
+            };
+            header?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved images */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Images"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    deleteImageAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the image. */
+                imageId: components["parameters"]["ImageIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully deleted image */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    restoreImageAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the image. */
+                imageId: components["parameters"]["ImageIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully restored image */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Image"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    listServiceAccountsAdmin: {
+        parameters: {
+            query?: {
+                /** @description Offset for pagination */
+                offset?: components["parameters"]["OffsetQuery"];
+                /** @description Limit for pagination */
+                limit?: components["parameters"]["LimitQuery"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved service accounts */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ServiceAccounts"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    createServiceAccountAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": components["schemas"]["CreateServiceAccountAdminRequest"];
+            };
+        };
+        responses: {
+            /** @description Successfully created service account */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ServiceAccountWithApiKey"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    getServiceAccountAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the service account. */
+                serviceAccountId: components["parameters"]["ServiceAccountIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved service account details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ServiceAccount"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    updateServiceAccountAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the service account. */
+                serviceAccountId: components["parameters"]["ServiceAccountIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": components["schemas"]["UpdateServiceAccountAdminRequest"];
+            };
+        };
+        responses: {
+            /** @description Successfully updated service account */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ServiceAccount"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    deleteServiceAccountAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the service account. */
+                serviceAccountId: components["parameters"]["ServiceAccountIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully deleted service account */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    listServiceAccountApiKeysAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the service account. */
+                serviceAccountId: components["parameters"]["ServiceAccountIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved API keys */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ServiceAccountApiKeys"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    createServiceAccountApiKeyAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the service account. */
+                serviceAccountId: components["parameters"]["ServiceAccountIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": components["schemas"]["CreateServiceAccountApiKeyAdminRequest"];
+            };
+        };
+        responses: {
+            /** @description Successfully minted API key */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ServiceAccountWithApiKey"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    revokeServiceAccountApiKeyAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the service account. */
+                serviceAccountId: components["parameters"]["ServiceAccountIdPath"];
+                /** @description The ID of the API key. */
+                apiKeyId: components["parameters"]["ApiKeyIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully revoked API key */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    listUsersAdmin: {
+        parameters: {
+            query?: {
+                /** @description Offset for pagination */
+                offset?: components["parameters"]["OffsetQuery"];
+                /** @description Limit for pagination */
+                limit?: components["parameters"]["LimitQuery"];
+                /** @description Match users whose nicknames or emails contain the keyword, ignoring case */
+                keyword?: components["parameters"]["KeywordQuery"];
+                /** @description Match users of the role */
+                role?: components["parameters"]["UserRoleQuery"];
+                /** @description Match suspended users if true, and active users if false */
+                suspended?: components["parameters"]["SuspendedQuery"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved users */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Users"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    getUserAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the user. */
+                userId: components["parameters"]["UserIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved user details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["User"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    updateUserAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the user. */
+                userId: components["parameters"]["UserIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": components["schemas"]["UpdateUserAdminRequest"];
+            };
+        };
+        responses: {
+            /** @description Successfully updated user */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["User"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    suspendUserAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the user. */
+                userId: components["parameters"]["UserIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully suspended user */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["User"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    revokeUserSessionsAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the user. */
+                userId: components["parameters"]["UserIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully revoked sessions */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    unsuspendUserAdmin: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description The ID of the user. */
+                userId: components["parameters"]["UserIdPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully unsuspended user */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["User"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    listAuditLogsAdmin: {
+        parameters: {
+            query?: {
+                /** @description Offset for pagination */
+                offset?: components["parameters"]["OffsetQuery"];
+                /** @description Limit for pagination */
+                limit?: components["parameters"]["LimitQuery"];
+                /** @description List only audit entries of the user or service account */
+                actorId?: components["parameters"]["ActorIdQuery"];
+                /** @description List only audit entries of the action */
+                action?: components["parameters"]["AuditActionQuery"];
+                /** @description List only audit entries of the target type */
+                targetType?: components["parameters"]["AuditTargetTypeQuery"];
+                /** @description List only audit entries of the target */
+                targetId?: components["parameters"]["TargetIdQuery"];
+                /** @description List only resources created at or after the time */
+                createdAfter?: components["parameters"]["CreatedAfterQuery"];
+                /** @description List only resources created before the time */
+                createdBefore?: components["parameters"]["CreatedBeforeQuery"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved audit log entries */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["AuditEntries"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    getCurrentUser: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved current user details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["User"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    listAuthProviders: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully retrieved OIDC providers */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["AuthProviders"];
+                };
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    startSignIn: {
+        parameters: {
+            query?: {
+                /** @description The path to redirect to after successful sign-in. Defaults to root path if not provided. */
+                redirect?: components["parameters"]["RedirectQuery"];
+            };
+            header?: never;
+            path: {
+                /** @description The name of the OIDC provider. */
+                provider: components["parameters"]["OIDCProviderPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Redirecting to the OIDC provider */
+            302: {
+                headers: {
+                    /** @description The URL to redirect the user to for sign-in */
+                    Location?: string;
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            default: components["responses"]["ErrorResponse"];
+        };
+    };
+    finishSignIn: {
+        parameters: {
+            query: {
+                /** @description The authorization code returned by the OIDC provider after sign-in. */
+                code: string;
+                /** @description The state parameter to prevent CSRF attacks. */
+                state: string;
+            };
+            header?: never;
+            path: {
+                /** @description The name of the OIDC provider. */
+                provider: components["parameters"]["OIDCProviderPath"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successfully signed in with the OIDC provider */
+            302: {
                 headers: {
+                    /** @description The URL to redirect the user to after signing in */
+                    Location?: string;
                     [name: string]: unknown;
                 };
-                content: {
-                    "application/json": components["schemas"]["Project"];
-                };
+                content?: never;
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    getProjectAdmin: {
+    signOut: {
         parameters: {
-            query?: never;
-            header?: never;
-            path: {
-                /** @description The ID of the project to which the image belongs. */
-                projectId: components["parameters"]["ProjectIdPath"];
+            query?: {
+                /** @description Whether to revoke all sessions of the user, signing out everywhere. */
+                all?: boolean;
             };
+            header?: never;
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully retrieved project details */
+            /** @description Successfully signed out */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content: {
-                    "application/json": components["schemas"]["Project"];
-                };
+                content?: never;
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    updateProjectAdmin: {
+    listProjects: {
         parameters: {
-            query?: never;
-            header?: never;
-            path: {
-                /** @description The ID of the project to which the image belongs. */
-                projectId: components["parameters"]["ProjectIdPath"];
+            query?: {
+                /** @description Offset for pagination */
+                offset?: components["parameters"]["OffsetQuery"];
+                /** @description Limit for pagination */
+                limit?: components["parameters"]["LimitQuery"];
             };
+            header?: never;
+            path?: never;
             cookie?: never;
         };
-        requestBody?: {
-            content: {
-                "application/json": components["schemas"]["UpdateProjectAdminRequest"];
-            };
-        };
+        requestBody?: never;
         responses: {
-            /** @description Successfully updated project details */
+            /** @description Successfully retrieved projects */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Project"];
+                    "application/json": components["schemas"]["Projects"];
                 };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    deleteProjectAdmin: {
+    getProject: {
         parameters: {
             query?: never;
             header?: never;
@@ -930,17 +3014,19 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully deleted project */
+            /** @description Successfully retrieved project details */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["Project"];
+                };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    reprocessImagesAdmin: {
+    createUploadUrl: {
         parameters: {
             query?: never;
             header?: never;
@@ -952,23 +3038,23 @@ export interface operations {
         };
         requestBody?: {
             content: {
-                "application/json": components["schemas"]["ReprocessImagesAdminRequest"];
+                "application/json": components["schemas"]["CreateUploadUrlRequest"];
             };
         };
         responses: {
-            /** @description Successfully triggered batch image reprocessing */
+            /** @description Successfully issued the presigned URL */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Images"];
+                    "application/json": components["schemas"]["UploadUrl"];
                 };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    listImagesAdmin: {
+    listImages: {
         parameters: {
             query?: {
                 /** @description Offset for pagination */
@@ -979,6 +3065,23 @@ export interface operations {
                 sortBy?: components["parameters"]["SortByQuery"];
                 /** @description Sort direction */
                 sortOrder?: components["parameters"]["SortOrderQuery"];
+                /** @description List only images with the external ID */
+                externalId?: components["parameters"]["ExternalIdQuery"];
+                /** @description List only images having all of the tags */
+                tag?: components["parameters"]["TagQuery"];
+                /**
+                 * @description List only images having all of the metadata entries, given as
+                 *     metadata[key]=value
+                 */
+                metadata?: components["parameters"]["MetadataQuery"];
+                /** @description List only images whose file names start with the prefix */
+                fileNamePrefix?: components["parameters"]["FileNamePrefixQuery"];
+                /** @description List only images of the format */
+                format?: components["parameters"]["FormatQuery"];
+                /** @description List only resources created at or after the time */
+                createdAfter?: components["parameters"]["CreatedAfterQuery"];
+                /** @description List only resources created before the time */
+                createdBefore?: components["parameters"]["CreatedBeforeQuery"];
             };
             header?: never;
             path: {
@@ -1001,9 +3104,12 @@ export interface operations {
             default: components["responses"]["ErrorResponse"];
         };
     };
-    deleteImageAdmin: {
+    getImage: {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description Wait until the image processing is completed */
+                waitUntilProcessed?: components["parameters"]["WaitUntilProcessedQuery"];
+            };
             header?: never;
             path: {
                 /** @description The ID of the project to which the image belongs. */
@@ -1015,222 +3121,252 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully deleted image */
+            /** @description Successfully retrieved image details */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["Image"];
+                };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    listServiceAccountsAdmin: {
+    deleteImage: {
         parameters: {
-            query?: {
-                /** @description Offset for pagination */
-                offset?: components["parameters"]["OffsetQuery"];
-                /** @description Limit for pagination */
-                limit?: components["parameters"]["LimitQuery"];
-            };
+            query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the image. */
+                imageId: components["parameters"]["ImageIdPath"];
+            };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully retrieved service accounts */
+            /** @description Successfully deleted image */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content: {
-                    "application/json": components["schemas"]["ServiceAccounts"];
-                };
+                content?: never;
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    createServiceAccountAdmin: {
+    updateImage: {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the image. */
+                imageId: components["parameters"]["ImageIdPath"];
+            };
             cookie?: never;
         };
         requestBody?: {
             content: {
-                "application/json": components["schemas"]["CreateServiceAccountAdminRequest"];
+                "application/json": components["schemas"]["UpdateImageRequest"];
             };
         };
         responses: {
-            /** @description Successfully created service account */
+            /** @description Successfully updated image */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ServiceAccountWithApiKey"];
+                    "application/json": components["schemas"]["Image"];
                 };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    getServiceAccountAdmin: {
+    restoreImage: {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description The ID of the service account. */
-                serviceAccountId: components["parameters"]["ServiceAccountIdPath"];
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the image. */
+                imageId: components["parameters"]["ImageIdPath"];
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully retrieved service account details */
+            /** @description Successfully restored image */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ServiceAccount"];
+                    "application/json": components["schemas"]["Image"];
                 };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    updateServiceAccountAdmin: {
+    updateImageFocus: {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description The ID of the service account. */
-                serviceAccountId: components["parameters"]["ServiceAccountIdPath"];
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the image. */
+                imageId: components["parameters"]["ImageIdPath"];
             };
             cookie?: never;
         };
         requestBody?: {
             content: {
-                "application/json": components["schemas"]["UpdateServiceAccountAdminRequest"];
+                "application/json": components["schemas"]["ImageFocus"];
             };
         };
         responses: {
-            /** @description Successfully updated service account */
+            /** @description Successfully updated image focus */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ServiceAccount"];
+                    "application/json": components["schemas"]["Image"];
                 };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    deleteServiceAccountAdmin: {
+    completeUpload: {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description The ID of the service account. */
-                serviceAccountId: components["parameters"]["ServiceAccountIdPath"];
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the image. */
+                imageId: components["parameters"]["ImageIdPath"];
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully deleted service account */
+            /** @description Successfully completed image upload */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["Image"];
+                };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    getCurrentUser: {
+    createWatermarkUploadUrl: {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+            };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody?: {
+            content: {
+                "application/json": components["schemas"]["CreateWatermarkUploadUrlRequest"];
+            };
+        };
         responses: {
-            /** @description Successfully retrieved current user details */
+            /** @description Successfully issued the presigned URL */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["User"];
+                    "application/json": components["schemas"]["WatermarkUploadUrl"];
                 };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    startGoogleSignIn: {
+    completeWatermarkUpload: {
         parameters: {
-            query?: {
-                /** @description The path to redirect to after successful sign-in. Defaults to root path if not provided. */
-                redirect?: components["parameters"]["RedirectQuery"];
-            };
+            query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the watermark. */
+                watermarkId: components["parameters"]["WatermarkIdPath"];
+            };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Redirecting to Google Sign-In */
-            302: {
+            /** @description Successfully completed watermark upload */
+            200: {
                 headers: {
-                    /** @description The URL to redirect the user to for Google Sign-In */
-                    Location?: string;
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["Watermark"];
+                };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    finishGoogleSignIn: {
+    listWatermarks: {
         parameters: {
-            query: {
-                /** @description The authorization code returned by Google after sign-in. */
-                code: string;
-                /** @description The state parameter to prevent CSRF attacks. */
-                state: string;
+            query?: {
+                /** @description Offset for pagination */
+                offset?: components["parameters"]["OffsetQuery"];
+                /** @description Limit for pagination */
+                limit?: components["parameters"]["LimitQuery"];
             };
             header?: never;
-            path?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+            };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully signed in with Google */
-            302: {
+            /** @description Successfully retrieved watermarks */
+            200: {
                 headers: {
-                    /** @description The URL to redirect the user to after signing in */
-                    Location?: string;
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["Watermarks"];
+                };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    signOut: {
+    deleteWatermark: {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @description The ID of the project to which the image belongs. */
+                projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the watermark. */
+                watermarkId: components["parameters"]["WatermarkIdPath"];
+            };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully signed out */
+            /** @description Successfully deleted watermark */
             200: {
                 headers: {
                     [name: string]: unknown;
@@ -1240,7 +3376,7 @@ export interface operations {
             default: components["responses"]["ErrorResponse"];
         };
     };
-    getProject: {
+    listProjectMembers: {
         parameters: {
             query?: never;
             header?: never;
@@ -1252,19 +3388,19 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully retrieved project details */
+            /** @description Successfully retrieved project members */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Project"];
+                    "application/json": components["schemas"]["ProjectMembers"];
                 };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    createUploadUrl: {
+    addProjectMember: {
         parameters: {
             query?: never;
             header?: never;
@@ -1276,100 +3412,122 @@ export interface operations {
         };
         requestBody?: {
             content: {
-                "application/json": components["schemas"]["CreateUploadUrlRequest"];
+                "application/json": components["schemas"]["AddProjectMemberRequest"];
             };
         };
         responses: {
-            /** @description Successfully issued the presigned URL */
+            /** @description Successfully added project member */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UploadUrl"];
+                    "application/json": components["schemas"]["ProjectMember"];
                 };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    listImages: {
+    updateProjectMember: {
         parameters: {
-            query?: {
-                /** @description Offset for pagination */
-                offset?: components["parameters"]["OffsetQuery"];
-                /** @description Limit for pagination */
-                limit?: components["parameters"]["LimitQuery"];
-                /** @description Field to sort by */
-                sortBy?: components["parameters"]["SortByQuery"];
-                /** @description Sort direction */
-                sortOrder?: components["parameters"]["SortOrderQuery"];
-            };
+            query?: never;
             header?: never;
             path: {
                 /** @description The ID of the project to which the image belongs. */
                 projectId: components["parameters"]["ProjectIdPath"];
+                /** @description The ID of the user. */
+                userId: components["parameters"]["UserIdPath"];
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody?: {
+            content: {
+                "application/json": components["schemas"]["UpdateProjectMemberRequest"];
+            };
+        };
         responses: {
-            /** @description Successfully retrieved images */
+            /** @description Successfully updated project member */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Images"];
+                    "application/json": components["schemas"]["ProjectMember"];
                 };
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    getImage: {
+    removeProjectMember: {
         parameters: {
-            query?: {
-                /** @description Wait until the image processing is completed */
-                waitUntilProcessed?: components["parameters"]["WaitUntilProcessedQuery"];
-            };
+            query?: never;
             header?: never;
             path: {
                 /** @description The ID of the project to which the image belongs. */
                 projectId: components["parameters"]["ProjectIdPath"];
-                /** @description The ID of the image. */
-                imageId: components["parameters"]["ImageIdPath"];
+                /** @description The ID of the user. */
+                userId: components["parameters"]["UserIdPath"];
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully retrieved image details */
+            /** @description Successfully removed project member */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content: {
-                    "application/json": components["schemas"]["Image"];
-                };
+                content?: never;
             };
             default: components["responses"]["ErrorResponse"];
         };
     };
-    deleteImage: {
+    deliverImage: {
         parameters: {
             query?: never;
-            header?: never;
+            header?: {
+                /** @description Media types the client can display. */
+                Accept?: string;
+                /** @description Device pixel ratio of the client. */
+                DPR?: number;
+                /** @description Intended display width of the image in physical pixels. */
+                Width?: number;
+                /** @description Device pixel ratio of the client. Precedes DPR. */
+                "Sec-CH-DPR"?: number;
+                /** @description Intended display width of the image in physical pixels. Precedes Width. */
+                "Sec-CH-Width"?: number;
+                /** @description Entity tag of the image cached by the client. */
+                "If-None-Match"?: string;
+            };
             path: {
                 /** @description The ID of the project to which the image belongs. */
                 projectId: components["parameters"]["ProjectIdPath"];
                 /** @description The ID of the image. */
                 imageId: components["parameters"]["ImageIdPath"];
+                /** @description The name of the preset family to deliver. */
+                presetName: string;
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Successfully deleted image */
-            200: {
+            /** @description Redirecting to the negotiated image */
+            302: {
+                headers: {
+                    /** @description The URL of the negotiated image */
+                    Location?: string;
+                    /** @description Entity tag of the negotiated image */
+                    ETag?: string;
+                    /** @description Caching policy of the redirection */
+                    "Cache-Control"?: string;
+                    /** @description Request headers the negotiation depends on */
+                    Vary?: string;
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description The image cached by the client is still valid */
+            304: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -30,4 +30,4 @@ export { default as PresetForm } from './components/admin/PresetForm.svelte';
 export { default as ApiKeyDisplay } from './components/admin/ApiKeyDisplay.svelte';
 
 // Types
-export { type PresetData } from './types/admin';
+export { type PresetData, serviceAccountPermissionOptions } from './types/admin';
@@ -1,5 +1,28 @@
 // Admin-related types
 
+import type { ServiceAccountPermission } from '$lib/api';
+
+export const serviceAccountPermissionOptions: { value: ServiceAccountPermission; label: string }[] =
+  [
+    { value: 'projects:read', label: 'projects:read - Read projects' },
+    { value: 'projects:write', label: 'projects:write - Create and change projects' },
+    { value: 'members:read', label: 'members:read - Read project members' },
+    { value: 'members:write', label: 'members:write - Manage project members' },
+    { value: 'images:read', label: 'images:read - Read images' },
+    { value: 'images:write', label: 'images:write - Upload and change images' },
+    { value: 'images:delete', label: 'images:delete - Delete images' },
+    { value: 'watermarks:read', label: 'watermarks:read - Read watermarks' },
+    { value: 'watermarks:write', label: 'watermarks:write - Upload and delete watermarks' },
+    { value: 'service-accounts:read', label: 'service-accounts:read - Read service accounts' },
+    {
+      value: 'service-accounts:write',
+      label: 'service-accounts:write - Manage service accounts',
+    },
+    { value: 'users:read', label: 'users:read - Read users' },
+    { value: 'users:write', label: 'users:write - Manage users' },
+    { value: 'audit-logs:read', label: 'audit-logs:read - Read audit logs' },
+  ];
+
 export interface PresetData {
   id?: string;
   name: string;
@@ -10,4 +33,8 @@ export interface PresetData {
   anchor?: string;
   width?: number;
   height?: number;
+  withoutEnlargement?: boolean;
+  effort?: number;
+  lossless?: boolean;
+  chromaSubsampling?: string;
 }
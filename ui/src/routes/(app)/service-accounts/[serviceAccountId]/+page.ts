@@ -5,21 +5,26 @@ import { env } from '$env/dynamic/public';
 export const load: PageLoad = async ({ fetch, params }) => {
   const client = createApiClient({ fetch, baseUrl: env.PUBLIC_API_BASE_URL });
 
-  // Load service account and projects in parallel
-  const [saResult, projectsResult] = await Promise.all([
+  // Load service account, its API keys and projects in parallel
+  const [saResult, apiKeysResult, projectsResult] = await Promise.all([
     client.GET('/api/v1/admin/service-accounts/{serviceAccountId}', {
       params: { path: { serviceAccountId: params.serviceAccountId } },
     }),
+    client.GET('/api/v1/admin/service-accounts/{serviceAccountId}/api-keys', {
+      params: { path: { serviceAccountId: params.serviceAccountId } },
+    }),
     client.GET('/api/v1/admin/projects', {
       params: { query: { offset: 0, limit: 100 } },
     }),
   ]);
 
   const serviceAccount = unwrap(saResult);
+  const apiKeys = unwrap(apiKeysResult);
   const projects = unwrap(projectsResult);
 
   return {
     serviceAccount,
+    apiKeys,
     projects,
   };
 };